@@ -64,8 +64,8 @@ type StructsTreeElement struct {
 
 // ManagementContractMetaData contains all meta data concerning the ManagementContract contract.
 var ManagementContractMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"_rollupData\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"crossChainData\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetHostAddresses\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"element\",\"type\":\"tuple\"}],\"name\":\"GetParentRollup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"rollupID\",\"type\":\"uint256\"}],\"name\":\"GetRollupByID\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"HasSecondCousinFork\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_aggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_hostAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"}],\"name\":\"InitializeTree\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
-	Bin: "0x608060405234801561001057600080fd5b5060405161001d9061009f565b604051809103906000f080158015610039573d6000803e3d6000fd5b50600a805462010000600160b01b031916620100006001600160a01b0393841681029190911791829055604051910490911681527fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf9060200160405180910390a16100ac565b610e9180611e1683390190565b611d5b806100bb6000396000f3fe608060405234801561001057600080fd5b50600436106100df5760003560e01c806373bba8461161008c578063a1a227fa11610066578063a1a227fa14610219578063a52f433c1461024a578063bbd79e151461025a578063e34fbfc81461026d57600080fd5b806373bba846146101e05780638236a7ba146101f357806392aaec791461020657600080fd5b806353e145f7116100bd57806353e145f7146101b057806357b70600146101c557806359a90071146101cd57600080fd5b806331b1d255146100e4578063324ff8661461015f57806343348b2f14610174575b600080fd5b6100f76100f2366004611559565b610280565b6040805192151583528151602080850191909152808301518483015291810151805160608086019190915292810151608080860191909152918101516001600160a01b031660a08501529182015160c0840152015160e0820152610100015b60405180910390f35b6101676102d6565b60405161015691906115e6565b6101a0610182366004611660565b6001600160a01b031660009081526001602052604090205460ff1690565b6040519015158152602001610156565b6101c36101be3660046116c4565b6103af565b005b6101a06104f9565b6101c36101db3660046117d8565b6106c3565b6101c36101ee36600461187d565b61074b565b6100f7610201366004611899565b61092e565b6100f7610214366004611899565b610982565b600a54610232906201000090046001600160a01b031681565b6040516001600160a01b039091168152602001610156565b600a54610100900460ff166101a0565b6101c36102683660046118b2565b610a38565b6101c361027b366004611974565b610b9b565b604080516060808201835260008083526020808401829052845160a08101865282815290810182905280850182905291820181905260808201819052928201526102cd8360200151610982565b91509150915091565b60606002805480602002602001604051908101604052809291908181526020016000905b828210156103a6578382906000526020600020018054610319906119b6565b80601f0160208091040260200160405190810160405280929190818152602001828054610345906119b6565b80156103925780601f1061036757610100808354040283529160200191610392565b820191906000526020600020905b81548152906001019060200180831161037557829003601f168201915b5050505050815260200190600101906102fa565b50505050905090565b600160006103c36060870160408801611660565b6001600160a01b0316815260208101919091526040016000205460ff166104315760405162461bcd60e51b815260206004820152601760248201527f61676772656761746f72206e6f7420617474657374656400000000000000000060448201526064015b60405180910390fd5b60095460ff166104525761044d6101ee3686900386018661187d565b6104f3565b60008061045f863561092e565b91509150816104b05760405162461bcd60e51b815260206004820152601a60248201527f756e61626c6520746f2066696e6420706172656e7420686173680000000000006044820152606401610428565b600754600210156104db5760006104c56104f9565b905080156104d957600a805461ff00191690555b505b80516104e79087610bba565b6104f083610d1f565b50505b50505050565b600080610504610de4565b905060008061051283610280565b91509150816105635760405162461bcd60e51b815260206004820152600960248201527f6e6f20706172656e7400000000000000000000000000000000000000000000006044820152606401610428565b60008061056f83610280565b91509150816105c05760405162461bcd60e51b815260206004820152600f60248201527f6e6f206772616e6420706172656e7400000000000000000000000000000000006044820152606401610428565b805160009081526005602090815260408083208054825181850281018501909352808352919290919083018282801561061857602002820191906000526020600020905b815481526020019060010190808311610604575b5050505050905060005b81518110156106b557600080610650848481518110610643576106436119f1565b6020026020010151610982565b9150915081610669576000995050505050505050505090565b86518151141561067a5750506106a3565b8051600090815260056020526040902054156106a0576001995050505050505050505090565b50505b806106ad81611a1d565b915050610622565b506000965050505050505090565b600a5460ff16156106d357600080fd5b600a8054600160ff1991821681179092556001600160a01b03881660009081526020838152604082208054909316841790925560028054938401815590528451610742927f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01918601906113a3565b50505050505050565b60095460ff161561079e5760405162461bcd60e51b815260206004820152601b60248201527f63616e6e6f7420626520696e697469616c697a656420616761696e00000000006044820152606401610428565b6009805460ff191660019081179091556040805160608082018352838252600060208084018281528486018881528784526003835294517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054c55517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054d55925180517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054e55808401517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054f55808501517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3055080546001600160a01b0390921673ffffffffffffffffffffffffffffffffffffffff19909216919091179055918201517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c30551556080909101517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3055255600784905560026008559381015184526004905290912055600a805461ff001916610100179055565b604080516060808201835260008083526020808401829052845160a08101865282815290810182905280850182905291820181905260808201819052928201526000838152600460205260409020546102cd905b604080516060808201835260008083526020808401829052845160a0810186528281529081018290528085018290529182018190526080820181905292820152505060009081526003602081815260409283902083516060808201865282548252600183015482850152855160a08101875260028401548152948301549385019390935260048201546001600160a01b031684860152600582015492840192909252600601546080830152918201528051151591565b6001600160a01b03861660009081526001602052604090205460ff1680610a5e57600080fd5b8115610b2e576000610a9488888688604051602001610a809493929190611a38565b604051602081830303815290604052610e9d565b90506000610aa28288610ed8565b9050886001600160a01b0316816001600160a01b031614610b2b5760405162461bcd60e51b815260206004820152602c60248201527f63616c63756c61746564206164647265737320616e642061747465737465724960448201527f4420646f6e74206d6174636800000000000000000000000000000000000000006064820152608401610428565b50505b6001600160a01b03861660009081526001602081815260408320805460ff1916831790556002805492830181559092528451610b91927f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace909201918601906113a3565b5050505050505050565b336000908152602081905260409020610bb5908383611427565b505050565b600880549081906000610bcc83611a1d565b9190505550600080610bdd85610982565b9150915081610c2e5760405162461bcd60e51b815260206004820152601060248201527f706172656e74206e6f7420666f756e64000000000000000000000000000000006044820152606401610428565b604051806060016040528084815260200186815260200185803603810190610c56919061187d565b90526000848152600360208181526040808420855181558583015160018083019190915595820151805160028301558084015194820194909455838201516004808301805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039093169290921790915560608501516005808401919091556080909501516006909201919091558a8552928252808420805495860181558452818420909401879055878101358352522083905560075481511415610d185760078390555b5050505050565b6000610d2e6040830183611a94565b9050905060005b81811015610bb557600a546201000090046001600160a01b0316639730886d610d616040860186611a94565b84818110610d7157610d716119f1565b9050602002810190610d839190611ade565b426040518363ffffffff1660e01b8152600401610da1929190611b92565b600060405180830381600087803b158015610dbb57600080fd5b505af1158015610dcf573d6000803e3d6000fd5b5050505080610ddd90611a1d565b9050610d35565b610e29604080516060808201835260008083526020808401829052845160a0810186528281529081018290528085018290529182018190526080820152909182015290565b5060075460009081526003602081815260409283902083516060808201865282548252600183015482850152855160a08101875260028401548152948301549385019390935260048201546001600160a01b0316848601526005820154928401929092526006015460808301529182015290565b6000610ea98251610efc565b82604051602001610ebb929190611c47565b604051602081830303815290604052805190602001209050919050565b6000806000610ee78585611036565b91509150610ef4816110a6565b509392505050565b606081610f3c57505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115610f665780610f5081611a1d565b9150610f5f9050600a83611cb8565b9150610f40565b60008167ffffffffffffffff811115610f8157610f816114b0565b6040519080825280601f01601f191660200182016040528015610fab576020820181803683370190505b5090505b841561102e57610fc0600183611ccc565b9150610fcd600a86611ce3565b610fd8906030611cf7565b60f81b818381518110610fed57610fed6119f1565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350611027600a86611cb8565b9450610faf565b949350505050565b60008082516041141561106d5760208301516040840151606085015160001a61106187828585611264565b9450945050505061109f565b825160401415611097576020830151604084015161108c868383611351565b93509350505061109f565b506000905060025b9250929050565b60008160048111156110ba576110ba611d0f565b14156110c35750565b60018160048111156110d7576110d7611d0f565b14156111255760405162461bcd60e51b815260206004820152601860248201527f45434453413a20696e76616c6964207369676e617475726500000000000000006044820152606401610428565b600281600481111561113957611139611d0f565b14156111875760405162461bcd60e51b815260206004820152601f60248201527f45434453413a20696e76616c6964207369676e6174757265206c656e677468006044820152606401610428565b600381600481111561119b5761119b611d0f565b14156111f45760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202773272076616c604482015261756560f01b6064820152608401610428565b600481600481111561120857611208611d0f565b14156112615760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202776272076616c604482015261756560f01b6064820152608401610428565b50565b6000807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a083111561129b5750600090506003611348565b8460ff16601b141580156112b357508460ff16601c14155b156112c45750600090506004611348565b6040805160008082526020820180845289905260ff881692820192909252606081018690526080810185905260019060a0016020604051602081039080840390855afa158015611318573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811661134157600060019250925050611348565b9150600090505b94509492505050565b6000807f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff83168161138760ff86901c601b611cf7565b905061139587828885611264565b935093505050935093915050565b8280546113af906119b6565b90600052602060002090601f0160209004810192826113d15760008555611417565b82601f106113ea57805160ff1916838001178555611417565b82800160010185558215611417579182015b828111156114175782518255916020019190600101906113fc565b5061142392915061149b565b5090565b828054611433906119b6565b90600052602060002090601f0160209004810192826114555760008555611417565b82601f1061146e5782800160ff19823516178555611417565b82800160010185558215611417579182015b82811115611417578235825591602001919060010190611480565b5b80821115611423576000815560010161149c565b634e487b7160e01b600052604160045260246000fd5b80356001600160a01b03811681146114dd57600080fd5b919050565b600060a082840312156114f457600080fd5b60405160a0810181811067ffffffffffffffff82111715611517576115176114b0565b80604052508091508235815260208301356020820152611539604084016114c6565b604082015260608301356060820152608083013560808201525092915050565b600060e0828403121561156b57600080fd5b6040516060810181811067ffffffffffffffff8211171561158e5761158e6114b0565b806040525082358152602083013560208201526115ae84604085016114e2565b60408201529392505050565b60005b838110156115d55781810151838201526020016115bd565b838111156104f35750506000910152565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101561165357878503603f1901845281518051808752611634818989018a85016115ba565b601f01601f19169590950186019450928501929085019060010161160d565b5092979650505050505050565b60006020828403121561167257600080fd5b61167b826114c6565b9392505050565b60008083601f84011261169457600080fd5b50813567ffffffffffffffff8111156116ac57600080fd5b60208301915083602082850101111561109f57600080fd5b60008060008084860360e08112156116db57600080fd5b60a08112156116e957600080fd5b5084935060a085013567ffffffffffffffff8082111561170857600080fd5b61171488838901611682565b909550935060c087013591508082111561172d57600080fd5b5085016060818803121561174057600080fd5b939692955090935050565b600082601f83011261175c57600080fd5b813567ffffffffffffffff80821115611777576117776114b0565b604051601f8301601f19908116603f0116810190828211818310171561179f5761179f6114b0565b816040528381528660208588010111156117b857600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080600080600080608087890312156117f157600080fd5b6117fa876114c6565b9550602087013567ffffffffffffffff8082111561181757600080fd5b6118238a838b01611682565b9097509550604089013591508082111561183c57600080fd5b6118488a838b0161174b565b9450606089013591508082111561185e57600080fd5b5061186b89828a01611682565b979a9699509497509295939492505050565b600060a0828403121561188f57600080fd5b61167b83836114e2565b6000602082840312156118ab57600080fd5b5035919050565b60008060008060008060c087890312156118cb57600080fd5b6118d4876114c6565b95506118e2602088016114c6565b9450604087013567ffffffffffffffff808211156118ff57600080fd5b61190b8a838b0161174b565b9550606089013591508082111561192157600080fd5b61192d8a838b0161174b565b9450608089013591508082111561194357600080fd5b5061195089828a0161174b565b92505060a0870135801515811461196657600080fd5b809150509295509295509295565b6000806020838503121561198757600080fd5b823567ffffffffffffffff81111561199e57600080fd5b6119aa85828601611682565b90969095509350505050565b600181811c908216806119ca57607f821691505b602082108114156119eb57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b6000600019821415611a3157611a31611a07565b5060010190565b60006bffffffffffffffffffffffff19808760601b168352808660601b166014840152508351611a6f8160288501602088016115ba565b835190830190611a868160288401602088016115ba565b016028019695505050505050565b6000808335601e19843603018112611aab57600080fd5b83018035915067ffffffffffffffff821115611ac657600080fd5b6020019150600581901b360382131561109f57600080fd5b6000823560be19833603018112611af457600080fd5b9190910192915050565b803563ffffffff811681146114dd57600080fd5b6000808335601e19843603018112611b2957600080fd5b830160208101925035905067ffffffffffffffff811115611b4957600080fd5b80360383131561109f57600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b803560ff811681146114dd57600080fd5b604081526001600160a01b03611ba7846114c6565b1660408201526000602084013567ffffffffffffffff8116808214611bcb57600080fd5b60608401525063ffffffff611be260408601611afe565b166080830152611bf460608501611afe565b63ffffffff1660a0830152611c0c6080850185611b12565b60c080850152611c2161010085018284611b58565b915050611c3060a08601611b81565b60ff1660e084015260209092019290925292915050565b7f19457468657265756d205369676e6564204d6573736167653a0a000000000000815260008351611c7f81601a8501602088016115ba565b835190830190611c9681601a8401602088016115ba565b01601a01949350505050565b634e487b7160e01b600052601260045260246000fd5b600082611cc757611cc7611ca2565b500490565b600082821015611cde57611cde611a07565b500390565b600082611cf257611cf2611ca2565b500690565b60008219821115611d0a57611d0a611a07565b500190565b634e487b7160e01b600052602160045260246000fdfea264697066735822122079df961687d519ac47544d3b6b0ac1d9462daf4970831e05c5a83a2351b5eaff64736f6c63430008090033608060405234801561001057600080fd5b5061001a3361001f565b61006f565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b610e138061007e6000396000f3fe6080604052600436106100745760003560e01c80638da5cb5b1161004e5780638da5cb5b146101ae5780639730886d146101d6578063b1454caa146101f6578063f2fde38b1461022f576100ec565b80630fcfbd111461013457806333a88c7214610167578063715018a614610197576100ec565b366100ec5760405162461bcd60e51b815260206004820152602c60248201527f74686520576f726d686f6c6520636f6e747261637420646f6573206e6f74206160448201527f636365707420617373657473000000000000000000000000000000000000000060648201526084015b60405180910390fd5b60405162461bcd60e51b815260206004820152600b60248201527f756e737570706f7274656400000000000000000000000000000000000000000060448201526064016100e3565b34801561014057600080fd5b5061015461014f366004610770565b61024f565b6040519081526020015b60405180910390f35b34801561017357600080fd5b50610187610182366004610770565b610305565b604051901515815260200161015e565b3480156101a357600080fd5b506101ac610358565b005b3480156101ba57600080fd5b506000546040516001600160a01b03909116815260200161015e565b3480156101e257600080fd5b506101ac6101f13660046107a5565b6103be565b34801561020257600080fd5b5061021661021136600461081b565b610562565b60405167ffffffffffffffff909116815260200161015e565b34801561023b57600080fd5b506101ac61024a3660046108dd565b6105bb565b600080826040516020016102639190610939565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150806102fe5760405162461bcd60e51b815260206004820152602160248201527f54686973206d65737361676520776173206e65766572207375626d697474656460448201527f2e0000000000000000000000000000000000000000000000000000000000000060648201526084016100e3565b9392505050565b600080826040516020016103199190610939565b60408051601f1981840301815291815281516020928301206000818152600190935291205490915080158015906103505750428111155b949350505050565b6000546001600160a01b031633146103b25760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016100e3565b6103bc600061069d565b565b6000546001600160a01b031633146104185760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016100e3565b60006104248242610a39565b90506000836040516020016104399190610939565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150156104d45760405162461bcd60e51b815260206004820152602160248201527f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636560448201527f210000000000000000000000000000000000000000000000000000000000000060648201526084016100e3565b60008181526001602090815260408220849055600291906104f7908701876108dd565b6001600160a01b0316815260208101919091526040016000908120906105236080870160608801610a51565b63ffffffff1681526020808201929092526040016000908120805460018101825590825291902085916004020161055a8282610c33565b505050505050565b600061056d336106fa565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef775937338288888888886040516105aa9796959493929190610d51565b60405180910390a195945050505050565b6000546001600160a01b031633146106155760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016100e3565b6001600160a01b0381166106915760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201527f646472657373000000000000000000000000000000000000000000000000000060648201526084016100e3565b61069a8161069d565b50565b600080546001600160a01b0383811673ffffffffffffffffffffffffffffffffffffffff19831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b0381166000908152600360205260408120805467ffffffffffffffff16916001919061072d8385610db1565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b600060c0828403121561076a57600080fd5b50919050565b60006020828403121561078257600080fd5b813567ffffffffffffffff81111561079957600080fd5b61035084828501610758565b600080604083850312156107b857600080fd5b823567ffffffffffffffff8111156107cf57600080fd5b6107db85828601610758565b95602094909401359450505050565b63ffffffff8116811461069a57600080fd5b60ff8116811461069a57600080fd5b8035610816816107fc565b919050565b60008060008060006080868803121561083357600080fd5b853561083e816107ea565b9450602086013561084e816107ea565b9350604086013567ffffffffffffffff8082111561086b57600080fd5b818801915088601f83011261087f57600080fd5b81358181111561088e57600080fd5b8960208285010111156108a057600080fd5b60208301955080945050505060608601356108ba816107fc565b809150509295509295909350565b6001600160a01b038116811461069a57600080fd5b6000602082840312156108ef57600080fd5b81356102fe816108c8565b67ffffffffffffffff8116811461069a57600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b602081526000823561094a816108c8565b6001600160a01b0381166020840152506020830135610968816108fa565b67ffffffffffffffff808216604085015260408501359150610989826107ea565b63ffffffff8083166060860152606086013592506109a6836107ea565b80831660808601525060808501359150601e198536030182126109c857600080fd5b908401908135818111156109db57600080fd5b8036038613156109ea57600080fd5b60c060a0860152610a0260e086018260208601610910565b92505050610a1260a0850161080b565b60ff811660c0850152509392505050565b634e487b7160e01b600052601160045260246000fd5b60008219821115610a4c57610a4c610a23565b500190565b600060208284031215610a6357600080fd5b81356102fe816107ea565b60008135610a7b816107ea565b92915050565b6000808335601e19843603018112610a9857600080fd5b83018035915067ffffffffffffffff821115610ab357600080fd5b602001915036819003821315610ac857600080fd5b9250929050565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680610af957607f821691505b6020821081141561076a57634e487b7160e01b600052602260045260246000fd5b601f821115610b6057600081815260208120601f850160051c81016020861015610b415750805b601f850160051c820191505b8181101561055a57828155600101610b4d565b505050565b67ffffffffffffffff831115610b7d57610b7d610acf565b610b9183610b8b8354610ae5565b83610b1a565b6000601f841160018114610bc55760008515610bad5750838201355b600019600387901b1c1916600186901b178355610c1f565b600083815260209020601f19861690835b82811015610bf65786850135825560209485019460019092019101610bd6565b5086821015610c135760001960f88860031b161c19848701351681555b505060018560011b0183555b5050505050565b60008135610a7b816107fc565b8135610c3e816108c8565b6001600160a01b038116905081548173ffffffffffffffffffffffffffffffffffffffff1982161783556020840135610c76816108fa565b7bffffffffffffffff00000000000000000000000000000000000000008160a01b1690507fffffffff0000000000000000000000000000000000000000000000000000000081848285161717855560408601359250610cd4836107ea565b921760e09190911b909116178155610d0c610cf160608401610a6e565b6001830163ffffffff821663ffffffff198254161781555050565b610d196080830183610a81565b610d27818360028601610b65565b5050610d4d610d3860a08401610c26565b6003830160ff821660ff198254161781555050565b5050565b6001600160a01b038816815267ffffffffffffffff87166020820152600063ffffffff808816604084015280871660608401525060c06080830152610d9a60c083018587610910565b905060ff831660a083015298975050505050505050565b600067ffffffffffffffff808316818516808303821115610dd457610dd4610a23565b0194935050505056fea2646970667358221220e790a069b7a49368e0f1c281855881b133f1eac9bbac989876cb3bc659282fbe64736f6c63430008090033",
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"hostID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"}],\"name\":\"HostRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"aggregatorID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"initSecret\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"genesisAttestation\",\"type\":\"string\"}],\"name\":\"NetworkSecretInitialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requester\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"NetworkSecretRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"}],\"name\":\"NetworkSecretResponded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"aggregatorID\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"rollupData\",\"type\":\"string\"}],\"name\":\"RollupAdded\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"_rollupData\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"crossChainData\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetHostAddresses\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"element\",\"type\":\"tuple\"}],\"name\":\"GetParentRollup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"rollupID\",\"type\":\"uint256\"}],\"name\":\"GetRollupByID\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"ElementID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"ParentID\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"rollup\",\"type\":\"tuple\"}],\"internalType\":\"structStructs.TreeElement\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"HasSecondCousinFork\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_aggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_hostAddress\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"ParentHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"AggregatorID\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"L1Block\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"Number\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"}],\"name\":\"InitializeTree\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"hostAddress\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5060405161001d9061009f565b604051809103906000f080158015610039573d6000803e3d6000fd5b50600a805462010000600160b01b031916620100006001600160a01b0393841681029190911791829055604051910490911681527fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf9060200160405180910390a16100ad565b610e8880620020b183390190565b611ff480620000bd6000396000f3fe608060405234801561001057600080fd5b50600436106100df5760003560e01c806373bba8461161008c578063a1a227fa11610066578063a1a227fa14610219578063a52f433c1461024a578063bbd79e151461025a578063e34fbfc81461026d57600080fd5b806373bba846146101e05780638236a7ba146101f357806392aaec791461020657600080fd5b806353e145f7116100bd57806353e145f7146101b057806357b70600146101c557806359a90071146101cd57600080fd5b806331b1d255146100e4578063324ff8661461015f57806343348b2f14610174575b600080fd5b6100f76100f236600461157b565b610280565b6040805192151583528151602080850191909152808301518483015291810151805160608086019190915292810151608080860191909152918101516001600160a01b031660a08501529182015160c0840152015160e0820152610100015b60405180910390f35b6101676102d6565b604051610156919061162c565b6101a061018236600461168e565b6001600160a01b031660009081526001602052604090205460ff1690565b6040519015158152602001610156565b6101c36101be3660046116f2565b6103af565b005b6101a0610550565b6101c36101db366004611806565b61069f565b6101c36101ee3660046118ab565b6107ac565b6100f76102013660046118c7565b61098f565b6100f76102143660046118c7565b6109e3565b600a54610232906201000090046001600160a01b031681565b6040516001600160a01b039091168152602001610156565b600a54610100900460ff166101a0565b6101c36102683660046118e0565b610a99565b6101c361027b3660046119a2565b610c85565b604080516060808201835260008083526020808401829052845160a08101865282815290810182905280850182905291820181905260808201819052928201526102cd83602001516109e3565b91509150915091565b60606002805480602002602001604051908101604052809291908181526020016000905b828210156103a6578382906000526020600020018054610319906119e4565b80601f0160208091040260200160405190810160405280929190818152602001828054610345906119e4565b80156103925780601f1061036757610100808354040283529160200191610392565b820191906000526020600020905b81548152906001019060200180831161037557829003601f168201915b5050505050815260200190600101906102fa565b50505050905090565b600160006103c3606087016040880161168e565b6001600160a01b0316815260208101919091526040016000205460ff166104315760405162461bcd60e51b815260206004820152601760248201527f61676772656761746f72206e6f7420617474657374656400000000000000000060448201526064015b60405180910390fd5b610441606085016040860161168e565b6001600160a01b031684602001357fa153c21aa71e7fe30e5ee458cbc9ee4216c114ff6538d24b5a9545338bacde818585604051610480929190611a47565b60405180910390a360095460ff166104a9576104a46101ee368690038601866118ab565b61054a565b6000806104b6863561098f565b91509150816105075760405162461bcd60e51b815260206004820152601a60248201527f756e61626c6520746f2066696e6420706172656e7420686173680000000000006044820152606401610428565b80516105139087610ce7565b600a54610100900460ff16801561052d575061052d610550565b1561053e57600a805461ff00191690555b61054783610e4b565b50505b50505050565b60008061055b610f15565b905060008061056983610280565b915091508161057c576000935050505090565b60008061058883610280565b915091508161059d5760009550505050505090565b80516000908152600560209081526040808320805482518185028101850190935280835291929091908301828280156105f557602002820191906000526020600020905b8154815260200190600101908083116105e1575b5050505050905060005b81518110156106915760008061062d84848151811061062057610620611a5b565b60200260200101516109e3565b9150915081610646576000995050505050505050505090565b865181510361065657505061067f565b80516000908152600560205260409020541561067c576001995050505050505050505090565b50505b8061068981611a87565b9150506105ff565b506000965050505050505090565b600a5460ff16156106af57600080fd5b600a8054600160ff1991821681179092556001600160a01b03881660009081526020839052604081208054909216831790915560028054928301815590527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace016107198482611ae6565b50856001600160a01b03167f060f17118170663d8538d657b444736b310e4bd1732eb9a8bb48ea076a955d3d868686868660405161075b959493929190611ba6565b60405180910390a2856001600160a01b03167f21b4d5f10dbcc003395cb6157114fd420591dfdb2abb92446c4473393fa0d1b28460405161079c9190611bed565b60405180910390a2505050505050565b60095460ff16156107ff5760405162461bcd60e51b815260206004820152601b60248201527f63616e6e6f7420626520696e697469616c697a656420616761696e00000000006044820152606401610428565b6009805460ff191660019081179091556040805160608082018352838252600060208084018281528486018881528784526003835294517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054c55517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054d55925180517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054e55808401517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3054f55808501517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3055080546001600160a01b0390921673ffffffffffffffffffffffffffffffffffffffff19909216919091179055918201517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c30551556080909101517fa15bc60c955c405d20d9149c709e2460f1c2d9a497496a7f46004d1772c3055255600784905560026008559381015184526004905290912055600a805461ff001916610100179055565b604080516060808201835260008083526020808401829052845160a08101865282815290810182905280850182905291820181905260808201819052928201526000838152600460205260409020546102cd905b604080516060808201835260008083526020808401829052845160a0810186528281529081018290528085018290529182018190526080820181905292820152505060009081526003602081815260409283902083516060808201865282548252600183015482850152855160a08101875260028401548152948301549385019390935260048201546001600160a01b031684860152600582015492840192909252600601546080830152918201528051151591565b6001600160a01b03861660009081526001602052604090205460ff1680610abf57600080fd5b8115610b8f576000610af588888688604051602001610ae19493929190611c00565b604051602081830303815290604052610fce565b90506000610b038288611009565b9050886001600160a01b0316816001600160a01b031614610b8c5760405162461bcd60e51b815260206004820152602c60248201527f63616c63756c61746564206164647265737320616e642061747465737465724960448201527f4420646f6e74206d6174636800000000000000000000000000000000000000006064820152608401610428565b50505b6001600160a01b03861660009081526001602081905260408220805460ff1916821790556002805491820181559091527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01610beb8482611ae6565b50856001600160a01b0316876001600160a01b03167fa043eb490e8e8d161ff77cb1464968946f88044430d1a809eab94a380130310d878787604051610c3393929190611c5c565b60405180910390a3856001600160a01b03167f21b4d5f10dbcc003395cb6157114fd420591dfdb2abb92446c4473393fa0d1b284604051610c749190611bed565b60405180910390a250505050505050565b336000908152602081905260409020610c9f828483611c9f565b50336001600160a01b03167f0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d43018383604051610cdb929190611a47565b60405180910390a25050565b600880549081906000610cf983611a87565b9190505550600080610d0a856109e3565b9150915081610d5b5760405162461bcd60e51b815260206004820152601060248201527f706172656e74206e6f7420666f756e64000000000000000000000000000000006044820152606401610428565b604051806060016040528084815260200186815260200185803603810190610d8391906118ab565b90526000848152600360208181526040808420855181558583015160018083019190915595820151805160028301558084015194820194909455838201516004808301805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039093169290921790915560608501516005808401919091556080909501516006909201919091558a85529282528084208054958601815584528184209094018790558781013583525220839055600754815103610e445760078390555b5050505050565b6000610e5a6040830183611d5f565b9050905060005b81811015610f1057600a546201000090046001600160a01b0316639730886d610e8d6040860186611d5f565b84818110610e9d57610e9d611a5b565b9050602002810190610eaf9190611da9565b426040518363ffffffff1660e01b8152600401610ecd929190611e34565b600060405180830381600087803b158015610ee757600080fd5b505af1158015610efb573d6000803e3d6000fd5b5050505080610f0990611a87565b9050610e61565b505050565b610f5a604080516060808201835260008083526020808401829052845160a0810186528281529081018290528085018290529182018190526080820152909182015290565b5060075460009081526003602081815260409283902083516060808201865282548252600183015482850152855160a08101875260028401548152948301549385019390935260048201546001600160a01b0316848601526005820154928401929092526006015460808301529182015290565b6000610fda825161102f565b82604051602001610fec929190611ee9565b604051602081830303815290604052805190602001209050919050565b6000806000611018858561116c565b91509150611025816111da565b5090505b92915050565b60608160000361107257505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b811561109c578061108681611a87565b91506110959050600a83611f5a565b9150611076565b60008167ffffffffffffffff8111156110b7576110b76114d2565b6040519080825280601f01601f1916602001820160405280156110e1576020820181803683370190505b5090505b8415611164576110f6600183611f6e565b9150611103600a86611f81565b61110e906030611f95565b60f81b81838151811061112357611123611a5b565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535061115d600a86611f5a565b94506110e5565b949350505050565b60008082516041036111a25760208301516040840151606085015160001a61119687828585611393565b945094505050506111d3565b82516040036111cb57602083015160408401516111c0868383611480565b9350935050506111d3565b506000905060025b9250929050565b60008160048111156111ee576111ee611fa8565b036111f65750565b600181600481111561120a5761120a611fa8565b036112575760405162461bcd60e51b815260206004820152601860248201527f45434453413a20696e76616c6964207369676e617475726500000000000000006044820152606401610428565b600281600481111561126b5761126b611fa8565b036112b85760405162461bcd60e51b815260206004820152601f60248201527f45434453413a20696e76616c6964207369676e6174757265206c656e677468006044820152606401610428565b60038160048111156112cc576112cc611fa8565b036113245760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202773272076616c604482015261756560f01b6064820152608401610428565b600481600481111561133857611338611fa8565b036113905760405162461bcd60e51b815260206004820152602260248201527f45434453413a20696e76616c6964207369676e6174757265202776272076616c604482015261756560f01b6064820152608401610428565b50565b6000807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08311156113ca5750600090506003611477565b8460ff16601b141580156113e257508460ff16601c14155b156113f35750600090506004611477565b6040805160008082526020820180845289905260ff881692820192909252606081018690526080810185905260019060a0016020604051602081039080840390855afa158015611447573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811661147057600060019250925050611477565b9150600090505b94509492505050565b6000807f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8316816114b660ff86901c601b611f95565b90506114c487828885611393565b935093505050935093915050565b634e487b7160e01b600052604160045260246000fd5b80356001600160a01b03811681146114ff57600080fd5b919050565b600060a0828403121561151657600080fd5b60405160a0810181811067ffffffffffffffff82111715611539576115396114d2565b8060405250809150823581526020830135602082015261155b604084016114e8565b604082015260608301356060820152608083013560808201525092915050565b600060e0828403121561158d57600080fd5b6040516060810181811067ffffffffffffffff821117156115b0576115b06114d2565b806040525082358152602083013560208201526115d08460408501611504565b60408201529392505050565b60005b838110156115f75781810151838201526020016115df565b50506000910152565b600081518084526116188160208601602086016115dc565b601f01601f19169290920160200192915050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101561168157603f1988860301845261166f858351611600565b94509285019290850190600101611653565b5092979650505050505050565b6000602082840312156116a057600080fd5b6116a9826114e8565b9392505050565b60008083601f8401126116c257600080fd5b50813567ffffffffffffffff8111156116da57600080fd5b6020830191508360208285010111156111d357600080fd5b60008060008084860360e081121561170957600080fd5b60a081121561171757600080fd5b5084935060a085013567ffffffffffffffff8082111561173657600080fd5b611742888389016116b0565b909550935060c087013591508082111561175b57600080fd5b5085016060818803121561176e57600080fd5b939692955090935050565b600082601f83011261178a57600080fd5b813567ffffffffffffffff808211156117a5576117a56114d2565b604051601f8301601f19908116603f011681019082821181831017156117cd576117cd6114d2565b816040528381528660208588010111156117e657600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806000806000806080878903121561181f57600080fd5b611828876114e8565b9550602087013567ffffffffffffffff8082111561184557600080fd5b6118518a838b016116b0565b9097509550604089013591508082111561186a57600080fd5b6118768a838b01611779565b9450606089013591508082111561188c57600080fd5b5061189989828a016116b0565b979a9699509497509295939492505050565b600060a082840312156118bd57600080fd5b6116a98383611504565b6000602082840312156118d957600080fd5b5035919050565b60008060008060008060c087890312156118f957600080fd5b611902876114e8565b9550611910602088016114e8565b9450604087013567ffffffffffffffff8082111561192d57600080fd5b6119398a838b01611779565b9550606089013591508082111561194f57600080fd5b61195b8a838b01611779565b9450608089013591508082111561197157600080fd5b5061197e89828a01611779565b92505060a0870135801515811461199457600080fd5b809150509295509295509295565b600080602083850312156119b557600080fd5b823567ffffffffffffffff8111156119cc57600080fd5b6119d8858286016116b0565b90969095509350505050565b600181811c908216806119f857607f821691505b602082108103611a1857634e487b7160e01b600052602260045260246000fd5b50919050565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b602081526000611164602083018486611a1e565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b600060018201611a9957611a99611a71565b5060010190565b601f821115610f1057600081815260208120601f850160051c81016020861015611ac75750805b601f850160051c820191505b8181101561054757828155600101611ad3565b815167ffffffffffffffff811115611b0057611b006114d2565b611b1481611b0e84546119e4565b84611aa0565b602080601f831160018114611b495760008415611b315750858301515b600019600386901b1c1916600185901b178555610547565b600085815260208120601f198616915b82811015611b7857888601518255948401946001909101908401611b59565b5085821015611b965787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b606081526000611bba606083018789611a1e565b8281036020840152611bcc8187611600565b90508281036040840152611be1818587611a1e565b98975050505050505050565b6020815260006116a96020830184611600565b60006bffffffffffffffffffffffff19808760601b168352808660601b166014840152508351611c378160288501602088016115dc565b835190830190611c4e8160288401602088016115dc565b016028019695505050505050565b606081526000611c6f6060830186611600565b8281036020840152611c818186611600565b90508281036040840152611c958185611600565b9695505050505050565b67ffffffffffffffff831115611cb757611cb76114d2565b611ccb83611cc583546119e4565b83611aa0565b6000601f841160018114611cff5760008515611ce75750838201355b600019600387901b1c1916600186901b178355610e44565b600083815260209020601f19861690835b82811015611d305786850135825560209485019460019092019101611d10565b5086821015611d4d5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b6000808335601e19843603018112611d7657600080fd5b83018035915067ffffffffffffffff821115611d9157600080fd5b6020019150600581901b36038213156111d357600080fd5b6000823560be19833603018112611dbf57600080fd5b9190910192915050565b803563ffffffff811681146114ff57600080fd5b6000808335601e19843603018112611df457600080fd5b830160208101925035905067ffffffffffffffff811115611e1457600080fd5b8036038213156111d357600080fd5b803560ff811681146114ff57600080fd5b604081526001600160a01b03611e49846114e8565b1660408201526000602084013567ffffffffffffffff8116808214611e6d57600080fd5b60608401525063ffffffff611e8460408601611dc9565b166080830152611e9660608501611dc9565b63ffffffff1660a0830152611eae6080850185611ddd565b60c080850152611ec361010085018284611a1e565b915050611ed260a08601611e23565b60ff1660e084015260209092019290925292915050565b7f19457468657265756d205369676e6564204d6573736167653a0a000000000000815260008351611f2181601a8501602088016115dc565b835190830190611f3881601a8401602088016115dc565b01601a01949350505050565b634e487b7160e01b600052601260045260246000fd5b600082611f6957611f69611f44565b500490565b8181038181111561102957611029611a71565b600082611f9057611f90611f44565b500690565b8082018082111561102957611029611a71565b634e487b7160e01b600052602160045260246000fdfea264697066735822122080bd303e51b88a0e5ef769c1812529be7bbf176a982a105fb14f481480cc7ca764736f6c63430008150033608060405234801561001057600080fd5b5061001a3361001f565b61006f565b600080546001600160a01b038381166001600160a01b0319831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b610e0a8061007e6000396000f3fe6080604052600436106100745760003560e01c80638da5cb5b1161004e5780638da5cb5b146101ae5780639730886d146101d6578063b1454caa146101f6578063f2fde38b1461022f576100ec565b80630fcfbd111461013457806333a88c7214610167578063715018a614610197576100ec565b366100ec5760405162461bcd60e51b815260206004820152602c60248201527f74686520576f726d686f6c6520636f6e747261637420646f6573206e6f74206160448201527f636365707420617373657473000000000000000000000000000000000000000060648201526084015b60405180910390fd5b60405162461bcd60e51b815260206004820152600b60248201527f756e737570706f7274656400000000000000000000000000000000000000000060448201526064016100e3565b34801561014057600080fd5b5061015461014f366004610770565b61024f565b6040519081526020015b60405180910390f35b34801561017357600080fd5b50610187610182366004610770565b610305565b604051901515815260200161015e565b3480156101a357600080fd5b506101ac610358565b005b3480156101ba57600080fd5b506000546040516001600160a01b03909116815260200161015e565b3480156101e257600080fd5b506101ac6101f13660046107a5565b6103be565b34801561020257600080fd5b5061021661021136600461081b565b610562565b60405167ffffffffffffffff909116815260200161015e565b34801561023b57600080fd5b506101ac61024a3660046108dd565b6105bb565b600080826040516020016102639190610939565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150806102fe5760405162461bcd60e51b815260206004820152602160248201527f54686973206d65737361676520776173206e65766572207375626d697474656460448201527f2e0000000000000000000000000000000000000000000000000000000000000060648201526084016100e3565b9392505050565b600080826040516020016103199190610939565b60408051601f1981840301815291815281516020928301206000818152600190935291205490915080158015906103505750428111155b949350505050565b6000546001600160a01b031633146103b25760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016100e3565b6103bc600061069d565b565b6000546001600160a01b031633146104185760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016100e3565b60006104248242610a3a565b90506000836040516020016104399190610939565b60408051601f19818403018152918152815160209283012060008181526001909352912054909150156104d45760405162461bcd60e51b815260206004820152602160248201527f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636560448201527f210000000000000000000000000000000000000000000000000000000000000060648201526084016100e3565b60008181526001602090815260408220849055600291906104f7908701876108dd565b6001600160a01b0316815260208101919091526040016000908120906105236080870160608801610a53565b63ffffffff1681526020808201929092526040016000908120805460018101825590825291902085916004020161055a8282610c2e565b505050505050565b600061056d336106fa565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef775937338288888888886040516105aa9796959493929190610d4c565b60405180910390a195945050505050565b6000546001600160a01b031633146106155760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016100e3565b6001600160a01b0381166106915760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201527f646472657373000000000000000000000000000000000000000000000000000060648201526084016100e3565b61069a8161069d565b50565b600080546001600160a01b0383811673ffffffffffffffffffffffffffffffffffffffff19831681178455604051919092169283917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e09190a35050565b6001600160a01b0381166000908152600360205260408120805467ffffffffffffffff16916001919061072d8385610dac565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b600060c0828403121561076a57600080fd5b50919050565b60006020828403121561078257600080fd5b813567ffffffffffffffff81111561079957600080fd5b61035084828501610758565b600080604083850312156107b857600080fd5b823567ffffffffffffffff8111156107cf57600080fd5b6107db85828601610758565b95602094909401359450505050565b63ffffffff8116811461069a57600080fd5b60ff8116811461069a57600080fd5b8035610816816107fc565b919050565b60008060008060006080868803121561083357600080fd5b853561083e816107ea565b9450602086013561084e816107ea565b9350604086013567ffffffffffffffff8082111561086b57600080fd5b818801915088601f83011261087f57600080fd5b81358181111561088e57600080fd5b8960208285010111156108a057600080fd5b60208301955080945050505060608601356108ba816107fc565b809150509295509295909350565b6001600160a01b038116811461069a57600080fd5b6000602082840312156108ef57600080fd5b81356102fe816108c8565b67ffffffffffffffff8116811461069a57600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b602081526000823561094a816108c8565b6001600160a01b0381166020840152506020830135610968816108fa565b67ffffffffffffffff808216604085015260408501359150610989826107ea565b63ffffffff8083166060860152606086013592506109a6836107ea565b80831660808601525060808501359150601e198536030182126109c857600080fd5b60209185019182019135818111156109df57600080fd5b8036038313156109ee57600080fd5b60c060a0860152610a0360e086018285610910565b92505050610a1360a0850161080b565b60ff811660c0850152509392505050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610a4d57610a4d610a24565b92915050565b600060208284031215610a6557600080fd5b81356102fe816107ea565b60008135610a4d816107ea565b6000808335601e19843603018112610a9457600080fd5b83018035915067ffffffffffffffff821115610aaf57600080fd5b602001915036819003821315610ac457600080fd5b9250929050565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680610af557607f821691505b60208210810361076a57634e487b7160e01b600052602260045260246000fd5b601f821115610b5b57600081815260208120601f850160051c81016020861015610b3c5750805b601f850160051c820191505b8181101561055a57828155600101610b48565b505050565b67ffffffffffffffff831115610b7857610b78610acb565b610b8c83610b868354610ae1565b83610b15565b6000601f841160018114610bc05760008515610ba85750838201355b600019600387901b1c1916600186901b178355610c1a565b600083815260209020601f19861690835b82811015610bf15786850135825560209485019460019092019101610bd1565b5086821015610c0e5760001960f88860031b161c19848701351681555b505060018560011b0183555b5050505050565b60008135610a4d816107fc565b8135610c39816108c8565b6001600160a01b038116905081548173ffffffffffffffffffffffffffffffffffffffff1982161783556020840135610c71816108fa565b7bffffffffffffffff00000000000000000000000000000000000000008160a01b1690507fffffffff0000000000000000000000000000000000000000000000000000000081848285161717855560408601359250610ccf836107ea565b921760e09190911b909116178155610d07610cec60608401610a70565b6001830163ffffffff821663ffffffff198254161781555050565b610d146080830183610a7d565b610d22818360028601610b60565b5050610d48610d3360a08401610c21565b6003830160ff821660ff198254161781555050565b5050565b6001600160a01b038816815267ffffffffffffffff87166020820152600063ffffffff808816604084015280871660608401525060c06080830152610d9560c083018587610910565b905060ff831660a083015298975050505050505050565b67ffffffffffffffff818116838216019080821115610dcd57610dcd610a24565b509291505056fea26469706673582212202e6db2203e49e532f7e25456b040b52a99044eae9c4ac415300206b9eae79d0164736f6c63430008150033",
 }
 
 // ManagementContractABI is the input ABI used to generate the binding from.
@@ -591,6 +591,151 @@ func (_ManagementContract *ManagementContractTransactorSession) RespondNetworkSe
 	return _ManagementContract.Contract.RespondNetworkSecret(&_ManagementContract.TransactOpts, attesterID, requesterID, attesterSig, responseSecret, hostAddress, verifyAttester)
 }
 
+// ManagementContractHostRegisteredIterator is returned from FilterHostRegistered and is used to iterate over the raw logs and unpacked data for HostRegistered events raised by the ManagementContract contract.
+type ManagementContractHostRegisteredIterator struct {
+	Event *ManagementContractHostRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractHostRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractHostRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractHostRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractHostRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractHostRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractHostRegistered represents a HostRegistered event raised by the ManagementContract contract.
+type ManagementContractHostRegistered struct {
+	HostID      common.Address
+	HostAddress string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterHostRegistered is a free log retrieval operation binding the contract event 0x21b4d5f10dbcc003395cb6157114fd420591dfdb2abb92446c4473393fa0d1b2.
+//
+// Solidity: event HostRegistered(address indexed hostID, string hostAddress)
+func (_ManagementContract *ManagementContractFilterer) FilterHostRegistered(opts *bind.FilterOpts, hostID []common.Address) (*ManagementContractHostRegisteredIterator, error) {
+
+	var hostIDRule []interface{}
+	for _, hostIDItem := range hostID {
+		hostIDRule = append(hostIDRule, hostIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "HostRegistered", hostIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractHostRegisteredIterator{contract: _ManagementContract.contract, event: "HostRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchHostRegistered is a free log subscription operation binding the contract event 0x21b4d5f10dbcc003395cb6157114fd420591dfdb2abb92446c4473393fa0d1b2.
+//
+// Solidity: event HostRegistered(address indexed hostID, string hostAddress)
+func (_ManagementContract *ManagementContractFilterer) WatchHostRegistered(opts *bind.WatchOpts, sink chan<- *ManagementContractHostRegistered, hostID []common.Address) (event.Subscription, error) {
+
+	var hostIDRule []interface{}
+	for _, hostIDItem := range hostID {
+		hostIDRule = append(hostIDRule, hostIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "HostRegistered", hostIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractHostRegistered)
+				if err := _ManagementContract.contract.UnpackLog(event, "HostRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseHostRegistered is a log parse operation binding the contract event 0x21b4d5f10dbcc003395cb6157114fd420591dfdb2abb92446c4473393fa0d1b2.
+//
+// Solidity: event HostRegistered(address indexed hostID, string hostAddress)
+func (_ManagementContract *ManagementContractFilterer) ParseHostRegistered(log types.Log) (*ManagementContractHostRegistered, error) {
+	event := new(ManagementContractHostRegistered)
+	if err := _ManagementContract.contract.UnpackLog(event, "HostRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ManagementContractLogManagementContractCreatedIterator is returned from FilterLogManagementContractCreated and is used to iterate over the raw logs and unpacked data for LogManagementContractCreated events raised by the ManagementContract contract.
 type ManagementContractLogManagementContractCreatedIterator struct {
 	Event *ManagementContractLogManagementContractCreated // Event containing the contract specifics and raw log
@@ -724,3 +869,605 @@ func (_ManagementContract *ManagementContractFilterer) ParseLogManagementContrac
 	event.Raw = log
 	return event, nil
 }
+
+// ManagementContractNetworkSecretInitializedIterator is returned from FilterNetworkSecretInitialized and is used to iterate over the raw logs and unpacked data for NetworkSecretInitialized events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretInitializedIterator struct {
+	Event *ManagementContractNetworkSecretInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretInitialized represents a NetworkSecretInitialized event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretInitialized struct {
+	AggregatorID       common.Address
+	InitSecret         []byte
+	HostAddress        string
+	GenesisAttestation string
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretInitialized is a free log retrieval operation binding the contract event 0x060f17118170663d8538d657b444736b310e4bd1732eb9a8bb48ea076a955d3d.
+//
+// Solidity: event NetworkSecretInitialized(address indexed aggregatorID, bytes initSecret, string hostAddress, string genesisAttestation)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretInitialized(opts *bind.FilterOpts, aggregatorID []common.Address) (*ManagementContractNetworkSecretInitializedIterator, error) {
+
+	var aggregatorIDRule []interface{}
+	for _, aggregatorIDItem := range aggregatorID {
+		aggregatorIDRule = append(aggregatorIDRule, aggregatorIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretInitialized", aggregatorIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretInitializedIterator{contract: _ManagementContract.contract, event: "NetworkSecretInitialized", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretInitialized is a free log subscription operation binding the contract event 0x060f17118170663d8538d657b444736b310e4bd1732eb9a8bb48ea076a955d3d.
+//
+// Solidity: event NetworkSecretInitialized(address indexed aggregatorID, bytes initSecret, string hostAddress, string genesisAttestation)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretInitialized(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretInitialized, aggregatorID []common.Address) (event.Subscription, error) {
+
+	var aggregatorIDRule []interface{}
+	for _, aggregatorIDItem := range aggregatorID {
+		aggregatorIDRule = append(aggregatorIDRule, aggregatorIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretInitialized", aggregatorIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretInitialized)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretInitialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretInitialized is a log parse operation binding the contract event 0x060f17118170663d8538d657b444736b310e4bd1732eb9a8bb48ea076a955d3d.
+//
+// Solidity: event NetworkSecretInitialized(address indexed aggregatorID, bytes initSecret, string hostAddress, string genesisAttestation)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretInitialized(log types.Log) (*ManagementContractNetworkSecretInitialized, error) {
+	event := new(ManagementContractNetworkSecretInitialized)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretInitialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRequestedIterator is returned from FilterNetworkSecretRequested and is used to iterate over the raw logs and unpacked data for NetworkSecretRequested events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRequestedIterator struct {
+	Event *ManagementContractNetworkSecretRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretRequested represents a NetworkSecretRequested event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRequested struct {
+	Requester     common.Address
+	RequestReport string
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretRequested is a free log retrieval operation binding the contract event 0x0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d4301.
+//
+// Solidity: event NetworkSecretRequested(address indexed requester, string requestReport)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretRequested(opts *bind.FilterOpts, requester []common.Address) (*ManagementContractNetworkSecretRequestedIterator, error) {
+
+	var requesterRule []interface{}
+	for _, requesterItem := range requester {
+		requesterRule = append(requesterRule, requesterItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretRequested", requesterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRequestedIterator{contract: _ManagementContract.contract, event: "NetworkSecretRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretRequested is a free log subscription operation binding the contract event 0x0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d4301.
+//
+// Solidity: event NetworkSecretRequested(address indexed requester, string requestReport)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretRequested(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretRequested, requester []common.Address) (event.Subscription, error) {
+
+	var requesterRule []interface{}
+	for _, requesterItem := range requester {
+		requesterRule = append(requesterRule, requesterItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretRequested", requesterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretRequested)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretRequested is a log parse operation binding the contract event 0x0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d4301.
+//
+// Solidity: event NetworkSecretRequested(address indexed requester, string requestReport)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretRequested(log types.Log) (*ManagementContractNetworkSecretRequested, error) {
+	event := new(ManagementContractNetworkSecretRequested)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRequested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRespondedIterator is returned from FilterNetworkSecretResponded and is used to iterate over the raw logs and unpacked data for NetworkSecretResponded events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRespondedIterator struct {
+	Event *ManagementContractNetworkSecretResponded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRespondedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretResponded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretResponded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRespondedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRespondedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretResponded represents a NetworkSecretResponded event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretResponded struct {
+	AttesterID     common.Address
+	RequesterID    common.Address
+	AttesterSig    []byte
+	ResponseSecret []byte
+	HostAddress    string
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretResponded is a free log retrieval operation binding the contract event 0xa043eb490e8e8d161ff77cb1464968946f88044430d1a809eab94a380130310d.
+//
+// Solidity: event NetworkSecretResponded(address indexed attesterID, address indexed requesterID, bytes attesterSig, bytes responseSecret, string hostAddress)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretResponded(opts *bind.FilterOpts, attesterID []common.Address, requesterID []common.Address) (*ManagementContractNetworkSecretRespondedIterator, error) {
+
+	var attesterIDRule []interface{}
+	for _, attesterIDItem := range attesterID {
+		attesterIDRule = append(attesterIDRule, attesterIDItem)
+	}
+	var requesterIDRule []interface{}
+	for _, requesterIDItem := range requesterID {
+		requesterIDRule = append(requesterIDRule, requesterIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretResponded", attesterIDRule, requesterIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRespondedIterator{contract: _ManagementContract.contract, event: "NetworkSecretResponded", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretResponded is a free log subscription operation binding the contract event 0xa043eb490e8e8d161ff77cb1464968946f88044430d1a809eab94a380130310d.
+//
+// Solidity: event NetworkSecretResponded(address indexed attesterID, address indexed requesterID, bytes attesterSig, bytes responseSecret, string hostAddress)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretResponded(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretResponded, attesterID []common.Address, requesterID []common.Address) (event.Subscription, error) {
+
+	var attesterIDRule []interface{}
+	for _, attesterIDItem := range attesterID {
+		attesterIDRule = append(attesterIDRule, attesterIDItem)
+	}
+	var requesterIDRule []interface{}
+	for _, requesterIDItem := range requesterID {
+		requesterIDRule = append(requesterIDRule, requesterIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretResponded", attesterIDRule, requesterIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretResponded)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretResponded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretResponded is a log parse operation binding the contract event 0xa043eb490e8e8d161ff77cb1464968946f88044430d1a809eab94a380130310d.
+//
+// Solidity: event NetworkSecretResponded(address indexed attesterID, address indexed requesterID, bytes attesterSig, bytes responseSecret, string hostAddress)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretResponded(log types.Log) (*ManagementContractNetworkSecretResponded, error) {
+	event := new(ManagementContractNetworkSecretResponded)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretResponded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractRollupAddedIterator is returned from FilterRollupAdded and is used to iterate over the raw logs and unpacked data for RollupAdded events raised by the ManagementContract contract.
+type ManagementContractRollupAddedIterator struct {
+	Event *ManagementContractRollupAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractRollupAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractRollupAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractRollupAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractRollupAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractRollupAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractRollupAdded represents a RollupAdded event raised by the ManagementContract contract.
+type ManagementContractRollupAdded struct {
+	RollupHash   [32]byte
+	AggregatorID common.Address
+	RollupData   string
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterRollupAdded is a free log retrieval operation binding the contract event 0xa153c21aa71e7fe30e5ee458cbc9ee4216c114ff6538d24b5a9545338bacde81.
+//
+// Solidity: event RollupAdded(bytes32 indexed rollupHash, address indexed aggregatorID, string rollupData)
+func (_ManagementContract *ManagementContractFilterer) FilterRollupAdded(opts *bind.FilterOpts, rollupHash [][32]byte, aggregatorID []common.Address) (*ManagementContractRollupAddedIterator, error) {
+
+	var rollupHashRule []interface{}
+	for _, rollupHashItem := range rollupHash {
+		rollupHashRule = append(rollupHashRule, rollupHashItem)
+	}
+	var aggregatorIDRule []interface{}
+	for _, aggregatorIDItem := range aggregatorID {
+		aggregatorIDRule = append(aggregatorIDRule, aggregatorIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "RollupAdded", rollupHashRule, aggregatorIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractRollupAddedIterator{contract: _ManagementContract.contract, event: "RollupAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchRollupAdded is a free log subscription operation binding the contract event 0xa153c21aa71e7fe30e5ee458cbc9ee4216c114ff6538d24b5a9545338bacde81.
+//
+// Solidity: event RollupAdded(bytes32 indexed rollupHash, address indexed aggregatorID, string rollupData)
+func (_ManagementContract *ManagementContractFilterer) WatchRollupAdded(opts *bind.WatchOpts, sink chan<- *ManagementContractRollupAdded, rollupHash [][32]byte, aggregatorID []common.Address) (event.Subscription, error) {
+
+	var rollupHashRule []interface{}
+	for _, rollupHashItem := range rollupHash {
+		rollupHashRule = append(rollupHashRule, rollupHashItem)
+	}
+	var aggregatorIDRule []interface{}
+	for _, aggregatorIDItem := range aggregatorID {
+		aggregatorIDRule = append(aggregatorIDRule, aggregatorIDItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "RollupAdded", rollupHashRule, aggregatorIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractRollupAdded)
+				if err := _ManagementContract.contract.UnpackLog(event, "RollupAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRollupAdded is a log parse operation binding the contract event 0xa153c21aa71e7fe30e5ee458cbc9ee4216c114ff6538d24b5a9545338bacde81.
+//
+// Solidity: event RollupAdded(bytes32 indexed rollupHash, address indexed aggregatorID, string rollupData)
+func (_ManagementContract *ManagementContractFilterer) ParseRollupAdded(log types.Log) (*ManagementContractRollupAdded, error) {
+	event := new(ManagementContractRollupAdded)
+	if err := _ManagementContract.contract.UnpackLog(event, "RollupAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -10,6 +10,14 @@ contract ManagementContract {
 
     event LogManagementContractCreated(address messageBusAddress);
 
+    // The events below are consumed by the Obscuro hosts and enclaves from the L1 receipts, so that the calls are picked
+    // up whichever way the contract is called (e.g. directly, via a proxy or via a multisig).
+    event RollupAdded(bytes32 indexed rollupHash, address indexed aggregatorID, string rollupData);
+    event NetworkSecretInitialized(address indexed aggregatorID, bytes initSecret, string hostAddress, string genesisAttestation);
+    event NetworkSecretRequested(address indexed requester, string requestReport);
+    event NetworkSecretResponded(address indexed attesterID, address indexed requesterID, bytes attesterSig, bytes responseSecret, string hostAddress);
+    event HostRegistered(address indexed hostID, string hostAddress);
+
     mapping(address => string) private attestationRequests;
     mapping(address => bool) private attested;
     // TODO - Revisit the decision to store the host addresses in the smart contract.
@@ -101,14 +109,19 @@ contract ManagementContract {
     // 0 -> 1 -> 2 -> 3 -> 4 -> 5
     //                  -> 4'-> 5'
     //
+    // Returns false while the tree is too shallow to contain such a fork.
     function HasSecondCousinFork() view public returns (bool) {
         Structs.TreeElement memory currentElement = GetHeadRollup();
 
         // traverse up to the grandpa ( 2 levels up )
         (bool foundParent, Structs.TreeElement memory parentElement) = GetParentRollup(currentElement);
-        require(foundParent, "no parent");
+        if (!foundParent) {
+            return false;
+        }
         (bool foundGrandpa, Structs.TreeElement memory grandpaElement) = GetParentRollup(parentElement);
-        require(foundGrandpa, "no grand parent");
+        if (!foundGrandpa) {
+            return false;
+        }
 
         // follow each of the grandpa children until it's two levels deep
         uint256[] memory childrenIDs = tree.rollupChildren[grandpaElement.ElementID];
@@ -146,7 +159,6 @@ contract ManagementContract {
         }
     }
 
-    // solc-ignore-next-line unused-param
     function AddRollup(Structs.MetaRollup calldata r, string calldata  _rollupData, Structs.HeaderCrossChainData calldata crossChainData) public {
         // TODO How to ensure the sender without hashing the calldata ?
         // bytes32 derp = keccak256(abi.encodePacked(ParentHash, AggregatorID, L1Block, Number, rollupData));
@@ -156,6 +168,8 @@ contract ManagementContract {
         // revert if the AggregatorID is not attested
         require(attested[r.AggregatorID], "aggregator not attested");
 
+        emit RollupAdded(r.Hash, r.AggregatorID, _rollupData);
+
         // if this is the first element initialize the tree structure
         // TODO this should be moved to the network initialization
         if (!tree.initialized) {
@@ -166,22 +180,20 @@ contract ManagementContract {
         (bool found, Structs.TreeElement memory parent) = GetRollupByHash(r.ParentHash);
         require(found, "unable to find parent hash");
 
-        // don't check for forks at the start
-        if (tree._HEAD > 2) {
-            bool forkFound = HasSecondCousinFork();
-            if (forkFound) {
-                isWithdrawalAvailable = false;
-                // We keep accepting rollups just locks the contract
-                // require(!found, "detected a fork");
-            }
+        // the head only moves if the rollup extends it, so the first rollup published on top of the head wins and
+        // competing rollups are kept in the tree on their own branch
+        AppendRollup(parent.ElementID, r);
+
+        // check for forks once the rollup is in the tree, so withdrawals are halted as soon as a fork appears
+        if (isWithdrawalAvailable && HasSecondCousinFork()) {
+            isWithdrawalAvailable = false;
+            // We keep accepting rollups just locks the contract
         }
 
-        AppendRollup(parent.ElementID, r);
         pushCrossChainMessages(crossChainData);
     }
 
     // InitializeNetworkSecret kickstarts the network secret, can only be called once
-    // solc-ignore-next-line unused-param
     function InitializeNetworkSecret(address _aggregatorID, bytes calldata  _initSecret, string memory _hostAddress, string calldata _genesisAttestation) public {
         require(!networkSecretInitialized);
 
@@ -191,12 +203,17 @@ contract ManagementContract {
         // aggregator is now on the list of attested aggregators and its host address is available
         attested[_aggregatorID] = true;
         hostAddresses.push(_hostAddress);
+
+        emit NetworkSecretInitialized(_aggregatorID, _initSecret, _hostAddress, _genesisAttestation);
+        emit HostRegistered(_aggregatorID, _hostAddress);
     }
 
     // Aggregators can request the Network Secret given an attestation request report
     function RequestNetworkSecret(string calldata requestReport) public {
         // Attestations should only be allowed to produce once ?
         attestationRequests[msg.sender] = requestReport;
+
+        emit NetworkSecretRequested(msg.sender, requestReport);
     }
 
     // Attested node will pickup on Network Secret Request
@@ -223,6 +240,9 @@ contract ManagementContract {
         attested[requesterID] = true;
         // TODO - Consider whether to remove duplicates.
         hostAddresses.push(hostAddress);
+
+        emit NetworkSecretResponded(attesterID, requesterID, attesterSig, responseSecret, hostAddress);
+        emit HostRegistered(requesterID, hostAddress);
     }
 
     function GetHostAddresses() public view returns (string[] memory) {
@@ -7,7 +7,8 @@ import { HardhatNetworkUserConfig } from "hardhat/types/config";
 task("obscuro:deploy", "Prepares for deploying.")
 .setAction(async function(args, hre, runSuper) {
 
-    const rpcURL = (hre.network.config as HardhatNetworkUserConfig).obscuroEncRpcUrl;
+    const networkConfig = hre.network.config as HardhatNetworkUserConfig;
+    const rpcURL = networkConfig.obscuroEncRpcUrl;
     
     if (!rpcURL) {
         console.log(`obscuro:deploy requires "obscuroEncRpcUrl" to be set as part of the selected network's config.`)
@@ -16,8 +17,12 @@ task("obscuro:deploy", "Prepares for deploying.")
     
     // Start a wallet extension as a child process
     // This process is auto signaled to terminate when this process dies
+    // The node's attestation is checked against the network's attestation policy, unless the network's enclaves do not
+    // run in SGX
     await hre.run("obscuro:wallet-extension:start:local", {
         rpcUrl : rpcURL,
+        attestationPolicy: networkConfig.obscuroAttestationPolicy,
+        insecureSkipAttestation: networkConfig.obscuroInsecureSkipAttestation ?? false,
         withStdOut: true
     });    
 
@@ -37,5 +42,7 @@ task("obscuro:deploy", "Prepares for deploying.")
 declare module 'hardhat/types/config' {
     interface HardhatNetworkUserConfig {
         obscuroEncRpcUrl?: string
+        obscuroAttestationPolicy?: string
+        obscuroInsecureSkipAttestation?: boolean
       }    
 }
\ No newline at end of file
@@ -16,6 +16,8 @@ task("obscuro:wallet-extension:start:local")
 .addFlag('withStdOut')
 .addParam('rpcUrl', "Node rpc endpoint where the wallet extension should connect to.")
 .addOptionalParam('port', "Port that the wallet extension will open for incoming requests.", "3001")
+.addOptionalParam('attestationPolicy', "Path to the policy that the node's attestation is checked against.")
+.addFlag('insecureSkipAttestation', "Skips checking the node's attestation. Only for local networks whose enclaves do not run in SGX.")
 .setAction(async function(args, hre) {
     const nodeUrl = url.parse(args.rpcUrl)
 
@@ -23,12 +25,20 @@ task("obscuro:wallet-extension:start:local")
         console.log(`Node url = ${JSON.stringify(nodeUrl, null, "  ")}`);
     }
 
-    const walletExtensionPath = path.resolve(hre.config.paths.root, "../tools/walletextension/bin/wallet_extension_linux");
-    const weProcess = spawn(walletExtensionPath, [
+    const walletExtensionArgs = [
         `-portWS`, `${args.port}`,
         `-nodeHost`, `${nodeUrl.hostname}`,
         `-nodePortWS`, `${nodeUrl.port}`
-    ]);
+    ];
+    if (args.attestationPolicy) {
+        walletExtensionArgs.push(`-attestationPolicy`, `${args.attestationPolicy}`);
+    }
+    if (args.insecureSkipAttestation) {
+        walletExtensionArgs.push(`-insecureSkipAttestation`);
+    }
+
+    const walletExtensionPath = path.resolve(hre.config.paths.root, "../tools/walletextension/bin/wallet_extension_linux");
+    const weProcess = spawn(walletExtensionPath, walletExtensionArgs);
 
     console.log("Waiting for Wallet Extension to start");
     await new Promise((resolve, fail)=>{
@@ -60,19 +70,29 @@ task("obscuro:wallet-extension:start:docker", "Starts up the wallet extension do
     'The docker image to use for wallet extension', 
     'testnetobscuronet.azurecr.io/obscuronet/walletextension')
 .addParam('rpcUrl', "Which network to pick the node connection info from?")
+.addOptionalParam('attestationPolicy', "Path within the container to the policy that the node's attestation is checked against.")
+.addFlag('insecureSkipAttestation', "Skips checking the node's attestation. Only for local networks whose enclaves do not run in SGX.")
 .setAction(async function(args, hre) {
     const docker = new dockerApi.Docker({ socketPath: '/var/run/docker.sock' });
 
     const parsedUrl = url.parse(args.rpcUrl)
 
+    const cmd = [
+        "--port=3000",
+        "--portWS=3001",
+        `--nodeHost=${parsedUrl.hostname}`,
+        `--nodePortWS=${parsedUrl.port}`
+    ];
+    if (args.attestationPolicy) {
+        cmd.push(`--attestationPolicy=${args.attestationPolicy}`);
+    }
+    if (args.insecureSkipAttestation) {
+        cmd.push("--insecureSkipAttestation");
+    }
+
     const container = await docker.container.create({
         Image: args.dockerImage,
-        Cmd: [
-            "--port=3000",
-            "--portWS=3001",
-            `--nodeHost=${parsedUrl.hostname}`,
-            `--nodePortWS=${parsedUrl.port}`
-        ],
+        Cmd: cmd,
         ExposedPorts: { "3000/tcp": {}, "3001/tcp": {}, "3000/udp": {}, "3001/udp": {} },
         PortBindings:  { "3000/tcp": [{ "HostPort": "3000" }], "3001/tcp": [{ "HostPort": "3001" }] }
     })
@@ -0,0 +1,60 @@
+package attestation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const measurementLength = 32 // The length in bytes of an SGX MRENCLAVE or MRSIGNER value
+
+// Policy describes the enclaves that are trusted. An attestation report passes the policy if the enclave it was produced
+// by has one of the expected measurements, and meets the minimum security requirements.
+type Policy struct {
+	UniqueIDs          []hexutil.Bytes // The accepted MRENCLAVE values. If empty, any enclave code is accepted.
+	SignerID           hexutil.Bytes   // The expected MRSIGNER value. If empty, any signer is accepted.
+	ProductID          *uint16         // The expected ISVPRODID value. If nil, any product ID is accepted.
+	MinSecurityVersion uint            // The minimum ISVSVN value.
+	AllowDebug         bool            // Whether enclaves running in debug mode are accepted.
+	AllowOutdatedTCB   bool            // Whether enclaves running on a platform whose TCB is not up-to-date are accepted.
+}
+
+// ParsePolicy parses a policy from JSON, e.g.
+//
+//	{"UniqueIDs": ["0x..."], "SignerID": "0x...", "MinSecurityVersion": 1}
+//
+// Fields that are not set take their zero values, so debug enclaves and outdated TCBs are rejected unless explicitly
+// allowed.
+func ParsePolicy(policyJSON []byte) (*Policy, error) {
+	var policy Policy
+	if err := json.Unmarshal(policyJSON, &policy); err != nil {
+		return nil, fmt.Errorf("could not parse attestation policy. Cause: %w", err)
+	}
+
+	for _, uniqueID := range policy.UniqueIDs {
+		if len(uniqueID) != measurementLength {
+			return nil, fmt.Errorf("unique ID %s is not %d bytes long", uniqueID, measurementLength)
+		}
+	}
+	if len(policy.SignerID) != 0 && len(policy.SignerID) != measurementLength {
+		return nil, fmt.Errorf("signer ID %s is not %d bytes long", policy.SignerID, measurementLength)
+	}
+	return &policy, nil
+}
+
+// PinsEnclaveIdentity indicates whether the policy restricts the accepted enclaves to known code or a known signer. A
+// policy that does not accepts any genuine enclave, whatever code it runs.
+func (p *Policy) PinsEnclaveIdentity() bool {
+	return len(p.UniqueIDs) > 0 || len(p.SignerID) > 0
+}
+
+// LoadPolicy parses the policy in the JSON file at the given path.
+func LoadPolicy(path string) (*Policy, error) {
+	policyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read attestation policy file %s. Cause: %w", path, err)
+	}
+	return ParsePolicy(policyJSON)
+}
@@ -0,0 +1,185 @@
+package attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+	"github.com/edgelesssys/ego/enclave"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	egoattestation "github.com/edgelesssys/ego/attestation"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// ReportVerifier checks the signature of a remote attestation report, and returns its parsed contents. As for
+// `enclave.VerifyRemoteReport`, a report whose TCB is not up-to-date is returned along with
+// `egoattestation.ErrTCBLevelInvalid`.
+type ReportVerifier func(reportBytes []byte) (egoattestation.Report, error)
+
+// Report is the content of a verified attestation report.
+type Report struct {
+	SecurityVersion uint
+	Debug           bool
+	UniqueID        hexutil.Bytes
+	SignerID        hexutil.Bytes
+	ProductID       hexutil.Bytes
+	TCBStatus       string
+}
+
+// Result is the outcome of verifying an attestation against a policy.
+type Result struct {
+	Owner      gethcommon.Address
+	Report     *Report
+	Violations []string // The ways in which the attestation breaches the policy. Empty if the attestation passed.
+}
+
+// Passed indicates whether the attestation satisfied the policy.
+func (r *Result) Passed() bool {
+	return len(r.Violations) == 0
+}
+
+// Verifier checks attestations against a policy. It can be used by any party that needs to establish that it is talking
+// to a trusted enclave before sharing encrypted data with it.
+type Verifier struct {
+	policy       *Policy
+	verifyReport ReportVerifier
+}
+
+// NewVerifier returns a verifier that checks attestation reports using EGo.
+func NewVerifier(policy *Policy) *Verifier {
+	return NewVerifierWithReportVerifier(policy, VerifyRemoteReport)
+}
+
+// NewVerifierWithReportVerifier returns a verifier that checks attestation reports' signatures using the provided
+// function.
+func NewVerifierWithReportVerifier(policy *Policy, verifyReport ReportVerifier) *Verifier {
+	return &Verifier{policy: policy, verifyReport: verifyReport}
+}
+
+// Verify checks that the attestation report is genuine, that it was produced for the attestation's owner, public keys
+// and host address, and that the enclave that produced it satisfies the policy. An error is returned if the report cannot
+// be verified at all. Otherwise, any breaches of the policy are listed in the result.
+func (v *Verifier) Verify(att *common.AttestationReport) (*Result, error) {
+	report, err := v.verifyReport(att.Report)
+	if err != nil && !errors.Is(err, egoattestation.ErrTCBLevelInvalid) {
+		return nil, fmt.Errorf("could not verify attestation report. Cause: %w", err)
+	}
+	if err = VerifyIdentity(report.Data, att); err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Owner: att.Owner,
+		Report: &Report{
+			SecurityVersion: report.SecurityVersion,
+			Debug:           report.Debug,
+			UniqueID:        report.UniqueID,
+			SignerID:        report.SignerID,
+			ProductID:       report.ProductID,
+			TCBStatus:       report.TCBStatus.String(),
+		},
+		Violations: v.checkPolicy(report),
+	}
+	return result, nil
+}
+
+// Returns the ways in which the report breaches the policy.
+func (v *Verifier) checkPolicy(report egoattestation.Report) []string {
+	violations := []string{}
+
+	if len(v.policy.UniqueIDs) > 0 && !containsBytes(v.policy.UniqueIDs, report.UniqueID) {
+		violations = append(violations, fmt.Sprintf("unique ID %s is not one of the accepted unique IDs", hexutil.Encode(report.UniqueID)))
+	}
+	if len(v.policy.SignerID) > 0 && !bytes.Equal(v.policy.SignerID, report.SignerID) {
+		violations = append(violations, fmt.Sprintf("signer ID %s does not match expected signer ID %s", hexutil.Encode(report.SignerID), v.policy.SignerID))
+	}
+	if v.policy.ProductID != nil && productID(report.ProductID) != *v.policy.ProductID {
+		violations = append(violations, fmt.Sprintf("product ID %d does not match expected product ID %d", productID(report.ProductID), *v.policy.ProductID))
+	}
+	if report.SecurityVersion < v.policy.MinSecurityVersion {
+		violations = append(violations, fmt.Sprintf("security version %d is below the minimum of %d", report.SecurityVersion, v.policy.MinSecurityVersion))
+	}
+	if report.Debug && !v.policy.AllowDebug {
+		violations = append(violations, "enclave is running in debug mode")
+	}
+	// A revoked platform is never trusted.
+	if report.TCBStatus == tcbstatus.Revoked || (report.TCBStatus != tcbstatus.UpToDate && !v.policy.AllowOutdatedTCB) {
+		violations = append(violations, fmt.Sprintf("TCB status is %s", report.TCBStatus))
+	}
+
+	return violations
+}
+
+// VerifyRemoteReport verifies the report using EGo. Outside an SGX enclave, EGo's verification syscall raises a SIGSYS
+// signal; we catch it so that it doesn't crash the program, and an error is returned instead.
+func VerifyRemoteReport(reportBytes []byte) (egoattestation.Report, error) {
+	sigChannel := make(chan os.Signal, 1)
+	signal.Notify(sigChannel, syscall.SIGSYS)
+	defer signal.Stop(sigChannel)
+
+	return enclave.VerifyRemoteReport(reportBytes)
+}
+
+// IDData is the identifying data whose hash is included in an attestation report.
+type IDData struct {
+	Owner       gethcommon.Address
+	PubKey      []byte
+	HostAddress string
+	RPCPubKey   []byte `json:",omitempty"` // Omitted if not set, so that the hash of an attestation without an RPC key is unchanged.
+}
+
+// IDHash provides a hash of identifying data to be included in an attestation report (or verified against the contents
+// of an attestation report).
+func IDHash(owner gethcommon.Address, pubKey []byte, rpcPubKey []byte, hostAddress string) ([]byte, error) {
+	idData := IDData{
+		Owner:       owner,
+		PubKey:      pubKey,
+		HostAddress: hostAddress,
+		RPCPubKey:   rpcPubKey,
+	}
+	idJSON, err := json.Marshal(idData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format ID data as JSON. Cause: %w", err)
+	}
+	hash := sha256.Sum256(idJSON)
+	return hash[:], nil
+}
+
+// VerifyIdentity checks that the data extracted from a verified attestation report matches the attestation's owner,
+// public keys and host address.
+func VerifyIdentity(data []byte, att *common.AttestationReport) error {
+	expectedIDHash, err := IDHash(att.Owner, att.PubKey, att.RPCPubKey, att.HostAddress)
+	if err != nil {
+		return fmt.Errorf("failed to create ID data to check attestation report with owner: %s. Cause: %w", att.Owner, err)
+	}
+	// we trim the actual data because data extracted from the verified attestation is always 64 bytes long (padded with zeroes at the end)
+	if len(data) < len(expectedIDHash) || !bytes.Equal(expectedIDHash, data[:len(expectedIDHash)]) {
+		return fmt.Errorf("failed to verify hash for attestation report with owner: %s", att.Owner)
+	}
+	return nil
+}
+
+func containsBytes(values []hexutil.Bytes, value []byte) bool {
+	for _, v := range values {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Decodes an SGX product ID, which is a little-endian uint16 padded to 16 bytes.
+func productID(productIDBytes []byte) uint16 {
+	if len(productIDBytes) < 2 {
+		return 0
+	}
+	return uint16(productIDBytes[0]) | uint16(productIDBytes[1])<<8
+}
@@ -0,0 +1,131 @@
+package attestation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	egoattestation "github.com/edgelesssys/ego/attestation"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	testUniqueID = hexutil.Bytes(gethcommon.HexToHash("0x1").Bytes())
+	testSignerID = hexutil.Bytes(gethcommon.HexToHash("0x2").Bytes())
+)
+
+func TestAttestationPassesMatchingPolicy(t *testing.T) {
+	att, report := createTestAttestation(t)
+	policy := &Policy{UniqueIDs: []hexutil.Bytes{testUniqueID}, SignerID: testSignerID, MinSecurityVersion: 2}
+
+	result, err := NewVerifierWithReportVerifier(policy, fixedReport(report, nil)).Verify(att)
+	if err != nil {
+		t.Fatalf("could not verify attestation. Cause: %s", err)
+	}
+	if !result.Passed() {
+		t.Fatalf("expected attestation to pass, got violations %v", result.Violations)
+	}
+}
+
+func TestAttestationViolationsAreReported(t *testing.T) {
+	att, report := createTestAttestation(t)
+	report.Debug = true
+	report.TCBStatus = tcbstatus.OutOfDate
+	productID := uint16(7)
+	policy := &Policy{
+		UniqueIDs:          []hexutil.Bytes{testSignerID},
+		SignerID:           testUniqueID,
+		ProductID:          &productID,
+		MinSecurityVersion: 3,
+	}
+
+	result, err := NewVerifierWithReportVerifier(policy, fixedReport(report, egoattestation.ErrTCBLevelInvalid)).Verify(att)
+	if err != nil {
+		t.Fatalf("could not verify attestation. Cause: %s", err)
+	}
+	expectedViolations := []string{"unique ID", "signer ID", "product ID", "security version", "debug mode", "TCB status"}
+	if len(result.Violations) != len(expectedViolations) {
+		t.Fatalf("expected %d violations, got %v", len(expectedViolations), result.Violations)
+	}
+	for i, violation := range result.Violations {
+		if !strings.Contains(violation, expectedViolations[i]) {
+			t.Fatalf("expected violation about %s, got %s", expectedViolations[i], violation)
+		}
+	}
+}
+
+func TestRevokedPlatformIsNeverAccepted(t *testing.T) {
+	att, report := createTestAttestation(t)
+	report.TCBStatus = tcbstatus.Revoked
+
+	result, err := NewVerifierWithReportVerifier(&Policy{AllowOutdatedTCB: true}, fixedReport(report, egoattestation.ErrTCBLevelInvalid)).Verify(att)
+	if err != nil {
+		t.Fatalf("could not verify attestation. Cause: %s", err)
+	}
+	if result.Passed() {
+		t.Fatal("expected attestation from a revoked platform to fail")
+	}
+}
+
+func TestAttestationForAnotherKeyIsRejected(t *testing.T) {
+	att, report := createTestAttestation(t)
+	att.PubKey = []byte("another key")
+	if _, err := NewVerifierWithReportVerifier(&Policy{}, fixedReport(report, nil)).Verify(att); err == nil {
+		t.Fatal("expected attestation with a mismatched public key to be rejected")
+	}
+
+	att, report = createTestAttestation(t)
+	att.RPCPubKey = []byte("another key")
+	if _, err := NewVerifierWithReportVerifier(&Policy{}, fixedReport(report, nil)).Verify(att); err == nil {
+		t.Fatal("expected attestation with a mismatched RPC public key to be rejected")
+	}
+}
+
+func TestPolicyWithInvalidMeasurementIsRejected(t *testing.T) {
+	if _, err := ParsePolicy([]byte(`{"UniqueIDs": ["0x1234"]}`)); err == nil {
+		t.Fatal("expected policy with a short unique ID to be rejected")
+	}
+
+	policy, err := ParsePolicy([]byte(`{"SignerID": "` + testSignerID.String() + `", "MinSecurityVersion": 1}`))
+	if err != nil {
+		t.Fatalf("could not parse policy. Cause: %s", err)
+	}
+	if policy.SignerID.String() != testSignerID.String() || policy.MinSecurityVersion != 1 || policy.AllowDebug {
+		t.Fatalf("unexpected policy %+v", policy)
+	}
+}
+
+// Creates an attestation, and the report that EGo would produce for it.
+func createTestAttestation(t *testing.T) (*common.AttestationReport, egoattestation.Report) {
+	att := &common.AttestationReport{
+		Report:      []byte("report"),
+		PubKey:      []byte("key"),
+		RPCPubKey:   []byte("rpc key"),
+		Owner:       gethcommon.HexToAddress("0x3"),
+		HostAddress: "127.0.0.1:10000",
+	}
+	idHash, err := IDHash(att.Owner, att.PubKey, att.RPCPubKey, att.HostAddress)
+	if err != nil {
+		t.Fatalf("could not create ID hash. Cause: %s", err)
+	}
+
+	// The report data is padded to 64 bytes.
+	report := egoattestation.Report{
+		Data:            append(idHash, make([]byte, 32)...),
+		SecurityVersion: 2,
+		UniqueID:        testUniqueID,
+		SignerID:        testSignerID,
+		ProductID:       make([]byte, 16),
+		TCBStatus:       tcbstatus.UpToDate,
+	}
+	return att, report
+}
+
+func fixedReport(report egoattestation.Report, err error) ReportVerifier {
+	return func([]byte) (egoattestation.Report, error) {
+		return report, err
+	}
+}
@@ -0,0 +1,169 @@
+package beacon
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// The beacon chain signs with BLS12-381, with public keys in G1 and signatures in G2, both in the compressed encoding
+// (see https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#bls-signatures). The curve
+// arithmetic, point decoding and hashing to the curve are those of gnark-crypto.
+
+const (
+	PubkeyLength    = bls12381.SizeOfG1AffineCompressed
+	SignatureLength = bls12381.SizeOfG2AffineCompressed
+
+	compressedFlag = 0x80 // set for points in the compressed encoding
+	infinityFlag   = 0x40 // set for the point at infinity
+
+	// the domain separation tag of the proof-of-possession scheme used by the beacon chain
+	signatureDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+// FastAggregateVerify checks that the signature is the aggregate of the signatures of the message by each of the public
+// keys.
+func FastAggregateVerify(pubkeys [][]byte, message []byte, signature []byte) error {
+	if len(pubkeys) == 0 {
+		return errors.New("no public keys to verify the signature against")
+	}
+	aggregate, err := aggregatePubkeys(pubkeys)
+	if err != nil {
+		return err
+	}
+	sig, err := decodeSignature(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature. Cause: %w", err)
+	}
+	hash, err := bls12381.HashToG2(message, []byte(signatureDST))
+	if err != nil {
+		return fmt.Errorf("could not hash message to curve. Cause: %w", err)
+	}
+
+	// e(aggregate, H(message)) == e(generator, signature)
+	_, _, g1Generator, _ := bls12381.Generators()
+	var negGenerator bls12381.G1Affine
+	negGenerator.Neg(&g1Generator)
+	valid, err := bls12381.PairingCheck([]bls12381.G1Affine{aggregate, negGenerator}, []bls12381.G2Affine{hash, sig})
+	if err != nil {
+		return fmt.Errorf("could not compute pairing. Cause: %w", err)
+	}
+	if !valid {
+		return errors.New("signature does not match the public keys")
+	}
+	return nil
+}
+
+// SecretKey is a BLS secret key. It is only used to produce signatures in tests, the enclave only ever verifies them.
+type SecretKey struct {
+	scalar *big.Int
+}
+
+// NewSecretKey returns the secret key for the given seed, which is reduced modulo the group order.
+func NewSecretKey(seed []byte) (*SecretKey, error) {
+	scalar := new(big.Int).Mod(new(big.Int).SetBytes(seed), fr.Modulus())
+	if scalar.Sign() == 0 {
+		return nil, errors.New("secret key cannot be zero")
+	}
+	return &SecretKey{scalar: scalar}, nil
+}
+
+// PublicKey returns the compressed public key.
+func (k *SecretKey) PublicKey() []byte {
+	var pubkey bls12381.G1Affine
+	pubkey.ScalarMultiplicationBase(k.scalar)
+	compressed := pubkey.Bytes()
+	return compressed[:]
+}
+
+// Sign returns the compressed signature of the message.
+func (k *SecretKey) Sign(message []byte) ([]byte, error) {
+	hash, err := bls12381.HashToG2(message, []byte(signatureDST))
+	if err != nil {
+		return nil, err
+	}
+	var signature bls12381.G2Affine
+	signature.ScalarMultiplication(&hash, k.scalar)
+	compressed := signature.Bytes()
+	return compressed[:], nil
+}
+
+// AggregateSecretKeys returns a key whose signatures are the aggregate of the signatures of the given keys.
+func AggregateSecretKeys(keys []*SecretKey) *SecretKey {
+	scalar := new(big.Int)
+	for _, key := range keys {
+		scalar.Add(scalar, key.scalar)
+	}
+	return &SecretKey{scalar: scalar.Mod(scalar, fr.Modulus())}
+}
+
+// AggregatePublicKeys returns the compressed sum of the given compressed public keys.
+func AggregatePublicKeys(pubkeys [][]byte) ([]byte, error) {
+	aggregate, err := aggregatePubkeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	compressed := aggregate.Bytes()
+	return compressed[:], nil
+}
+
+func aggregatePubkeys(pubkeys [][]byte) (bls12381.G1Affine, error) {
+	var sum bls12381.G1Jac
+	for i, pubkey := range pubkeys {
+		point, err := decodePubkey(pubkey)
+		if err != nil {
+			return bls12381.G1Affine{}, fmt.Errorf("invalid public key at index %d. Cause: %w", i, err)
+		}
+		sum.AddMixed(&point)
+	}
+	var aggregate bls12381.G1Affine
+	aggregate.FromJacobian(&sum)
+	return aggregate, nil
+}
+
+// Decodes a compressed public key, rejecting the point at infinity and points outside the prime-order subgroup, as
+// required by the KeyValidate procedure of the BLS signature standard.
+func decodePubkey(in []byte) (bls12381.G1Affine, error) {
+	var point bls12381.G1Affine
+	if len(in) != PubkeyLength {
+		return point, fmt.Errorf("expected %d bytes, got %d", PubkeyLength, len(in))
+	}
+	if err := checkCompressedFlags(in[0]); err != nil {
+		return point, err
+	}
+	// gnark-crypto checks that the coordinate is a canonical field element, and that the point is in the subgroup
+	if _, err := point.SetBytes(in); err != nil {
+		return point, err
+	}
+	return point, nil
+}
+
+// Decodes a compressed signature, rejecting the point at infinity and points outside the prime-order subgroup.
+func decodeSignature(in []byte) (bls12381.G2Affine, error) {
+	var point bls12381.G2Affine
+	if len(in) != SignatureLength {
+		return point, fmt.Errorf("expected %d bytes, got %d", SignatureLength, len(in))
+	}
+	if err := checkCompressedFlags(in[0]); err != nil {
+		return point, err
+	}
+	if _, err := point.SetBytes(in); err != nil {
+		return point, err
+	}
+	return point, nil
+}
+
+// Checks the flags of a compressed point. gnark-crypto also accepts uncompressed points and reads unexpected flag
+// combinations as compressed points, so only the compressed encoding of a point other than infinity is let through.
+func checkCompressedFlags(flags byte) error {
+	if flags&compressedFlag == 0 {
+		return errors.New("point is not compressed")
+	}
+	if flags&infinityFlag != 0 {
+		return errors.New("point at infinity is not allowed")
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package beacon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The test vectors of the beacon chain consensus specs (see https://github.com/ethereum/consensus-spec-tests)
+const (
+	testSecretKey = "0x263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"
+	testPubkey    = "0xa491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	testSignature = "0xb6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+)
+
+var testMessage = make([]byte, 32)
+
+func TestSignMatchesSpecVectors(t *testing.T) {
+	key, err := NewSecretKey(hexutil.MustDecode(testSecretKey))
+	if err != nil {
+		t.Fatalf("could not create secret key. Cause: %s", err)
+	}
+	if pubkey := key.PublicKey(); !bytes.Equal(pubkey, hexutil.MustDecode(testPubkey)) {
+		t.Fatalf("expected public key %s, got %s", testPubkey, hexutil.Encode(pubkey))
+	}
+	signature, err := key.Sign(testMessage)
+	if err != nil {
+		t.Fatalf("could not sign message. Cause: %s", err)
+	}
+	if !bytes.Equal(signature, hexutil.MustDecode(testSignature)) {
+		t.Fatalf("expected signature %s, got %s", testSignature, hexutil.Encode(signature))
+	}
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	pubkey := hexutil.MustDecode(testPubkey)
+	signature := hexutil.MustDecode(testSignature)
+	if err := FastAggregateVerify([][]byte{pubkey}, testMessage, signature); err != nil {
+		t.Fatalf("expected spec signature to verify. Cause: %s", err)
+	}
+	if err := FastAggregateVerify([][]byte{pubkey}, []byte("another message"), signature); err == nil {
+		t.Fatal("expected signature of another message to be rejected")
+	}
+
+	keys := make([]*SecretKey, 3)
+	pubkeys := make([][]byte, len(keys))
+	for i := range keys {
+		key, err := NewSecretKey([]byte{byte(i + 1)})
+		if err != nil {
+			t.Fatalf("could not create secret key. Cause: %s", err)
+		}
+		keys[i] = key
+		pubkeys[i] = key.PublicKey()
+	}
+	aggregateSignature, err := AggregateSecretKeys(keys).Sign(testMessage)
+	if err != nil {
+		t.Fatalf("could not sign message. Cause: %s", err)
+	}
+	if err = FastAggregateVerify(pubkeys, testMessage, aggregateSignature); err != nil {
+		t.Fatalf("expected aggregate signature to verify. Cause: %s", err)
+	}
+	if err = FastAggregateVerify(pubkeys[:2], testMessage, aggregateSignature); err == nil {
+		t.Fatal("expected aggregate signature to be rejected for a subset of the keys")
+	}
+	if err = FastAggregateVerify([][]byte{pubkeys[0][:PubkeyLength-1]}, testMessage, aggregateSignature); err == nil {
+		t.Fatal("expected truncated public key to be rejected")
+	}
+}
+
+func TestFastAggregateVerifyRejectsInvalidPoints(t *testing.T) {
+	pubkey := hexutil.MustDecode(testPubkey)
+	signature := hexutil.MustDecode(testSignature)
+	modulus := hexutil.MustDecode("0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+
+	invalidPubkeys := map[string][]byte{
+		"infinity":              compressedPoint(PubkeyLength, infinityFlag),
+		"x equal to modulus":    compressedPoint(PubkeyLength, 0, modulus...),
+		"not on curve":          compressedPoint(PubkeyLength, 0, 1),
+		"not in subgroup":       compressedPoint(PubkeyLength, 0, 4),
+		"uncompressed flag":     append([]byte{pubkey[0] &^ compressedFlag}, pubkey[1:]...),
+		"infinity and sign":     compressedPoint(PubkeyLength, infinityFlag|0x20),
+		"too few bytes":         pubkey[:PubkeyLength-1],
+		"too many bytes":        append(append([]byte{}, pubkey...), 0),
+		"signature as a pubkey": signature,
+	}
+	for name, invalid := range invalidPubkeys {
+		if err := FastAggregateVerify([][]byte{invalid}, testMessage, signature); err == nil {
+			t.Errorf("expected public key (%s) to be rejected", name)
+		}
+		if err := FastAggregateVerify([][]byte{pubkey, invalid}, testMessage, signature); err == nil {
+			t.Errorf("expected public keys including an invalid public key (%s) to be rejected", name)
+		}
+	}
+
+	invalidSignatures := map[string][]byte{
+		"infinity":              compressedPoint(SignatureLength, infinityFlag),
+		"x equal to modulus":    compressedPoint(SignatureLength, 0, modulus...),
+		"not on curve":          compressedPoint(SignatureLength, 0, 1),
+		"not in subgroup":       compressedPoint(SignatureLength, 0, 2),
+		"uncompressed flag":     append([]byte{signature[0] &^ compressedFlag}, signature[1:]...),
+		"tampered":              append(append([]byte{}, signature[:SignatureLength-1]...), signature[SignatureLength-1]^1),
+		"too few bytes":         signature[:SignatureLength-1],
+		"pubkey as a signature": pubkey,
+	}
+	for name, invalid := range invalidSignatures {
+		if err := FastAggregateVerify([][]byte{pubkey}, testMessage, invalid); err == nil {
+			t.Errorf("expected signature (%s) to be rejected", name)
+		}
+	}
+
+	if err := FastAggregateVerify(nil, testMessage, signature); err == nil {
+		t.Error("expected signature to be rejected for no public keys")
+	}
+	if err := FastAggregateVerify([][]byte{pubkey, pubkey}, testMessage, signature); err == nil {
+		t.Error("expected signature to be rejected for a repeated public key")
+	}
+}
+
+// Returns the compressed encoding of a point with the given flags, whose x coordinate ends with the given bytes.
+func compressedPoint(length int, flags byte, x ...byte) []byte {
+	point := make([]byte, length)
+	copy(point[length-len(x):], x)
+	point[0] |= compressedFlag | flags
+	return point
+}
@@ -0,0 +1,155 @@
+package beacon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The names of the beacon chain forks, in the order they activate.
+const (
+	Phase0    = "phase0"
+	Altair    = "altair"
+	Bellatrix = "bellatrix"
+	Capella   = "capella"
+	Deneb     = "deneb"
+	Electra   = "electra"
+	Fulu      = "fulu"
+)
+
+var forkNames = []string{Phase0, Altair, Bellatrix, Capella, Deneb, Electra, Fulu}
+
+// ForkVersion is the version of a beacon chain fork, encoded as hex in JSON.
+type ForkVersion [4]byte
+
+func (v ForkVersion) MarshalText() ([]byte, error) {
+	return hexutil.Bytes(v[:]).MarshalText()
+}
+
+func (v *ForkVersion) UnmarshalText(input []byte) error {
+	return hexutil.UnmarshalFixedText("ForkVersion", input, v[:])
+}
+
+// Fork is a beacon chain fork, which the beacon chain is on from its activation epoch until the next fork.
+type Fork struct {
+	Name    string      `json:"name"`
+	Epoch   uint64      `json:"epoch"`
+	Version ForkVersion `json:"version"`
+}
+
+// AtLeast returns whether the fork is the named fork or a later one.
+func (f Fork) AtLeast(name string) bool {
+	return forkIndex(f.Name) >= forkIndex(name)
+}
+
+// ForkSchedule lists the forks of a beacon chain by activation epoch, starting with the fork at genesis. The version of
+// the fork the beacon chain is on is part of what the sync committee signs, and the fork determines the layout of the
+// light client headers, so the schedule must list a fork before the beacon chain reaches its activation epoch.
+type ForkSchedule []Fork
+
+// The fork schedules of the public beacon chains.
+var (
+	MainnetForks = ForkSchedule{
+		{Name: Phase0, Epoch: 0, Version: ForkVersion{0x00, 0x00, 0x00, 0x00}},
+		{Name: Altair, Epoch: 74240, Version: ForkVersion{0x01, 0x00, 0x00, 0x00}},
+		{Name: Bellatrix, Epoch: 144896, Version: ForkVersion{0x02, 0x00, 0x00, 0x00}},
+		{Name: Capella, Epoch: 194048, Version: ForkVersion{0x03, 0x00, 0x00, 0x00}},
+		{Name: Deneb, Epoch: 269568, Version: ForkVersion{0x04, 0x00, 0x00, 0x00}},
+		{Name: Electra, Epoch: 364032, Version: ForkVersion{0x05, 0x00, 0x00, 0x00}},
+		{Name: Fulu, Epoch: 411392, Version: ForkVersion{0x06, 0x00, 0x00, 0x00}},
+	}
+	SepoliaForks = ForkSchedule{
+		{Name: Phase0, Epoch: 0, Version: ForkVersion{0x90, 0x00, 0x00, 0x69}},
+		{Name: Altair, Epoch: 50, Version: ForkVersion{0x90, 0x00, 0x00, 0x70}},
+		{Name: Bellatrix, Epoch: 100, Version: ForkVersion{0x90, 0x00, 0x00, 0x71}},
+		{Name: Capella, Epoch: 56832, Version: ForkVersion{0x90, 0x00, 0x00, 0x72}},
+		{Name: Deneb, Epoch: 132608, Version: ForkVersion{0x90, 0x00, 0x00, 0x73}},
+		{Name: Electra, Epoch: 222464, Version: ForkVersion{0x90, 0x00, 0x00, 0x74}},
+		{Name: Fulu, Epoch: 272640, Version: ForkVersion{0x90, 0x00, 0x00, 0x75}},
+	}
+	HoodiForks = ForkSchedule{
+		{Name: Phase0, Epoch: 0, Version: ForkVersion{0x10, 0x00, 0x09, 0x10}},
+		{Name: Altair, Epoch: 0, Version: ForkVersion{0x20, 0x00, 0x09, 0x10}},
+		{Name: Bellatrix, Epoch: 0, Version: ForkVersion{0x30, 0x00, 0x09, 0x10}},
+		{Name: Capella, Epoch: 0, Version: ForkVersion{0x40, 0x00, 0x09, 0x10}},
+		{Name: Deneb, Epoch: 0, Version: ForkVersion{0x50, 0x00, 0x09, 0x10}},
+		{Name: Electra, Epoch: 2048, Version: ForkVersion{0x60, 0x00, 0x09, 0x10}},
+		{Name: Fulu, Epoch: 50688, Version: ForkVersion{0x70, 0x00, 0x09, 0x10}},
+	}
+)
+
+var knownForkSchedules = map[string]ForkSchedule{
+	"mainnet": MainnetForks,
+	"sepolia": SepoliaForks,
+	"hoodi":   HoodiForks,
+}
+
+// ParseForkSchedule returns the fork schedule of the named public beacon chain (mainnet, sepolia or hoodi), or else
+// parses the schedule as a JSON list of forks.
+func ParseForkSchedule(schedule string) (ForkSchedule, error) {
+	if forks, found := knownForkSchedules[schedule]; found {
+		return forks, nil
+	}
+	var forks ForkSchedule
+	if err := json.Unmarshal([]byte(schedule), &forks); err != nil {
+		return nil, fmt.Errorf("fork schedule is neither a known network nor a JSON list of forks. Cause: %w", err)
+	}
+	if err := forks.Validate(); err != nil {
+		return nil, err
+	}
+	return forks, nil
+}
+
+// Validate checks that the schedule starts at genesis, and lists known forks in activation order.
+func (s ForkSchedule) Validate() error {
+	if len(s) == 0 {
+		return errors.New("fork schedule is empty")
+	}
+	if s[0].Epoch != 0 {
+		return fmt.Errorf("fork schedule starts at epoch %d instead of genesis", s[0].Epoch)
+	}
+	for i, fork := range s {
+		if forkIndex(fork.Name) < 0 {
+			return fmt.Errorf("unknown fork %q", fork.Name)
+		}
+		if i == 0 {
+			continue
+		}
+		if forkIndex(fork.Name) <= forkIndex(s[i-1].Name) {
+			return fmt.Errorf("fork %q is listed after fork %q", fork.Name, s[i-1].Name)
+		}
+		if fork.Epoch < s[i-1].Epoch {
+			return fmt.Errorf("fork %q activates at epoch %d, before the previous fork at epoch %d", fork.Name, fork.Epoch, s[i-1].Epoch)
+		}
+	}
+	return nil
+}
+
+// At returns the fork the beacon chain is on at the given epoch.
+func (s ForkSchedule) At(epoch uint64) Fork {
+	fork := s[0]
+	for _, next := range s[1:] {
+		if next.Epoch > epoch {
+			break
+		}
+		fork = next
+	}
+	return fork
+}
+
+// AtSlot returns the fork the beacon chain is on at the given slot.
+func (s ForkSchedule) AtSlot(slot uint64) Fork {
+	return s.At(slot / SlotsPerEpoch)
+}
+
+// Returns the position of the named fork in the order forks activate in, or -1 if the fork is not known.
+func forkIndex(name string) int {
+	for i, known := range forkNames {
+		if known == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,67 @@
+package beacon
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestParseForkSchedule(t *testing.T) {
+	forks, err := ParseForkSchedule("sepolia")
+	if err != nil {
+		t.Fatalf("could not parse known fork schedule. Cause: %s", err)
+	}
+	if fork := forks.At(132607); fork.Name != Capella {
+		t.Fatalf("expected Sepolia to be on Capella before epoch 132608, got %s", fork.Name)
+	}
+	if fork := forks.AtSlot(132608 * SlotsPerEpoch); fork.Name != Deneb || fork.Version != (ForkVersion{0x90, 0x00, 0x00, 0x73}) {
+		t.Fatalf("expected Sepolia to be on Deneb from epoch 132608, got %+v", fork)
+	}
+
+	forks, err = ParseForkSchedule(`[{"name":"phase0","epoch":0,"version":"0x00000001"},{"name":"capella","epoch":10,"version":"0x03000001"}]`)
+	if err != nil {
+		t.Fatalf("could not parse fork schedule. Cause: %s", err)
+	}
+	if fork := forks.At(9); fork.Name != Phase0 || fork.Version != (ForkVersion{0x00, 0x00, 0x00, 0x01}) {
+		t.Fatalf("expected phase0 before epoch 10, got %+v", fork)
+	}
+	if fork := forks.At(1000); fork.Name != Capella || !fork.AtLeast(Bellatrix) || fork.AtLeast(Deneb) {
+		t.Fatalf("expected Capella from epoch 10, got %+v", fork)
+	}
+
+	invalidSchedules := map[string]string{
+		"unknown network":     "goerli",
+		"empty":               `[]`,
+		"not from genesis":    `[{"name":"phase0","epoch":1,"version":"0x00000001"}]`,
+		"unknown fork":        `[{"name":"phase0","epoch":0,"version":"0x00000001"},{"name":"gloas","epoch":1,"version":"0x07000001"}]`,
+		"forks out of order":  `[{"name":"phase0","epoch":0,"version":"0x00000001"},{"name":"deneb","epoch":1,"version":"0x04000001"},{"name":"capella","epoch":2,"version":"0x03000001"}]`,
+		"epochs out of order": `[{"name":"phase0","epoch":0,"version":"0x00000001"},{"name":"capella","epoch":2,"version":"0x03000001"},{"name":"deneb","epoch":1,"version":"0x04000001"}]`,
+		"invalid version":     `[{"name":"phase0","epoch":0,"version":"0x000001"}]`,
+		"repeated fork":       `[{"name":"phase0","epoch":0,"version":"0x00000001"},{"name":"phase0","epoch":1,"version":"0x00000002"}]`,
+	}
+	for name, schedule := range invalidSchedules {
+		if _, err = ParseForkSchedule(schedule); err == nil {
+			t.Errorf("expected %s fork schedule to be rejected", name)
+		}
+	}
+}
+
+func TestSigningRootUsesForkOfSlotBeforeSignature(t *testing.T) {
+	genesisRoot := gethcommon.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")
+	mainnet := Config{GenesisValidatorsRoot: genesisRoot, Forks: MainnetForks}
+	capella := Config{GenesisValidatorsRoot: genesisRoot, Forks: ForkSchedule{MainnetForks[3]}}
+	deneb := Config{GenesisValidatorsRoot: genesisRoot, Forks: ForkSchedule{MainnetForks[4]}}
+
+	firstDenebSlot := uint64(269568 * SlotsPerEpoch)
+	header := &BeaconBlockHeader{Slot: QuotedUint64(firstDenebSlot - 1)}
+	// a block signed in the first slot of a fork is signed with the previous fork's version
+	if mainnet.SigningRoot(header, firstDenebSlot) != capella.SigningRoot(header, firstDenebSlot) {
+		t.Fatal("expected the signature in the first Deneb slot to use the Capella fork version")
+	}
+	if mainnet.SigningRoot(header, firstDenebSlot+1) != deneb.SigningRoot(header, firstDenebSlot+1) {
+		t.Fatal("expected the signature after the first Deneb slot to use the Deneb fork version")
+	}
+	if capella.SigningRoot(header, firstDenebSlot+1) == deneb.SigningRoot(header, firstDenebSlot+1) {
+		t.Fatal("expected the signing root to depend on the fork version")
+	}
+}
@@ -0,0 +1,236 @@
+package beacon
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	SlotsPerEpoch                = 32
+	EpochsPerSyncCommitteePeriod = 256
+
+	// The generalized indices of the light client proofs. The indices in the beacon state moved when the state grew past
+	// 32 fields in the Electra fork.
+	ExecutionPayloadGIndex            = 25 // in the block body
+	FinalizedRootGIndex               = 105
+	FinalizedRootGIndexElectra        = 169
+	CurrentSyncCommitteeGIndex        = 54
+	CurrentSyncCommitteeGIndexElectra = 86
+	NextSyncCommitteeGIndex           = 55
+	NextSyncCommitteeGIndexElectra    = 87
+)
+
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// Config identifies the beacon chain whose sync committee signatures are verified.
+type Config struct {
+	GenesisValidatorsRoot gethcommon.Hash
+	Forks                 ForkSchedule
+}
+
+// SigningRoot returns the root the sync committee signs for the given header, in a block at the given slot. The
+// signature is made with the version of the fork of the slot before the signature slot, as specified by
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#validate_light_client_update.
+func (c Config) SigningRoot(header *BeaconBlockHeader, signatureSlot uint64) gethcommon.Hash {
+	if signatureSlot > 0 {
+		signatureSlot--
+	}
+	var version gethcommon.Hash
+	forkVersion := c.Forks.AtSlot(signatureSlot).Version
+	copy(version[:], forkVersion[:])
+	forkDataRoot := hashPair(version, c.GenesisValidatorsRoot)
+
+	var domain gethcommon.Hash
+	copy(domain[:4], domainSyncCommittee[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return hashPair(header.HashTreeRoot(), domain)
+}
+
+// SyncCommitteePeriod returns the sync committee period of the slot.
+func SyncCommitteePeriod(slot uint64) uint64 {
+	return slot / SlotsPerEpoch / EpochsPerSyncCommitteePeriod
+}
+
+// LightClient follows the finalized head of the beacon chain from a trusted block, by verifying the updates signed by
+// the sync committee, as specified by the light client sync protocol (see
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md). Only updates signed
+// by a supermajority of the sync committee that prove a finalized header are accepted.
+type LightClient struct {
+	config           Config
+	finalized        *LightClientHeader
+	currentCommittee *SyncCommittee
+	nextCommittee    *SyncCommittee // nil until an update proves it
+}
+
+// NewLightClient returns a light client that starts from the bootstrap of the block with the trusted root.
+func NewLightClient(config Config, trustedRoot gethcommon.Hash, bootstrap *LightClientBootstrap) (*LightClient, error) {
+	if err := config.Forks.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fork schedule. Cause: %w", err)
+	}
+	if root := bootstrap.Header.Beacon.HashTreeRoot(); root != trustedRoot {
+		return nil, fmt.Errorf("bootstrap is for block %s, not the trusted block %s", root, trustedRoot)
+	}
+	if err := verifyHeader(&bootstrap.Header, config.Forks); err != nil {
+		return nil, fmt.Errorf("invalid bootstrap header. Cause: %w", err)
+	}
+	committeeRoot, err := bootstrap.CurrentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync committee. Cause: %w", err)
+	}
+	fork := config.Forks.AtSlot(uint64(bootstrap.Header.Beacon.Slot))
+	gIndex := forkGIndex(fork, CurrentSyncCommitteeGIndex, CurrentSyncCommitteeGIndexElectra)
+	if !VerifyMerkleBranch(committeeRoot, bootstrap.CurrentSyncCommitteeBranch, gIndex, bootstrap.Header.Beacon.StateRoot) {
+		return nil, errors.New("invalid sync committee proof")
+	}
+
+	return &LightClient{
+		config:           config,
+		finalized:        &bootstrap.Header,
+		currentCommittee: &bootstrap.CurrentSyncCommittee,
+	}, nil
+}
+
+// Finalized returns the latest finalized header.
+func (lc *LightClient) Finalized() *LightClientHeader {
+	return lc.finalized
+}
+
+// Period returns the sync committee period of the latest finalized header.
+func (lc *LightClient) Period() uint64 {
+	return SyncCommitteePeriod(uint64(lc.finalized.Beacon.Slot))
+}
+
+// ProcessUpdate verifies the update and, if it finalizes a later header than the latest finalized header, makes it the
+// latest finalized header. The update must be signed by the sync committee of the period of the latest finalized
+// header or, if the next sync committee is known, of the following period.
+func (lc *LightClient) ProcessUpdate(update *LightClientUpdate) error {
+	if update.FinalizedHeader == nil {
+		return errors.New("update does not prove a finalized header")
+	}
+	attestedSlot := uint64(update.AttestedHeader.Beacon.Slot)
+	finalizedSlot := uint64(update.FinalizedHeader.Beacon.Slot)
+	if uint64(update.SignatureSlot) <= attestedSlot || attestedSlot < finalizedSlot {
+		return fmt.Errorf("slots out of order, signature=%d, attested=%d, finalized=%d", update.SignatureSlot, attestedSlot, finalizedSlot)
+	}
+
+	storePeriod := lc.Period()
+	signaturePeriod := SyncCommitteePeriod(uint64(update.SignatureSlot))
+	committee := lc.currentCommittee
+	switch {
+	case signaturePeriod == storePeriod:
+	case signaturePeriod == storePeriod+1 && lc.nextCommittee != nil:
+		committee = lc.nextCommittee
+	default:
+		return fmt.Errorf("update is signed in period %d, which the sync committee is not known for (latest finalized period is %d)", signaturePeriod, storePeriod)
+	}
+
+	if err := verifyHeader(&update.AttestedHeader, lc.config.Forks); err != nil {
+		return fmt.Errorf("invalid attested header. Cause: %w", err)
+	}
+	if err := verifyHeader(update.FinalizedHeader, lc.config.Forks); err != nil {
+		return fmt.Errorf("invalid finalized header. Cause: %w", err)
+	}
+	// the proofs are for the state of the attested block, so their indices depend on the fork of the attested block
+	attestedFork := lc.config.Forks.AtSlot(attestedSlot)
+	gIndex := forkGIndex(attestedFork, FinalizedRootGIndex, FinalizedRootGIndexElectra)
+	if !VerifyMerkleBranch(update.FinalizedHeader.Beacon.HashTreeRoot(), update.FinalityBranch, gIndex, update.AttestedHeader.Beacon.StateRoot) {
+		return errors.New("invalid finality proof")
+	}
+
+	// the next sync committee is only taken from updates whose attested and finalized headers are in the same period
+	var nextCommittee *SyncCommittee
+	if update.NextSyncCommittee != nil {
+		root, err := update.NextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return fmt.Errorf("invalid next sync committee. Cause: %w", err)
+		}
+		gIndex = forkGIndex(attestedFork, NextSyncCommitteeGIndex, NextSyncCommitteeGIndexElectra)
+		if !VerifyMerkleBranch(root, update.NextSyncCommitteeBranch, gIndex, update.AttestedHeader.Beacon.StateRoot) {
+			return errors.New("invalid next sync committee proof")
+		}
+		if SyncCommitteePeriod(attestedSlot) == SyncCommitteePeriod(finalizedSlot) {
+			nextCommittee = update.NextSyncCommittee
+		}
+	}
+
+	if err := lc.verifySignature(update, committee); err != nil {
+		return err
+	}
+
+	finalizedPeriod := SyncCommitteePeriod(finalizedSlot)
+	switch {
+	case lc.nextCommittee == nil:
+		if finalizedPeriod == storePeriod {
+			lc.nextCommittee = nextCommittee
+		}
+	case finalizedPeriod == storePeriod+1:
+		lc.currentCommittee = lc.nextCommittee
+		lc.nextCommittee = nextCommittee
+	}
+	if finalizedSlot > uint64(lc.finalized.Beacon.Slot) {
+		lc.finalized = update.FinalizedHeader
+	}
+	return nil
+}
+
+// Checks that the attested header of the update is signed by a supermajority of the sync committee.
+func (lc *LightClient) verifySignature(update *LightClientUpdate, committee *SyncCommittee) error {
+	signatureBits := update.SyncAggregate.SyncCommitteeBits
+	if len(signatureBits)*8 != len(committee.Pubkeys) {
+		return fmt.Errorf("expected %d sync committee bits, got %d", len(committee.Pubkeys), len(signatureBits)*8)
+	}
+	var participants [][]byte
+	for i, pubkey := range committee.Pubkeys {
+		if signatureBits[i/8]>>(i%8)&1 == 1 {
+			participants = append(participants, pubkey)
+		}
+	}
+	if 3*len(participants) < 2*len(committee.Pubkeys) {
+		return fmt.Errorf("update is only signed by %d of the %d sync committee members", len(participants), len(committee.Pubkeys))
+	}
+
+	signingRoot := lc.config.SigningRoot(&update.AttestedHeader.Beacon, uint64(update.SignatureSlot))
+	if err := FastAggregateVerify(participants, signingRoot[:], update.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return fmt.Errorf("invalid sync committee signature. Cause: %w", err)
+	}
+	return nil
+}
+
+// Checks that the beacon block includes the execution payload, with the payload header layout of the block's fork.
+// Light client headers before the Capella fork have no execution payload, and an empty proof.
+func verifyHeader(header *LightClientHeader, forks ForkSchedule) error {
+	fork := forks.AtSlot(uint64(header.Beacon.Slot))
+	if !fork.AtLeast(Capella) {
+		if header.Execution != nil {
+			return fmt.Errorf("header of the %s fork cannot have an execution payload", fork.Name)
+		}
+		for _, node := range header.ExecutionBranch {
+			if node != (gethcommon.Hash{}) {
+				return fmt.Errorf("header of the %s fork cannot have an execution payload proof", fork.Name)
+			}
+		}
+		return nil
+	}
+
+	if header.Execution == nil {
+		return errors.New("header has no execution payload")
+	}
+	root, err := header.Execution.HashTreeRoot(fork)
+	if err != nil {
+		return err
+	}
+	if !VerifyMerkleBranch(root, header.ExecutionBranch, ExecutionPayloadGIndex, header.Beacon.BodyRoot) {
+		return errors.New("invalid execution payload proof")
+	}
+	return nil
+}
+
+// Returns the generalized index of a proof in the beacon state of the given fork.
+func forkGIndex(fork Fork, gIndex uint64, electraGIndex uint64) uint64 {
+	if fork.AtLeast(Electra) {
+		return electraGIndex
+	}
+	return gIndex
+}
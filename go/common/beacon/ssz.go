@@ -0,0 +1,120 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// The beacon chain commits to its objects with SSZ hash tree roots, a binary merkle tree over 32-byte chunks (see
+// https://github.com/ethereum/consensus-specs/blob/dev/ssz/simple-serialize.md#merkleization). Only the parts needed
+// to verify light client data are implemented.
+
+const maxTreeDepth = 64
+
+// zeroHashes[i] is the root of a tree of depth i whose leaves are all zero.
+var zeroHashes = func() [maxTreeDepth + 1]gethcommon.Hash {
+	var hashes [maxTreeDepth + 1]gethcommon.Hash
+	for i := 1; i <= maxTreeDepth; i++ {
+		hashes[i] = hashPair(hashes[i-1], hashes[i-1])
+	}
+	return hashes
+}()
+
+// MerkleProof returns the root of the tree whose leaves are the given chunks, padded with zero chunks to the next
+// power of two, along with the branch proving the leaf at the given index.
+func MerkleProof(leaves []gethcommon.Hash, index int) (gethcommon.Hash, []gethcommon.Hash) {
+	depth := treeDepth(len(leaves))
+	layer := append([]gethcommon.Hash{}, leaves...)
+	branch := make([]gethcommon.Hash, depth)
+	for level := 0; level < depth; level++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[level])
+		}
+		branch[level] = zeroHashes[level]
+		if sibling := index ^ 1; sibling < len(layer) {
+			branch[level] = layer[sibling]
+		}
+		parents := make([]gethcommon.Hash, len(layer)/2)
+		for i := range parents {
+			parents[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = parents
+		index /= 2
+	}
+	if len(layer) == 0 {
+		return zeroHashes[depth], branch
+	}
+	return layer[0], branch
+}
+
+// VerifyMerkleBranch checks that the branch proves the leaf at the given generalized index of the tree with the given
+// root.
+func VerifyMerkleBranch(leaf gethcommon.Hash, branch []gethcommon.Hash, generalizedIndex uint64, root gethcommon.Hash) bool {
+	depth := bits.Len64(generalizedIndex) - 1
+	if depth < 0 || len(branch) != depth {
+		return false
+	}
+	node := leaf
+	for i, sibling := range branch {
+		if generalizedIndex>>i&1 == 1 {
+			node = hashPair(sibling, node)
+		} else {
+			node = hashPair(node, sibling)
+		}
+	}
+	return node == root
+}
+
+// Returns the root of the tree whose leaves are the given chunks, padded with zero chunks to the next power of two.
+func merkleize(chunks []gethcommon.Hash) gethcommon.Hash {
+	root, _ := MerkleProof(chunks, 0)
+	return root
+}
+
+// Returns the root of the contents of a list that has room for the given number of chunks.
+func merkleizeWithLimit(chunks []gethcommon.Hash, limit int) gethcommon.Hash {
+	root := merkleize(chunks)
+	for depth := treeDepth(len(chunks)); depth < treeDepth(limit); depth++ {
+		root = hashPair(root, zeroHashes[depth])
+	}
+	return root
+}
+
+// Returns the root of a list, given the root of its contents.
+func mixInLength(root gethcommon.Hash, length int) gethcommon.Hash {
+	return hashPair(root, uint64Chunk(uint64(length)))
+}
+
+// Returns the root of a byte vector, or of the contents of a byte list.
+func bytesRoot(b []byte) gethcommon.Hash {
+	return merkleize(bytesChunks(b))
+}
+
+func bytesChunks(b []byte) []gethcommon.Hash {
+	chunks := make([]gethcommon.Hash, (len(b)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], b[i*32:])
+	}
+	return chunks
+}
+
+func uint64Chunk(v uint64) gethcommon.Hash {
+	var chunk gethcommon.Hash
+	binary.LittleEndian.PutUint64(chunk[:], v)
+	return chunk
+}
+
+func hashPair(a, b gethcommon.Hash) gethcommon.Hash {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// Returns the depth of the smallest tree with at least the given number of leaves.
+func treeDepth(leaves int) int {
+	if leaves <= 1 {
+		return 0
+	}
+	return bits.Len(uint(leaves - 1))
+}
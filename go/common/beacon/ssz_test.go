@@ -0,0 +1,32 @@
+package beacon
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestMerkleProofVerifies(t *testing.T) {
+	leaves := make([]gethcommon.Hash, 11)
+	for i := range leaves {
+		leaves[i] = gethcommon.BytesToHash([]byte{byte(i + 1)})
+	}
+	root, _ := MerkleProof(leaves, 0)
+	if root != merkleize(leaves) {
+		t.Fatalf("expected proof root to match the merkleized leaves")
+	}
+
+	for index := range leaves {
+		proofRoot, branch := MerkleProof(leaves, index)
+		if proofRoot != root {
+			t.Fatalf("expected the same root for the proof of leaf %d", index)
+		}
+		generalizedIndex := uint64(1)<<len(branch) + uint64(index)
+		if !VerifyMerkleBranch(leaves[index], branch, generalizedIndex, root) {
+			t.Fatalf("expected the proof of leaf %d to verify", index)
+		}
+		if VerifyMerkleBranch(leaves[index], branch, generalizedIndex^1, root) {
+			t.Fatalf("expected the proof of leaf %d to be rejected at another index", index)
+		}
+	}
+}
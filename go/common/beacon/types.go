@@ -0,0 +1,238 @@
+package beacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// The light client objects below are encoded in JSON as in the beacon node API (see
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientFinalityUpdate), so they can be passed on as served.
+
+const (
+	logsBloomLength    = 256
+	maxExtraDataLength = 32
+
+	maxBytesPerTransaction    = 1 << 30
+	maxTransactionsPerPayload = 1 << 20
+)
+
+// QuotedUint64 is a uint64 encoded as a decimal string in JSON, as in the beacon node API.
+type QuotedUint64 uint64
+
+func (q QuotedUint64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(q), 10))
+}
+
+func (q *QuotedUint64) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return err
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*q = QuotedUint64(v)
+	return nil
+}
+
+// QuotedUint256 is a 256-bit unsigned integer encoded as a decimal string in JSON, as in the beacon node API.
+type QuotedUint256 big.Int
+
+func (q *QuotedUint256) MarshalJSON() ([]byte, error) {
+	return json.Marshal((*big.Int)(q).String())
+}
+
+func (q *QuotedUint256) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return err
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 || v.BitLen() > 256 {
+		return fmt.Errorf("invalid uint256 %q", s)
+	}
+	*q = QuotedUint256(*v)
+	return nil
+}
+
+// BeaconBlockHeader is the header of a beacon chain block.
+type BeaconBlockHeader struct {
+	Slot          QuotedUint64    `json:"slot"`
+	ProposerIndex QuotedUint64    `json:"proposer_index"`
+	ParentRoot    gethcommon.Hash `json:"parent_root"`
+	StateRoot     gethcommon.Hash `json:"state_root"`
+	BodyRoot      gethcommon.Hash `json:"body_root"`
+}
+
+// HashTreeRoot returns the root of the header, which is the beacon block root.
+func (h *BeaconBlockHeader) HashTreeRoot() gethcommon.Hash {
+	return merkleize([]gethcommon.Hash{
+		uint64Chunk(uint64(h.Slot)),
+		uint64Chunk(uint64(h.ProposerIndex)),
+		h.ParentRoot,
+		h.StateRoot,
+		h.BodyRoot,
+	})
+}
+
+// ExecutionPayloadHeader is the header of the execution payload of a beacon chain block, which identifies the block of
+// the execution chain (i.e. the L1 block) that the beacon chain block includes.
+type ExecutionPayloadHeader struct {
+	ParentHash       gethcommon.Hash    `json:"parent_hash"`
+	FeeRecipient     gethcommon.Address `json:"fee_recipient"`
+	StateRoot        gethcommon.Hash    `json:"state_root"`
+	ReceiptsRoot     gethcommon.Hash    `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes      `json:"logs_bloom"`
+	PrevRandao       gethcommon.Hash    `json:"prev_randao"`
+	BlockNumber      QuotedUint64       `json:"block_number"`
+	GasLimit         QuotedUint64       `json:"gas_limit"`
+	GasUsed          QuotedUint64       `json:"gas_used"`
+	Timestamp        QuotedUint64       `json:"timestamp"`
+	ExtraData        hexutil.Bytes      `json:"extra_data"`
+	BaseFeePerGas    *QuotedUint256     `json:"base_fee_per_gas"`
+	BlockHash        gethcommon.Hash    `json:"block_hash"`
+	TransactionsRoot gethcommon.Hash    `json:"transactions_root"`
+	WithdrawalsRoot  gethcommon.Hash    `json:"withdrawals_root"`
+	// The blob gas fields are only present from the Deneb fork onwards
+	BlobGasUsed   *QuotedUint64 `json:"blob_gas_used,omitempty"`
+	ExcessBlobGas *QuotedUint64 `json:"excess_blob_gas,omitempty"`
+}
+
+// HashTreeRoot returns the root of the header, with the fields of the payload header of the given fork. The header
+// was introduced to light client headers in the Capella fork, and gained the blob gas fields in the Deneb fork.
+func (h *ExecutionPayloadHeader) HashTreeRoot(fork Fork) (gethcommon.Hash, error) {
+	if !fork.AtLeast(Capella) {
+		return gethcommon.Hash{}, fmt.Errorf("light client headers of the %s fork have no execution payload", fork.Name)
+	}
+	withBlobGas := fork.AtLeast(Deneb)
+	if (h.BlobGasUsed != nil) != withBlobGas || (h.ExcessBlobGas != nil) != withBlobGas {
+		return gethcommon.Hash{}, fmt.Errorf("blob gas fields do not match the payload header of the %s fork", fork.Name)
+	}
+	if len(h.LogsBloom) != logsBloomLength {
+		return gethcommon.Hash{}, fmt.Errorf("logs bloom has %d bytes, expected %d", len(h.LogsBloom), logsBloomLength)
+	}
+	if len(h.ExtraData) > maxExtraDataLength {
+		return gethcommon.Hash{}, fmt.Errorf("extra data has %d bytes, expected at most %d", len(h.ExtraData), maxExtraDataLength)
+	}
+
+	var feeRecipient, baseFee gethcommon.Hash
+	copy(feeRecipient[:], h.FeeRecipient[:])
+	if h.BaseFeePerGas != nil {
+		// uint256s are little-endian
+		bigEndian := (*big.Int)(h.BaseFeePerGas).FillBytes(make([]byte, 32))
+		for i, b := range bigEndian {
+			baseFee[31-i] = b
+		}
+	}
+
+	fields := []gethcommon.Hash{
+		h.ParentHash,
+		feeRecipient,
+		h.StateRoot,
+		h.ReceiptsRoot,
+		bytesRoot(h.LogsBloom),
+		h.PrevRandao,
+		uint64Chunk(uint64(h.BlockNumber)),
+		uint64Chunk(uint64(h.GasLimit)),
+		uint64Chunk(uint64(h.GasUsed)),
+		uint64Chunk(uint64(h.Timestamp)),
+		mixInLength(bytesRoot(h.ExtraData), len(h.ExtraData)),
+		baseFee,
+		h.BlockHash,
+		h.TransactionsRoot,
+		h.WithdrawalsRoot,
+	}
+	if withBlobGas {
+		fields = append(fields, uint64Chunk(uint64(*h.BlobGasUsed)), uint64Chunk(uint64(*h.ExcessBlobGas)))
+	}
+	return merkleize(fields), nil
+}
+
+// TransactionsRoot returns the root of the transactions of an execution payload, given their binary encodings, as in
+// the `transactions_root` of its header. Unlike the transactions root of the L1 block header, it is an SSZ root.
+func TransactionsRoot(transactions [][]byte) (gethcommon.Hash, error) {
+	if len(transactions) > maxTransactionsPerPayload {
+		return gethcommon.Hash{}, fmt.Errorf("payload cannot have more than %d transactions", maxTransactionsPerPayload)
+	}
+	roots := make([]gethcommon.Hash, len(transactions))
+	for i, tx := range transactions {
+		if len(tx) > maxBytesPerTransaction {
+			return gethcommon.Hash{}, fmt.Errorf("transaction at index %d has more than %d bytes", i, maxBytesPerTransaction)
+		}
+		roots[i] = mixInLength(merkleizeWithLimit(bytesChunks(tx), maxBytesPerTransaction/32), len(tx))
+	}
+	return mixInLength(merkleizeWithLimit(roots, maxTransactionsPerPayload), len(transactions)), nil
+}
+
+// LightClientHeader is a beacon chain block header, along with the header of its execution payload and the proof that
+// the block includes that payload. Before the Capella fork, light client headers have no execution payload.
+type LightClientHeader struct {
+	Beacon          BeaconBlockHeader       `json:"beacon"`
+	Execution       *ExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []gethcommon.Hash       `json:"execution_branch"`
+}
+
+// SyncCommittee is the committee of validators that sign the beacon chain head during a sync committee period.
+type SyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+// HashTreeRoot returns the root of the committee.
+func (c *SyncCommittee) HashTreeRoot() (gethcommon.Hash, error) {
+	pubkeyRoots := make([]gethcommon.Hash, len(c.Pubkeys))
+	for i, pubkey := range c.Pubkeys {
+		if len(pubkey) != PubkeyLength {
+			return gethcommon.Hash{}, fmt.Errorf("public key at index %d has %d bytes, expected %d", i, len(pubkey), PubkeyLength)
+		}
+		pubkeyRoots[i] = bytesRoot(pubkey)
+	}
+	if len(c.AggregatePubkey) != PubkeyLength {
+		return gethcommon.Hash{}, fmt.Errorf("aggregate public key has %d bytes, expected %d", len(c.AggregatePubkey), PubkeyLength)
+	}
+	return hashPair(merkleize(pubkeyRoots), bytesRoot(c.AggregatePubkey)), nil
+}
+
+// SyncAggregate is the aggregate signature of the beacon chain head by the members of the sync committee.
+type SyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`      // bit i is set if the i-th member signed
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"` // the aggregate signature of the members that signed
+}
+
+// Checkpoint is an epoch boundary of the beacon chain, identified by the root of the block at the boundary.
+type Checkpoint struct {
+	Epoch QuotedUint64    `json:"epoch"`
+	Root  gethcommon.Hash `json:"root"`
+}
+
+// HashTreeRoot returns the root of the checkpoint.
+func (c *Checkpoint) HashTreeRoot() gethcommon.Hash {
+	return hashPair(uint64Chunk(uint64(c.Epoch)), c.Root)
+}
+
+// LightClientBootstrap is the data a light client starts from, given a trusted beacon block root.
+type LightClientBootstrap struct {
+	Header                     LightClientHeader `json:"header"`
+	CurrentSyncCommittee       SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []gethcommon.Hash `json:"current_sync_committee_branch"`
+}
+
+// LightClientUpdate is a header signed by the sync committee (the attested header), along with the proof of the
+// finalized header in its state and, for sync committee updates, the proof of the next sync committee. It is used for
+// both the sync committee updates and the finality updates of the beacon node API.
+type LightClientUpdate struct {
+	AttestedHeader          LightClientHeader  `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch []gethcommon.Hash  `json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         *LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []gethcommon.Hash  `json:"finality_branch"`
+	SyncAggregate           SyncAggregate      `json:"sync_aggregate"`
+	SignatureSlot           QuotedUint64       `json:"signature_slot"`
+}
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// The test blocks are real blocks from go-ethereum's test data: mainnet block 18189758 (beacon slot 7378495, during
+// Capella), and block 141529 of an Electra devnet (beacon slot 151717). Each file holds the light client header a
+// beacon node serves for the block, with the body root and execution payload proof computed with zrnt, along with
+// the block's transactions and the RLP encoding of its execution header.
+var testBlocks = []struct {
+	file  string
+	forks ForkSchedule
+}{
+	{file: "block_capella.json", forks: MainnetForks},
+	{file: "block_electra.json", forks: ForkSchedule{{Name: Phase0}, {Name: Electra}}},
+}
+
+type testBlock struct {
+	Header          LightClientHeader `json:"header"`
+	ExecutionHeader hexutil.Bytes     `json:"execution_header"`
+	Transactions    []hexutil.Bytes   `json:"transactions"`
+}
+
+func TestVerifyHeaderOfRealBlocks(t *testing.T) {
+	for _, test := range testBlocks {
+		block := readTestBlock(t, test.file)
+		fork := test.forks.AtSlot(uint64(block.Header.Beacon.Slot))
+		if err := verifyHeader(&block.Header, test.forks); err != nil {
+			t.Fatalf("expected header of %s to verify. Cause: %s", test.file, err)
+		}
+
+		transactions := make([][]byte, len(block.Transactions))
+		for i, tx := range block.Transactions {
+			transactions[i] = tx
+		}
+		transactionsRoot, err := TransactionsRoot(transactions)
+		if err != nil {
+			t.Fatalf("could not compute transactions root of %s. Cause: %s", test.file, err)
+		}
+		if transactionsRoot != block.Header.Execution.TransactionsRoot {
+			t.Fatalf("expected transactions root %s for %s, got %s", block.Header.Execution.TransactionsRoot, test.file, transactionsRoot)
+		}
+
+		// the payload header of the other forks has a different layout
+		otherFork := Fork{Name: Deneb}
+		if fork.AtLeast(Deneb) {
+			otherFork = Fork{Name: Capella}
+		}
+		if _, err = block.Header.Execution.HashTreeRoot(otherFork); err == nil {
+			t.Fatalf("expected payload header of %s to be rejected with the layout of the %s fork", test.file, otherFork.Name)
+		}
+		if err = verifyHeader(&block.Header, ForkSchedule{{Name: Phase0}, {Name: Bellatrix}}); err == nil {
+			t.Fatalf("expected header of %s to be rejected before the Capella fork", test.file)
+		}
+
+		block.Header.Execution.GasUsed++
+		if err = verifyHeader(&block.Header, test.forks); err == nil {
+			t.Fatalf("expected tampered header of %s to be rejected", test.file)
+		}
+	}
+}
+
+func TestVerifyHeaderBeforeCapella(t *testing.T) {
+	forks := ForkSchedule{{Name: Phase0}, {Name: Altair}, {Name: Bellatrix}}
+	header := &LightClientHeader{Beacon: BeaconBlockHeader{Slot: 100}}
+	if err := verifyHeader(header, forks); err != nil {
+		t.Fatalf("expected header without execution payload to verify before the Capella fork. Cause: %s", err)
+	}
+	header.ExecutionBranch = make([]gethcommon.Hash, 4)
+	if err := verifyHeader(header, forks); err != nil {
+		t.Fatalf("expected header with an empty execution payload proof to verify before the Capella fork. Cause: %s", err)
+	}
+
+	header.ExecutionBranch[0] = gethcommon.Hash{1}
+	if err := verifyHeader(header, forks); err == nil {
+		t.Fatal("expected header with an execution payload proof to be rejected before the Capella fork")
+	}
+	header.ExecutionBranch = nil
+	header.Execution = &ExecutionPayloadHeader{}
+	if err := verifyHeader(header, forks); err == nil {
+		t.Fatal("expected header with an execution payload to be rejected before the Capella fork")
+	}
+}
+
+func readTestBlock(t *testing.T, file string) *testBlock {
+	data, err := os.ReadFile(filepath.Join("testdata", file))
+	if err != nil {
+		t.Fatalf("could not read test block. Cause: %s", err)
+	}
+	var block testBlock
+	if err = json.Unmarshal(data, &block); err != nil {
+		t.Fatalf("could not decode test block. Cause: %s", err)
+	}
+	return &block
+}
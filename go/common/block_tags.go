@@ -0,0 +1,38 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The `safe` and `finalized` block tags, which the version of Geth we use predates, with the values later versions of
+// Geth give them. A batch is safe once it is included in a canonical rollup on the L1, and finalized once the L1 block
+// that rollup was published in is finalized.
+const (
+	FinalizedBlockNumber = rpc.BlockNumber(-3)
+	SafeBlockNumber      = rpc.BlockNumber(-4)
+)
+
+// BlockNumber is an rpc.BlockNumber that can also be unmarshalled from the `safe` and `finalized` tags.
+type BlockNumber rpc.BlockNumber
+
+// UnmarshalJSON parses a block number, or one of the `earliest`, `latest`, `pending`, `safe` and `finalized` tags.
+func (bn *BlockNumber) UnmarshalJSON(data []byte) error {
+	input := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	switch input {
+	case "safe":
+		*bn = BlockNumber(SafeBlockNumber)
+		return nil
+	case "finalized":
+		*bn = BlockNumber(FinalizedBlockNumber)
+		return nil
+	}
+
+	var number rpc.BlockNumber
+	if err := number.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*bn = BlockNumber(number)
+	return nil
+}
@@ -19,6 +19,19 @@ const (
 	Unavailable                  // the enclave is unavailable (no guarantee it will self-recover)
 )
 
+func (s Status) String() string {
+	switch s {
+	case Running:
+		return "running"
+	case AwaitingSecret:
+		return "awaiting secret"
+	case Unavailable:
+		return "unavailable"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
 // Enclave represents the API of the service that runs inside the TEE.
 type Enclave interface {
 	// Status checks whether the enclave is ready to process requests - only implemented by the RPC layer
@@ -42,11 +55,21 @@ type Enclave interface {
 	SubmitL1Block(block L1Block, receipts L1Receipts, isLatest bool) (*BlockSubmissionResponse, error)
 
 	// SubmitTx - user transactions
-	SubmitTx(tx EncryptedTx) (EncryptedResponseSendRawTx, error)
+	SubmitTx(tx EncryptedTx) (*TxSubmissionResponse, error)
 
 	// SubmitBatch submits a batch received from the sequencer for processing.
 	SubmitBatch(batch *ExtBatch) error
 
+	// SubmitL1Finality proves to an enclave that validates L1 blocks against the beacon chain which L1 blocks are
+	// finalized, and returns which L1 blocks it will accept. An empty update only returns the status. The host must
+	// ensure the status covers an L1 block before submitting it
+	SubmitL1Finality(update *L1FinalityUpdate) (*L1FinalityStatus, error)
+
+	// SubmitFinalizedL1Block tells an enclave which of the L1 blocks it has ingested the host's L1 node considers
+	// finalized, so that it can resolve the `finalized` block tag. An enclave that validates L1 blocks against the
+	// beacon chain only ingests finalized blocks, and ignores it
+	SubmitFinalizedL1Block(blockHash gethcommon.Hash) error
+
 	// ExecuteOffChainTransaction - Execute a smart contract to retrieve data
 	// Todo - return the result with a block delay. To prevent frontrunning.
 	ExecuteOffChainTransaction(encryptedParams EncryptedParamsCall) (EncryptedResponseCall, error)
@@ -63,6 +86,10 @@ type Enclave interface {
 	// GetTransactionReceipt returns a transaction receipt given its signed hash, or nil if the transaction is unknown
 	GetTransactionReceipt(encryptedParams EncryptedParamsGetTxReceipt) (EncryptedResponseGetTxReceipt, error)
 
+	// GetTransactionStatus returns the lifecycle status of a transaction (see `TransactionStatus`) given its hash,
+	// encrypted with the viewing key for the transaction's sender, or nil if the transaction is unknown
+	GetTransactionStatus(encryptedParams EncryptedParamsGetTxStatus) (EncryptedResponseGetTxStatus, error)
+
 	// AddViewingKey - Decrypts, verifies and saves viewing keys.
 	// Viewing keys are asymmetric keys generated inside the wallet extension, and then signed by the wallet (e.g.
 	// MetaMask) in which the user holds the signing keys.
@@ -83,11 +110,17 @@ type Enclave interface {
 
 	// Subscribe adds a log subscription to the enclave under the given ID, provided the request is authenticated
 	// correctly. The events will be populated in the BlockSubmissionResponse. If there is an existing subscription
-	// with the given ID, it is overwritten.
-	Subscribe(id rpc.ID, encryptedParams EncryptedParamsLogSubscription) error
+	// with the given ID, it is overwritten. If the subscription requests a replay of historical logs, the matching logs
+	// up to the current head batch are returned, encrypted with the subscriber's viewing key.
+	Subscribe(id rpc.ID, encryptedParams EncryptedParamsLogSubscription) (EncryptedLogs, error)
 
-	// Unsubscribe removes the log subscription with the given ID from the enclave. If there is no subscription with
-	// the given ID, nothing is deleted.
+	// SubscribePendingTxs adds a pending transaction subscription to the enclave under the given ID, provided the
+	// request is authenticated correctly. The hashes of the subscribing account's transactions will be populated in the
+	// TxSubmissionResponse. If there is an existing subscription with the given ID, it is overwritten.
+	SubscribePendingTxs(id rpc.ID, encryptedParams EncryptedParamsPendingTxSubscription) error
+
+	// Unsubscribe removes the log or pending transaction subscription with the given ID from the enclave. If there is
+	// no subscription with the given ID, nothing is deleted.
 	Unsubscribe(id rpc.ID) error
 
 	// StopClient stops the enclave client if one exists - only implemented by the RPC layer
@@ -110,10 +143,17 @@ type BlockSubmissionResponse struct {
 	ProducedBatch           *ExtBatch                 // The batch produced iff the node is a sequencer and is on the latest block.
 	ProducedRollup          *ExtRollup                // The rollup produced iff the node is a sequencer and it is time to produce a new rollup.
 	ProducedSecretResponses []*ProducedSecretResponse // The responses to any secret requests in the ingested L1 block.
+	RejectedSecretRequests  []*RejectedSecretRequest  // The secret requests in the ingested L1 block that the enclave refused to respond to.
 	SubscribedLogs          map[rpc.ID][]byte         // The logs produced by the L1 block and all its ancestors for each subscription ID.
 	RejectError             *BlockRejectError         // If block was rejected, contains information about what block to submit next.
 }
 
+// TxSubmissionResponse is the response sent from the enclave back to the node after submitting a transaction
+type TxSubmissionResponse struct {
+	EncryptedHash      EncryptedResponseSendRawTx // The transaction's hash, encrypted with the viewing key of its sender.
+	SubscribedTxHashes map[rpc.ID][]byte          // The transaction's hash for each of its sender's pending transaction subscriptions.
+}
+
 // ProducedSecretResponse contains the data to publish to L1 in response to a secret request discovered while processing an L1 block
 type ProducedSecretResponse struct {
 	Secret      []byte
@@ -121,6 +161,13 @@ type ProducedSecretResponse struct {
 	HostAddress string
 }
 
+// RejectedSecretRequest records a secret request discovered while processing an L1 block that the enclave will not respond
+// to, because the requester's attestation could not be verified or does not satisfy the attestation policy
+type RejectedSecretRequest struct {
+	RequesterID gethcommon.Address // The zero address if the attestation could not be decoded.
+	Reason      string
+}
+
 // Standard errors that can be returned from block submission
 var (
 	ErrBlockAlreadyProcessed = errors.New("block already processed")
@@ -0,0 +1,282 @@
+package enclaverecording
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/snappy"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Version of the recording format, bumped whenever a change to the format breaks older readers
+const Version = 1
+
+// EntryKind identifies the enclave input held by an Entry
+type EntryKind uint8
+
+const (
+	// SessionStart marks the start of a recording session (the host started), the entry carries the Session
+	SessionStart EntryKind = iota
+	// L1Block is an L1 block submitted to the enclave, along with its receipts
+	L1Block
+	// Batch is a batch received from the sequencer and submitted to the enclave
+	Batch
+	// ProducedBatch is a batch produced by the enclave when it ingested the preceding L1 block (sequencer only)
+	ProducedBatch
+)
+
+func (k EntryKind) String() string {
+	switch k {
+	case SessionStart:
+		return "session start"
+	case L1Block:
+		return "L1 block"
+	case Batch:
+		return "batch"
+	case ProducedBatch:
+		return "produced batch"
+	default:
+		return fmt.Sprintf("unknown(%d)", k)
+	}
+}
+
+// Session describes the node a recording session was made on
+type Session struct {
+	Version                   uint64
+	HostID                    gethcommon.Address
+	NodeType                  string
+	L1ChainID                 uint64
+	ObscuroChainID            uint64
+	ManagementContractAddress gethcommon.Address
+	StartTime                 uint64 // unix time at which the session started
+}
+
+// Entry is a single enclave input, along with the outcome observed when the host submitted it
+type Entry struct {
+	Kind    EntryKind `rlp:"-"`
+	Session *Session  `rlp:"-"` // only set for SessionStart entries
+
+	Block    []byte // the RLP-encoded L1 block, exactly as sent to the enclave
+	Receipts []byte // the RLP-encoded L1 receipts, exactly as sent to the enclave
+	IsLatest bool
+	Batch    []byte // the RLP-encoded batch
+
+	Outcome Outcome // how the enclave responded to the input
+}
+
+// Outcome is the enclave's response to an input
+type Outcome struct {
+	Err          string          // the error returned by the enclave, empty if the input was accepted
+	Rejected     bool            // whether the error was a common.BlockRejectError
+	RejectL1Head gethcommon.Hash // the L1 head reported by the enclave when it rejected the block
+}
+
+// NewOutcome returns the outcome of an input the enclave responded to with err
+func NewOutcome(err error) Outcome {
+	if err == nil {
+		return Outcome{}
+	}
+	outcome := Outcome{Err: err.Error()}
+	var rejErr *common.BlockRejectError
+	if errors.As(err, &rejErr) {
+		outcome.Rejected = true
+		outcome.RejectL1Head = rejErr.L1Head
+	}
+	return outcome
+}
+
+// Accepted returns whether the enclave accepted the input
+func (o Outcome) Accepted() bool {
+	return o.Err == ""
+}
+
+func (o Outcome) String() string {
+	switch {
+	case o.Accepted():
+		return "accepted"
+	case o.Rejected:
+		return fmt.Sprintf("rejected (enclave L1 head %s): %s", o.RejectL1Head, o.Err)
+	default:
+		return fmt.Sprintf("failed: %s", o.Err)
+	}
+}
+
+// record is the envelope in which entries are written to the recording
+type record struct {
+	Kind    EntryKind
+	Payload []byte // the snappy-compressed RLP encoding of the entry (or of the session for SessionStart entries)
+}
+
+// NewL1BlockEntry returns the entry for an L1 block submitted to the enclave and the enclave's response to it
+func NewL1BlockEntry(block types.Block, receipts types.Receipts, isLatest bool, submitErr error) (*Entry, error) {
+	var encodedBlock bytes.Buffer
+	if err := block.EncodeRLP(&encodedBlock); err != nil {
+		return nil, fmt.Errorf("could not encode block. Cause: %w", err)
+	}
+	encodedReceipts, err := rlp.EncodeToBytes(receipts)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode receipts. Cause: %w", err)
+	}
+	return &Entry{
+		Kind:     L1Block,
+		Block:    encodedBlock.Bytes(),
+		Receipts: encodedReceipts,
+		IsLatest: isLatest,
+		Outcome:  NewOutcome(submitErr),
+	}, nil
+}
+
+// NewBatchEntry returns the entry for a batch of the given kind (Batch or ProducedBatch) and the enclave's response to it
+func NewBatchEntry(kind EntryKind, batch *common.ExtBatch, submitErr error) (*Entry, error) {
+	encodedBatch, err := rlp.EncodeToBytes(batch)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode batch. Cause: %w", err)
+	}
+	return &Entry{Kind: kind, Batch: encodedBatch, Outcome: NewOutcome(submitErr)}, nil
+}
+
+// DecodeBlock returns the L1 block held by an L1Block entry
+func (e *Entry) DecodeBlock() (*types.Block, error) {
+	block := new(types.Block)
+	if err := rlp.DecodeBytes(e.Block, block); err != nil {
+		return nil, fmt.Errorf("could not decode block. Cause: %w", err)
+	}
+	return block, nil
+}
+
+// DecodeReceipts returns the L1 receipts held by an L1Block entry
+func (e *Entry) DecodeReceipts() (types.Receipts, error) {
+	receipts := make(types.Receipts, 0)
+	if err := rlp.DecodeBytes(e.Receipts, &receipts); err != nil {
+		return nil, fmt.Errorf("could not decode receipts. Cause: %w", err)
+	}
+	return receipts, nil
+}
+
+// DecodeBatch returns the batch held by a Batch or ProducedBatch entry
+func (e *Entry) DecodeBatch() (*common.ExtBatch, error) {
+	batch := new(common.ExtBatch)
+	if err := rlp.DecodeBytes(e.Batch, batch); err != nil {
+		return nil, fmt.Errorf("could not decode batch. Cause: %w", err)
+	}
+	return batch, nil
+}
+
+// Recorder appends entries to a recording file. The file is a stream of RLP-encoded records, each entry is written as
+// soon as it is recorded so a recording remains readable up to its last complete entry if the host crashes. Recordings
+// are appended to, so a host restart starts a new session in the same file.
+type Recorder struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// NewRecorder opens (or creates) the recording file at path and starts a new session in it
+func NewRecorder(path string, session Session) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open recording file %s. Cause: %w", path, err)
+	}
+	r := &Recorder{file: file}
+
+	session.Version = Version
+	if err = r.Record(&Entry{Kind: SessionStart, Session: &session}); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record appends an entry to the recording
+func (r *Recorder) Record(entry *Entry) error {
+	var payload []byte
+	var err error
+	if entry.Kind == SessionStart {
+		payload, err = rlp.EncodeToBytes(entry.Session)
+	} else {
+		payload, err = rlp.EncodeToBytes(entry)
+	}
+	if err != nil {
+		return fmt.Errorf("could not encode recording entry. Cause: %w", err)
+	}
+	encodedRecord, err := rlp.EncodeToBytes(&record{Kind: entry.Kind, Payload: snappy.Encode(nil, payload)})
+	if err != nil {
+		return fmt.Errorf("could not encode recording entry. Cause: %w", err)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, err = r.file.Write(encodedRecord); err != nil {
+		return fmt.Errorf("could not write recording entry. Cause: %w", err)
+	}
+	return nil
+}
+
+// Close closes the recording file
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.file.Close()
+}
+
+// Reader reads the entries of a recording in the order they were recorded
+type Reader struct {
+	file   *os.File
+	stream *rlp.Stream
+}
+
+// OpenRecording opens the recording file at path for reading
+func OpenRecording(path string) (*Reader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open recording file %s. Cause: %w", path, err)
+	}
+	return &Reader{file: file, stream: rlp.NewStream(bufio.NewReader(file), 0)}, nil
+}
+
+// Next returns the next entry of the recording, or io.EOF once all entries were read. A recording cut short (e.g.
+// because the host crashed mid-write) ends at its last complete entry.
+func (r *Reader) Next() (*Entry, error) {
+	var rec record
+	if err := r.stream.Decode(&rec); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("could not read recording entry. Cause: %w", err)
+	}
+	payload, err := snappy.Decode(nil, rec.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress recording entry. Cause: %w", err)
+	}
+
+	if rec.Kind == SessionStart {
+		session := new(Session)
+		if err = rlp.DecodeBytes(payload, session); err != nil {
+			return nil, fmt.Errorf("could not decode recording session. Cause: %w", err)
+		}
+		if session.Version != Version {
+			return nil, fmt.Errorf("unsupported recording version %d, expected %d", session.Version, Version)
+		}
+		return &Entry{Kind: SessionStart, Session: session}, nil
+	}
+
+	entry := new(Entry)
+	if err = rlp.DecodeBytes(payload, entry); err != nil {
+		return nil, fmt.Errorf("could not decode recording entry. Cause: %w", err)
+	}
+	entry.Kind = rec.Kind
+	return entry, nil
+}
+
+// Close closes the recording file
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
@@ -0,0 +1,72 @@
+package enclaverecording
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// recordingEnclave is a common.Enclave that records the L1 blocks and batches submitted to the wrapped enclave, along
+// with the batches the enclave produced, so the enclave's behaviour can be replayed (see tools/enclavereplay)
+type recordingEnclave struct {
+	common.Enclave
+	recorder *Recorder
+	logger   gethlog.Logger
+}
+
+// NewRecordingEnclave wraps an enclave so that its inputs are recorded by the recorder. Failing to record an input
+// is logged but does not fail the submission.
+func NewRecordingEnclave(enclave common.Enclave, recorder *Recorder, logger gethlog.Logger) common.Enclave {
+	return &recordingEnclave{
+		Enclave:  enclave,
+		recorder: recorder,
+		logger:   logger,
+	}
+}
+
+func (e *recordingEnclave) SubmitL1Block(block types.Block, receipts types.Receipts, isLatest bool) (*common.BlockSubmissionResponse, error) {
+	response, submitErr := e.Enclave.SubmitL1Block(block, receipts, isLatest)
+
+	entry, err := NewL1BlockEntry(block, receipts, isLatest, submitErr)
+	if err != nil {
+		e.logger.Error("Could not record L1 block.", log.ErrKey, err)
+		return response, submitErr
+	}
+	e.record(entry)
+
+	if submitErr == nil && response.ProducedBatch != nil && response.ProducedBatch.Header != nil {
+		e.recordBatch(ProducedBatch, response.ProducedBatch, nil)
+	}
+	return response, submitErr
+}
+
+func (e *recordingEnclave) SubmitBatch(batch *common.ExtBatch) error {
+	submitErr := e.Enclave.SubmitBatch(batch)
+	e.recordBatch(Batch, batch, submitErr)
+	return submitErr
+}
+
+func (e *recordingEnclave) StopClient() error {
+	err := e.Enclave.StopClient()
+	if closeErr := e.recorder.Close(); closeErr != nil {
+		e.logger.Error("Could not close enclave recording.", log.ErrKey, closeErr)
+	}
+	return err
+}
+
+func (e *recordingEnclave) recordBatch(kind EntryKind, batch *common.ExtBatch, submitErr error) {
+	entry, err := NewBatchEntry(kind, batch, submitErr)
+	if err != nil {
+		e.logger.Error("Could not record batch.", log.ErrKey, err)
+		return
+	}
+	e.record(entry)
+}
+
+func (e *recordingEnclave) record(entry *Entry) {
+	if err := e.recorder.Record(entry); err != nil {
+		e.logger.Error("Could not record enclave input.", log.ErrKey, err)
+	}
+}
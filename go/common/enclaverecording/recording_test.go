@@ -0,0 +1,141 @@
+package enclaverecording
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestRecordingIsReadBackInOrderAcrossSessions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording")
+	block := types.NewBlockWithHeader(&types.Header{Number: gethcommon.Big1})
+	rejectErr := &common.BlockRejectError{L1Head: gethcommon.HexToHash("0x1"), Wrapped: errors.New("block was rejected")}
+
+	recorder, err := NewRecorder(path, Session{HostID: gethcommon.HexToAddress("0x2"), NodeType: common.Sequencer.String()})
+	if err != nil {
+		t.Fatalf("could not create recorder. Cause: %s", err)
+	}
+	recordEntry(t, recorder, mustL1BlockEntry(t, block, nil))
+	recordEntry(t, recorder, mustL1BlockEntry(t, block, rejectErr))
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("could not close recorder. Cause: %s", err)
+	}
+
+	// a host restart appends a new session to the recording
+	recorder, err = NewRecorder(path, Session{HostID: gethcommon.HexToAddress("0x2"), NodeType: common.Sequencer.String()})
+	if err != nil {
+		t.Fatalf("could not create recorder. Cause: %s", err)
+	}
+	batch := &common.ExtBatch{Header: &common.BatchHeader{Number: gethcommon.Big2}}
+	batchEntry, err := NewBatchEntry(ProducedBatch, batch, nil)
+	if err != nil {
+		t.Fatalf("could not create batch entry. Cause: %s", err)
+	}
+	recordEntry(t, recorder, batchEntry)
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("could not close recorder. Cause: %s", err)
+	}
+
+	entries := readAll(t, path)
+	expectedKinds := []EntryKind{SessionStart, L1Block, L1Block, SessionStart, ProducedBatch}
+	if len(entries) != len(expectedKinds) {
+		t.Fatalf("expected %d entries, got %d", len(expectedKinds), len(entries))
+	}
+	for i, kind := range expectedKinds {
+		if entries[i].Kind != kind {
+			t.Errorf("expected entry %d to be a %s, got a %s", i, kind, entries[i].Kind)
+		}
+	}
+
+	if entries[0].Session.HostID != gethcommon.HexToAddress("0x2") || entries[0].Session.Version != Version {
+		t.Errorf("session was not read back, got %+v", entries[0].Session)
+	}
+	decodedBlock, err := entries[1].DecodeBlock()
+	if err != nil {
+		t.Fatalf("could not decode block. Cause: %s", err)
+	}
+	if decodedBlock.Hash() != block.Hash() {
+		t.Errorf("expected block %s, got %s", block.Hash(), decodedBlock.Hash())
+	}
+	if !entries[1].Outcome.Accepted() {
+		t.Errorf("expected first block to be recorded as accepted, got %s", entries[1].Outcome)
+	}
+	if !entries[2].Outcome.Rejected || entries[2].Outcome.RejectL1Head != rejectErr.L1Head {
+		t.Errorf("expected second block to be recorded as rejected, got %s", entries[2].Outcome)
+	}
+	decodedBatch, err := entries[4].DecodeBatch()
+	if err != nil {
+		t.Fatalf("could not decode batch. Cause: %s", err)
+	}
+	if decodedBatch.Hash() != batch.Hash() {
+		t.Errorf("expected batch %s, got %s", batch.Hash(), decodedBatch.Hash())
+	}
+}
+
+func TestTruncatedRecordingEndsAtLastCompleteEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording")
+	recorder, err := NewRecorder(path, Session{})
+	if err != nil {
+		t.Fatalf("could not create recorder. Cause: %s", err)
+	}
+	block := types.NewBlockWithHeader(&types.Header{Number: gethcommon.Big1})
+	recordEntry(t, recorder, mustL1BlockEntry(t, block, nil))
+	recordEntry(t, recorder, mustL1BlockEntry(t, block, nil))
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("could not close recorder. Cause: %s", err)
+	}
+
+	// simulate the host crashing while it was writing the last entry
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("could not stat recording. Cause: %s", err)
+	}
+	if err = os.Truncate(path, info.Size()-5); err != nil {
+		t.Fatalf("could not truncate recording. Cause: %s", err)
+	}
+
+	if entries := readAll(t, path); len(entries) != 2 {
+		t.Errorf("expected the session and the first block to be read back, got %d entries", len(entries))
+	}
+}
+
+func mustL1BlockEntry(t *testing.T, block *types.Block, submitErr error) *Entry {
+	entry, err := NewL1BlockEntry(*block, types.Receipts{}, true, submitErr)
+	if err != nil {
+		t.Fatalf("could not create L1 block entry. Cause: %s", err)
+	}
+	return entry
+}
+
+func recordEntry(t *testing.T, recorder *Recorder, entry *Entry) {
+	if err := recorder.Record(entry); err != nil {
+		t.Fatalf("could not record entry. Cause: %s", err)
+	}
+}
+
+func readAll(t *testing.T, path string) []*Entry {
+	reader, err := OpenRecording(path)
+	if err != nil {
+		t.Fatalf("could not open recording. Cause: %s", err)
+	}
+	defer reader.Close()
+
+	var entries []*Entry
+	for {
+		entry, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return entries
+		}
+		if err != nil {
+			t.Fatalf("could not read entry. Cause: %s", err)
+		}
+		entries = append(entries, entry)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/common/gethapi"
 
@@ -106,19 +107,21 @@ func ExtractOptionalBlockNumber(params []interface{}, idx int) (*gethrpc.BlockNu
 	return ExtractBlockNumber(params[idx])
 }
 
-// ExtractBlockNumber returns a gethrpc.BlockNumber given an interface{}, errors if unexpected values are used
+// ExtractBlockNumber returns a gethrpc.BlockNumber given an interface{}, errors if unexpected values are used. The
+// `safe` and `finalized` tags are returned as common.SafeBlockNumber and common.FinalizedBlockNumber.
 func ExtractBlockNumber(param interface{}) (*gethrpc.BlockNumber, error) {
 	if param == nil {
 		return nil, errutil.ErrNotFound
 	}
 
-	blockNumber := gethrpc.BlockNumber(0)
+	blockNumber := common.BlockNumber(0)
 	err := blockNumber.UnmarshalJSON([]byte(param.(string)))
 	if err != nil {
 		return nil, fmt.Errorf("could not parse requested rollup number %s - %w", param.(string), err)
 	}
 
-	return &blockNumber, err
+	rpcBlockNumber := gethrpc.BlockNumber(blockNumber)
+	return &rpcBlockNumber, err
 }
 
 // ExtractEthCall extracts the eth_call gethapi.TransactionArgs from an interface{}
@@ -169,11 +172,11 @@ func ExtractEthCall(param interface{}) (*gethapi.TransactionArgs, error) {
 			gas = (*hexutil.Uint64)(&gasVal)
 
 		case callFieldGasPrice:
-			valueVal, err := hexutil.DecodeBig(valString)
+			gasPriceVal, err := hexutil.DecodeBig(valString)
 			if err != nil {
-				return nil, fmt.Errorf("could not decode value in CallMsg - %w", err)
+				return nil, fmt.Errorf("could not decode gas price in CallMsg - %w", err)
 			}
-			value = (*hexutil.Big)(valueVal)
+			gasPrice = (*hexutil.Big)(gasPriceVal)
 
 		case callFieldMaxFeePerGas:
 			maxFeePerGasVal, err := hexutil.DecodeBig(valString)
@@ -0,0 +1,47 @@
+package gethencoding
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+func TestExtractEthCallKeepsGasPriceAndValueSeparate(t *testing.T) {
+	callMsg, err := ExtractEthCall(map[string]interface{}{
+		"from":     "0x0000000000000000000000000000000000000001",
+		"gasPrice": "0x1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if callMsg.Value != nil {
+		t.Fatalf("expected no value, got %d", callMsg.Value.ToInt())
+	}
+	if callMsg.GasPrice == nil || callMsg.GasPrice.ToInt().Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("expected gas price of 1, got %v", callMsg.GasPrice)
+	}
+}
+
+func TestExtractBlockNumberAcceptsSafeAndFinalizedTags(t *testing.T) {
+	expected := map[string]gethrpc.BlockNumber{
+		"latest":    gethrpc.LatestBlockNumber,
+		"safe":      common.SafeBlockNumber,
+		"finalized": common.FinalizedBlockNumber,
+		"0x5":       gethrpc.BlockNumber(5),
+	}
+	for param, number := range expected {
+		blockNumber, err := ExtractBlockNumber(param)
+		if err != nil {
+			t.Fatalf("could not extract block number %s. Cause: %s", param, err)
+		}
+		if *blockNumber != number {
+			t.Errorf("expected %s to be extracted as %d, got %d", param, number, *blockNumber)
+		}
+	}
+	if _, err := ExtractBlockNumber("unsafe"); err == nil {
+		t.Error("expected unknown tag to be rejected")
+	}
+}
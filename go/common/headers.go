@@ -105,7 +105,7 @@ func (b *BatchHeader) ToRollupHeader() *RollupHeader {
 		UncleHash:                     b.UncleHash,
 		Coinbase:                      b.Coinbase,
 		Root:                          b.Root,
-		HeadBatchHash:                 b.TxHash,
+		HeadBatchHash:                 b.Hash(),
 		ReceiptHash:                   b.ReceiptHash,
 		Bloom:                         b.Bloom,
 		Difficulty:                    b.Difficulty,
@@ -28,15 +28,27 @@ type Host interface {
 	ReceiveBatches(batches common.EncodedBatchMsg)
 	// ReceiveBatchRequest receives a batch request from a peer host. Used during catch-up.
 	ReceiveBatchRequest(batchRequest common.EncodedBatchRequest)
-	// Subscribe feeds logs matching the encrypted log subscription to the matchedLogs channel.
-	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogs chan []byte) error
-	// Unsubscribe terminates a log subscription between the host and the enclave.
+	// Subscribe feeds logs matching the encrypted log subscription to the matchedLogs channel. If the subscription
+	// requests a replay of historical logs, the encrypted historical logs are returned, and must be delivered before
+	// any logs on the channel.
+	Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogs chan []byte) (common.EncryptedLogs, error)
+	// SubscribePendingTxs feeds the hashes of the subscribing account's submitted transactions, encrypted with its
+	// viewing key, to the txHashes channel.
+	SubscribePendingTxs(id rpc.ID, encryptedParams common.EncryptedParamsPendingTxSubscription, txHashes chan []byte) error
+	// SubscribeNewHeads feeds the headers of new batches to the batchHeaders channel.
+	SubscribeNewHeads(id rpc.ID, batchHeaders chan *common.BatchHeader)
+	// SubscribeRollups feeds the headers of the rollups published to the L1 to the rollupHeaders channel.
+	SubscribeRollups(id rpc.ID, rollupHeaders chan *common.RollupHeader)
+	// Unsubscribe terminates a subscription, including the log or pending transaction subscription between the host
+	// and the enclave.
 	Unsubscribe(id rpc.ID)
 	// Stop gracefully stops the host execution.
 	Stop()
 
 	// HealthCheck returns the health status of the host + enclave + db
 	HealthCheck() (*HealthCheck, error)
+	// SyncStatus returns the node's progress processing the L1 and L2 chains
+	SyncStatus() (*SyncStatus, error)
 }
 
 // P2P is the layer responsible for sending and receiving messages to Obscuro network peers.
@@ -79,6 +91,8 @@ type ReconnectingBlockProvider interface {
 	StartStreamingFromHeight(height *big.Int) (*BlockStream, error)
 	StartStreamingFromHash(latestHash gethcommon.Hash) (*BlockStream, error)
 	IsLive(hash gethcommon.Hash) bool // returns true if hash is of the latest known L1 head block
+	// Receipts returns the receipts of a recently streamed block, if the provider fetched them along with the block
+	Receipts(hash gethcommon.Hash) (types.Receipts, bool)
 }
 
 type BlockStream struct {
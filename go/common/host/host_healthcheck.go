@@ -1,8 +1,18 @@
 package host
 
+import (
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
 // HealthCheck is the object returned by the API with the Health and Status of the Node
 type HealthCheck struct {
 	OverallHealth bool
+	// Live is false if the node is not running correctly and should be restarted
+	Live bool
+	// Ready is true if every component of the node is healthy and caught up, so the node can serve requests
+	Ready bool
 	*HealthCheckHost
 	*HealthCheckEnclave
 }
@@ -10,16 +20,109 @@ type HealthCheck struct {
 // HealthCheckEnclave is the representation of the Health and Status of the Enclave
 type HealthCheckEnclave struct {
 	EnclaveHealthy bool
+	// Status is the status reported by the enclave (e.g. running, awaiting secret)
+	Status string
+	// L1Head is the hash of the latest L1 block the enclave has ingested (empty if it hasn't ingested any yet)
+	L1Head gethcommon.Hash
+	// Error describes why the enclave is unhealthy, if it is
+	Error string `json:",omitempty"`
 }
 
 // HealthCheckHost is the representation of the Health and Status of the Host
 type HealthCheckHost struct {
-	P2PStatus *P2PStatus
+	P2PStatus   *P2PStatus
+	L1Status    *L1Status
+	DBStatus    *DBStatus
+	BatchStatus *BatchStatus
+	L1TxStatus  *L1TxStatus
+	// RollupStatus reports the condition of the management contract's rollup tree, which is a property of the network
+	// rather than of the node, so it does not affect the node's readiness
+	RollupStatus *RollupStatus
 }
 
 // P2PStatus is the representation of the Status of the P2P layer
 type P2PStatus struct {
+	Healthy                bool
 	FailedReceivedMessages int64
 	FailedSendMessage      int64
 	ReceivedMessages       int64
+	// Peers holds the time the latest message was received from each peer
+	Peers []*PeerStatus
+}
+
+// PeerStatus is the representation of the Status of the host's communication with one of its peers
+type PeerStatus struct {
+	Address         string
+	LastMessageTime time.Time
+	// Stale is true if no message has been received from the peer for longer than the configured threshold
+	Stale bool
+}
+
+// L1Status is the representation of the Status of the host's connection to the L1, and of the enclave's progress on it
+type L1Status struct {
+	Healthy bool
+	// Connected is true if the L1 node could be reached
+	Connected bool
+	// HeadHeight is the height of the L1 node's head block
+	HeadHeight uint64
+	// EnclaveHeadHeight is the height of the latest L1 block the enclave has ingested
+	EnclaveHeadHeight uint64
+	// Lag is the number of L1 blocks the enclave is behind the L1 head
+	Lag uint64
+	// Error describes why the L1 status is unhealthy, if it is
+	Error string `json:",omitempty"`
+}
+
+// DBStatus is the representation of the Status of the host's database
+type DBStatus struct {
+	Healthy bool
+	// Error describes why the database is unhealthy, if it is
+	Error string `json:",omitempty"`
+}
+
+// BatchStatus is the representation of the Status of the node's batch chain, compared to the sequencer's
+type BatchStatus struct {
+	Healthy bool
+	// HeadBatchHeight is the height of the node's head batch
+	HeadBatchHeight uint64
+	// SequencerHeadBatchHeight is the height of the latest batch received from the sequencer (the node's own head
+	// batch, for the sequencer)
+	SequencerHeadBatchHeight uint64
+	// Lag is the number of batches the node is behind the sequencer
+	Lag uint64
+	// Error describes why the batch status is unhealthy, if it is
+	Error string `json:",omitempty"`
+}
+
+// RollupStatus is the representation of the Status of the rollup tree stored by the management contract
+type RollupStatus struct {
+	Healthy bool
+	// L1Height is the height of the L1 block after which the management contract was last checked (0 if it hasn't been
+	// checked yet)
+	L1Height uint64
+	// ForkDetected is true if the rollup tree contains competing rollups that have been built on (i.e. a fork at least
+	// two rollups deep)
+	ForkDetected bool
+	// WithdrawalsAvailable is false once the management contract has halted withdrawals because of a fork
+	WithdrawalsAvailable bool
+	// Error describes why the rollup status is unhealthy, if it is
+	Error string `json:",omitempty"`
+}
+
+// L1TxStatus is the representation of the Status of the transactions the host has sent to the L1
+type L1TxStatus struct {
+	Healthy bool
+	// NextNonce is the nonce that will be assigned to the host's next L1 transaction
+	NextNonce uint64
+	// PendingTxs is the number of transactions that have been sent but not yet included in a block
+	PendingTxs int
+	// Replacements is the number of times the pending transactions have been replaced with higher fees
+	Replacements uint64
+	// CappedTxs is the number of stuck transactions that cannot be replaced, as they have reached the maximum fee per gas
+	// or number of replacements
+	CappedTxs int
+	// LastError describes the latest failure to send a transaction, or the latest reverted transaction
+	LastError string `json:",omitempty"`
+	// Error describes why the L1 transactions status is unhealthy, if it is
+	Error string `json:",omitempty"`
 }
@@ -0,0 +1,29 @@
+package host
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// SyncStatus is the object returned by the API with the node's progress processing the L1 and L2 chains
+type SyncStatus struct {
+	// L1HeadHash and L1HeadHeight identify the head of the L1, as seen by the host
+	L1HeadHash   gethcommon.Hash
+	L1HeadHeight uint64
+	// EnclaveL1HeadHash and EnclaveL1HeadHeight identify the latest L1 block ingested by the enclave (empty if the
+	// enclave hasn't ingested any block since the host started)
+	EnclaveL1HeadHash   gethcommon.Hash
+	EnclaveL1HeadHeight uint64
+	// HeadBatchHash and HeadBatchHeight identify the node's head batch (empty if the node has no batches yet)
+	HeadBatchHash   gethcommon.Hash
+	HeadBatchHeight uint64
+	// SequencerHeadBatchHeight is the height of the latest batch the node received from the sequencer over P2P (the
+	// node's own head batch, for the sequencer)
+	SequencerHeadBatchHeight uint64
+	// StartingBatchHeight is the height of the node's head batch when the host started
+	StartingBatchHeight uint64
+}
+
+// IsSyncing returns whether the node is still catching up with the sequencer's batches
+func (s *SyncStatus) IsSyncing() bool {
+	return s.HeadBatchHeight < s.SequencerHeadBatchHeight
+}
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// L1FinalityUpdate is sent by the host to an enclave that validates L1 blocks against the beacon chain, to prove which
+// L1 blocks the beacon chain has finalized. Its parts are applied in order.
+type L1FinalityUpdate struct {
+	// The data to start following the beacon chain from, for the enclave's trusted beacon block root (see
+	// `L1FinalityStatus.BootstrapRoot`). Only needed if the enclave is not bootstrapped yet
+	Bootstrap *beacon.LightClientBootstrap `json:"bootstrap,omitempty"`
+	// The sync committee updates from the enclave's sync committee period onwards, followed by a finality update
+	Updates []*beacon.LightClientUpdate `json:"updates,omitempty"`
+	// RLP-encoded `L1Header`s, each of which must either be of a finalized block or of the parent of one. The hashes of
+	// their parents are finalized in turn, so headers are sent newest first to prove the blocks behind a finalized block
+	Headers []hexutil.Bytes `json:"headers,omitempty"`
+}
+
+// L1Header is the header of an L1 block, including the fields the L1 added after the London fork, which the block
+// header type of the go-ethereum version this repo builds on lacks. Its RLP encoding hashes to the block hash.
+type L1Header struct {
+	ParentHash  gethcommon.Hash
+	UncleHash   gethcommon.Hash
+	Coinbase    gethcommon.Address
+	Root        gethcommon.Hash
+	TxHash      gethcommon.Hash
+	ReceiptHash gethcommon.Hash
+	Bloom       types.Bloom
+	Difficulty  *big.Int
+	Number      *big.Int
+	GasLimit    uint64
+	GasUsed     uint64
+	Time        uint64
+	Extra       []byte
+	MixDigest   gethcommon.Hash
+	Nonce       types.BlockNonce
+	BaseFee     *big.Int `rlp:"optional"` // added in the London fork
+
+	WithdrawalsHash  *gethcommon.Hash `rlp:"optional"` // added in the Shanghai fork
+	BlobGasUsed      *uint64          `rlp:"optional"` // added in the Cancun fork
+	ExcessBlobGas    *uint64          `rlp:"optional"` // added in the Cancun fork
+	ParentBeaconRoot *gethcommon.Hash `rlp:"optional"` // added in the Cancun fork
+	RequestsHash     *gethcommon.Hash `rlp:"optional"` // added in the Prague fork
+}
+
+// NewL1Header returns the L1 header with the fields of the given block header.
+func NewL1Header(header *types.Header) *L1Header {
+	return &L1Header{
+		ParentHash:  header.ParentHash,
+		UncleHash:   header.UncleHash,
+		Coinbase:    header.Coinbase,
+		Root:        header.Root,
+		TxHash:      header.TxHash,
+		ReceiptHash: header.ReceiptHash,
+		Bloom:       header.Bloom,
+		Difficulty:  header.Difficulty,
+		Number:      header.Number,
+		GasLimit:    header.GasLimit,
+		GasUsed:     header.GasUsed,
+		Time:        header.Time,
+		Extra:       header.Extra,
+		MixDigest:   header.MixDigest,
+		Nonce:       header.Nonce,
+		BaseFee:     header.BaseFee,
+	}
+}
+
+// Hash returns the hash of the block.
+func (h *L1Header) Hash() gethcommon.Hash {
+	encoded, _ := rlp.EncodeToBytes(h) // the fields of the header can always be encoded
+	return crypto.Keccak256Hash(encoded)
+}
+
+// L1FinalityStatus describes which L1 blocks an enclave that validates L1 blocks against the beacon chain will accept.
+// It will only accept blocks between the lowest and the highest finalized heights it has the hashes of.
+type L1FinalityStatus struct {
+	Bootstrapped    bool            `json:"bootstrapped"`
+	BootstrapRoot   gethcommon.Hash `json:"bootstrapRoot"`   // the trusted beacon block root the enclave bootstraps from
+	Period          uint64          `json:"period"`          // the sync committee period of the latest finalized beacon block
+	LowestHeight    uint64          `json:"lowestHeight"`    // the lowest height of a finalized L1 block the enclave has the hash of
+	FinalizedHeight uint64          `json:"finalizedHeight"` // the height of the latest finalized L1 block
+}
+
+// Covers returns whether the enclave has the hash of the finalized L1 block at the given height.
+func (s *L1FinalityStatus) Covers(height uint64) bool {
+	return s.Bootstrapped && s.LowestHeight <= height && height <= s.FinalizedHeight
+}
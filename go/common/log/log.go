@@ -21,20 +21,21 @@ const (
 
 // Logging is grouped by the component where it was initialised
 const (
-	EnclaveCmp      = "enclave"
-	HostCmp         = "host"
-	HostRPCCmp      = "host_rpc"
-	TxInjectCmp     = "tx_inject"
-	TestLogCmp      = "test_log"
-	P2PCmp          = "p2p"
-	RPCClientCmp    = "rpc_client"
-	DeployerCmp     = "deployer"
-	NetwMngCmp      = "network_manager"
-	WalletExtCmp    = "wallet_extension"
-	TestGethNetwCmp = "test_geth_network"
-	EthereumL1Cmp   = "l1_host"
-	ObscuroscanCmp  = "obscuroscan"
-	CrossChainCmp   = "cross_chain"
+	EnclaveCmp       = "enclave"
+	HostCmp          = "host"
+	HostRPCCmp       = "host_rpc"
+	TxInjectCmp      = "tx_inject"
+	TestLogCmp       = "test_log"
+	P2PCmp           = "p2p"
+	RPCClientCmp     = "rpc_client"
+	DeployerCmp      = "deployer"
+	NetwMngCmp       = "network_manager"
+	WalletExtCmp     = "wallet_extension"
+	TestGethNetwCmp  = "test_geth_network"
+	EthereumL1Cmp    = "l1_host"
+	ObscuroscanCmp   = "obscuroscan"
+	CrossChainCmp    = "cross_chain"
+	EnclaveReplayCmp = "enclave_replay"
 )
 
 // Used when the logger has to write to Sys.out
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -17,6 +19,9 @@ type LogSubscription struct {
 	Signature *[]byte
 	// A subscriber-defined filter to apply to the stream of logs.
 	Filter *filters.FilterCriteria
+	// If set, the number of the batch from which matching historical logs are replayed, before new logs are delivered.
+	// This is separate from the filter's `FromBlock`, since RLP cannot distinguish a nil block number from zero.
+	ReplayFromBatch *big.Int `rlp:"optional"`
 }
 
 // IDAndEncLog pairs an encrypted log with the ID of the subscription that generated it.
@@ -37,3 +37,31 @@ func ExtRollupFromExtBatches(batches []*ExtBatch) *ExtRollup {
 		Batches: batches,
 	}
 }
+
+// PublicRollup is the public information about a rollup published to the L1.
+type PublicRollup struct {
+	Header      *RollupHeader
+	L1Block     L1RootHash   // The L1 block the rollup was published in.
+	BatchHashes []L2RootHash // The hashes of the batches included in the rollup.
+}
+
+// ToPublicRollup returns the public information about the rollup, given the L1 block it was published in.
+func (r *ExtRollup) ToPublicRollup(l1Block L1RootHash) *PublicRollup {
+	batchHashes := make([]L2RootHash, len(r.Batches))
+	for i, batch := range r.Batches {
+		batchHashes[i] = batch.Hash()
+	}
+	return &PublicRollup{
+		Header:      r.Header,
+		L1Block:     l1Block,
+		BatchHashes: batchHashes,
+	}
+}
+
+// ExtendsHeadRollup applies the management contract's fork-choice rule: a rollup becomes the new head rollup if it is
+// built on the head rollup. Since the contract applies the rule to the rollups in the order they are published on the
+// L1, the first rollup published on top of the head wins, and competing rollups are kept on their own branch of the
+// contract's rollup tree.
+func ExtendsHeadRollup(rollup *RollupHeader, headRollup *RollupHeader) bool {
+	return rollup.ParentHash == headRollup.Hash()
+}
@@ -19,7 +19,13 @@ import (
 // Protobuf message classes.
 
 func ToAttestationReportMsg(report *common.AttestationReport) generated.AttestationReportMsg {
-	return generated.AttestationReportMsg{Report: report.Report, PubKey: report.PubKey, Owner: report.Owner.Bytes(), HostAddress: report.HostAddress}
+	return generated.AttestationReportMsg{
+		Report:      report.Report,
+		PubKey:      report.PubKey,
+		Owner:       report.Owner.Bytes(),
+		HostAddress: report.HostAddress,
+		RPCPubKey:   report.RPCPubKey,
+	}
 }
 
 func FromAttestationReportMsg(msg *generated.AttestationReportMsg) *common.AttestationReport {
@@ -28,6 +34,7 @@ func FromAttestationReportMsg(msg *generated.AttestationReportMsg) *common.Attes
 		PubKey:      msg.PubKey,
 		Owner:       gethcommon.BytesToAddress(msg.Owner),
 		HostAddress: msg.HostAddress,
+		RPCPubKey:   msg.RPCPubKey,
 	}
 }
 
@@ -45,6 +52,7 @@ func ToBlockSubmissionResponseMsg(response *common.BlockSubmissionResponse) (gen
 		ProducedRollup:          &producedRollupMsg,
 		SubscribedLogs:          subscribedLogBytes,
 		ProducedSecretResponses: ToSecretRespMsg(response.ProducedSecretResponses),
+		RejectedSecretRequests:  ToRejectedSecretRequestMsg(response.RejectedSecretRequests),
 	}, nil
 }
 
@@ -87,6 +95,28 @@ func FromSecretRespMsg(secretResponses []*generated.SecretResponseMsg) []*common
 	return respList
 }
 
+func ToRejectedSecretRequestMsg(rejections []*common.RejectedSecretRequest) []*generated.RejectedSecretRequestMsg {
+	rejectionMsgs := make([]*generated.RejectedSecretRequestMsg, len(rejections))
+	for i, rejection := range rejections {
+		rejectionMsgs[i] = &generated.RejectedSecretRequestMsg{
+			RequesterID: rejection.RequesterID.Bytes(),
+			Reason:      rejection.Reason,
+		}
+	}
+	return rejectionMsgs
+}
+
+func FromRejectedSecretRequestMsg(rejectionMsgs []*generated.RejectedSecretRequestMsg) []*common.RejectedSecretRequest {
+	rejections := make([]*common.RejectedSecretRequest, len(rejectionMsgs))
+	for i, msg := range rejectionMsgs {
+		rejections[i] = &common.RejectedSecretRequest{
+			RequesterID: gethcommon.BytesToAddress(msg.RequesterID),
+			Reason:      msg.Reason,
+		}
+	}
+	return rejections
+}
+
 func FromBlockSubmissionResponseMsg(msg *generated.BlockSubmissionResponseMsg) (*common.BlockSubmissionResponse, error) {
 	if msg.Error != nil {
 		return nil, &common.BlockRejectError{
@@ -103,6 +133,29 @@ func FromBlockSubmissionResponseMsg(msg *generated.BlockSubmissionResponseMsg) (
 		ProducedRollup:          FromExtRollupMsg(msg.ProducedRollup),
 		SubscribedLogs:          subscribedLogs,
 		ProducedSecretResponses: FromSecretRespMsg(msg.ProducedSecretResponses),
+		RejectedSecretRequests:  FromRejectedSecretRequestMsg(msg.RejectedSecretRequests),
+	}, nil
+}
+
+func ToSubmitTxResponseMsg(response *common.TxSubmissionResponse) (*generated.SubmitTxResponse, error) {
+	subscribedTxHashBytes, err := json.Marshal(response.SubscribedTxHashes)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal subscribed transaction hashes to JSON. Cause: %w", err)
+	}
+	return &generated.SubmitTxResponse{
+		EncryptedHash:      response.EncryptedHash,
+		SubscribedTxHashes: subscribedTxHashBytes,
+	}, nil
+}
+
+func FromSubmitTxResponseMsg(msg *generated.SubmitTxResponse) (*common.TxSubmissionResponse, error) {
+	var subscribedTxHashes map[rpc.ID][]byte
+	if err := json.Unmarshal(msg.SubscribedTxHashes, &subscribedTxHashes); err != nil {
+		return nil, fmt.Errorf("could not unmarshal subscribed transaction hashes from submission response JSON. Cause: %w", err)
+	}
+	return &common.TxSubmissionResponse{
+		EncryptedHash:      msg.EncryptedHash,
+		SubscribedTxHashes: subscribedTxHashes,
 	}, nil
 }
 
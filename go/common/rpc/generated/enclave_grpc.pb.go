@@ -43,6 +43,12 @@ type EnclaveProtoClient interface {
 	SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error)
 	// SubmitBatch submits a batch received from the sequencer for processing.
 	SubmitBatch(ctx context.Context, in *SubmitBatchRequest, opts ...grpc.CallOption) (*SubmitBatchResponse, error)
+	// SubmitL1Finality proves which L1 blocks are finalized to an enclave that validates L1 blocks against the beacon
+	// chain, and returns which L1 blocks it will accept
+	SubmitL1Finality(ctx context.Context, in *SubmitL1FinalityRequest, opts ...grpc.CallOption) (*SubmitL1FinalityResponse, error)
+	// SubmitFinalizedL1Block tells an enclave that does not validate L1 blocks against the beacon chain which of the L1
+	// blocks it has ingested is finalized
+	SubmitFinalizedL1Block(ctx context.Context, in *SubmitFinalizedL1BlockRequest, opts ...grpc.CallOption) (*SubmitFinalizedL1BlockResponse, error)
 	// ExecuteOffChainTransaction - returns the result of executing the smart contract as a user, encrypted with the
 	// viewing key corresponding to the `from` field
 	ExecuteOffChainTransaction(ctx context.Context, in *OffChainRequest, opts ...grpc.CallOption) (*OffChainResponse, error)
@@ -55,6 +61,9 @@ type EnclaveProtoClient interface {
 	// GetTransaction returns a transaction receipt given the transaction's signed hash, encrypted with the viewing key
 	// corresponding to the original transaction submitter
 	GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error)
+	// GetTransactionStatus returns the progress of a transaction given its signed hash, encrypted with the viewing key
+	// corresponding to the original transaction submitter
+	GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error)
 	// AddViewingKey adds a viewing key to the enclave
 	AddViewingKey(ctx context.Context, in *AddViewingKeyRequest, opts ...grpc.CallOption) (*AddViewingKeyResponse, error)
 	// GetBalance returns the address's balance on the Obscuro network, encrypted with the viewing key corresponding to
@@ -63,6 +72,8 @@ type EnclaveProtoClient interface {
 	// GetCode returns the code stored at the given address in the state for the given rollup height or rollup hash
 	GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	// SubscribePendingTxs adds a subscription to the hashes of the subscribing account's submitted transactions
+	SubscribePendingTxs(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
 	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
 	// EstimateGas returns the estimation of gas used for the given transactions
 	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
@@ -143,6 +154,24 @@ func (c *enclaveProtoClient) SubmitBatch(ctx context.Context, in *SubmitBatchReq
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SubmitL1Finality(ctx context.Context, in *SubmitL1FinalityRequest, opts ...grpc.CallOption) (*SubmitL1FinalityResponse, error) {
+	out := new(SubmitL1FinalityResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitL1Finality", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) SubmitFinalizedL1Block(ctx context.Context, in *SubmitFinalizedL1BlockRequest, opts ...grpc.CallOption) (*SubmitFinalizedL1BlockResponse, error) {
+	out := new(SubmitFinalizedL1BlockResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubmitFinalizedL1Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) ExecuteOffChainTransaction(ctx context.Context, in *OffChainRequest, opts ...grpc.CallOption) (*OffChainResponse, error) {
 	out := new(OffChainResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/ExecuteOffChainTransaction", in, out, opts...)
@@ -188,6 +217,15 @@ func (c *enclaveProtoClient) GetTransactionReceipt(ctx context.Context, in *GetT
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error) {
+	out := new(GetTransactionStatusResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/GetTransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) AddViewingKey(ctx context.Context, in *AddViewingKeyRequest, opts ...grpc.CallOption) (*AddViewingKeyResponse, error) {
 	out := new(AddViewingKeyResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/AddViewingKey", in, out, opts...)
@@ -224,6 +262,15 @@ func (c *enclaveProtoClient) Subscribe(ctx context.Context, in *SubscribeRequest
 	return out, nil
 }
 
+func (c *enclaveProtoClient) SubscribePendingTxs(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/SubscribePendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
 	out := new(UnsubscribeResponse)
 	err := c.cc.Invoke(ctx, "/generated.EnclaveProto/Unsubscribe", in, out, opts...)
@@ -294,6 +341,12 @@ type EnclaveProtoServer interface {
 	SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error)
 	// SubmitBatch submits a batch received from the sequencer for processing.
 	SubmitBatch(context.Context, *SubmitBatchRequest) (*SubmitBatchResponse, error)
+	// SubmitL1Finality proves which L1 blocks are finalized to an enclave that validates L1 blocks against the beacon
+	// chain, and returns which L1 blocks it will accept
+	SubmitL1Finality(context.Context, *SubmitL1FinalityRequest) (*SubmitL1FinalityResponse, error)
+	// SubmitFinalizedL1Block tells an enclave that does not validate L1 blocks against the beacon chain which of the L1
+	// blocks it has ingested is finalized
+	SubmitFinalizedL1Block(context.Context, *SubmitFinalizedL1BlockRequest) (*SubmitFinalizedL1BlockResponse, error)
 	// ExecuteOffChainTransaction - returns the result of executing the smart contract as a user, encrypted with the
 	// viewing key corresponding to the `from` field
 	ExecuteOffChainTransaction(context.Context, *OffChainRequest) (*OffChainResponse, error)
@@ -306,6 +359,9 @@ type EnclaveProtoServer interface {
 	// GetTransaction returns a transaction receipt given the transaction's signed hash, encrypted with the viewing key
 	// corresponding to the original transaction submitter
 	GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error)
+	// GetTransactionStatus returns the progress of a transaction given its signed hash, encrypted with the viewing key
+	// corresponding to the original transaction submitter
+	GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error)
 	// AddViewingKey adds a viewing key to the enclave
 	AddViewingKey(context.Context, *AddViewingKeyRequest) (*AddViewingKeyResponse, error)
 	// GetBalance returns the address's balance on the Obscuro network, encrypted with the viewing key corresponding to
@@ -314,6 +370,8 @@ type EnclaveProtoServer interface {
 	// GetCode returns the code stored at the given address in the state for the given rollup height or rollup hash
 	GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error)
 	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	// SubscribePendingTxs adds a subscription to the hashes of the subscribing account's submitted transactions
+	SubscribePendingTxs(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
 	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
 	// EstimateGas returns the estimation of gas used for the given transactions
 	EstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
@@ -349,6 +407,12 @@ func (UnimplementedEnclaveProtoServer) SubmitTx(context.Context, *SubmitTxReques
 func (UnimplementedEnclaveProtoServer) SubmitBatch(context.Context, *SubmitBatchRequest) (*SubmitBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatch not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SubmitL1Finality(context.Context, *SubmitL1FinalityRequest) (*SubmitL1FinalityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitL1Finality not implemented")
+}
+func (UnimplementedEnclaveProtoServer) SubmitFinalizedL1Block(context.Context, *SubmitFinalizedL1BlockRequest) (*SubmitFinalizedL1BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFinalizedL1Block not implemented")
+}
 func (UnimplementedEnclaveProtoServer) ExecuteOffChainTransaction(context.Context, *OffChainRequest) (*OffChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOffChainTransaction not implemented")
 }
@@ -364,6 +428,9 @@ func (UnimplementedEnclaveProtoServer) GetTransaction(context.Context, *GetTrans
 func (UnimplementedEnclaveProtoServer) GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionReceipt not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStatus not implemented")
+}
 func (UnimplementedEnclaveProtoServer) AddViewingKey(context.Context, *AddViewingKeyRequest) (*AddViewingKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddViewingKey not implemented")
 }
@@ -376,6 +443,9 @@ func (UnimplementedEnclaveProtoServer) GetCode(context.Context, *GetCodeRequest)
 func (UnimplementedEnclaveProtoServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedEnclaveProtoServer) SubscribePendingTxs(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribePendingTxs not implemented")
+}
 func (UnimplementedEnclaveProtoServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
@@ -530,6 +600,42 @@ func _EnclaveProto_SubmitBatch_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_SubmitL1Finality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitL1FinalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubmitL1Finality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubmitL1Finality",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubmitL1Finality(ctx, req.(*SubmitL1FinalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_SubmitFinalizedL1Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFinalizedL1BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubmitFinalizedL1Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubmitFinalizedL1Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubmitFinalizedL1Block(ctx, req.(*SubmitFinalizedL1BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_ExecuteOffChainTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OffChainRequest)
 	if err := dec(in); err != nil {
@@ -620,6 +726,24 @@ func _EnclaveProto_GetTransactionReceipt_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/GetTransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetTransactionStatus(ctx, req.(*GetTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_AddViewingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddViewingKeyRequest)
 	if err := dec(in); err != nil {
@@ -692,6 +816,24 @@ func _EnclaveProto_Subscribe_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_SubscribePendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).SubscribePendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generated.EnclaveProto/SubscribePendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).SubscribePendingTxs(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnsubscribeRequest)
 	if err := dec(in); err != nil {
@@ -817,6 +959,14 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBatch",
 			Handler:    _EnclaveProto_SubmitBatch_Handler,
 		},
+		{
+			MethodName: "SubmitL1Finality",
+			Handler:    _EnclaveProto_SubmitL1Finality_Handler,
+		},
+		{
+			MethodName: "SubmitFinalizedL1Block",
+			Handler:    _EnclaveProto_SubmitFinalizedL1Block_Handler,
+		},
 		{
 			MethodName: "ExecuteOffChainTransaction",
 			Handler:    _EnclaveProto_ExecuteOffChainTransaction_Handler,
@@ -837,6 +987,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionReceipt",
 			Handler:    _EnclaveProto_GetTransactionReceipt_Handler,
 		},
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _EnclaveProto_GetTransactionStatus_Handler,
+		},
 		{
 			MethodName: "AddViewingKey",
 			Handler:    _EnclaveProto_AddViewingKey_Handler,
@@ -853,6 +1007,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Subscribe",
 			Handler:    _EnclaveProto_Subscribe_Handler,
 		},
+		{
+			MethodName: "SubscribePendingTxs",
+			Handler:    _EnclaveProto_SubscribePendingTxs_Handler,
+		},
 		{
 			MethodName: "Unsubscribe",
 			Handler:    _EnclaveProto_Unsubscribe_Handler,
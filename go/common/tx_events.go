@@ -0,0 +1,26 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// PendingTxSubscription is an authenticated subscription to the hashes of an account's submitted transactions.
+type PendingTxSubscription struct {
+	// The account whose transactions are subscribed to.
+	Account *common.Address
+	// A signature over the account address using a private viewing key, as for a LogSubscription.
+	Signature *[]byte
+}
+
+// IDAndEncTxHash pairs an encrypted transaction hash with the ID of the subscription that generated it.
+type IDAndEncTxHash struct {
+	SubID     rpc.ID
+	EncTxHash []byte
+}
+
+// IDAndTxHash pairs a transaction hash with the ID of the subscription that generated it.
+type IDAndTxHash struct {
+	SubID  rpc.ID
+	TxHash common.Hash
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TxStatus is the stage a transaction has reached in its lifecycle.
+type TxStatus string
+
+const (
+	// TxStatusPending means the transaction is in the sequencer's mempool, waiting to be included in a batch.
+	TxStatusPending TxStatus = "pending"
+	// TxStatusDropped means the transaction was removed from the mempool without being included in a batch, and will
+	// never be included unless it is resubmitted.
+	TxStatusDropped TxStatus = "dropped"
+	// TxStatusBatched means the transaction is included in a batch on the canonical chain.
+	TxStatusBatched TxStatus = "batched"
+	// TxStatusRolledUp means the batch including the transaction has been published to the L1 in a rollup.
+	TxStatusRolledUp TxStatus = "rolledUp"
+	// TxStatusFinalised means the rollup including the transaction is in an L1 block that is at least
+	// `L1FinalityDepth` blocks deep.
+	TxStatusFinalised TxStatus = "finalised"
+)
+
+// TransactionStatus describes the progress of a transaction, as returned by `obscuro_getTransactionStatus`. Only the
+// fields relevant to the status are set.
+type TransactionStatus struct {
+	Status          TxStatus        `json:"status"`
+	BatchHash       *common.Hash    `json:"batchHash,omitempty"`
+	BatchNumber     *hexutil.Uint64 `json:"batchNumber,omitempty"`
+	RollupHash      *common.Hash    `json:"rollupHash,omitempty"`
+	L1BlockHash     *common.Hash    `json:"l1BlockHash,omitempty"`
+	L1BlockNumber   *hexutil.Uint64 `json:"l1BlockNumber,omitempty"`
+	DropReason      string          `json:"dropReason,omitempty"`      // Why the transaction was dropped, e.g. "nonce too low".
+	ExclusionReason string          `json:"exclusionReason,omitempty"` // Why a pending transaction was left out of the latest batch.
+}
+
+// IsTerminal indicates whether the transaction has reached a status it will not progress from.
+func (s *TransactionStatus) IsTerminal() bool {
+	return s.Status == TxStatusDropped || s.Status == TxStatusFinalised
+}
+
+// Reached indicates whether a transaction with this status has progressed at least as far as the target status. A
+// dropped transaction only reaches the dropped status.
+func (s TxStatus) Reached(target TxStatus) bool {
+	if s == TxStatusDropped || target == TxStatusDropped {
+		return s == target
+	}
+	return txStatusStage[s] >= txStatusStage[target]
+}
+
+// The order in which a transaction that is not dropped progresses through the statuses.
+var txStatusStage = map[TxStatus]int{
+	TxStatusPending:   0,
+	TxStatusBatched:   1,
+	TxStatusRolledUp:  2,
+	TxStatusFinalised: 3,
+}
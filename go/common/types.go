@@ -36,15 +36,17 @@ type (
 	EncryptedTx           []byte // A single transaction, encoded as a JSON list of transaction binary hexes and encrypted using the enclave's public key
 	EncryptedTransactions []byte // A blob of encrypted transactions, as they're stored in the rollup, with the nonce prepended.
 
-	EncryptedParamsGetBalance      []byte // The params for an RPC getBalance request, as a JSON object encrypted with the public key of the enclave.
-	EncryptedParamsCall            []byte // As above, but for an RPC call request.
-	EncryptedParamsGetTxByHash     []byte // As above, but for an RPC getTransactionByHash request.
-	EncryptedParamsGetTxReceipt    []byte // As above, but for an RPC getTransactionReceipt request.
-	EncryptedParamsLogSubscription []byte // As above, but for an RPC logs subscription request.
-	EncryptedParamsSendRawTx       []byte // As above, but for an RPC sendRawTransaction request.
-	EncryptedParamsGetTxCount      []byte // As above, but for an RPC getTransactionCount request.
-	EncryptedParamsEstimateGas     []byte // As above, but for an RPC estimateGas request.
-	EncryptedParamsGetLogs         []byte // As above, but for an RPC getLogs request.
+	EncryptedParamsGetBalance            []byte // The params for an RPC getBalance request, as a JSON object encrypted with the public key of the enclave.
+	EncryptedParamsCall                  []byte // As above, but for an RPC call request.
+	EncryptedParamsGetTxByHash           []byte // As above, but for an RPC getTransactionByHash request.
+	EncryptedParamsGetTxReceipt          []byte // As above, but for an RPC getTransactionReceipt request.
+	EncryptedParamsLogSubscription       []byte // As above, but for an RPC logs subscription request.
+	EncryptedParamsPendingTxSubscription []byte // As above, but for an RPC newPendingTransactions subscription request.
+	EncryptedParamsSendRawTx             []byte // As above, but for an RPC sendRawTransaction request.
+	EncryptedParamsGetTxCount            []byte // As above, but for an RPC getTransactionCount request.
+	EncryptedParamsEstimateGas           []byte // As above, but for an RPC estimateGas request.
+	EncryptedParamsGetLogs               []byte // As above, but for an RPC getLogs request.
+	EncryptedParamsGetTxStatus           []byte // As above, but for an RPC getTransactionStatus request.
 
 	EncryptedResponseGetBalance   []byte // The response for an RPC getBalance request, as a JSON object encrypted with the viewing key of the user.
 	EncryptedResponseCall         []byte // As above, but for an RPC call request.
@@ -56,6 +58,7 @@ type (
 	EncryptedLogs                 []byte // As above, but for a log subscription response.
 	EncryptedResponseEstimateGas  []byte // As above, but for an RPC estimateGas response.
 	EncryptedResponseGetLogs      []byte // As above, but for an RPC getLogs request.
+	EncryptedResponseGetTxStatus  []byte // As above, but for an RPC getTransactionStatus request.
 
 	Nonce               = uint64
 	EncodedRollup       []byte
@@ -68,6 +71,8 @@ const (
 	L1GenesisHeight = uint64(0)
 	// HeightCommittedBlocks is the number of blocks deep a transaction must be to be considered safe from reorganisations.
 	HeightCommittedBlocks = 15
+	// L1FinalityDepth is the number of blocks deep an L1 block must be for the rollups it contains to be considered final.
+	L1FinalityDepth = 64
 )
 
 // AttestationReport represents a signed attestation report from a TEE and some metadata about the source of it to verify it
@@ -76,6 +81,7 @@ type AttestationReport struct {
 	PubKey      []byte         // a public key that can be used to send encrypted data back to the TEE securely (should only be used once Report has been verified)
 	Owner       common.Address // address identifying the owner of the TEE which signed this report, can also be verified from the encrypted Report data
 	HostAddress string         // the IP address on which the host can be contacted by other Obscuro hosts for peer-to-peer communication
+	RPCPubKey   []byte         `rlp:"optional"` // the public key that clients encrypt RPC requests to the TEE with (should only be used once Report has been verified)
 }
 
 type (
@@ -27,10 +27,23 @@ type EnclaveConfig struct {
 	ObscuroChainID int64
 	// Whether to produce a verified attestation report
 	WillAttest bool
+	// Whether an enclave that does not produce verified attestation reports sends the network secret to other enclaves
+	// without verifying their attestations. Insecure, only for networks whose enclaves do not run in SGX
+	InsecureSkipAttestation bool
 	// Whether to validate incoming L1 blocks
 	ValidateL1Blocks bool
 	// When validating incoming blocks, the genesis config for the L1 chain
 	GenesisJSON []byte
+	// The root of a trusted beacon chain block of the L1. If set, the enclave only ingests the L1 blocks the beacon chain
+	// has finalized since that block, as proven by the light client updates the host submits. Cannot be combined with
+	// `ValidateL1Blocks`
+	L1BeaconCheckpoint gethcommon.Hash
+	// When validating incoming blocks against the beacon chain, the genesis validators root of the beacon chain
+	L1BeaconGenesisRoot gethcommon.Hash
+	// When validating incoming blocks against the beacon chain, the fork schedule of the beacon chain: either the name of
+	// a public beacon chain (mainnet, sepolia or hoodi), or a JSON list of forks. It must list every fork before the
+	// beacon chain activates it
+	L1BeaconForks string
 	// The management contract address on the L1 network
 	ManagementContractAddress gethcommon.Address
 	// LogLevel determines the verbosity of output logs
@@ -56,6 +69,18 @@ type EnclaveConfig struct {
 	ObscuroGenesis string
 	// Cadence
 	Cadence uint64
+	// A json string that declares the visibility of contracts' events (see `events.EventVisibilityRules`)
+	EventVisibilityRules string
+	// The maximum number of batches an eth_getLogs request can span (zero for no limit)
+	MaxGetLogsRange uint64
+	// The maximum number of logs an eth_getLogs request can return (zero for no limit)
+	MaxGetLogsResults uint64
+	// The maximum number of batches a log subscription can replay historical logs from (zero for no limit)
+	MaxLogSubscriptionReplay uint64
+	// A json string with the policy other enclaves' attestations must satisfy to be sent the network secret (see
+	// `attestation.Policy`), which must set the trusted unique IDs or signer ID. If empty, only enclaves running the same
+	// code as this enclave are accepted. Only enforced if the enclave produces verified attestation reports itself
+	AttestationPolicy string
 }
 
 // DefaultEnclaveConfig returns an EnclaveConfig with default values.
@@ -68,8 +93,12 @@ func DefaultEnclaveConfig() EnclaveConfig {
 		L1ChainID:                 1337,
 		ObscuroChainID:            777,
 		WillAttest:                false, // todo: attestation should be on by default before production release
+		InsecureSkipAttestation:   false,
 		ValidateL1Blocks:          false,
 		GenesisJSON:               nil,
+		L1BeaconCheckpoint:        gethcommon.Hash{},
+		L1BeaconGenesisRoot:       gethcommon.Hash{},
+		L1BeaconForks:             "",
 		ManagementContractAddress: gethcommon.BytesToAddress([]byte("")),
 		LogLevel:                  int(gethlog.LvlInfo),
 		LogPath:                   log.SysOut,
@@ -81,5 +110,10 @@ func DefaultEnclaveConfig() EnclaveConfig {
 		SequencerID:               gethcommon.BytesToAddress([]byte("")),
 		ObscuroGenesis:            "",
 		Cadence:                   10,
+		EventVisibilityRules:      "",
+		MaxGetLogsRange:           10000,
+		MaxGetLogsResults:         10000,
+		MaxLogSubscriptionReplay:  1000,
+		AttestationPolicy:         "",
 	}
 }
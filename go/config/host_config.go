@@ -13,6 +13,13 @@ const (
 	defaultRPCTimeoutSecs   = 10
 	defaultL1RPCTimeoutSecs = 15
 	defaultP2PTimeoutSecs   = 10
+
+	defaultL1MaxFeePerGasGwei  = 500
+	defaultL1MaxTxReplacements = 10
+
+	defaultHealthMaxL1Lag           = 10
+	defaultHealthMaxBatchLag        = 10
+	defaultHealthMaxPeerSilenceSecs = 300
 )
 
 // HostInputConfig contains the configuration that was parsed from a config file / command line to start the Obscuro host.
@@ -37,14 +44,23 @@ type HostInputConfig struct {
 	P2PBindAddress string
 	// P2PPublicAddress is the advertised P2P server address
 	P2PPublicAddress string
-	// The host of the connected L1 node
-	L1NodeHost string
+	// The hosts of the connected L1 nodes, in order of preference. Each is a host, or a host:port pair
+	L1NodeHosts []string
 	// The websocket port of the connected L1 node
 	L1NodeWebsocketPort uint
 	// Timeout duration for RPC requests to the enclave service
 	EnclaveRPCTimeout time.Duration
 	// Timeout duration for connecting to, and communicating with, the L1 node
 	L1RPCTimeout time.Duration
+	// The interval at which to poll the L1 nodes for new blocks. If zero, new blocks are subscribed to over websockets instead
+	L1PollInterval time.Duration
+	// The URL of the L1 beacon node to fetch the beacon chain's light client data from, for an enclave that validates L1
+	// blocks against the beacon chain (empty if the enclave does not)
+	L1BeaconURL string
+	// The maximum fee per gas, in gwei, that the host's L1 transactions are sent or replaced with (0 for the default)
+	L1MaxFeePerGasGwei uint64
+	// The maximum number of times a stuck L1 transaction is replaced with higher fees (0 for the default)
+	L1MaxTxReplacements uint64
 	// Timeout duration for messaging between hosts.
 	P2PConnectionTimeout time.Duration
 	// The rollup contract address on the L1 network
@@ -75,6 +91,18 @@ type HostInputConfig struct {
 
 	// LevelDBPath path for the levelDB persistence dir (can be empty if a throwaway file in /tmp/ is acceptable, or if using InMemory DB)
 	LevelDBPath string
+
+	// EnclaveRecordingPath, if set, is the file where the L1 blocks and batches submitted to the enclave are recorded
+	// (for replay with tools/enclavereplay)
+	EnclaveRecordingPath string
+
+	// HealthMaxL1Lag is the number of L1 blocks the enclave can be behind the L1 head before the node is reported as not ready
+	HealthMaxL1Lag uint64
+	// HealthMaxBatchLag is the number of batches the node can be behind the sequencer before it is reported as not ready
+	HealthMaxBatchLag uint64
+	// HealthMaxPeerSilence is how long the host can go without a message from a known peer before the peer is reported as
+	// stale and the node as not ready (0 to disable the check)
+	HealthMaxPeerSilence time.Duration
 }
 
 // ToHostConfig returns a HostConfig given a HostInputConfig
@@ -90,10 +118,14 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		EnclaveRPCAddress:         p.EnclaveRPCAddress,
 		P2PBindAddress:            p.P2PBindAddress,
 		P2PPublicAddress:          p.P2PPublicAddress,
-		L1NodeHost:                p.L1NodeHost,
+		L1NodeHosts:               p.L1NodeHosts,
 		L1NodeWebsocketPort:       p.L1NodeWebsocketPort,
 		EnclaveRPCTimeout:         p.EnclaveRPCTimeout,
 		L1RPCTimeout:              p.L1RPCTimeout,
+		L1PollInterval:            p.L1PollInterval,
+		L1BeaconURL:               p.L1BeaconURL,
+		L1MaxFeePerGasGwei:        p.L1MaxFeePerGasGwei,
+		L1MaxTxReplacements:       p.L1MaxTxReplacements,
 		P2PConnectionTimeout:      p.P2PConnectionTimeout,
 		ManagementContractAddress: p.ManagementContractAddress,
 		LogLevel:                  p.LogLevel,
@@ -108,6 +140,10 @@ func (p HostInputConfig) ToHostConfig() *HostConfig {
 		MetricsHTTPPort:           p.MetricsHTTPPort,
 		UseInMemoryDB:             p.UseInMemoryDB,
 		LevelDBPath:               p.LevelDBPath,
+		EnclaveRecordingPath:      p.EnclaveRecordingPath,
+		HealthMaxL1Lag:            p.HealthMaxL1Lag,
+		HealthMaxBatchLag:         p.HealthMaxBatchLag,
+		HealthMaxPeerSilence:      p.HealthMaxPeerSilence,
 	}
 }
 
@@ -133,14 +169,23 @@ type HostConfig struct {
 	P2PBindAddress string
 	// P2PPublicAddress is the advertised P2P server address
 	P2PPublicAddress string
-	// The host of the connected L1 node
-	L1NodeHost string
+	// The hosts of the connected L1 nodes, in order of preference. Each is a host, or a host:port pair
+	L1NodeHosts []string
 	// The websocket port of the connected L1 node
 	L1NodeWebsocketPort uint
 	// Timeout duration for RPC requests to the enclave service
 	EnclaveRPCTimeout time.Duration
 	// Timeout duration for connecting to, and communicating with, the L1 node
 	L1RPCTimeout time.Duration
+	// The interval at which to poll the L1 nodes for new blocks. If zero, new blocks are subscribed to over websockets instead
+	L1PollInterval time.Duration
+	// The URL of the L1 beacon node to fetch the beacon chain's light client data from, for an enclave that validates L1
+	// blocks against the beacon chain (empty if the enclave does not)
+	L1BeaconURL string
+	// The maximum fee per gas, in gwei, that the host's L1 transactions are sent or replaced with (0 for the default)
+	L1MaxFeePerGasGwei uint64
+	// The maximum number of times a stuck L1 transaction is replaced with higher fees (0 for the default)
+	L1MaxTxReplacements uint64
 	// Timeout duration for messaging between hosts.
 	P2PConnectionTimeout time.Duration
 	// The rollup contract address on the L1 network
@@ -174,6 +219,16 @@ type HostConfig struct {
 
 	// filepath for the levelDB persistence dir (can be empty if a throwaway file in /tmp/ is acceptable, or if using InMemory DB)
 	LevelDBPath string
+
+	// file where the L1 blocks and batches submitted to the enclave are recorded, recording is disabled if empty
+	EnclaveRecordingPath string
+
+	// number of L1 blocks the enclave can be behind the L1 head before the node is reported as not ready
+	HealthMaxL1Lag uint64
+	// number of batches the node can be behind the sequencer before it is reported as not ready
+	HealthMaxBatchLag uint64
+	// how long the host can go without a message from a known peer before the node is reported as not ready (0 to disable)
+	HealthMaxPeerSilence time.Duration
 }
 
 // DefaultHostParsedConfig returns a HostConfig with default values.
@@ -189,10 +244,14 @@ func DefaultHostParsedConfig() *HostInputConfig {
 		EnclaveRPCAddress:         "127.0.0.1:11000",
 		P2PBindAddress:            "0.0.0.0:10000",
 		P2PPublicAddress:          "127.0.0.1:10000",
-		L1NodeHost:                "127.0.0.1",
+		L1NodeHosts:               []string{"127.0.0.1"},
 		L1NodeWebsocketPort:       8546,
 		EnclaveRPCTimeout:         time.Duration(defaultRPCTimeoutSecs) * time.Second,
 		L1RPCTimeout:              time.Duration(defaultL1RPCTimeoutSecs) * time.Second,
+		L1PollInterval:            0,
+		L1BeaconURL:               "",
+		L1MaxFeePerGasGwei:        defaultL1MaxFeePerGasGwei,
+		L1MaxTxReplacements:       defaultL1MaxTxReplacements,
 		P2PConnectionTimeout:      time.Duration(defaultP2PTimeoutSecs) * time.Second,
 		ManagementContractAddress: gethcommon.BytesToAddress([]byte("")),
 		LogLevel:                  int(log.LvlInfo),
@@ -205,5 +264,8 @@ func DefaultHostParsedConfig() *HostInputConfig {
 		MetricsEnabled:            true,
 		MetricsHTTPPort:           14000,
 		UseInMemoryDB:             true,
+		HealthMaxL1Lag:            defaultHealthMaxL1Lag,
+		HealthMaxBatchLag:         defaultHealthMaxBatchLag,
+		HealthMaxPeerSilence:      time.Duration(defaultHealthMaxPeerSilenceSecs) * time.Second,
 	}
 }
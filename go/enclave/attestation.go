@@ -1,34 +1,48 @@
 package enclave
 
 import (
-	"bytes"
-	"crypto/sha256"
-	"encoding/json"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
 
 	"github.com/edgelesssys/ego/enclave"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	egoattestation "github.com/edgelesssys/ego/attestation"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
-type IDData struct {
-	Owner       gethcommon.Address
-	PubKey      []byte
-	HostAddress string
-}
+// ErrAttestationPolicyViolation is returned when a genuine attestation report was produced by an enclave that the
+// attestation policy does not trust.
+var ErrAttestationPolicyViolation = errors.New("attestation does not satisfy the attestation policy")
+
+// ErrAttestationNotVerifiable is returned when an enclave that does not attest is asked to verify an attestation.
+var ErrAttestationNotVerifiable = errors.New("attestation cannot be verified by an enclave that does not attest")
 
 type AttestationProvider interface {
 	// GetReport returns the verifiable attestation report
-	GetReport(pubKey []byte, owner gethcommon.Address, hostAddress string) (*common.AttestationReport, error)
-	// VerifyReport returns the embedded report data
-	VerifyReport(att *common.AttestationReport) ([]byte, error)
+	GetReport(pubKey []byte, rpcPubKey []byte, owner gethcommon.Address, hostAddress string) (*common.AttestationReport, error)
+	// VerifyReport checks that the attestation report is genuine, that it was produced for the attestation's owner,
+	// public keys and host address, and that the enclave that produced it is trusted
+	VerifyReport(att *common.AttestationReport) error
 }
 
-type EgoAttestationProvider struct{}
+// EgoAttestationProvider produces and verifies SGX attestation reports using EGo. Reports are only trusted if the
+// enclave that produced them satisfies the attestation policy.
+type EgoAttestationProvider struct {
+	verifier *attestation.Verifier
+}
+
+func NewEgoAttestationProvider(policy *attestation.Policy) *EgoAttestationProvider {
+	return &EgoAttestationProvider{verifier: attestation.NewVerifier(policy)}
+}
 
-func (e *EgoAttestationProvider) GetReport(pubKey []byte, owner gethcommon.Address, hostAddress string) (*common.AttestationReport, error) {
-	idHash, err := getIDHash(owner, pubKey, hostAddress)
+func (e *EgoAttestationProvider) GetReport(pubKey []byte, rpcPubKey []byte, owner gethcommon.Address, hostAddress string) (*common.AttestationReport, error) {
+	idHash, err := attestation.IDHash(owner, pubKey, rpcPubKey, hostAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -42,57 +56,85 @@ func (e *EgoAttestationProvider) GetReport(pubKey []byte, owner gethcommon.Addre
 		PubKey:      pubKey,
 		Owner:       owner,
 		HostAddress: hostAddress,
+		RPCPubKey:   rpcPubKey,
 	}, nil
 }
 
-// TODO: we need to verify the hash is a recognized enclave - figure out how we solve for upgradability
-// todo: we should probably return other properties for manual verification, not just the data (e.g. validate code hash)
-func (e *EgoAttestationProvider) VerifyReport(att *common.AttestationReport) ([]byte, error) {
-	remoteReport, err := enclave.VerifyRemoteReport(att.Report)
+// Upgrades are handled by listing the unique IDs of both the old and the new enclave code in the policy.
+func (e *EgoAttestationProvider) VerifyReport(att *common.AttestationReport) error {
+	result, err := e.verifier.Verify(att)
 	if err != nil {
-		return []byte{}, err
+		return err
 	}
-	return remoteReport.Data, nil
+	if !result.Passed() {
+		return fmt.Errorf("%w: %s", ErrAttestationPolicyViolation, strings.Join(result.Violations, "; "))
+	}
+	return nil
 }
 
-type DummyAttestationProvider struct{}
+// DummyAttestationProvider produces mock attestation reports, for enclaves that do not run in SGX. Since the reports of
+// other enclaves cannot be verified either, they are rejected unless verification is explicitly skipped.
+type DummyAttestationProvider struct {
+	skipVerification bool
+}
+
+func NewDummyAttestationProvider(skipVerification bool) *DummyAttestationProvider {
+	return &DummyAttestationProvider{skipVerification: skipVerification}
+}
 
-func (e *DummyAttestationProvider) GetReport(pubKey []byte, owner gethcommon.Address, hostAddress string) (*common.AttestationReport, error) {
+func (e *DummyAttestationProvider) GetReport(pubKey []byte, rpcPubKey []byte, owner gethcommon.Address, hostAddress string) (*common.AttestationReport, error) {
 	return &common.AttestationReport{
 		Report:      []byte("MOCK REPORT"),
 		PubKey:      pubKey,
 		Owner:       owner,
 		HostAddress: hostAddress,
+		RPCPubKey:   rpcPubKey,
 	}, nil
 }
 
-func (e *DummyAttestationProvider) VerifyReport(att *common.AttestationReport) ([]byte, error) {
-	return getIDHash(att.Owner, att.PubKey, att.HostAddress)
+// VerifyReport accepts any attestation if verification is skipped, and rejects every attestation otherwise, since an
+// enclave that does not attest cannot verify the reports of other enclaves.
+func (e *DummyAttestationProvider) VerifyReport(*common.AttestationReport) error {
+	if !e.skipVerification {
+		return ErrAttestationNotVerifiable
+	}
+	return nil
 }
 
-// getIDHash provides a hash of identifying data to be included in an attestation report (or verified against the contents of an attestation report)
-func getIDHash(owner gethcommon.Address, pubKey []byte, hostAddress string) ([]byte, error) {
-	idData := IDData{
-		Owner:       owner,
-		PubKey:      pubKey,
-		HostAddress: hostAddress,
+// Parses the policy that other enclaves' attestations are checked against. If no policy is configured, the default
+// policy is derived from the enclave's own report, so that only enclaves running the same code are trusted. A configured
+// policy must pin the identity of the trusted enclaves, since otherwise any genuine enclave could obtain the secret.
+func parseAttestationPolicy(policyJSON string, getSelfReport func() (egoattestation.Report, error)) (*attestation.Policy, error) {
+	if policyJSON == "" {
+		self, err := getSelfReport()
+		if err != nil {
+			return nil, fmt.Errorf("could not get the enclave's own report to derive the default attestation policy. Cause: %w", err)
+		}
+		return selfPolicy(self), nil
 	}
-	idJSON, err := json.Marshal(idData)
+
+	policy, err := attestation.ParsePolicy([]byte(policyJSON))
 	if err != nil {
-		return nil, fmt.Errorf("failed to format ID data as JSON. Cause: %w", err)
+		return nil, err
+	}
+	if !policy.PinsEnclaveIdentity() {
+		return nil, errors.New("attestation policy must set the trusted unique IDs or signer ID")
 	}
-	hash := sha256.Sum256(idJSON)
-	return hash[:], nil
+	return policy, nil
 }
 
-func VerifyIdentity(data []byte, att *common.AttestationReport) error {
-	expectedIDHash, err := getIDHash(att.Owner, att.PubKey, att.HostAddress)
-	if err != nil {
-		return fmt.Errorf("failed to create ID data to check attestation report with owner: %s. Cause: %w", att.Owner, err)
+// Returns the policy that only trusts enclaves running the same code as the enclave that produced the report. Debug
+// enclaves are only trusted by a debug enclave, whose secret is not protected in the first place.
+func selfPolicy(self egoattestation.Report) *attestation.Policy {
+	policy := &attestation.Policy{
+		UniqueIDs:          []hexutil.Bytes{self.UniqueID},
+		SignerID:           self.SignerID,
+		MinSecurityVersion: self.SecurityVersion,
+		AllowDebug:         self.Debug,
 	}
-	// we trim the actual data because data extracted from the verified attestation is always 64 bytes long (padded with zeroes at the end)
-	if !bytes.Equal(expectedIDHash, data[:len(expectedIDHash)]) {
-		return fmt.Errorf("failed to verify hash for attestation report with owner: %s", att.Owner)
+	if len(self.ProductID) >= 2 {
+		productID := binary.LittleEndian.Uint16(self.ProductID)
+		policy.ProductID = &productID
 	}
-	return nil
+	return policy
 }
@@ -0,0 +1,83 @@
+package enclave
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/edgelesssys/ego/attestation/tcbstatus"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
+
+	egoattestation "github.com/edgelesssys/ego/attestation"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestEgoAttestationProviderEnforcesPolicy(t *testing.T) {
+	att := &common.AttestationReport{Owner: gethcommon.HexToAddress("0x1"), PubKey: []byte{1}, HostAddress: "127.0.0.1:10000"}
+	idHash, err := attestation.IDHash(att.Owner, att.PubKey, att.RPCPubKey, att.HostAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uniqueID := bytes.Repeat([]byte{1}, 32)
+	signerID := bytes.Repeat([]byte{2}, 32)
+	report := egoattestation.Report{Data: idHash, UniqueID: uniqueID, SignerID: signerID, Debug: true, TCBStatus: tcbstatus.UpToDate}
+	reportVerifier := func([]byte) (egoattestation.Report, error) { return report, nil }
+
+	// by default, only non-debug enclaves running the same code as this one are trusted
+	selfReport := func() (egoattestation.Report, error) {
+		return egoattestation.Report{UniqueID: uniqueID, SignerID: signerID}, nil
+	}
+	defaultPolicy, err := parseAttestationPolicy("", selfReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &EgoAttestationProvider{verifier: attestation.NewVerifierWithReportVerifier(defaultPolicy, reportVerifier)}
+	if err = provider.VerifyReport(att); !errors.Is(err, ErrAttestationPolicyViolation) {
+		t.Fatalf("expected attestation from a debug enclave to violate the default policy, got %v", err)
+	}
+	report.Debug = false
+	if err = provider.VerifyReport(att); err != nil {
+		t.Fatalf("expected attestation from an enclave running the same code to satisfy the default policy, got %v", err)
+	}
+	report.UniqueID = bytes.Repeat([]byte{3}, 32)
+	if err = provider.VerifyReport(att); !errors.Is(err, ErrAttestationPolicyViolation) {
+		t.Fatalf("expected attestation from an enclave running other code to violate the default policy, got %v", err)
+	}
+	report.Debug = true
+
+	debugPolicy, err := parseAttestationPolicy(`{"SignerID": "0x`+hex.EncodeToString(signerID)+`", "AllowDebug": true}`, selfReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider = &EgoAttestationProvider{verifier: attestation.NewVerifierWithReportVerifier(debugPolicy, reportVerifier)}
+	if err = provider.VerifyReport(att); err != nil {
+		t.Fatalf("expected attestation to satisfy a policy allowing debug enclaves, got %v", err)
+	}
+
+	att.HostAddress = "127.0.0.1:10001"
+	if err = provider.VerifyReport(att); err == nil || errors.Is(err, ErrAttestationPolicyViolation) {
+		t.Fatalf("expected attestation with a mismatched identity to fail verification, got %v", err)
+	}
+}
+
+func TestAttestationPolicyMustPinEnclaveIdentity(t *testing.T) {
+	selfReport := func() (egoattestation.Report, error) { return egoattestation.Report{}, errors.New("not in an enclave") }
+	if _, err := parseAttestationPolicy(`{"AllowDebug": true}`, selfReport); err == nil {
+		t.Fatal("expected a policy that trusts any enclave to be rejected")
+	}
+	if _, err := parseAttestationPolicy("", selfReport); err == nil {
+		t.Fatal("expected the default policy to fail if the enclave's own report is unavailable")
+	}
+}
+
+func TestDummyAttestationProviderRejectsReportsUnlessVerificationIsSkipped(t *testing.T) {
+	att := &common.AttestationReport{Report: []byte("MOCK REPORT")}
+	if err := NewDummyAttestationProvider(false).VerifyReport(att); !errors.Is(err, ErrAttestationNotVerifiable) {
+		t.Fatalf("expected an enclave that does not attest to reject attestations, got %v", err)
+	}
+	if err := NewDummyAttestationProvider(true).VerifyReport(att); err != nil {
+		t.Fatalf("expected attestations to be accepted when verification is skipped, got %v", err)
+	}
+}
@@ -22,7 +22,11 @@ type EnclaveConfigToml struct {
 	L1ChainID                 int64
 	ObscuroChainID            int64
 	WillAttest                bool
+	InsecureSkipAttestation   bool
 	ValidateL1Blocks          bool
+	L1BeaconCheckpoint        string
+	L1BeaconGenesisRoot       string
+	L1BeaconForks             string
 	ManagementContractAddress string
 	LogLevel                  int
 	LogPath                   string
@@ -36,6 +40,11 @@ type EnclaveConfigToml struct {
 	SequencerID               string
 	ObscuroGenesis            string
 	Cadence                   uint64
+	EventVisibilityRules      string
+	MaxGetLogsRange           uint64
+	MaxGetLogsResults         uint64
+	MaxLogSubscriptionReplay  uint64
+	AttestationPolicy         string
 }
 
 // ParseConfig returns a config.EnclaveConfig based on either the file identified by the `config` flag, or the flags
@@ -52,7 +61,11 @@ func ParseConfig() (config.EnclaveConfig, error) {
 	l1ChainID := flag.Int64(l1ChainIDName, cfg.L1ChainID, flagUsageMap[l1ChainIDName])
 	obscuroChainID := flag.Int64(obscuroChainIDName, cfg.ObscuroChainID, flagUsageMap[obscuroChainIDName])
 	willAttest := flag.Bool(willAttestName, cfg.WillAttest, flagUsageMap[willAttestName])
+	insecureSkipAttestation := flag.Bool(insecureSkipAttestationName, cfg.InsecureSkipAttestation, flagUsageMap[insecureSkipAttestationName])
 	validateL1Blocks := flag.Bool(validateL1BlocksName, cfg.ValidateL1Blocks, flagUsageMap[validateL1BlocksName])
+	l1BeaconCheckpoint := flag.String(l1BeaconCheckpointName, cfg.L1BeaconCheckpoint.Hex(), flagUsageMap[l1BeaconCheckpointName])
+	l1BeaconGenesisRoot := flag.String(l1BeaconGenesisRootName, cfg.L1BeaconGenesisRoot.Hex(), flagUsageMap[l1BeaconGenesisRootName])
+	l1BeaconForks := flag.String(l1BeaconForksName, cfg.L1BeaconForks, flagUsageMap[l1BeaconForksName])
 	managementContractAddress := flag.String(ManagementContractAddressName, cfg.ManagementContractAddress.Hex(), flagUsageMap[ManagementContractAddressName])
 	loglevel := flag.Int(logLevelName, cfg.LogLevel, flagUsageMap[logLevelName])
 	logPath := flag.String(logPathName, cfg.LogPath, flagUsageMap[logPathName])
@@ -65,6 +78,11 @@ func ParseConfig() (config.EnclaveConfig, error) {
 	sequencerID := flag.String(sequencerIDName, cfg.SequencerID.Hex(), flagUsageMap[sequencerIDName])
 	obscuroGenesis := flag.String(obscuroGenesisName, cfg.ObscuroGenesis, flagUsageMap[obscuroGenesisName])
 	Cadence := flag.Uint64(CadenceName, cfg.Cadence, flagUsageMap[CadenceName])
+	eventVisibilityRules := flag.String(eventVisibilityRulesName, cfg.EventVisibilityRules, flagUsageMap[eventVisibilityRulesName])
+	maxGetLogsRange := flag.Uint64(maxGetLogsRangeName, cfg.MaxGetLogsRange, flagUsageMap[maxGetLogsRangeName])
+	maxGetLogsResults := flag.Uint64(maxGetLogsResultsName, cfg.MaxGetLogsResults, flagUsageMap[maxGetLogsResultsName])
+	maxLogSubscriptionReplay := flag.Uint64(maxLogSubscriptionReplayName, cfg.MaxLogSubscriptionReplay, flagUsageMap[maxLogSubscriptionReplayName])
+	attestationPolicy := flag.String(attestationPolicyName, cfg.AttestationPolicy, flagUsageMap[attestationPolicyName])
 
 	flag.Parse()
 
@@ -84,7 +102,11 @@ func ParseConfig() (config.EnclaveConfig, error) {
 	cfg.L1ChainID = *l1ChainID
 	cfg.ObscuroChainID = *obscuroChainID
 	cfg.WillAttest = *willAttest
+	cfg.InsecureSkipAttestation = *insecureSkipAttestation
 	cfg.ValidateL1Blocks = *validateL1Blocks
+	cfg.L1BeaconCheckpoint = gethcommon.HexToHash(*l1BeaconCheckpoint)
+	cfg.L1BeaconGenesisRoot = gethcommon.HexToHash(*l1BeaconGenesisRoot)
+	cfg.L1BeaconForks = *l1BeaconForks
 	cfg.ManagementContractAddress = gethcommon.HexToAddress(*managementContractAddress)
 	cfg.LogLevel = *loglevel
 	cfg.LogPath = *logPath
@@ -97,6 +119,11 @@ func ParseConfig() (config.EnclaveConfig, error) {
 	cfg.SequencerID = gethcommon.HexToAddress(*sequencerID)
 	cfg.ObscuroGenesis = *obscuroGenesis
 	cfg.Cadence = *Cadence
+	cfg.EventVisibilityRules = *eventVisibilityRules
+	cfg.MaxGetLogsRange = *maxGetLogsRange
+	cfg.MaxGetLogsResults = *maxGetLogsResults
+	cfg.MaxLogSubscriptionReplay = *maxLogSubscriptionReplay
+	cfg.AttestationPolicy = *attestationPolicy
 
 	return cfg, nil
 }
@@ -127,7 +154,11 @@ func fileBasedConfig(configPath string) (config.EnclaveConfig, error) {
 		L1ChainID:                 tomlConfig.L1ChainID,
 		ObscuroChainID:            tomlConfig.ObscuroChainID,
 		WillAttest:                tomlConfig.WillAttest,
+		InsecureSkipAttestation:   tomlConfig.InsecureSkipAttestation,
 		ValidateL1Blocks:          tomlConfig.ValidateL1Blocks,
+		L1BeaconCheckpoint:        gethcommon.HexToHash(tomlConfig.L1BeaconCheckpoint),
+		L1BeaconGenesisRoot:       gethcommon.HexToHash(tomlConfig.L1BeaconGenesisRoot),
+		L1BeaconForks:             tomlConfig.L1BeaconForks,
 		ManagementContractAddress: gethcommon.HexToAddress(tomlConfig.ManagementContractAddress),
 		LogLevel:                  tomlConfig.LogLevel,
 		LogPath:                   tomlConfig.LogPath,
@@ -136,5 +167,10 @@ func fileBasedConfig(configPath string) (config.EnclaveConfig, error) {
 		EdgelessDBHost:            tomlConfig.EdgelessDBHost,
 		SqliteDBPath:              tomlConfig.SqliteDBPath,
 		ProfilerEnabled:           tomlConfig.ProfilerEnabled,
+		EventVisibilityRules:      tomlConfig.EventVisibilityRules,
+		MaxGetLogsRange:           tomlConfig.MaxGetLogsRange,
+		MaxGetLogsResults:         tomlConfig.MaxGetLogsResults,
+		MaxLogSubscriptionReplay:  tomlConfig.MaxLogSubscriptionReplay,
+		AttestationPolicy:         tomlConfig.AttestationPolicy,
 	}, nil
 }
@@ -10,7 +10,11 @@ const (
 	l1ChainIDName                 = "l1ChainID"
 	obscuroChainIDName            = "obscuroChainID"
 	willAttestName                = "willAttest"
+	insecureSkipAttestationName   = "insecureSkipAttestation"
 	validateL1BlocksName          = "validateL1Blocks"
+	l1BeaconCheckpointName        = "l1BeaconCheckpoint"
+	l1BeaconGenesisRootName       = "l1BeaconGenesisRoot"
+	l1BeaconForksName             = "l1BeaconForks"
 	ManagementContractAddressName = "managementContractAddress"
 	logLevelName                  = "logLevel"
 	logPathName                   = "logPath"
@@ -23,6 +27,11 @@ const (
 	sequencerIDName               = "sequencerID"
 	obscuroGenesisName            = "obscuroGenesis"
 	CadenceName                   = "Cadence"
+	eventVisibilityRulesName      = "eventVisibilityRules"
+	maxGetLogsRangeName           = "maxGetLogsRange"
+	maxGetLogsResultsName         = "maxGetLogsResults"
+	maxLogSubscriptionReplayName  = "maxLogSubscriptionReplay"
+	attestationPolicyName         = "attestationPolicy"
 )
 
 // Returns a map of the flag usages.
@@ -37,7 +46,11 @@ func getFlagUsageMap() map[string]string {
 		l1ChainIDName:                 "An integer representing the unique chain id of the Ethereum chain used as an L1 (default 1337)",
 		obscuroChainIDName:            "An integer representing the unique chain id of the Obscuro chain (default 777)",
 		willAttestName:                "Whether the enclave will produce a verified attestation report",
+		insecureSkipAttestationName:   "Whether an enclave that does not attest sends the network secret to other enclaves without verifying their attestations (insecure, only for enclaves not running in SGX)",
 		validateL1BlocksName:          "Whether to validate incoming blocks using the hardcoded L1 genesis.json config",
+		l1BeaconCheckpointName:        "The root of a trusted L1 beacon chain block. If set, only L1 blocks the beacon chain has finalized since are ingested",
+		l1BeaconGenesisRootName:       "The genesis validators root of the L1 beacon chain, when validating blocks against the beacon chain",
+		l1BeaconForksName:             "The fork schedule of the L1 beacon chain, either mainnet, sepolia, hoodi or a JSON list of forks, when validating blocks against the beacon chain",
 		ManagementContractAddressName: "The management contract address on the L1",
 		logLevelName:                  "The verbosity level of logs. (Defaults to Info)",
 		logPathName:                   "The path to use for the enclave service's log file",
@@ -50,5 +63,10 @@ func getFlagUsageMap() map[string]string {
 		sequencerIDName:               "The 20 bytes of the address of the sequencer for this network",
 		obscuroGenesisName:            "The json string with the obscuro genesis",
 		CadenceName:                   "The amounts of batches between each rollup",
+		eventVisibilityRulesName:      "The json string declaring the visibility of contracts' events",
+		maxGetLogsRangeName:           "The maximum number of batches an eth_getLogs request can span (0 for no limit)",
+		maxGetLogsResultsName:         "The maximum number of logs an eth_getLogs request can return (0 for no limit)",
+		maxLogSubscriptionReplayName:  "The maximum number of batches a log subscription can replay historical logs from (0 for no limit)",
+		attestationPolicyName:         "The json string with the policy other enclaves' attestations must satisfy to be sent the network secret (must set the trusted unique IDs or signer ID; defaults to enclaves running the same code)",
 	}
 }
@@ -61,6 +61,12 @@ func NewEnclaveContainerWithLogger(config config.EnclaveConfig, logger gethlog.L
 	contractAddr := config.ManagementContractAddress
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&contractAddr, logger)
 
+	return NewEnclaveContainerWithMgmtContractLib(config, mgmtContractLib, logger)
+}
+
+// NewEnclaveContainerWithMgmtContractLib allows the management contract lib to be swapped out (e.g. for the mock lib when
+// the enclave is fed by an in-memory L1)
+func NewEnclaveContainerWithMgmtContractLib(config config.EnclaveConfig, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger) *EnclaveContainer {
 	if config.ValidateL1Blocks {
 		config.GenesisJSON = []byte(hardcodedGenesisJSON)
 	}
@@ -0,0 +1,15 @@
+package core
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// SecretRequest is a request for the network secret seen on the L1. It is kept until a response to the request is seen
+// on the L1, or until this enclave produces its own response.
+type SecretRequest struct {
+	RequesterID        gethcommon.Address
+	HostAddress        string
+	Secret             []byte // The network secret, encrypted with the requester's key
+	RequestBlockHash   gethcommon.Hash
+	RequestBlockNumber uint64
+}
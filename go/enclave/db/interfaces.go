@@ -42,8 +42,11 @@ type BatchResolver interface {
 }
 
 type RollupResolver interface {
-	// StoreRollup stores a rollup.
-	StoreRollup(rollup *core.Rollup) error
+	// StoreRollup stores a rollup, and the L1 block it was published in.
+	StoreRollup(rollup *core.Rollup, l1Block common.L1RootHash) error
+	// FetchRollupForBatch returns the hash of the rollup that includes the batch, and of the L1 block the rollup was
+	// published in.
+	FetchRollupForBatch(batchHash common.L2RootHash) (*common.L2RootHash, *common.L1RootHash, error)
 }
 
 type HeadsAfterL1BlockStorage interface {
@@ -73,6 +76,26 @@ type SharedSecretStorage interface {
 	StoreSecret(secret crypto.SharedEnclaveSecret) error
 }
 
+type EnclaveKeyStorage interface {
+	// FetchEnclaveKey returns the key the enclave signs with, so it survives restarts
+	FetchEnclaveKey() (*ecdsa.PrivateKey, error)
+	// StoreEnclaveKey stores the key generated by the enclave the first time it started
+	StoreEnclaveKey(key *ecdsa.PrivateKey) error
+}
+
+type MempoolStorage interface {
+	// FetchMempoolTxs returns the transactions that were in the mempool when the enclave last stopped
+	FetchMempoolTxs() ([]*common.L2Tx, error)
+	// StoreMempoolTx persists a transaction added to the mempool
+	StoreMempoolTx(tx *common.L2Tx) error
+	// DeleteMempoolTx removes a transaction from the persisted mempool
+	DeleteMempoolTx(hash common.L2TxHash) error
+	// StoreDroppedTx records that a transaction was dropped from the mempool without being included in a batch
+	StoreDroppedTx(tx *common.L2Tx, reason string) error
+	// FetchDroppedTx returns a transaction that was dropped from the mempool, and the reason it was dropped
+	FetchDroppedTx(hash common.L2TxHash) (*common.L2Tx, string, error)
+}
+
 type TransactionStorage interface {
 	// GetTransaction - returns the positional metadata of the tx by hash
 	GetTransaction(txHash common.L2TxHash) (*types.Transaction, gethcommon.Hash, uint64, uint64, error)
@@ -91,6 +114,16 @@ type AttestationStorage interface {
 	FetchAttestedKey(aggregator gethcommon.Address) (*ecdsa.PublicKey, error)
 	// StoreAttestedKey - store the public key of an attested aggregator
 	StoreAttestedKey(aggregator gethcommon.Address, key *ecdsa.PublicKey) error
+	// FetchAttestedNodes returns the IDs of the nodes that have been given the network secret, in the order they were attested
+	FetchAttestedNodes() ([]gethcommon.Address, error)
+	// StoreAttestedNodes stores the IDs of the nodes that have been given the network secret
+	StoreAttestedNodes(nodes []gethcommon.Address) error
+	// FetchSecretRequests returns the secret requests this enclave may have to respond to
+	FetchSecretRequests() ([]*core.SecretRequest, error)
+	// StoreSecretRequest stores a secret request this enclave may have to respond to
+	StoreSecretRequest(request *core.SecretRequest) error
+	// DeleteSecretRequest removes the secret request from the given requester
+	DeleteSecretRequest(requester gethcommon.Address) error
 }
 
 type CrossChainMessagesStorage interface {
@@ -104,6 +137,8 @@ type Storage interface {
 	BatchResolver
 	RollupResolver
 	SharedSecretStorage
+	EnclaveKeyStorage
+	MempoolStorage
 	HeadsAfterL1BlockStorage
 	TransactionStorage
 	AttestationStorage
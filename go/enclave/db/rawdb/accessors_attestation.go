@@ -4,6 +4,9 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -29,3 +32,72 @@ func WriteAttestationKey(db ethdb.KeyValueWriter, address gethcommon.Address, ke
 	}
 	return nil
 }
+
+// ReadAttestedNodes returns the IDs of the nodes that have been given the network secret, in the order they were
+// attested. It is empty if no node has been attested yet.
+func ReadAttestedNodes(db ethdb.KeyValueReader) ([]gethcommon.Address, error) {
+	has, err := db.Has(attestedNodes)
+	if err != nil {
+		return nil, fmt.Errorf("could not check for attested nodes. Cause: %w", err)
+	}
+	if !has {
+		return nil, nil
+	}
+	enc, err := db.Get(attestedNodes)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attested nodes. Cause: %w", err)
+	}
+	var nodes []gethcommon.Address
+	if err = rlp.DecodeBytes(enc, &nodes); err != nil {
+		return nil, fmt.Errorf("could not decode attested nodes. Cause: %w", err)
+	}
+	return nodes, nil
+}
+
+func WriteAttestedNodes(db ethdb.KeyValueWriter, nodes []gethcommon.Address) error {
+	enc, err := rlp.EncodeToBytes(nodes)
+	if err != nil {
+		return fmt.Errorf("could not encode attested nodes. Cause: %w", err)
+	}
+	if err = db.Put(attestedNodes, enc); err != nil {
+		return fmt.Errorf("could not write attested nodes. Cause: %w", err)
+	}
+	return nil
+}
+
+// ReadSecretRequests returns the secret requests awaiting a response
+func ReadSecretRequests(db ethdb.Iteratee) ([]*core.SecretRequest, error) {
+	it := db.NewIterator(secretRequestPrefix, nil)
+	defer it.Release()
+
+	var requests []*core.SecretRequest
+	for it.Next() {
+		request := new(core.SecretRequest)
+		if err := rlp.DecodeBytes(it.Value(), request); err != nil {
+			return nil, fmt.Errorf("could not decode secret request. Cause: %w", err)
+		}
+		requests = append(requests, request)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("could not iterate over secret requests. Cause: %w", err)
+	}
+	return requests, nil
+}
+
+func WriteSecretRequest(db ethdb.KeyValueWriter, request *core.SecretRequest) error {
+	enc, err := rlp.EncodeToBytes(request)
+	if err != nil {
+		return fmt.Errorf("could not encode secret request. Cause: %w", err)
+	}
+	if err = db.Put(secretRequestKey(request.RequesterID), enc); err != nil {
+		return fmt.Errorf("could not write secret request. Cause: %w", err)
+	}
+	return nil
+}
+
+func DeleteSecretRequest(db ethdb.KeyValueWriter, requester gethcommon.Address) error {
+	if err := db.Delete(secretRequestKey(requester)); err != nil {
+		return fmt.Errorf("could not delete secret request. Cause: %w", err)
+	}
+	return nil
+}
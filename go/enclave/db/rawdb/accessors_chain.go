@@ -82,6 +82,40 @@ func WriteRollup(db ethdb.KeyValueWriter, rollup *core.Rollup) error {
 	return nil
 }
 
+// The stored form of the rollup that includes a batch.
+type batchRollup struct {
+	RollupHash common.L2RootHash
+	L1Block    common.L1RootHash // The L1 block the rollup was published in
+}
+
+// WriteBatchRollups records the rollup that includes each of the rollup's batches, and the L1 block the rollup was
+// published in.
+func WriteBatchRollups(db ethdb.KeyValueWriter, rollup *core.Rollup, l1Block common.L1RootHash) error {
+	enc, err := rlp.EncodeToBytes(batchRollup{RollupHash: *rollup.Hash(), L1Block: l1Block})
+	if err != nil {
+		return fmt.Errorf("could not encode batch rollup. Cause: %w", err)
+	}
+	for _, batch := range rollup.Batches {
+		if err = db.Put(batchRollupKey(*batch.Hash()), enc); err != nil {
+			return fmt.Errorf("could not put batch rollup in DB. Cause: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReadBatchRollup returns the hash of the rollup that includes the batch, and of the L1 block the rollup was published in.
+func ReadBatchRollup(db ethdb.KeyValueReader, batchHash common.L2RootHash) (*common.L2RootHash, *common.L1RootHash, error) {
+	enc, err := db.Get(batchRollupKey(batchHash))
+	if err != nil {
+		return nil, nil, errutil.ErrNotFound
+	}
+	var rollup batchRollup
+	if err = rlp.DecodeBytes(enc, &rollup); err != nil {
+		return nil, nil, fmt.Errorf("could not decode batch rollup. Cause: %w", err)
+	}
+	return &rollup.RollupHash, &rollup.L1Block, nil
+}
+
 // Stores a batch header into the database and also stores the hash-to-number mapping.
 func writeBatchHeader(db ethdb.KeyValueWriter, header *common.BatchHeader) error {
 	// Write the hash -> number mapping
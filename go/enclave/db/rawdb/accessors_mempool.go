@@ -0,0 +1,89 @@
+package rawdb
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+)
+
+// The stored form of a transaction that was dropped from the mempool.
+type droppedTx struct {
+	Tx     []byte // The transaction's binary encoding
+	Reason string
+}
+
+// WriteMempoolTx stores a transaction that is waiting in the mempool, so it is not lost if the enclave restarts
+func WriteMempoolTx(db ethdb.KeyValueWriter, tx *common.L2Tx) error {
+	enc, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not encode mempool transaction. Cause: %w", err)
+	}
+	if err = db.Put(mempoolTxKey(tx.Hash()), enc); err != nil {
+		return fmt.Errorf("could not store mempool transaction. Cause: %w", err)
+	}
+	return nil
+}
+
+// DeleteMempoolTx removes a transaction from the persisted mempool
+func DeleteMempoolTx(db ethdb.KeyValueWriter, hash common.TxHash) error {
+	if err := db.Delete(mempoolTxKey(hash)); err != nil {
+		return fmt.Errorf("could not delete mempool transaction. Cause: %w", err)
+	}
+	return nil
+}
+
+// ReadMempoolTxs returns all the transactions in the persisted mempool
+func ReadMempoolTxs(db ethdb.Iteratee) ([]*common.L2Tx, error) {
+	it := db.NewIterator(mempoolTxPrefix, nil)
+	defer it.Release()
+
+	var txs []*common.L2Tx
+	for it.Next() {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(it.Value()); err != nil {
+			return nil, fmt.Errorf("could not decode mempool transaction. Cause: %w", err)
+		}
+		txs = append(txs, tx)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("could not iterate over mempool transactions. Cause: %w", err)
+	}
+	return txs, nil
+}
+
+// WriteDroppedTx records that a transaction was dropped from the mempool without being included in a batch, and why
+func WriteDroppedTx(db ethdb.KeyValueWriter, tx *common.L2Tx, reason string) error {
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not encode dropped transaction. Cause: %w", err)
+	}
+	enc, err := rlp.EncodeToBytes(droppedTx{Tx: txBytes, Reason: reason})
+	if err != nil {
+		return fmt.Errorf("could not encode dropped transaction. Cause: %w", err)
+	}
+	if err = db.Put(droppedTxKey(tx.Hash()), enc); err != nil {
+		return fmt.Errorf("could not store dropped transaction. Cause: %w", err)
+	}
+	return nil
+}
+
+// ReadDroppedTx returns a transaction that was dropped from the mempool, and why it was dropped
+func ReadDroppedTx(db ethdb.KeyValueReader, hash common.TxHash) (*common.L2Tx, string, error) {
+	enc, err := db.Get(droppedTxKey(hash))
+	if err != nil {
+		return nil, "", errutil.ErrNotFound
+	}
+	var dropped droppedTx
+	if err = rlp.DecodeBytes(enc, &dropped); err != nil {
+		return nil, "", fmt.Errorf("could not decode dropped transaction. Cause: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(dropped.Tx); err != nil {
+		return nil, "", fmt.Errorf("could not decode dropped transaction. Cause: %w", err)
+	}
+	return tx, dropped.Reason, nil
+}
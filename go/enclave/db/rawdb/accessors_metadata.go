@@ -1,10 +1,13 @@
 package rawdb
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/enclave/crypto"
 )
@@ -33,3 +36,22 @@ func WriteSharedSecret(db ethdb.KeyValueWriter, ss crypto.SharedEnclaveSecret) e
 	}
 	return nil
 }
+
+func ReadEnclaveKey(db ethdb.KeyValueReader) (*ecdsa.PrivateKey, error) {
+	enc, err := db.Get(enclaveKey)
+	if err != nil {
+		return nil, errutil.ErrNotFound
+	}
+	key, err := gethcrypto.ToECDSA(enc)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode enclave key. Cause: %w", err)
+	}
+	return key, nil
+}
+
+func WriteEnclaveKey(db ethdb.KeyValueWriter, key *ecdsa.PrivateKey) error {
+	if err := db.Put(enclaveKey, gethcrypto.FromECDSA(key)); err != nil {
+		return fmt.Errorf("could not store enclave key in DB. Cause: %w", err)
+	}
+	return nil
+}
@@ -9,9 +9,13 @@ import (
 
 var (
 	sharedSecret  = []byte("SharedSecret")
+	enclaveKey    = []byte("EnclaveKey")
 	headBatchHash = []byte("HeadBatch") // headBatchHashPrefix -> curr L2 head batch hash
 
+	attestedNodes = []byte("AttestedNodes") // attestedNodes -> the IDs of the attested nodes, in the order they were attested
+
 	attestationKeyPrefix           = []byte("oAK")  // attestationKeyPrefix + address -> key
+	secretRequestPrefix            = []byte("oSR")  // secretRequestPrefix + address -> secret request awaiting a response
 	syntheticTransactionsKeyPrefix = []byte("oSTX") // attestationKeyPrefix + address -> key
 
 	batchHeaderPrefix            = []byte("oh")  // batchHeaderPrefix + num (uint64 big endian) + hash -> header
@@ -27,6 +31,9 @@ var (
 	batchReceiptsPrefix          = []byte("or")  // batchReceiptsPrefix + num (uint64 big endian) + hash -> batch receipts
 	contractReceiptPrefix        = []byte("ocr") // contractReceiptPrefix + address -> tx hash
 	txLookupPrefix               = []byte("ol")  // txLookupPrefix + hash -> transaction/receipt lookup metadata
+	mempoolTxPrefix              = []byte("omp") // mempoolTxPrefix + hash -> transaction waiting in the mempool
+	droppedTxPrefix              = []byte("odt") // droppedTxPrefix + hash -> transaction dropped from the mempool, and why
+	batchRollupPrefix            = []byte("obr") // batchRollupPrefix + hash -> the rollup including the batch, and its L1 block
 )
 
 // encodeNumber encodes a number as big endian uint64
@@ -85,6 +92,20 @@ func txLookupKey(hash common.TxHash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
 }
 
+// mempoolTxKey = mempoolTxPrefix + hash
+func secretRequestKey(requester gethcommon.Address) []byte {
+	return append(secretRequestPrefix, requester.Bytes()...)
+}
+
+func mempoolTxKey(hash common.TxHash) []byte {
+	return append(mempoolTxPrefix, hash.Bytes()...)
+}
+
+// droppedTxKey = droppedTxPrefix + hash
+func droppedTxKey(hash common.TxHash) []byte {
+	return append(droppedTxPrefix, hash.Bytes()...)
+}
+
 func attestationPkKey(aggregator gethcommon.Address) []byte {
 	return append(attestationKeyPrefix, aggregator.Bytes()...)
 }
@@ -107,3 +128,8 @@ func rollupBodyKey(hash common.L2RootHash) []byte {
 func rollupNumberKey(hash common.L2RootHash) []byte {
 	return append(rollupNumberPrefix, hash.Bytes()...)
 }
+
+// For storing and fetching the rollup that includes a batch by batch hash.
+func batchRollupKey(hash common.L2RootHash) []byte {
+	return append(batchRollupPrefix, hash.Bytes()...)
+}
@@ -108,6 +108,34 @@ func (s *storageImpl) FetchSecret() (*crypto.SharedEnclaveSecret, error) {
 	return obscurorawdb.ReadSharedSecret(s.db)
 }
 
+func (s *storageImpl) StoreEnclaveKey(key *ecdsa.PrivateKey) error {
+	return obscurorawdb.WriteEnclaveKey(s.db, key)
+}
+
+func (s *storageImpl) FetchEnclaveKey() (*ecdsa.PrivateKey, error) {
+	return obscurorawdb.ReadEnclaveKey(s.db)
+}
+
+func (s *storageImpl) FetchMempoolTxs() ([]*common.L2Tx, error) {
+	return obscurorawdb.ReadMempoolTxs(s.db)
+}
+
+func (s *storageImpl) StoreMempoolTx(tx *common.L2Tx) error {
+	return obscurorawdb.WriteMempoolTx(s.db, tx)
+}
+
+func (s *storageImpl) DeleteMempoolTx(hash common.L2TxHash) error {
+	return obscurorawdb.DeleteMempoolTx(s.db, hash)
+}
+
+func (s *storageImpl) StoreDroppedTx(tx *common.L2Tx, reason string) error {
+	return obscurorawdb.WriteDroppedTx(s.db, tx, reason)
+}
+
+func (s *storageImpl) FetchDroppedTx(hash common.L2TxHash) (*common.L2Tx, string, error) {
+	return obscurorawdb.ReadDroppedTx(s.db, hash)
+}
+
 func (s *storageImpl) IsAncestor(block *types.Block, maybeAncestor *types.Block) bool {
 	s.assertSecretAvailable()
 	if bytes.Equal(maybeAncestor.Hash().Bytes(), block.Hash().Bytes()) {
@@ -325,6 +353,26 @@ func (s *storageImpl) StoreAttestedKey(aggregator gethcommon.Address, key *ecdsa
 	return obscurorawdb.WriteAttestationKey(s.db, aggregator, key)
 }
 
+func (s *storageImpl) FetchAttestedNodes() ([]gethcommon.Address, error) {
+	return obscurorawdb.ReadAttestedNodes(s.db)
+}
+
+func (s *storageImpl) StoreAttestedNodes(nodes []gethcommon.Address) error {
+	return obscurorawdb.WriteAttestedNodes(s.db, nodes)
+}
+
+func (s *storageImpl) FetchSecretRequests() ([]*core.SecretRequest, error) {
+	return obscurorawdb.ReadSecretRequests(s.db)
+}
+
+func (s *storageImpl) StoreSecretRequest(request *core.SecretRequest) error {
+	return obscurorawdb.WriteSecretRequest(s.db, request)
+}
+
+func (s *storageImpl) DeleteSecretRequest(requester gethcommon.Address) error {
+	return obscurorawdb.DeleteSecretRequest(s.db, requester)
+}
+
 func (s *storageImpl) StoreBatch(batch *core.Batch, receipts []*types.Receipt) error {
 	dbBatch := s.db.NewBatch()
 
@@ -355,15 +403,22 @@ func (s *storageImpl) GetL1Messages(blockHash common.L1RootHash) (common.CrossCh
 	return obscurorawdb.GetL1Messages(s.db, blockHash, s.logger)
 }
 
-func (s *storageImpl) StoreRollup(rollup *core.Rollup) error {
+func (s *storageImpl) StoreRollup(rollup *core.Rollup, l1Block common.L1RootHash) error {
 	dbBatch := s.db.NewBatch()
 
 	if err := obscurorawdb.WriteRollup(dbBatch, rollup); err != nil {
 		return fmt.Errorf("could not write rollup. Cause: %w", err)
 	}
+	if err := obscurorawdb.WriteBatchRollups(dbBatch, rollup, l1Block); err != nil {
+		return fmt.Errorf("could not write rollup's batches. Cause: %w", err)
+	}
 
 	if err := dbBatch.Write(); err != nil {
 		return fmt.Errorf("could not write rollup to storage. Cause: %w", err)
 	}
 	return nil
 }
+
+func (s *storageImpl) FetchRollupForBatch(batchHash common.L2RootHash) (*common.L2RootHash, *common.L1RootHash, error) {
+	return obscurorawdb.ReadBatchRollup(s.db, batchHash)
+}
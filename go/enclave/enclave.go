@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
 	"github.com/obscuronet/go-obscuro/go/common/profiler"
 	"github.com/obscuronet/go-obscuro/go/config"
 	"github.com/obscuronet/go-obscuro/go/enclave/crosschain"
@@ -42,6 +43,7 @@ import (
 	"github.com/obscuronet/go-obscuro/go/enclave/rpc"
 	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
 
+	egoenclave "github.com/edgelesssys/ego/enclave"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -55,6 +57,7 @@ type enclaveImpl struct {
 	blockResolver        db.BlockResolver
 	mempool              mempool.Manager
 	l1Blockchain         *gethcore.BlockChain
+	l1Finality           *l2chain.L1FinalityValidator
 	rpcEncryptionManager rpc.EncryptionManager
 	rollupManager        rollupmanager.RollupManager
 	subscriptionManager  *events.SubscriptionManager
@@ -67,6 +70,7 @@ type enclaveImpl struct {
 
 	enclaveKey    *ecdsa.PrivateKey // this is a key specific to this enclave, which is included in the Attestation. Used for signing rollups and for encryption of the shared secret.
 	enclavePubKey []byte            // the public key of the above
+	rpcPubKey     []byte            // the public key clients encrypt RPC requests with, which is included in the Attestation
 
 	transactionBlobCrypto crypto.TransactionBlobCrypto
 	profiler              *profiler.Profiler
@@ -124,46 +128,85 @@ func NewEnclave(
 			logger.Crit("enclave is configured to validate blocks, but genesis JSON is nil")
 		}
 		l1Blockchain = l2chain.NewL1Blockchain(config.GenesisJSON, logger)
-	} else {
+	}
+
+	// the blocks of a post-merge L1 are validated by following the finality of its beacon chain instead
+	var l1Finality *l2chain.L1FinalityValidator
+	if config.L1BeaconCheckpoint != (gethcommon.Hash{}) {
+		if config.ValidateL1Blocks {
+			logger.Crit("enclave cannot validate L1 blocks both using the L1 genesis and against the beacon chain")
+		}
+		forks, err := beacon.ParseForkSchedule(config.L1BeaconForks)
+		if err != nil {
+			logger.Crit("Invalid L1 beacon chain fork schedule.", log.ErrKey, err)
+		}
+		beaconConfig := beacon.Config{GenesisValidatorsRoot: config.L1BeaconGenesisRoot, Forks: forks}
+		l1Finality = l2chain.NewL1FinalityValidator(beaconConfig, config.L1BeaconCheckpoint, logger)
+	}
+
+	if l1Blockchain == nil && l1Finality == nil {
 		logger.Info("validateBlocks is set to false. L1 blocks will not be validated.")
 	}
 
 	// Todo- make sure the enclave cannot be started in production with WillAttest=false
 	var attestationProvider AttestationProvider
 	if config.WillAttest {
-		attestationProvider = &EgoAttestationProvider{}
+		policy, err := parseAttestationPolicy(config.AttestationPolicy, egoenclave.GetSelfReport)
+		if err != nil {
+			logger.Crit("Failed to parse attestation policy.", log.ErrKey, err)
+		}
+		attestationProvider = NewEgoAttestationProvider(policy)
 	} else {
 		logger.Info("WARNING - Attestation is not enabled, enclave will not create a verified attestation report.")
-		attestationProvider = &DummyAttestationProvider{}
+		if config.InsecureSkipAttestation {
+			logger.Info("WARNING - Attestation verification is skipped, enclave will send the secret to any enclave that requests it.")
+		}
+		attestationProvider = NewDummyAttestationProvider(config.InsecureSkipAttestation)
 	}
 
-	// todo - this has to be read from the database when the node restarts.
-	// first time the node starts we derive the obscuro key from the master seed received after the shared secret exchange
-	logger.Info("Generating the Obscuro key")
-
-	// todo - save this to the db
-	enclaveKey, err := gethcrypto.GenerateKey()
+	// the enclave key is generated the first time the enclave starts and then read from the database, so that the
+	// rollups and batches it signs are still recognised by its peers after a restart
+	enclaveKey, err := storage.FetchEnclaveKey()
 	if err != nil {
-		logger.Crit("Failed to generate enclave key.", log.ErrKey, err)
+		if !errors.Is(err, errutil.ErrNotFound) {
+			logger.Crit("Failed to fetch enclave key.", log.ErrKey, err)
+		}
+		logger.Info("Generating the enclave key")
+		enclaveKey, err = gethcrypto.GenerateKey()
+		if err != nil {
+			logger.Crit("Failed to generate enclave key.", log.ErrKey, err)
+		}
+		if err = storage.StoreEnclaveKey(enclaveKey); err != nil {
+			logger.Crit("Failed to store enclave key.", log.ErrKey, err)
+		}
 	}
 	serializedEnclavePubKey := gethcrypto.CompressPubkey(&enclaveKey.PublicKey)
-	logger.Info(fmt.Sprintf("Generated public key %s", gethcommon.Bytes2Hex(serializedEnclavePubKey)))
+	logger.Info(fmt.Sprintf("Enclave public key %s", gethcommon.Bytes2Hex(serializedEnclavePubKey)))
 
 	obscuroKey := crypto.GetObscuroKey(logger)
 	rpcEncryptionManager := rpc.NewEncryptionManager(ecies.ImportECDSA(obscuroKey))
+	serializedRPCPubKey := gethcrypto.CompressPubkey(&obscuroKey.PublicKey)
 
 	transactionBlobCrypto := crypto.NewTransactionBlobCryptoImpl(logger)
 
-	memp := mempool.New(config.ObscuroChainID)
+	memp, err := mempool.New(config.ObscuroChainID, storage)
+	if err != nil {
+		logger.Crit("Failed to create mempool.", log.ErrKey, err)
+	}
 
 	crossChainProcessors := crosschain.New(&config.MessageBusAddress, storage, big.NewInt(config.ObscuroChainID), logger)
 
-	subscriptionManager := events.NewSubscriptionManager(&rpcEncryptionManager, storage, logger)
+	visibilityRules, err := events.NewEventVisibilityRules(config.EventVisibilityRules)
+	if err != nil {
+		logger.Crit("Failed to parse event visibility rules.", log.ErrKey, err)
+	}
+	subscriptionManager := events.NewSubscriptionManager(&rpcEncryptionManager, storage, visibilityRules, config.MaxGetLogsRange, config.MaxGetLogsResults, config.MaxLogSubscriptionReplay, logger)
 	chain := l2chain.New(
 		config.HostID,
 		config.NodeType,
 		storage,
 		l1Blockchain,
+		l1Finality,
 		crossChainProcessors,
 		memp,
 		enclaveKey,
@@ -197,6 +240,7 @@ func NewEnclave(
 		blockResolver:         storage,
 		mempool:               memp,
 		l1Blockchain:          l1Blockchain,
+		l1Finality:            l1Finality,
 		rpcEncryptionManager:  rpcEncryptionManager,
 		rollupManager:         rollupManager,
 		subscriptionManager:   subscriptionManager,
@@ -206,6 +250,7 @@ func NewEnclave(
 		attestationProvider:   attestationProvider,
 		enclaveKey:            enclaveKey,
 		enclavePubKey:         serializedEnclavePubKey,
+		rpcPubKey:             serializedRPCPubKey,
 		transactionBlobCrypto: transactionBlobCrypto,
 		profiler:              prof,
 		logger:                logger,
@@ -260,13 +305,14 @@ func (e *enclaveImpl) SubmitL1Block(block types.Block, receipts types.Receipts,
 		rollup, err := e.rollupManager.CreateRollup()
 		if err != nil {
 			e.logger.Error("Failed to produce rollup", log.ErrKey, err)
+		} else {
+			blockSubmissionResponse.ProducedRollup = rollup.ToExtRollup(e.transactionBlobCrypto)
 		}
-		blockSubmissionResponse.ProducedRollup = rollup.ToExtRollup(e.transactionBlobCrypto)
 	}
 
 	e.logger.Info("produceBlockSubmissionResponse successful", log.BlockHeightKey, block.Number(), log.BlockHashKey, block.Hash(),
 		"newBatch", describeBSR(blockSubmissionResponse))
-	blockSubmissionResponse.ProducedSecretResponses = e.processNetworkSecretMsgs(block)
+	blockSubmissionResponse.ProducedSecretResponses, blockSubmissionResponse.RejectedSecretRequests = e.processNetworkSecretMsgs(br)
 
 	// We remove any transactions considered immune to re-orgs from the mempool.
 	if blockSubmissionResponse.ProducedBatch != nil {
@@ -298,7 +344,7 @@ func describeBSR(response *common.BlockSubmissionResponse) string {
 	return fmt.Sprintf("%s, %s", producedBatch, producedRollup)
 }
 
-func (e *enclaveImpl) SubmitTx(tx common.EncryptedTx) (common.EncryptedResponseSendRawTx, error) {
+func (e *enclaveImpl) SubmitTx(tx common.EncryptedTx) (*common.TxSubmissionResponse, error) {
 	encodedTx, err := e.rpcEncryptionManager.DecryptBytes(tx)
 	if err != nil {
 		return nil, fmt.Errorf("could not decrypt params in eth_sendRawTransaction request. Cause: %w", err)
@@ -334,7 +380,15 @@ func (e *enclaveImpl) SubmitTx(tx common.EncryptedTx) (common.EncryptedResponseS
 		return nil, fmt.Errorf("enclave could not respond securely to eth_sendRawTransaction request. Cause: %w", err)
 	}
 
-	return encryptedResult, nil
+	subscribedTxHashes, err := e.subscriptionManager.GetSubscribedTxHashesEncrypted(viewingKeyAddress, decryptedTx.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt transaction hash for pending transaction subscriptions. Cause: %w", err)
+	}
+
+	return &common.TxSubmissionResponse{
+		EncryptedHash:      encryptedResult,
+		SubscribedTxHashes: subscribedTxHashes,
+	}, nil
 }
 
 func (e *enclaveImpl) SubmitBatch(extBatch *common.ExtBatch) error {
@@ -347,6 +401,24 @@ func (e *enclaveImpl) SubmitBatch(extBatch *common.ExtBatch) error {
 	return nil
 }
 
+func (e *enclaveImpl) SubmitL1Finality(update *common.L1FinalityUpdate) (*common.L1FinalityStatus, error) {
+	if e.l1Finality == nil {
+		return nil, errors.New("enclave does not validate L1 blocks against the beacon chain")
+	}
+	status, err := e.l1Finality.SubmitUpdate(update)
+	if err != nil {
+		return nil, fmt.Errorf("could not submit L1 finality update. Cause: %w", err)
+	}
+	return status, nil
+}
+
+func (e *enclaveImpl) SubmitFinalizedL1Block(blockHash gethcommon.Hash) error {
+	if err := e.chain.SetFinalizedL1Block(blockHash); err != nil {
+		return fmt.Errorf("could not record finalized L1 block. Cause: %w", err)
+	}
+	return nil
+}
+
 func (e *enclaveImpl) GenerateRollup() (*common.ExtRollup, error) {
 	if e.config.NodeType != common.Sequencer {
 		return nil, errors.New("only sequencer can generate rollups")
@@ -550,12 +622,121 @@ func (e *enclaveImpl) GetTransactionReceipt(encryptedParams common.EncryptedPara
 	return encryptedTxReceipt, nil
 }
 
+func (e *enclaveImpl) GetTransactionStatus(encryptedParams common.EncryptedParamsGetTxStatus) (common.EncryptedResponseGetTxStatus, error) {
+	paramBytes, err := e.rpcEncryptionManager.DecryptBytes(encryptedParams)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt params in obscuro_getTransactionStatus request. Cause: %w", err)
+	}
+	txHash, err := rpc.ExtractTxHash(paramBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, txStatus, err := e.transactionStatus(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, nil
+	}
+
+	// Only the transaction's sender can learn its status.
+	sender, err := rpc.GetSender(tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover viewing key address to encrypt obscuro_getTransactionStatus response. Cause: %w", err)
+	}
+
+	txStatusBytes, err := json.Marshal(txStatus)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal transaction status to JSON in obscuro_getTransactionStatus request. Cause: %w", err)
+	}
+
+	encryptedTxStatus, err := e.rpcEncryptionManager.EncryptWithViewingKey(sender, txStatusBytes)
+	if err != nil {
+		return nil, fmt.Errorf("enclave could not respond securely to obscuro_getTransactionStatus request. Cause: %w", err)
+	}
+	return encryptedTxStatus, nil
+}
+
+// Returns the transaction and its status, or a nil transaction if the enclave does not know about the transaction.
+// A transaction's inclusion in the canonical chain takes precedence over the mempool, since included transactions
+// remain in the mempool until they are `HeightCommittedBlocks` batches deep.
+func (e *enclaveImpl) transactionStatus(txHash gethcommon.Hash) (*common.L2Tx, *common.TransactionStatus, error) {
+	tx, batchHash, batchHeight, _, err := e.storage.GetTransaction(txHash)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, nil, fmt.Errorf("could not retrieve transaction. Cause: %w", err)
+	}
+	if err == nil {
+		canonicalBatch, err := e.storage.FetchBatchByHeight(batchHeight)
+		if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil, fmt.Errorf("could not retrieve batch containing transaction. Cause: %w", err)
+		}
+		if err == nil && bytes.Equal(canonicalBatch.Hash().Bytes(), batchHash.Bytes()) {
+			txStatus, err := e.includedTransactionStatus(batchHash, batchHeight)
+			if err != nil {
+				return nil, nil, err
+			}
+			return tx, txStatus, nil
+		}
+	}
+
+	if mempoolTx, exclusionReason := e.mempool.FetchMempoolTx(txHash); mempoolTx != nil {
+		return mempoolTx, &common.TransactionStatus{Status: common.TxStatusPending, ExclusionReason: exclusionReason}, nil
+	}
+
+	droppedTx, reason, err := e.storage.FetchDroppedTx(txHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("could not retrieve dropped transaction. Cause: %w", err)
+	}
+	return droppedTx, &common.TransactionStatus{Status: common.TxStatusDropped, DropReason: reason}, nil
+}
+
+// Returns the status of a transaction included in the given canonical batch, based on whether the batch has been rolled
+// up into an L1 block on the canonical L1 chain, and how deep that block is.
+func (e *enclaveImpl) includedTransactionStatus(batchHash gethcommon.Hash, batchHeight uint64) (*common.TransactionStatus, error) {
+	batchNumber := hexutil.Uint64(batchHeight)
+	txStatus := &common.TransactionStatus{Status: common.TxStatusBatched, BatchHash: &batchHash, BatchNumber: &batchNumber}
+
+	rollupHash, l1BlockHash, err := e.storage.FetchRollupForBatch(batchHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return txStatus, nil
+		}
+		return nil, fmt.Errorf("could not retrieve rollup containing batch. Cause: %w", err)
+	}
+	l1Block, err := e.storage.FetchBlock(*l1BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve L1 block containing rollup. Cause: %w", err)
+	}
+	l1Head, err := e.storage.FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve L1 head. Cause: %w", err)
+	}
+	// The rollup may have been published in a block that has since been reorged out.
+	if !e.storage.IsAncestor(l1Head, l1Block) {
+		return txStatus, nil
+	}
+
+	l1BlockNumber := hexutil.Uint64(l1Block.NumberU64())
+	txStatus.Status = common.TxStatusRolledUp
+	txStatus.RollupHash = rollupHash
+	txStatus.L1BlockHash = l1BlockHash
+	txStatus.L1BlockNumber = &l1BlockNumber
+	if l1Head.NumberU64()-l1Block.NumberU64() >= common.L1FinalityDepth {
+		txStatus.Status = common.TxStatusFinalised
+	}
+	return txStatus, nil
+}
+
 func (e *enclaveImpl) Attestation() (*common.AttestationReport, error) {
 	if e.enclavePubKey == nil {
 		e.logger.Error("public key not initialized, we can't produce the attestation report")
 		return nil, fmt.Errorf("public key not initialized, we can't produce the attestation report")
 	}
-	report, err := e.attestationProvider.GetReport(e.enclavePubKey, e.config.HostID, e.config.HostAddress)
+	report, err := e.attestationProvider.GetReport(e.enclavePubKey, e.rpcPubKey, e.config.HostID, e.config.HostAddress)
 	if err != nil {
 		e.logger.Error("could not produce remote report")
 		return nil, fmt.Errorf("could not produce remote report")
@@ -592,19 +773,9 @@ func (e *enclaveImpl) InitEnclave(s common.EncryptedSharedEnclaveSecret) error {
 	return nil
 }
 
-// ShareSecret verifies the request and if it trusts the report and the public key it will return the secret encrypted with that public key.
-func (e *enclaveImpl) verifyAttestationAndEncryptSecret(att *common.AttestationReport) (common.EncryptedSharedEnclaveSecret, error) {
-	// First we verify the attestation report has come from a valid obscuro enclave running in a verified TEE.
-	data, err := e.attestationProvider.VerifyReport(att)
-	if err != nil {
-		return nil, fmt.Errorf("unable to verify report - %w", err)
-	}
-	// Then we verify the public key provided has come from the same enclave as that attestation report
-	if err = VerifyIdentity(data, att); err != nil {
-		return nil, fmt.Errorf("unable to verify identity - %w", err)
-	}
-	e.logger.Info(fmt.Sprintf("Successfully verified attestation and identity. Owner: %s", att.Owner))
-
+// encryptSecret returns the secret encrypted with the public key of the attested enclave. The attestation must have been
+// verified already.
+func (e *enclaveImpl) encryptSecret(att *common.AttestationReport) (common.EncryptedSharedEnclaveSecret, error) {
 	secret, err := e.storage.FetchSecret()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve secret; this should not happen. Cause: %w", err)
@@ -681,10 +852,14 @@ func (e *enclaveImpl) GetCode(address gethcommon.Address, batchHash *common.L2Ro
 	return stateDB.GetCode(address), nil
 }
 
-func (e *enclaveImpl) Subscribe(id gethrpc.ID, encryptedSubscription common.EncryptedParamsLogSubscription) error {
+func (e *enclaveImpl) Subscribe(id gethrpc.ID, encryptedSubscription common.EncryptedParamsLogSubscription) (common.EncryptedLogs, error) {
 	return e.subscriptionManager.AddSubscription(id, encryptedSubscription)
 }
 
+func (e *enclaveImpl) SubscribePendingTxs(id gethrpc.ID, encryptedSubscription common.EncryptedParamsPendingTxSubscription) error {
+	return e.subscriptionManager.AddPendingTxSubscription(id, encryptedSubscription)
+}
+
 func (e *enclaveImpl) Unsubscribe(id gethrpc.ID) error {
 	e.subscriptionManager.RemoveSubscription(id)
 	return nil
@@ -757,7 +932,7 @@ func (e *enclaveImpl) GetLogs(encryptedParams common.EncryptedParamsGetLogs) (co
 	}
 
 	// We extract the arguments from the param bytes.
-	filter, forAddress, err := extractGetLogsParams(paramBytes)
+	filter, forAddress, err := extractGetLogsParams(paramBytes, e.chain.ResolveBatchNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -964,22 +1139,28 @@ func (e *enclaveImpl) checkGas(tx *types.Transaction) error {
 	return nil
 }
 
-// processNetworkSecretMsgs we watch for all messages that are requesting or receiving the secret and we store the nodes attested keys
-func (e *enclaveImpl) processNetworkSecretMsgs(block types.Block) []*common.ProducedSecretResponse {
-	var responses []*common.ProducedSecretResponse
-	for _, tx := range block.Transactions() {
-		t := e.mgmtContractLib.DecodeTx(tx)
+// processNetworkSecretMsgs we watch for all messages that are requesting or receiving the secret and we store the nodes attested keys.
+// Returns the responses to publish, and the secret requests that were rejected.
+func (e *enclaveImpl) processNetworkSecretMsgs(br *common.BlockAndReceipts) ([]*common.ProducedSecretResponse, []*common.RejectedSecretRequest) {
+	block := br.Block
+	l1Txs, err := mgmtcontractlib.DecodeBlock(e.mgmtContractLib, block, *br.Receipts, e.logger)
+	if err != nil {
+		e.logger.Error("Could not decode management contract transactions.", log.ErrKey, err)
+	}
 
+	var rejections []*common.RejectedSecretRequest
+	for _, t := range l1Txs {
 		// this transaction is for a node that has joined the network and needs to be sent the network secret
 		if scrtReqTx, ok := t.(*ethadapter.L1RequestSecretTx); ok {
-			e.logger.Info(fmt.Sprintf("Process shared secret request. Block: %d. TxKey: %d",
-				block.NumberU64(), common.ShortHash(tx.Hash())))
-			resp, err := e.processSecretRequest(scrtReqTx)
+			e.logger.Info(fmt.Sprintf("Process shared secret request. Block: %d", block.NumberU64()))
+			rejection, err := e.processSecretRequest(block, scrtReqTx)
 			if err != nil {
 				e.logger.Error("Failed to process shared secret request.", log.ErrKey, err)
-				continue
 			}
-			responses = append(responses, resp)
+			if rejection != nil {
+				e.logger.Warn("Rejected shared secret request.", "requester", rejection.RequesterID, "reason", rejection.Reason)
+				rejections = append(rejections, rejection)
+			}
 		}
 
 		// this transaction was created by the genesis node, we need to store their attested key to decrypt their rollup
@@ -996,21 +1177,48 @@ func (e *enclaveImpl) processNetworkSecretMsgs(block types.Block) []*common.Prod
 			if err != nil {
 				e.logger.Error("Could not store the attestation report.", log.ErrKey, err)
 			}
+
+			err = e.processSecretInitialisation(initSecretTx)
+			if err != nil {
+				e.logger.Error("Could not process secret initialisation.", log.ErrKey, err)
+			}
+		}
+
+		// this transaction gives the secret to a node, which becomes attested
+		if respondSecretTx, ok := t.(*ethadapter.L1RespondSecretTx); ok {
+			err := e.processSecretResponse(respondSecretTx)
+			if err != nil {
+				e.logger.Warn("Ignoring secret response.", "requester", respondSecretTx.RequesterID, log.ErrKey, err)
+			}
 		}
 	}
-	return responses
+
+	responses, err := e.produceSecretResponses(block)
+	if err != nil {
+		e.logger.Error("Could not produce secret responses.", log.ErrKey, err)
+	}
+	return responses, rejections
 }
 
-func (e *enclaveImpl) processSecretRequest(req *ethadapter.L1RequestSecretTx) (*common.ProducedSecretResponse, error) {
+// Verifies the requester's attestation, and stores the request along with the encrypted secret until the request has
+// been responded to. If the attestation is not trusted, the request is rejected and the reason is returned.
+func (e *enclaveImpl) processSecretRequest(block *types.Block, req *ethadapter.L1RequestSecretTx) (*common.RejectedSecretRequest, error) {
 	att, err := common.DecodeAttestation(req.Attestation)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode attestation - %w", err)
+		return &common.RejectedSecretRequest{Reason: fmt.Sprintf("failed to decode attestation - %s", err)}, nil
 	}
 
 	e.logger.Info("received attestation", "attestation", att)
-	secret, err := e.verifyAttestationAndEncryptSecret(att)
+	// We verify the attestation report has come from a trusted obscuro enclave running in a verified TEE, and that the
+	// public key provided has come from the same enclave as that attestation report.
+	if err = e.attestationProvider.VerifyReport(att); err != nil {
+		return &common.RejectedSecretRequest{RequesterID: att.Owner, Reason: fmt.Sprintf("unable to verify report - %s", err)}, nil
+	}
+	e.logger.Info(fmt.Sprintf("Successfully verified attestation and identity. Owner: %s", att.Owner))
+
+	secret, err := e.encryptSecret(att)
 	if err != nil {
-		return nil, fmt.Errorf("secret request failed, no response will be published - %w", err)
+		return nil, fmt.Errorf("could not encrypt secret, no response will be published. Cause: %w", err)
 	}
 
 	// Store the attested key only if the attestation process succeeded.
@@ -1019,16 +1227,32 @@ func (e *enclaveImpl) processSecretRequest(req *ethadapter.L1RequestSecretTx) (*
 		return nil, fmt.Errorf("could not store attestation, no response will be published. Cause: %w", err)
 	}
 
+	// The request may have been answered already, e.g. if the block containing it is seen again after an L1 fork.
+	attestedNodes, err := e.storage.FetchAttestedNodes()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attested nodes. Cause: %w", err)
+	}
+	if containsAddress(attestedNodes, att.Owner) {
+		e.logger.Trace("Requester is already attested, so the secret request is ignored.", "owner", att.Owner)
+		return nil, nil
+	}
+
+	err = e.storage.StoreSecretRequest(&core.SecretRequest{
+		RequesterID:        att.Owner,
+		HostAddress:        att.HostAddress,
+		Secret:             secret,
+		RequestBlockHash:   block.Hash(),
+		RequestBlockNumber: block.NumberU64(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not store secret request. Cause: %w", err)
+	}
 	e.logger.Trace("Processed secret request.", "owner", att.Owner)
-	return &common.ProducedSecretResponse{
-		Secret:      secret,
-		RequesterID: att.Owner,
-		HostAddress: att.HostAddress,
-	}, nil
+	return nil, nil
 }
 
 // Returns the params extracted from an eth_getLogs request.
-func extractGetLogsParams(paramBytes []byte) (*filters.FilterCriteria, *gethcommon.Address, error) {
+func extractGetLogsParams(paramBytes []byte, resolveBatchNumber func(gethrpc.BlockNumber) (uint64, error)) (*filters.FilterCriteria, *gethcommon.Address, error) {
 	// We verify the params.
 	var paramsList []interface{}
 	err := json.Unmarshal(paramBytes, &paramsList)
@@ -1039,6 +1263,14 @@ func extractGetLogsParams(paramBytes []byte) (*filters.FilterCriteria, *gethcomm
 		return nil, nil, fmt.Errorf("expected 2 params in GetLogs request, but received %d", len(paramsList))
 	}
 
+	// The filter criteria cannot be unmarshalled with the `safe` and `finalized` tags, so we replace them with the
+	// numbers of the batches they refer to.
+	if filterMap, ok := paramsList[0].(map[string]interface{}); ok {
+		if err = resolveFilterTags(filterMap, resolveBatchNumber); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// We extract the first param, the filter for the logs.
 	// We marshal the filter criteria from a map to JSON, then back from JSON into a FilterCriteria. This is
 	// because the filter criteria arrives as a map, and there is no way to convert it to a map directly into a
@@ -1062,6 +1294,31 @@ func extractGetLogsParams(paramBytes []byte) (*filters.FilterCriteria, *gethcomm
 	return &filter, &forAddress, nil
 }
 
+// Replaces the `safe` and `finalized` tags in the filter's `fromBlock` and `toBlock` fields with batch numbers.
+func resolveFilterTags(filterMap map[string]interface{}, resolveBatchNumber func(gethrpc.BlockNumber) (uint64, error)) error {
+	for _, field := range []string{"fromBlock", "toBlock"} {
+		value, ok := filterMap[field].(string)
+		if !ok {
+			continue
+		}
+		var number common.BlockNumber
+		if err := number.UnmarshalJSON([]byte(value)); err != nil {
+			// We leave invalid values to the filter criteria's own parsing.
+			continue
+		}
+		tag := gethrpc.BlockNumber(number)
+		if tag != common.SafeBlockNumber && tag != common.FinalizedBlockNumber {
+			continue
+		}
+		height, err := resolveBatchNumber(tag)
+		if err != nil {
+			return fmt.Errorf("could not resolve %s `%s`. Cause: %w", field, value, err)
+		}
+		filterMap[field] = hexutil.EncodeUint64(height)
+	}
+	return nil
+}
+
 // Removes transactions from the mempool that are considered immune to re-orgs (i.e. over X batches deep).
 func (e *enclaveImpl) removeOldMempoolTxs(batchHeader *common.BatchHeader) error {
 	if batchHeader == nil {
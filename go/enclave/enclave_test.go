@@ -40,7 +40,7 @@ const _testEnclavePublicKeyHex = "034d3b7e63a8bcd532ee3d1d6ecad9d67fca7821981a04
 // _successfulRollupGasPrice can be deterministically calculated when evaluating the management smart contract.
 // It should change only when there are changes to the smart contract or if the gas estimation algorithm is modified.
 // Other changes would mean something is broken.
-const _successfulRollupGasPrice = 386824
+const _successfulRollupGasPrice = 407880
 
 var _enclavePubKey *ecies.PublicKey
 
@@ -439,6 +439,96 @@ func TestGetBalanceBlockHeight(t *testing.T) {
 	//}
 }
 
+func TestGetBalanceAtSafeAndFinalizedBatches(t *testing.T) {
+	w := datagenerator.RandomWallet(integration.ObscuroChainID)
+	funded := genesis.Account{
+		Address: w.Address(),
+		Amount:  big.NewInt(int64(datagenerator.RandomUInt64())),
+	}
+
+	testEnclave, err := createTestEnclave(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock, err := testEnclave.(*enclaveImpl).storage.FetchHeadBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the genesis batch is included in the genesis rollup, but no L1 block is known to be finalized yet
+	if err = checkExpectedBalance(testEnclave, common.SafeBlockNumber, w, big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	if err = checkExpectedBalance(testEnclave, common.FinalizedBlockNumber, w, big.NewInt(0)); err == nil {
+		t.Fatal("expected the finalized batch to be unknown before an L1 block is finalized")
+	}
+
+	if err = injectNewBlockAndChangeBalance(testEnclave, []genesis.Account{funded}); err != nil {
+		t.Fatal(err)
+	}
+	if err = checkExpectedBalance(testEnclave, common.SafeBlockNumber, w, funded.Amount); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testEnclave.SubmitFinalizedL1Block(genesisBlock.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if err = checkExpectedBalance(testEnclave, common.FinalizedBlockNumber, w, big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+
+	headBlock, err := testEnclave.(*enclaveImpl).storage.FetchHeadBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = testEnclave.SubmitFinalizedL1Block(headBlock.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if err = checkExpectedBalance(testEnclave, common.FinalizedBlockNumber, w, funded.Amount); err != nil {
+		t.Fatal(err)
+	}
+
+	// finality does not go backwards
+	if err = testEnclave.SubmitFinalizedL1Block(genesisBlock.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if err = checkExpectedBalance(testEnclave, common.FinalizedBlockNumber, w, funded.Amount); err != nil {
+		t.Fatal(err)
+	}
+	if err = testEnclave.SubmitFinalizedL1Block(gethcommon.Hash{1}); err == nil {
+		t.Fatal("expected an L1 block the enclave has not ingested to be rejected")
+	}
+}
+
+func TestGetLogsParamsResolveSafeAndFinalizedTags(t *testing.T) {
+	resolved := map[gethrpc.BlockNumber]uint64{common.SafeBlockNumber: 7, common.FinalizedBlockNumber: 3}
+	resolveBatchNumber := func(number gethrpc.BlockNumber) (uint64, error) {
+		height, found := resolved[number]
+		if !found {
+			return 0, fmt.Errorf("unexpected batch number %d", number)
+		}
+		return height, nil
+	}
+
+	paramBytes := []byte(`[{"fromBlock": "finalized", "toBlock": "safe"}, "0x0000000000000000000000000000000000000001"]`)
+	filter, _, err := extractGetLogsParams(paramBytes, resolveBatchNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter.FromBlock.Uint64() != 3 || filter.ToBlock.Uint64() != 7 {
+		t.Fatalf("expected range [3, 7], got [%d, %d]", filter.FromBlock, filter.ToBlock)
+	}
+
+	paramBytes = []byte(`[{"fromBlock": "0x1", "toBlock": "latest"}, "0x0000000000000000000000000000000000000001"]`)
+	filter, _, err = extractGetLogsParams(paramBytes, resolveBatchNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter.FromBlock.Uint64() != 1 || filter.ToBlock.Int64() != gethrpc.LatestBlockNumber.Int64() {
+		t.Fatalf("expected range [1, latest], got [%d, %d]", filter.FromBlock, filter.ToBlock)
+	}
+}
+
 // registerWalletViewingKey takes a wallet and registers a VK with the enclave
 func registerWalletViewingKey(t *testing.T, enclave common.Enclave, w wallet.Wallet) (*rpc.ViewingKey, error) {
 	// generate the VK from the wallet
@@ -460,12 +550,13 @@ func registerWalletViewingKey(t *testing.T, enclave common.Enclave, w wallet.Wal
 // createTestEnclave returns a test instance of the enclave
 func createTestEnclave(prefundedAddresses []genesis.Account) (common.Enclave, error) {
 	enclaveConfig := config.EnclaveConfig{
-		L1ChainID:      integration.EthereumChainID,
-		ObscuroChainID: integration.ObscuroChainID,
-		WillAttest:     false,
-		UseInMemoryDB:  true,
-		MinGasPrice:    big.NewInt(1),
-		Cadence:        10,
+		L1ChainID:               integration.EthereumChainID,
+		ObscuroChainID:          integration.ObscuroChainID,
+		WillAttest:              false,
+		InsecureSkipAttestation: true,
+		UseInMemoryDB:           true,
+		MinGasPrice:             big.NewInt(1),
+		Cadence:                 10,
 	}
 	logger := log.New(log.TestLogCmp, int(gethlog.LvlError), log.SysOut)
 
@@ -518,7 +609,7 @@ func createFakeGenesis(enclave common.Enclave, addresses []genesis.Account) erro
 	}
 
 	// We update the database
-	if err = enclave.(*enclaveImpl).storage.StoreRollup(genesisRollup); err != nil {
+	if err = enclave.(*enclaveImpl).storage.StoreRollup(genesisRollup, blk.Hash()); err != nil {
 		return err
 	}
 	if err = enclave.(*enclaveImpl).storage.StoreBatch(genesisBatch, nil); err != nil {
@@ -581,7 +672,7 @@ func injectNewBlockAndChangeBalance(enclave common.Enclave, funds []genesis.Acco
 	}
 
 	// We update the database.
-	if err = enclave.(*enclaveImpl).storage.StoreRollup(rollup); err != nil {
+	if err = enclave.(*enclaveImpl).storage.StoreRollup(rollup, blk.Hash()); err != nil {
 		return err
 	}
 	if err = enclave.(*enclaveImpl).storage.StoreBatch(batch, nil); err != nil {
@@ -0,0 +1,195 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// GetFilteredLogs returns the logs on the canonical chain that match the provided account and filter.
+//
+// If the filter has a block hash, only the logs of that batch are returned. Otherwise, the filter's `fromBlock` and
+// `toBlock` are interpreted as batch numbers, and only the batches in that range are scanned. Batches whose bloom
+// shows they contain no matching logs are skipped without loading their receipts.
+//
+// If the range spans more batches than the configured maximum, or the matching logs exceed the configured maximum, an
+// error is returned. In the latter case, the error suggests a narrower range that can be used to page through the logs.
+func (s *SubscriptionManager) GetFilteredLogs(account *gethcommon.Address, filter *filters.FilterCriteria) ([]*types.Log, error) {
+	headBatch, err := s.storage.FetchHeadBatch()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// There is no head batch, and thus no logs to retrieve.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not retrieve head batch. Cause: %w", err)
+	}
+
+	// The state at the head batch is used to determine which topics are user addresses.
+	stateDB, err := s.storage.CreateStateDB(*headBatch.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
+	}
+
+	if filter.BlockHash != nil && *filter.BlockHash != (gethcommon.Hash{}) {
+		batch, err := s.storage.FetchBatch(*filter.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve batch %s to extract its logs. Cause: %w", *filter.BlockHash, err)
+		}
+		return s.getBatchLogs(batch, stateDB, account, filter)
+	}
+
+	from, to, err := resolveBatchRange(filter, headBatch.NumberU64())
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		// The range starts after the head batch, so there are no logs yet.
+		return []*types.Log{}, nil
+	}
+	if s.maxGetLogsRange != 0 && to-from+1 > s.maxGetLogsRange {
+		return nil, fmt.Errorf("block range [%d, %d] spans %d batches, exceeding the maximum of %d batches per "+
+			"request", from, to, to-from+1, s.maxGetLogsRange)
+	}
+
+	return s.getLogsInRange(account, filter, from, to, stateDB)
+}
+
+// Returns the logs of the canonical batches from `from` to `to` (inclusive) that are relevant to the account and match
+// the filter, or an error if there are more than the maximum number of results.
+func (s *SubscriptionManager) getLogsInRange(account *gethcommon.Address, filter *filters.FilterCriteria, from uint64, to uint64, stateDB *state.StateDB) ([]*types.Log, error) {
+	logs := []*types.Log{}
+	for height := from; height <= to; height++ {
+		batch, err := s.storage.FetchBatchByHeight(height)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve batch %d to extract its logs. Cause: %w", height, err)
+		}
+
+		batchLogs, err := s.getBatchLogs(batch, stateDB, account, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if s.maxGetLogsResults != 0 && uint64(len(logs)+len(batchLogs)) > s.maxGetLogsResults {
+			return nil, tooManyLogsError(s.maxGetLogsResults, from, height)
+		}
+		logs = append(logs, batchLogs...)
+	}
+
+	return logs, nil
+}
+
+// FilterLogs takes a list of logs and the hash of the rollup to use to create the state DB. It returns the logs
+// filtered based on the provided account and filter.
+func (s *SubscriptionManager) FilterLogs(logs []*types.Log, rollupHash common.L2RootHash, account *gethcommon.Address, filter *filters.FilterCriteria) ([]*types.Log, error) {
+	stateDB, err := s.storage.CreateStateDB(rollupHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
+	}
+	return s.filterRelevantLogs(logs, stateDB, account, filter), nil
+}
+
+// Returns the logs of the batch that are relevant to the account and match the filter. The batch's receipts are only
+// loaded if its bloom indicates it may contain matching logs.
+func (s *SubscriptionManager) getBatchLogs(batch *core.Batch, stateDB *state.StateDB, account *gethcommon.Address, filter *filters.FilterCriteria) ([]*types.Log, error) {
+	// A batch without logs has an empty bloom.
+	if batch.Header.Bloom == (types.Bloom{}) || !bloomMatches(batch.Header.Bloom, filter.Addresses, filter.Topics) {
+		return nil, nil
+	}
+
+	receipts, err := s.storage.GetReceiptsByHash(*batch.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve receipts for batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	var logs []*types.Log
+	for _, receipt := range receipts {
+		logs = append(logs, receipt.Logs...)
+	}
+	return s.filterRelevantLogs(logs, stateDB, account, filter), nil
+}
+
+// Returns the logs that are relevant to the account and match the filter.
+func (s *SubscriptionManager) filterRelevantLogs(logs []*types.Log, stateDB *state.StateDB, account *gethcommon.Address, filter *filters.FilterCriteria) []*types.Log {
+	filteredLogs := []*types.Log{}
+	for _, logItem := range logs {
+		if isRelevant(logItem, s.getLogVisibility(logItem, stateDB), account, filter) {
+			filteredLogs = append(filteredLogs, logItem)
+		}
+	}
+	return filteredLogs
+}
+
+// Converts the filter's `fromBlock` and `toBlock` into an inclusive range of batch numbers. A missing `fromBlock`
+// defaults to the genesis batch, and a missing `toBlock` to the head batch. The `latest` and `pending` tags resolve to
+// the head batch. `toBlock` is capped at the head batch, so the returned range is empty if `fromBlock` is beyond it.
+func resolveBatchRange(filter *filters.FilterCriteria, headHeight uint64) (uint64, uint64, error) {
+	from := uint64(0)
+	if filter.FromBlock != nil {
+		if filter.FromBlock.Sign() < 0 {
+			from = headHeight
+		} else {
+			from = filter.FromBlock.Uint64()
+		}
+	}
+
+	to := headHeight
+	if filter.ToBlock != nil && filter.ToBlock.Sign() >= 0 {
+		if filter.ToBlock.Uint64() < from {
+			return 0, 0, fmt.Errorf("invalid block range: fromBlock %d is after toBlock %d", from, filter.ToBlock.Uint64())
+		}
+		if filter.ToBlock.Uint64() < headHeight {
+			to = filter.ToBlock.Uint64()
+		}
+	}
+
+	return from, to, nil
+}
+
+// Returns the error for a request whose logs exceeded the maximum number of results at the given batch. The error
+// suggests the range that ends just before that batch, which is the largest range starting at `from` whose logs fit.
+func tooManyLogsError(maxResults uint64, from uint64, exceededAt uint64) error {
+	if exceededAt == from {
+		return fmt.Errorf("query returned more than %d results. Batch %d alone exceeds the limit; narrow the filter's "+
+			"addresses or topics", maxResults, from)
+	}
+	return fmt.Errorf("query returned more than %d results. Try with this block range [0x%x, 0x%x]", maxResults, from, exceededAt-1)
+}
+
+// Lifted from eth/filters/filter.go in the go-ethereum repository.
+// bloomMatches indicates whether the bloom may contain logs matching the addresses and topics.
+func bloomMatches(bloom types.Bloom, addresses []gethcommon.Address, topics [][]gethcommon.Hash) bool {
+	if len(addresses) > 0 {
+		var included bool
+		for _, addr := range addresses {
+			if types.BloomLookup(bloom, addr) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, sub := range topics {
+		included := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if types.BloomLookup(bloom, topic) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
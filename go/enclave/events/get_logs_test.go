@@ -0,0 +1,219 @@
+package events
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+	"github.com/obscuronet/go-obscuro/go/enclave/db"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const numTestBatches = 5
+
+var (
+	testAccount       = gethcommon.HexToAddress("0x1")
+	testContract      = gethcommon.HexToAddress("0x2")
+	testOtherContract = gethcommon.HexToAddress("0x3")
+	testEventTopic    = gethcommon.HexToHash("0x4")
+)
+
+func TestGetLogsHonoursBatchRange(t *testing.T) {
+	manager, _ := createTestChain(t, 0, 0)
+
+	logs, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(2), ToBlock: big.NewInt(3)})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs, 2, 3)
+
+	// Without a range, the logs of the entire chain are returned.
+	logs, err = manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs, 0, 1, 2, 3, 4)
+
+	// A range that starts after the head batch contains no logs yet.
+	logs, err = manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(numTestBatches)})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs)
+}
+
+func TestGetLogsHonoursBlockHash(t *testing.T) {
+	manager, batches := createTestChain(t, 0, 0)
+
+	blockHash := *batches[1].Hash()
+	logs, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{BlockHash: &blockHash})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs, 1)
+}
+
+func TestGetLogsFiltersByAddress(t *testing.T) {
+	manager, _ := createTestChain(t, 0, 0)
+
+	logs, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{Addresses: []gethcommon.Address{testOtherContract}})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs)
+}
+
+func TestGetLogsRejectsInvalidRange(t *testing.T) {
+	manager, _ := createTestChain(t, 0, 0)
+
+	_, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(3), ToBlock: big.NewInt(2)})
+	if err == nil || !strings.Contains(err.Error(), "invalid block range") {
+		t.Fatalf("expected invalid block range error, got %v", err)
+	}
+}
+
+func TestGetLogsRejectsRangeAboveMaximum(t *testing.T) {
+	manager, _ := createTestChain(t, 2, 0)
+
+	_, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(3)})
+	if err == nil || !strings.Contains(err.Error(), "exceeding the maximum of 2 batches") {
+		t.Fatalf("expected range error, got %v", err)
+	}
+
+	logs, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(2)})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs, 1, 2)
+}
+
+func TestGetLogsSuggestsRangeWhenResultsAboveMaximum(t *testing.T) {
+	manager, _ := createTestChain(t, 0, 3)
+
+	_, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(1)})
+	if err == nil || !strings.Contains(err.Error(), "Try with this block range [0x1, 0x3]") {
+		t.Fatalf("expected results error with suggested range, got %v", err)
+	}
+
+	// The suggested range can be used to page through the logs.
+	logs, err := manager.GetFilteredLogs(&testAccount, &filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(3)})
+	if err != nil {
+		t.Fatalf("could not get logs. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs, 1, 2, 3)
+}
+
+func TestBloomMatches(t *testing.T) {
+	bloom := types.CreateBloom(types.Receipts{createTestReceipt(testContract)})
+
+	if !bloomMatches(bloom, nil, nil) {
+		t.Fatal("expected bloom to match a filter with no criteria")
+	}
+	if !bloomMatches(bloom, []gethcommon.Address{testContract}, [][]gethcommon.Hash{{testEventTopic}}) {
+		t.Fatal("expected bloom to match the log's address and topic")
+	}
+	if bloomMatches(bloom, []gethcommon.Address{testOtherContract}, nil) {
+		t.Fatal("expected bloom not to match another address")
+	}
+	if bloomMatches(bloom, nil, [][]gethcommon.Hash{{gethcommon.HexToHash("0x5")}}) {
+		t.Fatal("expected bloom not to match another topic")
+	}
+}
+
+// Creates a chain of batches, each of which contains a single lifecycle event emitted by the test contract, and
+// returns a subscription manager with the given maximums to query them.
+func createTestChain(t *testing.T, maxGetLogsRange uint64, maxGetLogsResults uint64) (*SubscriptionManager, []*core.Batch) {
+	storage := db.NewStorage(rawdb.NewMemoryDatabase(), params.AllEthashProtocolChanges, gethlog.New())
+
+	batches := make([]*core.Batch, numTestBatches)
+	parentHash := gethcommon.Hash{}
+	for i := 0; i < numTestBatches; i++ {
+		receipts := types.Receipts{createTestReceipt(testContract)}
+		tx := types.NewTx(&types.LegacyTx{Nonce: uint64(i), To: &testContract})
+		batch := &core.Batch{
+			Header: &common.BatchHeader{
+				ParentHash: parentHash,
+				Root:       types.EmptyRootHash,
+				Number:     big.NewInt(int64(i)),
+				Bloom:      types.CreateBloom(receipts),
+			},
+			Transactions: []*common.L2Tx{tx},
+		}
+
+		if err := storage.StoreBatch(batch, receipts); err != nil {
+			t.Fatalf("could not store batch. Cause: %s", err)
+		}
+		if err := storage.UpdateHeadBatch(gethcommon.Hash{}, batch, receipts); err != nil {
+			t.Fatalf("could not update head batch. Cause: %s", err)
+		}
+
+		batches[i] = batch
+		parentHash = *batch.Hash()
+	}
+
+	return NewSubscriptionManager(nil, storage, &EventVisibilityRules{}, maxGetLogsRange, maxGetLogsResults, 0, gethlog.New()), batches
+}
+
+func createTestReceipt(contract gethcommon.Address) *types.Receipt {
+	return &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{{Address: contract, Topics: []gethcommon.Hash{testEventTopic}}},
+	}
+}
+
+func assertLogBatchNumbers(t *testing.T, logs []*types.Log, expectedNumbers ...uint64) {
+	if len(logs) != len(expectedNumbers) {
+		t.Fatalf("expected %d logs, got %d", len(expectedNumbers), len(logs))
+	}
+	for i, logItem := range logs {
+		if logItem.BlockNumber != expectedNumbers[i] {
+			t.Fatalf("expected log %d to be from batch %d, got batch %d", i, expectedNumbers[i], logItem.BlockNumber)
+		}
+	}
+}
+
+func TestSubscriptionReplaysHistoricalLogs(t *testing.T) {
+	manager, _ := createTestChain(t, 0, 0)
+
+	subscription := &common.LogSubscription{
+		Account:         &testAccount,
+		Filter:          &filters.FilterCriteria{},
+		ReplayFromBatch: big.NewInt(2),
+	}
+	logs, err := manager.addSubscription("sub", subscription)
+	if err != nil {
+		t.Fatalf("could not add subscription. Cause: %s", err)
+	}
+	assertLogBatchNumbers(t, logs, 2, 3, 4)
+
+	// New logs are only delivered from the batch after the last replayed batch.
+	if subscription.Filter.FromBlock.Uint64() != numTestBatches {
+		t.Fatalf("expected subscription to start from batch %d, got %d", numTestBatches, subscription.Filter.FromBlock)
+	}
+}
+
+func TestSubscriptionReplayIsBounded(t *testing.T) {
+	manager, _ := createTestChain(t, 0, 0)
+	manager.maxLogSubscriptionReplay = 2
+
+	subscription := &common.LogSubscription{
+		Account:         &testAccount,
+		Filter:          &filters.FilterCriteria{},
+		ReplayFromBatch: big.NewInt(2),
+	}
+	_, err := manager.addSubscription("sub", subscription)
+	if err == nil || !strings.Contains(err.Error(), "more than 2 batches behind the head batch") {
+		t.Fatalf("expected replay window error, got %v", err)
+	}
+	if _, found := manager.getSubscriptionThreadsafe("sub"); found {
+		t.Fatal("expected subscription not to be added")
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/eth/filters"
 
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
 	"github.com/obscuronet/go-obscuro/go/enclave/db"
 
 	"github.com/ethereum/go-ethereum/core/state"
@@ -35,139 +36,182 @@ const (
 
 // TODO - Ensure chain reorgs are handled gracefully.
 
-// SubscriptionManager manages the creation/deletion of subscriptions, and the filtering and encryption of logs for
-// active subscriptions.
+// SubscriptionManager manages the creation/deletion of subscriptions, and the filtering and encryption of logs and
+// transaction hashes for active subscriptions.
 type SubscriptionManager struct {
 	rpcEncryptionManager *rpc.EncryptionManager
 	storage              db.Storage
 
-	subscriptions     map[gethrpc.ID]*common.LogSubscription
-	subscriptionMutex *sync.RWMutex
-	logger            gethlog.Logger
+	subscriptions          map[gethrpc.ID]*common.LogSubscription
+	pendingTxSubscriptions map[gethrpc.ID]*common.PendingTxSubscription
+	subscriptionMutex      *sync.RWMutex
+	visibilityRules        *EventVisibilityRules
+	logger                 gethlog.Logger
+
+	maxGetLogsRange          uint64 // The maximum number of batches an eth_getLogs request can span, or zero for no limit
+	maxGetLogsResults        uint64 // The maximum number of logs an eth_getLogs request can return, or zero for no limit
+	maxLogSubscriptionReplay uint64 // The maximum number of batches a log subscription can replay, or zero for no limit
 }
 
-func NewSubscriptionManager(rpcEncryptionManager *rpc.EncryptionManager, storage db.Storage, logger gethlog.Logger) *SubscriptionManager {
+func NewSubscriptionManager(rpcEncryptionManager *rpc.EncryptionManager, storage db.Storage, visibilityRules *EventVisibilityRules, maxGetLogsRange uint64, maxGetLogsResults uint64, maxLogSubscriptionReplay uint64, logger gethlog.Logger) *SubscriptionManager {
 	return &SubscriptionManager{
 		rpcEncryptionManager: rpcEncryptionManager,
 		storage:              storage,
+		maxGetLogsRange:      maxGetLogsRange,
+		maxGetLogsResults:    maxGetLogsResults,
+
+		maxLogSubscriptionReplay: maxLogSubscriptionReplay,
 
-		subscriptions:     map[gethrpc.ID]*common.LogSubscription{},
-		subscriptionMutex: &sync.RWMutex{},
-		logger:            logger,
+		subscriptions:          map[gethrpc.ID]*common.LogSubscription{},
+		pendingTxSubscriptions: map[gethrpc.ID]*common.PendingTxSubscription{},
+		subscriptionMutex:      &sync.RWMutex{},
+		visibilityRules:        visibilityRules,
+		logger:                 logger,
 	}
 }
 
 // AddSubscription adds a log subscription to the enclave under the given ID, provided the request is authenticated
 // correctly. If there is an existing subscription with the given ID, it is overwritten.
-func (s *SubscriptionManager) AddSubscription(id gethrpc.ID, encryptedSubscription common.EncryptedParamsLogSubscription) error {
+//
+// If the subscription requests a replay from a given batch, the matching logs from that batch up to the head batch are
+// returned, encrypted with the subscriber's viewing key. The subscription then delivers the logs of later batches only,
+// so that there are no gaps or duplicates between the replayed and the new logs.
+func (s *SubscriptionManager) AddSubscription(id gethrpc.ID, encryptedSubscription common.EncryptedParamsLogSubscription) (common.EncryptedLogs, error) {
 	encodedSubscription, err := s.rpcEncryptionManager.DecryptBytes(encryptedSubscription)
 	if err != nil {
-		return fmt.Errorf("could not decrypt params in eth_subscribe logs request. Cause: %w", err)
+		return nil, fmt.Errorf("could not decrypt params in eth_subscribe logs request. Cause: %w", err)
 	}
 
 	var subscription common.LogSubscription
 	if err = rlp.DecodeBytes(encodedSubscription, &subscription); err != nil {
-		return fmt.Errorf("could not decocde log subscription from RLP. Cause: %w", err)
+		return nil, fmt.Errorf("could not decocde log subscription from RLP. Cause: %w", err)
 	}
 
-	err = s.rpcEncryptionManager.AuthenticateSubscriptionRequest(subscription)
+	err = s.rpcEncryptionManager.AuthenticateSubscriptionRequest(subscription.Account, subscription.Signature)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// For subscriptions, only the Topics and Addresses fields of the filter are applied.
-	subscription.Filter.BlockHash = nil
-	subscription.Filter.ToBlock = nil
-
-	// We set the FromBlock to the current rollup height, so that historical logs aren't returned.
-	height := big.NewInt(0)
-	rollup, err := s.storage.FetchHeadBatch()
-	if err == nil {
-		height = rollup.Number()
+	historicalLogs, err := s.addSubscription(id, &subscription)
+	if err != nil {
+		return nil, err
+	}
+	if len(historicalLogs) == 0 {
+		return nil, nil
 	}
-	subscription.Filter.FromBlock = big.NewInt(0).Add(height, big.NewInt(1))
 
-	s.subscriptionMutex.Lock()
-	defer s.subscriptionMutex.Unlock()
-	s.subscriptions[id] = &subscription
-	return nil
+	jsonLogs, err := json.Marshal(historicalLogs)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal historical logs to JSON. Cause: %w", err)
+	}
+	return s.rpcEncryptionManager.EncryptWithViewingKey(*subscription.Account, jsonLogs)
 }
 
-// RemoveSubscription removes the log subscription with the given ID from the enclave. If there is no subscription with
-// the given ID, nothing is deleted.
-func (s *SubscriptionManager) RemoveSubscription(id gethrpc.ID) {
+// Adds the authenticated log subscription, and returns the historical logs it requested a replay of.
+func (s *SubscriptionManager) addSubscription(id gethrpc.ID, subscription *common.LogSubscription) ([]*types.Log, error) {
+	// For subscriptions, only the Topics and Addresses fields of the filter are applied.
+	subscription.Filter.BlockHash = nil
+	subscription.Filter.ToBlock = nil
+
+	// We hold the lock while retrieving the historical logs, so that the logs of any batch that becomes the head in the
+	// meantime are only delivered once the subscription's starting batch is set.
 	s.subscriptionMutex.Lock()
 	defer s.subscriptionMutex.Unlock()
-	delete(s.subscriptions, id)
-}
 
-// GetFilteredLogs returns the logs across the entire canonical chain that match the provided account and filter.
-func (s *SubscriptionManager) GetFilteredLogs(account *gethcommon.Address, filter *filters.FilterCriteria) ([]*types.Log, error) {
-	headBlock, err := s.storage.FetchHeadBlock()
-	if err != nil {
-		if errors.Is(err, errutil.ErrNotFound) {
-			// There is no head block, and thus no logs to retrieve.
-			return nil, nil
+	var historicalLogs []*types.Log
+	headBatch, err := s.storage.FetchHeadBatch()
+	switch {
+	case err == nil:
+		if subscription.ReplayFromBatch != nil && subscription.ReplayFromBatch.Uint64() <= headBatch.NumberU64() {
+			historicalLogs, err = s.getReplayedLogs(subscription, headBatch)
+			if err != nil {
+				return nil, err
+			}
 		}
-		return nil, err
+		// We set the FromBlock to the batch after the head batch, so that logs are not delivered twice.
+		subscription.Filter.FromBlock = big.NewInt(0).Add(headBatch.Number(), big.NewInt(1))
+	case errors.Is(err, errutil.ErrNotFound):
+		subscription.Filter.FromBlock = big.NewInt(0)
+	default:
+		return nil, fmt.Errorf("could not retrieve head batch. Cause: %w", err)
 	}
 
-	// We collect all the block hashes in the canonical chain.
-	// TODO: Only collect blocks within the filter's range.
-	blockHashes := []gethcommon.Hash{}
-	currentBlock := headBlock
-	for {
-		blockHashes = append(blockHashes, currentBlock.Hash())
+	s.subscriptions[id] = subscription
+	return historicalLogs, nil
+}
 
-		parentHash := currentBlock.ParentHash()
-		currentBlock, err = s.storage.FetchBlock(parentHash)
-		if err != nil {
-			if errors.Is(err, errutil.ErrNotFound) {
-				break // we have reached the beginning of the known chain
-			}
-			return nil, fmt.Errorf("could not retrieve block %s to extract its logs. Cause: %w", parentHash, err)
-		}
+// Returns the subscription's matching logs from the batch it requested a replay from up to the head batch.
+func (s *SubscriptionManager) getReplayedLogs(subscription *common.LogSubscription, headBatch *core.Batch) ([]*types.Log, error) {
+	from := subscription.ReplayFromBatch.Uint64()
+	if s.maxLogSubscriptionReplay != 0 && headBatch.NumberU64()-from >= s.maxLogSubscriptionReplay {
+		return nil, fmt.Errorf("cannot replay logs from batch %d, as it is more than %d batches behind the head batch; "+
+			"use eth_getLogs to retrieve older logs", from, s.maxLogSubscriptionReplay)
 	}
 
-	// We gather the logs across all the blocks in the canonical chain.
-	logs := []*types.Log{}
-	for _, hash := range blockHashes {
-		blockLogs, err := s.storage.FetchLogs(hash)
-		if err != nil {
-			if errors.Is(err, errutil.ErrNotFound) {
-				break // Blocks before the genesis rollup do not have associated logs (or block state).
-			}
-			return nil, fmt.Errorf("could not fetch logs for block hash. Cause: %w", err)
-		}
-		logs = append(logs, blockLogs...)
+	stateDB, err := s.storage.CreateStateDB(*headBatch.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
 	}
+	// The filter's FromBlock may be zero after RLP decoding, so we use a copy limited to the replayed range.
+	filter := *subscription.Filter
+	filter.FromBlock = nil
+	return s.getLogsInRange(subscription.Account, &filter, from, headBatch.NumberU64(), stateDB)
+}
 
-	// We proceed in this way instead of calling `FetchHeadRollup` because we want to ensure the chain has not advanced
-	// causing a head block/head rollup mismatch.
-	l2Head, err := s.storage.FetchHeadBatchForBlock(headBlock.Hash())
+// AddPendingTxSubscription adds a pending transaction subscription to the enclave under the given ID, provided the
+// request is authenticated correctly. If there is an existing subscription with the given ID, it is overwritten.
+func (s *SubscriptionManager) AddPendingTxSubscription(id gethrpc.ID, encryptedSubscription common.EncryptedParamsPendingTxSubscription) error {
+	encodedSubscription, err := s.rpcEncryptionManager.DecryptBytes(encryptedSubscription)
 	if err != nil {
-		return nil, fmt.Errorf("could not filter logs as block state for head block could not be retrieved. Cause: %w", err)
+		return fmt.Errorf("could not decrypt params in eth_subscribe newPendingTransactions request. Cause: %w", err)
 	}
-	return s.FilterLogs(logs, *l2Head.Hash(), account, filter)
-}
 
-// FilterLogs takes a list of logs and the hash of the rollup to use to create the state DB. It returns the logs
-// filtered based on the provided account and filter.
-func (s *SubscriptionManager) FilterLogs(logs []*types.Log, rollupHash common.L2RootHash, account *gethcommon.Address, filter *filters.FilterCriteria) ([]*types.Log, error) {
-	filteredLogs := []*types.Log{}
-	stateDB, err := s.storage.CreateStateDB(rollupHash)
+	var subscription common.PendingTxSubscription
+	if err = rlp.DecodeBytes(encodedSubscription, &subscription); err != nil {
+		return fmt.Errorf("could not decode pending transaction subscription from RLP. Cause: %w", err)
+	}
+
+	err = s.rpcEncryptionManager.AuthenticateSubscriptionRequest(subscription.Account, subscription.Signature)
 	if err != nil {
-		return nil, fmt.Errorf("could not create state DB to filter logs. Cause: %w", err)
+		return err
 	}
 
-	for _, logItem := range logs {
-		userAddrs := getUserAddrsFromLogTopics(logItem, stateDB)
-		if isRelevant(logItem, userAddrs, account, filter) {
-			filteredLogs = append(filteredLogs, logItem)
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	s.pendingTxSubscriptions[id] = &subscription
+	return nil
+}
+
+// RemoveSubscription removes the log or pending transaction subscription with the given ID from the enclave. If there
+// is no subscription with the given ID, nothing is deleted.
+func (s *SubscriptionManager) RemoveSubscription(id gethrpc.ID) {
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	delete(s.subscriptions, id)
+	delete(s.pendingTxSubscriptions, id)
+}
+
+// GetSubscribedTxHashesEncrypted returns, for each of the sender's pending transaction subscriptions, the transaction's
+// hash encrypted with the sender's viewing key.
+func (s *SubscriptionManager) GetSubscribedTxHashesEncrypted(sender gethcommon.Address, txHash gethcommon.Hash) (map[gethrpc.ID][]byte, error) {
+	s.subscriptionMutex.RLock()
+	defer s.subscriptionMutex.RUnlock()
+
+	encryptedTxHashesByID := map[gethrpc.ID][]byte{}
+	for subID, subscription := range s.pendingTxSubscriptions {
+		if *subscription.Account != sender {
+			continue
 		}
+
+		// Each subscription gets its own ciphertext, so that subscriptions can't be linked by comparing them.
+		encryptedTxHash, err := s.rpcEncryptionManager.EncryptWithViewingKey(sender, []byte(txHash.Hex()))
+		if err != nil {
+			return nil, err
+		}
+		encryptedTxHashesByID[subID] = encryptedTxHash
 	}
 
-	return filteredLogs, nil
+	return encryptedTxHashesByID, nil
 }
 
 // GetSubscribedLogsEncrypted returns, for each subscription, the logs filtered and encrypted with the appropriate
@@ -196,8 +240,8 @@ func (s *SubscriptionManager) getSubscribedLogs(logs []*types.Log, rollupHash co
 	}
 
 	for _, logItem := range logs {
-		userAddrs := getUserAddrsFromLogTopics(logItem, stateDB)
-		s.updateRelevantLogs(logItem, userAddrs, relevantLogsByID)
+		visibility := s.getLogVisibility(logItem, stateDB)
+		s.updateRelevantLogs(logItem, visibility, relevantLogsByID)
 	}
 
 	return relevantLogsByID, nil
@@ -234,8 +278,8 @@ func (s *SubscriptionManager) encryptLogs(logsByID map[gethrpc.ID][]*types.Log)
 //   - It has a non-zero nonce (to prevent accidental or malicious creation of the address matching a given topic,
 //     forcing its events to become permanently private
 //   - It does not have associated code (meaning it's a smart-contract address)
-func getUserAddrsFromLogTopics(log *types.Log, db *state.StateDB) []string {
-	var userAddrs []string
+func getUserAddrsFromLogTopics(log *types.Log, db *state.StateDB) []gethcommon.Address {
+	var userAddrs []gethcommon.Address
 
 	// We skip over the first topic, which is always the hash of the event.
 	for _, topic := range log.Topics[1:len(log.Topics)] {
@@ -250,7 +294,7 @@ func getUserAddrsFromLogTopics(log *types.Log, db *state.StateDB) []string {
 		if db.GetNonce(potentialAddr) != 0 {
 			// If the address has code, it's a smart contract address instead.
 			if db.GetCode(potentialAddr) == nil {
-				userAddrs = append(userAddrs, potentialAddr.Hex())
+				userAddrs = append(userAddrs, potentialAddr)
 			}
 		}
 	}
@@ -259,13 +303,13 @@ func getUserAddrsFromLogTopics(log *types.Log, db *state.StateDB) []string {
 }
 
 // For each subscription, updates the relevant logs in the provided map.
-func (s *SubscriptionManager) updateRelevantLogs(logItem *types.Log, userAddrs []string, relevantLogsByID map[gethrpc.ID][]*types.Log) {
+func (s *SubscriptionManager) updateRelevantLogs(logItem *types.Log, visibility *logVisibility, relevantLogsByID map[gethrpc.ID][]*types.Log) {
 	s.subscriptionMutex.RLock()
 	defer s.subscriptionMutex.RUnlock()
 
 	for subscriptionID, subscription := range s.subscriptions {
 		// We ignore irrelevant logs.
-		if !isRelevant(logItem, userAddrs, subscription.Account, subscription.Filter) {
+		if !isRelevant(logItem, visibility, subscription.Account, subscription.Filter) {
 			continue
 		}
 
@@ -295,26 +339,15 @@ func (s *SubscriptionManager) getNumberOfSubsThreadsafe() int {
 }
 
 // Indicates whether BOTH of the following apply:
-//   - One of the log's user addresses matches the subscription's account
+//   - The log is visible to the subscription's account
 //   - The log matches the filter
-func isRelevant(logItem *types.Log, userAddrs []string, account *gethcommon.Address, filter *filters.FilterCriteria) bool {
+func isRelevant(logItem *types.Log, visibility *logVisibility, account *gethcommon.Address, filter *filters.FilterCriteria) bool {
 	filteredLogs := filterLogs([]*types.Log{logItem}, filter.FromBlock, filter.ToBlock, filter.Addresses, filter.Topics)
 	if len(filteredLogs) == 0 {
 		return false
 	}
 
-	// If there are no user addresses, this is a lifecycle event, and is therefore relevant to everyone.
-	if len(userAddrs) == 0 {
-		return true
-	}
-
-	for _, addr := range userAddrs {
-		if addr == account.Hex() {
-			return true
-		}
-	}
-
-	return false
+	return visibility.isVisibleTo(account)
 }
 
 // Lifted from eth/filters/filter.go in the go-ethereum repository.
@@ -0,0 +1,156 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// EventVisibilityRule declares which accounts can see an event emitted by a contract. An account can see the event if
+// any of the rule's conditions applies to it.
+type EventVisibilityRule struct {
+	// The contract that emits the event
+	Contract gethcommon.Address
+	// The event's signature hash (i.e. its first topic). If nil, the rule applies to all the contract's events that do
+	// not have a rule of their own
+	EventTopic *gethcommon.Hash
+	// Whether the event can be seen by everyone
+	Public bool
+	// The indexes (from 1 to 3) of the topics that hold the addresses of accounts that can see the event
+	TopicIndexes []int
+	// Whether the event can be seen by the sender of the transaction that emitted it
+	Sender bool
+	// Additional accounts that can see the event
+	Accounts []gethcommon.Address
+}
+
+// EventVisibilityRules holds the event visibility rules declared by contract developers. Events emitted by contracts
+// without any rules fall back to the default heuristic (see `getUserAddrsFromLogTopics`).
+type EventVisibilityRules struct {
+	Rules []EventVisibilityRule
+}
+
+// NewEventVisibilityRules creates the EventVisibilityRules given a json string. If the string is empty, there are no
+// rules.
+func NewEventVisibilityRules(rulesJSON string) (*EventVisibilityRules, error) {
+	rules := &EventVisibilityRules{}
+	if rulesJSON == "" {
+		return rules, nil
+	}
+
+	if err := json.Unmarshal([]byte(rulesJSON), rules); err != nil {
+		return nil, fmt.Errorf("could not parse event visibility rules. Cause: %w", err)
+	}
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Checks that each rule grants visibility to someone, only references valid topics, and is not declared twice.
+func (r *EventVisibilityRules) validate() error {
+	type ruleKey struct {
+		contract   gethcommon.Address
+		eventTopic gethcommon.Hash
+		allEvents  bool
+	}
+	seen := map[ruleKey]bool{}
+
+	for _, rule := range r.Rules {
+		if !rule.Public && len(rule.TopicIndexes) == 0 && !rule.Sender && len(rule.Accounts) == 0 {
+			return fmt.Errorf("event visibility rule for contract %s does not make the event visible to anyone", rule.Contract)
+		}
+		for _, idx := range rule.TopicIndexes {
+			if idx < 1 || idx > 3 {
+				return fmt.Errorf("event visibility rule for contract %s has invalid topic index %d; only topics 1 to 3 "+
+					"can hold addresses", rule.Contract, idx)
+			}
+		}
+
+		key := ruleKey{contract: rule.Contract, allEvents: rule.EventTopic == nil}
+		if rule.EventTopic != nil {
+			key.eventTopic = *rule.EventTopic
+		}
+		if seen[key] {
+			return fmt.Errorf("event visibility rule for contract %s is declared more than once", rule.Contract)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Returns the rule that applies to the log, or nil if the emitting contract has not declared any rules for it.
+func (r *EventVisibilityRules) ruleFor(logItem *types.Log) *EventVisibilityRule {
+	var contractRule *EventVisibilityRule
+	for i, rule := range r.Rules {
+		if rule.Contract != logItem.Address {
+			continue
+		}
+		if rule.EventTopic == nil {
+			contractRule = &r.Rules[i]
+			continue
+		}
+		if len(logItem.Topics) > 0 && *rule.EventTopic == logItem.Topics[0] {
+			return &r.Rules[i]
+		}
+	}
+	return contractRule
+}
+
+// The accounts that can see a log.
+type logVisibility struct {
+	public  bool                 // Whether the log can be seen by everyone
+	viewers []gethcommon.Address // If the log is not public, the accounts that can see it
+}
+
+// Determines which accounts can see the log, based on the rule declared for the event, or on the default heuristic if
+// there is none.
+func (s *SubscriptionManager) getLogVisibility(logItem *types.Log, stateDB *state.StateDB) *logVisibility {
+	rule := s.visibilityRules.ruleFor(logItem)
+	if rule == nil {
+		userAddrs := getUserAddrsFromLogTopics(logItem, stateDB)
+		// If there are no user addresses, this is a lifecycle event, and is therefore visible to everyone.
+		return &logVisibility{public: len(userAddrs) == 0, viewers: userAddrs}
+	}
+
+	visibility := &logVisibility{public: rule.Public}
+	for _, idx := range rule.TopicIndexes {
+		if idx < len(logItem.Topics) {
+			visibility.viewers = append(visibility.viewers, gethcommon.BytesToAddress(logItem.Topics[idx].Bytes()))
+		}
+	}
+	if rule.Sender {
+		sender, err := s.storage.GetSender(logItem.TxHash)
+		if err != nil {
+			// We fail closed; the log remains visible to any other accounts the rule lists.
+			s.logger.Warn(fmt.Sprintf("Could not retrieve sender of transaction %s to determine log visibility.", logItem.TxHash), log.ErrKey, err)
+		} else {
+			visibility.viewers = append(visibility.viewers, sender)
+		}
+	}
+	visibility.viewers = append(visibility.viewers, rule.Accounts...)
+
+	return visibility
+}
+
+// Indicates whether the account can see the log.
+func (v *logVisibility) isVisibleTo(account *gethcommon.Address) bool {
+	if v.public {
+		return true
+	}
+	if account == nil {
+		return false
+	}
+	for _, viewer := range v.viewers {
+		if viewer == *account {
+			return true
+		}
+	}
+	return false
+}
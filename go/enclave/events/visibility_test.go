@@ -0,0 +1,89 @@
+package events
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	transferEventTopic = gethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	sender             = gethcommon.HexToAddress("0x10")
+	recipient          = gethcommon.HexToAddress("0x11")
+	auditor            = gethcommon.HexToAddress("0x12")
+	outsider           = gethcommon.HexToAddress("0x13")
+)
+
+func TestTopicRuleMakesEventVisibleToFreshRecipient(t *testing.T) {
+	manager := createVisibilityTestManager(t, fmt.Sprintf(
+		`{"Rules": [{"Contract": "%s", "EventTopic": "%s", "TopicIndexes": [1, 2], "Accounts": ["%s"]}]}`,
+		testContract.Hex(), transferEventTopic.Hex(), auditor.Hex()))
+
+	// The recipient has never transacted, so the default heuristic would treat the event as a lifecycle event.
+	visibility := manager.getLogVisibility(createTransferLog(testContract), nil)
+
+	viewers := []gethcommon.Address{sender, recipient, auditor}
+	for i := range viewers {
+		if !visibility.isVisibleTo(&viewers[i]) {
+			t.Fatalf("expected transfer event to be visible to %s", viewers[i])
+		}
+	}
+	if visibility.isVisibleTo(&outsider) {
+		t.Fatal("expected transfer event not to be visible to an unrelated account")
+	}
+}
+
+func TestEventRuleTakesPrecedenceOverContractRule(t *testing.T) {
+	manager := createVisibilityTestManager(t, fmt.Sprintf(
+		`{"Rules": [{"Contract": "%s", "Public": true}, {"Contract": "%s", "EventTopic": "%s", "TopicIndexes": [2]}]}`,
+		testContract.Hex(), testContract.Hex(), transferEventTopic.Hex()))
+
+	transferVisibility := manager.getLogVisibility(createTransferLog(testContract), nil)
+	if transferVisibility.isVisibleTo(&sender) || !transferVisibility.isVisibleTo(&recipient) {
+		t.Fatal("expected transfer event to be visible to the recipient only")
+	}
+
+	otherLog := &types.Log{Address: testContract, Topics: []gethcommon.Hash{testEventTopic}}
+	if !manager.getLogVisibility(otherLog, nil).isVisibleTo(&outsider) {
+		t.Fatal("expected the contract's other events to be public")
+	}
+}
+
+func TestInvalidVisibilityRulesAreRejected(t *testing.T) {
+	invalidRules := []string{
+		`{"Rules": [{"Contract": "0x0000000000000000000000000000000000000002"}]}`,
+		`{"Rules": [{"Contract": "0x0000000000000000000000000000000000000002", "TopicIndexes": [0]}]}`,
+		`{"Rules": [{"Contract": "0x0000000000000000000000000000000000000002", "Public": true},
+			{"Contract": "0x0000000000000000000000000000000000000002", "Sender": true}]}`,
+		`not json`,
+	}
+
+	for _, rules := range invalidRules {
+		if _, err := NewEventVisibilityRules(rules); err == nil {
+			t.Fatalf("expected rules to be rejected: %s", rules)
+		}
+	}
+}
+
+func createVisibilityTestManager(t *testing.T, rulesJSON string) *SubscriptionManager {
+	rules, err := NewEventVisibilityRules(rulesJSON)
+	if err != nil {
+		t.Fatalf("could not parse visibility rules. Cause: %s", err)
+	}
+	return NewSubscriptionManager(nil, nil, rules, 0, 0, 0, gethlog.New())
+}
+
+func createTransferLog(contract gethcommon.Address) *types.Log {
+	return &types.Log{
+		Address: contract,
+		Topics: []gethcommon.Hash{
+			transferEventTopic,
+			gethcommon.BytesToHash(sender.Bytes()),
+			gethcommon.BytesToHash(recipient.Bytes()),
+		},
+	}
+}
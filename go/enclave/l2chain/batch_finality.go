@@ -0,0 +1,95 @@
+package l2chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+	"github.com/obscuronet/go-obscuro/go/enclave/db"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// SetFinalizedL1Block records the L1 block the host's L1 node reports as finalized, which must have been ingested. The
+// batches included in the rollups published up to that block are finalized. Reports of a block below the one already
+// recorded are ignored, since finality does not go backwards. An enclave that validates L1 blocks against the beacon
+// chain only ingests finalized blocks, so it ignores the reports.
+func (oc *ObscuroChain) SetFinalizedL1Block(blockHash common.L1RootHash) error {
+	if oc.l1Finality != nil {
+		return nil
+	}
+	block, err := oc.storage.FetchBlock(blockHash)
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized L1 block %s. Cause: %w", blockHash, err)
+	}
+
+	oc.finalizedL1BlockLock.Lock()
+	defer oc.finalizedL1BlockLock.Unlock()
+	if oc.finalizedL1Block == nil || oc.finalizedL1Block.NumberU64() <= block.NumberU64() {
+		oc.finalizedL1Block = block
+	}
+	return nil
+}
+
+// ResolveBatchNumber returns the height of the batch with the given number, which may be one of the block tags.
+func (oc *ObscuroChain) ResolveBatchNumber(number gethrpc.BlockNumber) (uint64, error) {
+	batch, err := oc.getBatch(number)
+	if err != nil {
+		return 0, err
+	}
+	return batch.NumberU64(), nil
+}
+
+// Returns the head batch of the latest canonical rollup published on the L1 chain the enclave follows.
+func (oc *ObscuroChain) safeBatch() (*core.Batch, error) {
+	l1Head, err := oc.storage.FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve L1 head. Cause: %w", err)
+	}
+	return oc.headRollupBatch(l1Head)
+}
+
+// Returns the head batch of the latest canonical rollup published in a finalized L1 block.
+func (oc *ObscuroChain) finalizedBatch() (*core.Batch, error) {
+	if oc.l1Finality != nil {
+		// every L1 block the enclave ingested is finalized
+		return oc.safeBatch()
+	}
+
+	oc.finalizedL1BlockLock.RLock()
+	finalizedL1Block := oc.finalizedL1Block
+	oc.finalizedL1BlockLock.RUnlock()
+	if finalizedL1Block == nil {
+		return nil, errors.New("no L1 block is known to be finalized yet")
+	}
+	return oc.headRollupBatch(finalizedL1Block)
+}
+
+// Returns the head batch of the latest canonical rollup published in the L1 block or its ancestors.
+func (oc *ObscuroChain) headRollupBatch(block *types.Block) (*core.Batch, error) {
+	for {
+		blockHash := block.Hash()
+		rollup, err := oc.storage.FetchHeadRollupForBlock(&blockHash)
+		if err == nil {
+			return oc.storage.FetchBatch(rollup.Header.HeadBatchHash)
+		}
+		if errors.Is(err, db.ErrNoRollups) {
+			return nil, errors.New("no batch has been included in a rollup yet")
+		}
+		if !errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("could not retrieve head rollup for L1 block %s. Cause: %w", blockHash, err)
+		}
+
+		// the head rollup is only recorded for the blocks rollups were published in
+		block, err = oc.storage.FetchBlock(block.ParentHash())
+		if err != nil {
+			if errors.Is(err, errutil.ErrNotFound) {
+				return nil, errors.New("no batch has been included in a rollup yet")
+			}
+			return nil, fmt.Errorf("could not retrieve parent L1 block. Cause: %w", err)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package l2chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// L1FinalityValidator only lets the enclave ingest L1 blocks that the beacon chain has finalized, so that the host
+// cannot feed the enclave made-up or non-canonical blocks. It follows the beacon chain as a light client from a trusted
+// beacon block, verifying the sync committee signatures of the updates the host sends, and keeps the execution payload
+// headers of the finalized L1 blocks the updates prove, along with the headers of their ancestors, which the host sends
+// newest first so that each is proven by the parent hash of its child.
+//
+// L1 blocks are checked field by field against the payload or header of the finalized block at their height, rather
+// than by hash, since the block type of the go-ethereum version this repo builds on cannot hold the header fields the
+// L1 added after the London fork, so the hash it computes for recent blocks is not the block hash. The withdrawals,
+// which the block type also lacks, are not checked.
+type L1FinalityValidator struct {
+	config        beacon.Config
+	bootstrapRoot gethcommon.Hash
+	logger        gethlog.Logger
+
+	lock        sync.Mutex
+	lightClient *beacon.LightClient        // nil until the enclave is bootstrapped
+	finalized   map[uint64]*finalizedBlock // the finalized L1 blocks, by height
+	oldest      uint64                     // the lowest height in finalized
+	lowest      uint64                     // the lowest height from which finalized has every block up to the highest
+	highest     uint64                     // the height of the latest finalized L1 block
+}
+
+// finalizedBlock is a finalized L1 block, as proven either by the execution payload of a finalized beacon block, or by
+// its header, whose hash is the parent hash of a finalized block.
+type finalizedBlock struct {
+	hash    gethcommon.Hash
+	payload *beacon.ExecutionPayloadHeader // nil if the block is proven by its header
+	header  *common.L1Header               // nil if the block is proven by its payload
+}
+
+func (b *finalizedBlock) parentHash() gethcommon.Hash {
+	if b.payload != nil {
+		return b.payload.ParentHash
+	}
+	return b.header.ParentHash
+}
+
+func NewL1FinalityValidator(config beacon.Config, bootstrapRoot gethcommon.Hash, logger gethlog.Logger) *L1FinalityValidator {
+	return &L1FinalityValidator{
+		config:        config,
+		bootstrapRoot: bootstrapRoot,
+		logger:        logger,
+		finalized:     map[uint64]*finalizedBlock{},
+	}
+}
+
+// SubmitUpdate applies the parts of the update in order, and returns the resulting status. The parts applied before
+// an invalid part are kept.
+func (v *L1FinalityValidator) SubmitUpdate(update *common.L1FinalityUpdate) (*common.L1FinalityStatus, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if update.Bootstrap != nil && v.lightClient == nil {
+		lightClient, err := beacon.NewLightClient(v.config, v.bootstrapRoot, update.Bootstrap)
+		if err != nil {
+			return nil, fmt.Errorf("could not bootstrap from the beacon chain. Cause: %w", err)
+		}
+		v.lightClient = lightClient
+		if err = v.addFinalizedExecution(lightClient.Finalized()); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, beaconUpdate := range update.Updates {
+		if v.lightClient == nil {
+			return nil, errors.New("cannot apply beacon chain updates before bootstrapping")
+		}
+		if err := v.lightClient.ProcessUpdate(beaconUpdate); err != nil {
+			return nil, fmt.Errorf("invalid beacon chain update at index %d. Cause: %w", i, err)
+		}
+		if err := v.addFinalizedExecution(v.lightClient.Finalized()); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, encodedHeader := range update.Headers {
+		var header common.L1Header
+		if err := rlp.DecodeBytes(encodedHeader, &header); err != nil {
+			return nil, fmt.Errorf("could not decode header at index %d. Cause: %w", i, err)
+		}
+		hash := crypto.Keccak256Hash(encodedHeader)
+		height := header.Number.Uint64()
+		if !v.isFinalizedHash(height, hash) {
+			return nil, fmt.Errorf("header %s at height %d is not of a finalized block", hash, height)
+		}
+		if err := v.addFinalized(height, &finalizedBlock{hash: hash, header: &header}); err != nil {
+			return nil, err
+		}
+	}
+
+	status := v.status()
+	v.logger.Trace("Applied L1 finality update", "period", status.Period, "lowest", status.LowestHeight, "highest", status.FinalizedHeight)
+	return status, nil
+}
+
+// Validate returns an error unless the block is finalized. Since blocks are ingested in order, the blocks below a
+// validated block are forgotten.
+func (v *L1FinalityValidator) Validate(block *types.Block) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	height := block.NumberU64()
+	finalized, found := v.finalized[height]
+	if !found {
+		return fmt.Errorf("block at height %d is not known to be finalized by the beacon chain", height)
+	}
+	if err := finalized.check(block); err != nil {
+		return fmt.Errorf("block is not the block the beacon chain finalized at height %d. Cause: %w", height, err)
+	}
+
+	for ; v.oldest < height; v.oldest++ {
+		delete(v.finalized, v.oldest)
+	}
+	if v.lowest < height {
+		v.lowest = height
+	}
+	return nil
+}
+
+func (v *L1FinalityValidator) status() *common.L1FinalityStatus {
+	status := &common.L1FinalityStatus{
+		Bootstrapped:    v.lightClient != nil,
+		BootstrapRoot:   v.bootstrapRoot,
+		LowestHeight:    v.lowest,
+		FinalizedHeight: v.highest,
+	}
+	if v.lightClient != nil {
+		status.Period = v.lightClient.Period()
+	}
+	if len(v.finalized) == 0 {
+		status.LowestHeight = status.FinalizedHeight + 1 // no block is covered
+	}
+	return status
+}
+
+// Adds the L1 block the beacon block includes. Beacon blocks before the Capella fork do not prove their L1 block.
+func (v *L1FinalityValidator) addFinalizedExecution(header *beacon.LightClientHeader) error {
+	if header.Execution == nil {
+		return nil
+	}
+	return v.addFinalized(uint64(header.Execution.BlockNumber), &finalizedBlock{hash: header.Execution.BlockHash, payload: header.Execution})
+}
+
+// Returns whether the hash is that of the finalized block at the given height, or the parent hash of the finalized
+// block above it.
+func (v *L1FinalityValidator) isFinalizedHash(height uint64, hash gethcommon.Hash) bool {
+	if block, found := v.finalized[height]; found {
+		return block.hash == hash
+	}
+	child, found := v.finalized[height+1]
+	return found && child.parentHash() == hash
+}
+
+func (v *L1FinalityValidator) addFinalized(height uint64, block *finalizedBlock) error {
+	if existing, found := v.finalized[height]; found {
+		if existing.hash != block.hash {
+			return fmt.Errorf("block %s conflicts with finalized block %s at height %d", block.hash, existing.hash, height)
+		}
+		return nil
+	}
+	if child, found := v.finalized[height+1]; found && child.parentHash() != block.hash {
+		return fmt.Errorf("block %s at height %d is not the parent of finalized block %s", block.hash, height, child.hash)
+	}
+	if parent, found := v.finalized[height-1]; height > 0 && found && parent.hash != block.parentHash() {
+		return fmt.Errorf("block %s at height %d is not the child of finalized block %s", block.hash, height, parent.hash)
+	}
+
+	if len(v.finalized) == 0 {
+		v.oldest, v.lowest, v.highest = height, height, height
+	}
+	v.finalized[height] = block
+	if height < v.oldest {
+		v.oldest = height
+	}
+	switch {
+	case height > v.highest:
+		// the hashes from the previous highest height are not known, unless the heights are consecutive
+		if height > v.highest+1 {
+			v.lowest = height
+		}
+		v.highest = height
+	case height+1 == v.lowest:
+		for v.lowest = height; v.lowest > 0; v.lowest-- {
+			if _, found := v.finalized[v.lowest-1]; !found {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// Checks the block's header fields against those of the finalized block, and its transactions against the
+// transactions root of the finalized block.
+func (b *finalizedBlock) check(block *types.Block) error {
+	if types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)) != block.TxHash() {
+		return errors.New("transactions do not match the transactions root of the block")
+	}
+	if types.CalcUncleHash(block.Uncles()) != block.UncleHash() {
+		return errors.New("uncles do not match the uncle hash of the block")
+	}
+	expected := b.header
+	if b.payload != nil {
+		var err error
+		if expected, err = payloadL1Header(b.payload, block.Transactions()); err != nil {
+			return err
+		}
+		// the payload commits to the transactions with an SSZ root instead, which they were checked against
+		expected.TxHash = block.TxHash()
+	}
+
+	header := block.Header()
+	fields := []struct {
+		name  string
+		equal bool
+	}{
+		{"parent hash", header.ParentHash == expected.ParentHash},
+		{"uncle hash", header.UncleHash == expected.UncleHash},
+		{"coinbase", header.Coinbase == expected.Coinbase},
+		{"state root", header.Root == expected.Root},
+		{"transactions root", header.TxHash == expected.TxHash},
+		{"receipts root", header.ReceiptHash == expected.ReceiptHash},
+		{"logs bloom", header.Bloom == expected.Bloom},
+		{"difficulty", bigEqual(header.Difficulty, expected.Difficulty)},
+		{"number", bigEqual(header.Number, expected.Number)},
+		{"gas limit", header.GasLimit == expected.GasLimit},
+		{"gas used", header.GasUsed == expected.GasUsed},
+		{"time", header.Time == expected.Time},
+		{"extra data", bytes.Equal(header.Extra, expected.Extra)},
+		{"mix digest", header.MixDigest == expected.MixDigest},
+		{"nonce", header.Nonce == expected.Nonce},
+		{"base fee", bigEqual(header.BaseFee, expected.BaseFee)},
+	}
+	for _, field := range fields {
+		if !field.equal {
+			return fmt.Errorf("%s does not match", field.name)
+		}
+	}
+	return nil
+}
+
+// Returns the header fields of the block with the given payload, checking the transactions against the payload's
+// transactions root. The fields the payload does not have are those every post-merge block has.
+func payloadL1Header(payload *beacon.ExecutionPayloadHeader, transactions types.Transactions) (*common.L1Header, error) {
+	encodedTransactions := make([][]byte, len(transactions))
+	for i, tx := range transactions {
+		encoded, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("could not encode transaction at index %d. Cause: %w", i, err)
+		}
+		encodedTransactions[i] = encoded
+	}
+	transactionsRoot, err := beacon.TransactionsRoot(encodedTransactions)
+	if err != nil {
+		return nil, err
+	}
+	if transactionsRoot != payload.TransactionsRoot {
+		return nil, errors.New("transactions do not match the transactions root of the payload")
+	}
+
+	header := &common.L1Header{
+		ParentHash:  payload.ParentHash,
+		UncleHash:   types.EmptyUncleHash,
+		Coinbase:    payload.FeeRecipient,
+		Root:        payload.StateRoot,
+		ReceiptHash: payload.ReceiptsRoot,
+		Difficulty:  big.NewInt(0),
+		Number:      new(big.Int).SetUint64(uint64(payload.BlockNumber)),
+		GasLimit:    uint64(payload.GasLimit),
+		GasUsed:     uint64(payload.GasUsed),
+		Time:        uint64(payload.Timestamp),
+		Extra:       payload.ExtraData,
+		MixDigest:   payload.PrevRandao,
+	}
+	copy(header.Bloom[:], payload.LogsBloom)
+	if payload.BaseFeePerGas != nil {
+		header.BaseFee = (*big.Int)(payload.BaseFeePerGas)
+	}
+	return header, nil
+}
+
+func bigEqual(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
@@ -0,0 +1,412 @@
+package l2chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/integration/eth2network"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestL1FinalityValidatorOnlyAcceptsFinalizedBlocks(t *testing.T) {
+	chain := newFakeExecutionChain(20)
+	validator, beaconClient := newTestFinalityValidator(t, chain, eth2network.StandInBeaconConfig{FinalityDepth: 2}, 5)
+
+	if err := validator.Validate(chain.blocks[5]); err == nil {
+		t.Fatal("expected block to be rejected before bootstrapping")
+	}
+	bootstrap, err := beaconClient.Bootstrap(validator.bootstrapRoot)
+	if err != nil {
+		t.Fatalf("could not fetch bootstrap. Cause: %s", err)
+	}
+	status := submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Bootstrap: bootstrap})
+	if !status.Covers(5) || status.Covers(6) {
+		t.Fatalf("expected only the bootstrap block to be covered, got status %+v", status)
+	}
+	if err = validator.Validate(chain.blocks[5]); err != nil {
+		t.Fatalf("expected bootstrap block to be accepted. Cause: %s", err)
+	}
+
+	// the finality update proves the block two blocks behind the head
+	finalityUpdate, err := beaconClient.FinalityUpdate()
+	if err != nil {
+		t.Fatalf("could not fetch finality update. Cause: %s", err)
+	}
+	status = submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Updates: []*beacon.LightClientUpdate{finalityUpdate}})
+	if status.FinalizedHeight != 17 || status.LowestHeight != 17 {
+		t.Fatalf("expected the block at height 17 to be finalized, got status %+v", status)
+	}
+	if err = validator.Validate(chain.blocks[6]); err == nil {
+		t.Fatal("expected block to be rejected before the headers behind the finalized block are submitted")
+	}
+
+	// the headers prove the blocks behind the finalized block
+	if _, err = validator.SubmitUpdate(&common.L1FinalityUpdate{Headers: encodeHeaders(t, chain.blocks[18].Header())}); err == nil {
+		t.Fatal("expected header of a block that is not finalized to be rejected")
+	}
+	forgedHeader := chain.blocks[16].Header()
+	forgedHeader.Extra = []byte("forged")
+	if _, err = validator.SubmitUpdate(&common.L1FinalityUpdate{Headers: encodeHeaders(t, forgedHeader)}); err == nil {
+		t.Fatal("expected forged header to be rejected")
+	}
+	var headers []*types.Header
+	for height := 16; height >= 6; height-- {
+		headers = append(headers, chain.blocks[height].Header())
+	}
+	status = submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Headers: encodeHeaders(t, headers...)})
+	if status.LowestHeight != 5 || status.FinalizedHeight != 17 {
+		t.Fatalf("expected blocks 5 to 17 to be covered, got status %+v", status)
+	}
+
+	// the blocks are checked against the header or payload of the finalized block
+	for _, height := range []int{6, 17} {
+		forgedHeader = chain.blocks[height].Header()
+		forgedHeader.Extra = []byte("forged")
+		if err = validator.Validate(types.NewBlockWithHeader(forgedHeader)); err == nil {
+			t.Fatalf("expected forged block at height %d to be rejected", height)
+		}
+	}
+	for height := 6; height <= 17; height++ {
+		if err = validator.Validate(chain.blocks[height]); err != nil {
+			t.Fatalf("expected finalized block at height %d to be accepted. Cause: %s", height, err)
+		}
+	}
+	if err = validator.Validate(chain.blocks[18]); err == nil {
+		t.Fatal("expected block that is not finalized to be rejected")
+	}
+}
+
+func TestL1FinalityValidatorFollowsSyncCommitteeRotations(t *testing.T) {
+	// with 4096 slots per block, each sync committee period spans two blocks
+	chain := newFakeExecutionChain(12)
+	validator, beaconClient := newTestFinalityValidator(t, chain, eth2network.StandInBeaconConfig{SlotsPerBlock: 4096}, 1)
+
+	bootstrap, err := beaconClient.Bootstrap(validator.bootstrapRoot)
+	if err != nil {
+		t.Fatalf("could not fetch bootstrap. Cause: %s", err)
+	}
+	status := submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Bootstrap: bootstrap})
+	if status.Period != 0 {
+		t.Fatalf("expected bootstrap to be in period 0, got %d", status.Period)
+	}
+
+	finalityUpdate, err := beaconClient.FinalityUpdate()
+	if err != nil {
+		t.Fatalf("could not fetch finality update. Cause: %s", err)
+	}
+	if _, err = validator.SubmitUpdate(&common.L1FinalityUpdate{Updates: []*beacon.LightClientUpdate{finalityUpdate}}); err == nil {
+		t.Fatal("expected finality update signed by an unknown sync committee to be rejected")
+	}
+
+	updates, err := beaconClient.Updates(0, 5)
+	if err != nil {
+		t.Fatalf("could not fetch sync committee updates. Cause: %s", err)
+	}
+	if len(updates) != 5 {
+		t.Fatalf("expected 5 sync committee updates, got %d", len(updates))
+	}
+	status = submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Updates: append(updates, finalityUpdate)})
+	if status.Period != 5 || status.FinalizedHeight != 10 {
+		t.Fatalf("expected the block at height 10 to be finalized in period 5, got status %+v", status)
+	}
+}
+
+func TestL1FinalityValidatorFollowsForks(t *testing.T) {
+	// with 4096 slots per block, the Capella, Deneb and Electra forks activate at the blocks at heights 2, 5 and 8
+	forks := beacon.ForkSchedule{
+		{Name: beacon.Phase0, Version: beacon.ForkVersion{0x00, 0x00, 0x00, 0x01}},
+		{Name: beacon.Bellatrix, Version: beacon.ForkVersion{0x02, 0x00, 0x00, 0x01}},
+		{Name: beacon.Capella, Epoch: 256, Version: beacon.ForkVersion{0x03, 0x00, 0x00, 0x01}},
+		{Name: beacon.Deneb, Epoch: 640, Version: beacon.ForkVersion{0x04, 0x00, 0x00, 0x01}},
+		{Name: beacon.Electra, Epoch: 1024, Version: beacon.ForkVersion{0x05, 0x00, 0x00, 0x01}},
+	}
+	chain := newFakeExecutionChain(12)
+	validator, beaconClient := newTestFinalityValidator(t, chain, eth2network.StandInBeaconConfig{SlotsPerBlock: 4096, Forks: forks}, 1)
+	// a validator whose fork schedule misses the Deneb and Electra forks
+	staleConfig := validator.config
+	staleConfig.Forks = forks[:3]
+	staleValidator := NewL1FinalityValidator(staleConfig, validator.bootstrapRoot, gethlog.New())
+
+	// the bootstrap block is before the Capella fork, so it does not prove its L1 block
+	bootstrap, err := beaconClient.Bootstrap(validator.bootstrapRoot)
+	if err != nil {
+		t.Fatalf("could not fetch bootstrap. Cause: %s", err)
+	}
+	if bootstrap.Header.Execution != nil {
+		t.Fatal("expected bootstrap before the Capella fork to have no execution payload")
+	}
+	if status := submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Bootstrap: bootstrap}); status.Covers(0) || status.Covers(1) {
+		t.Fatalf("expected no block to be covered by a bootstrap before the Capella fork, got status %+v", status)
+	}
+	updates, err := beaconClient.Updates(0, 5)
+	if err != nil {
+		t.Fatalf("could not fetch sync committee updates. Cause: %s", err)
+	}
+	finalityUpdate, err := beaconClient.FinalityUpdate()
+	if err != nil {
+		t.Fatalf("could not fetch finality update. Cause: %s", err)
+	}
+
+	submitFinalityUpdate(t, staleValidator, &common.L1FinalityUpdate{Bootstrap: bootstrap, Updates: updates[:2]})
+	if _, err = staleValidator.SubmitUpdate(&common.L1FinalityUpdate{Updates: updates[2:]}); err == nil {
+		t.Fatal("expected update signed after an unknown fork to be rejected")
+	}
+
+	status := submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Updates: append(updates, finalityUpdate)})
+	if status.Period != 5 || status.FinalizedHeight != 10 {
+		t.Fatalf("expected the block at height 10 to be finalized in period 5, got status %+v", status)
+	}
+
+	// the blocks before the Capella fork are proven by their headers
+	var headers []*types.Header
+	for height := status.LowestHeight - 1; height >= 1; height-- {
+		headers = append(headers, chain.blocks[height].Header())
+	}
+	status = submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Headers: encodeHeaders(t, headers...)})
+	if status.LowestHeight != 1 {
+		t.Fatalf("expected blocks 1 to 10 to be covered, got status %+v", status)
+	}
+	for height := 1; height <= 10; height++ {
+		if err = validator.Validate(chain.blocks[height]); err != nil {
+			t.Fatalf("expected finalized block at height %d to be accepted. Cause: %s", height, err)
+		}
+	}
+}
+
+// The light client data of real blocks cannot be made up, since it is signed by the sync committee, so the finalized
+// block is added as if a finality update proved it.
+func TestL1FinalityValidatorChecksRealBlocks(t *testing.T) {
+	for _, file := range []string{"block_capella.json", "block_electra.json"} {
+		test := readTestBlock(t, file)
+		block := test.block(t)
+		hash := test.Header.Execution.BlockHash
+
+		// a block proven by the execution payload of a beacon block
+		validator := NewL1FinalityValidator(beacon.Config{}, gethcommon.Hash{}, gethlog.New())
+		if err := validator.addFinalizedExecution(&test.Header); err != nil {
+			t.Fatalf("could not add payload of %s. Cause: %s", file, err)
+		}
+		checkRealBlock(t, validator, block, file)
+
+		// a block proven by its header, whose hash is the parent hash of a finalized block
+		validator = NewL1FinalityValidator(beacon.Config{}, gethcommon.Hash{}, gethlog.New())
+		child := &common.L1Header{ParentHash: hash, Number: new(big.Int).Add(block.Number(), big.NewInt(1))}
+		if err := validator.addFinalized(child.Number.Uint64(), &finalizedBlock{hash: child.Hash(), header: child}); err != nil {
+			t.Fatalf("could not add child of %s. Cause: %s", file, err)
+		}
+		forgedHeader := append([]byte{}, test.ExecutionHeader...)
+		forgedHeader[len(forgedHeader)-1] ^= 1
+		if _, err := validator.SubmitUpdate(&common.L1FinalityUpdate{Headers: []hexutil.Bytes{forgedHeader}}); err == nil {
+			t.Fatalf("expected forged header of %s to be rejected", file)
+		}
+		submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Headers: []hexutil.Bytes{test.ExecutionHeader}})
+		checkRealBlock(t, validator, block, file)
+	}
+}
+
+func checkRealBlock(t *testing.T, validator *L1FinalityValidator, block *types.Block, file string) {
+	transactions := block.Transactions()
+	reordered := append(types.Transactions{transactions[len(transactions)-1]}, transactions[:len(transactions)-1]...)
+	if err := validator.Validate(types.NewBlock(block.Header(), reordered, nil, nil, trie.NewStackTrie(nil))); err == nil {
+		t.Fatalf("expected block of %s with reordered transactions to be rejected", file)
+	}
+	tamperedHeader := block.Header()
+	tamperedHeader.GasUsed++
+	if err := validator.Validate(block.WithSeal(tamperedHeader)); err == nil {
+		t.Fatalf("expected block of %s with tampered header to be rejected", file)
+	}
+	if err := validator.Validate(block); err != nil {
+		t.Fatalf("expected block of %s to be accepted. Cause: %s", file, err)
+	}
+}
+
+func TestL1FinalityValidatorRejectsInvalidUpdates(t *testing.T) {
+	chain := newFakeExecutionChain(10)
+	validator, beaconClient := newTestFinalityValidator(t, chain, eth2network.StandInBeaconConfig{FinalityDepth: 2}, 3)
+
+	bootstrap, err := beaconClient.Bootstrap(validator.bootstrapRoot)
+	if err != nil {
+		t.Fatalf("could not fetch bootstrap. Cause: %s", err)
+	}
+	bootstrap.CurrentSyncCommittee.Pubkeys[0], bootstrap.CurrentSyncCommittee.Pubkeys[1] = bootstrap.CurrentSyncCommittee.Pubkeys[1], bootstrap.CurrentSyncCommittee.Pubkeys[0]
+	if _, err = validator.SubmitUpdate(&common.L1FinalityUpdate{Bootstrap: bootstrap}); err == nil {
+		t.Fatal("expected bootstrap with a tampered sync committee to be rejected")
+	}
+	bootstrap, err = beaconClient.Bootstrap(validator.bootstrapRoot)
+	if err != nil {
+		t.Fatalf("could not fetch bootstrap. Cause: %s", err)
+	}
+	submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Bootstrap: bootstrap})
+
+	tamperings := map[string]func(update *beacon.LightClientUpdate){
+		"tampered signature": func(update *beacon.LightClientUpdate) {
+			update.SyncAggregate.SyncCommitteeSignature[10] ^= 1
+		},
+		"signature of another block": func(update *beacon.LightClientUpdate) {
+			update.AttestedHeader.Beacon.ProposerIndex++
+		},
+		"insufficient participation": func(update *beacon.LightClientUpdate) {
+			for i := len(update.SyncAggregate.SyncCommitteeBits) / 2; i < len(update.SyncAggregate.SyncCommitteeBits); i++ {
+				update.SyncAggregate.SyncCommitteeBits[i] = 0
+			}
+		},
+		"tampered finality branch": func(update *beacon.LightClientUpdate) {
+			update.FinalityBranch[1][0] ^= 1
+		},
+		"unproven finalized block": func(update *beacon.LightClientUpdate) {
+			update.FinalizedHeader.Execution.BlockHash = gethcommon.Hash{1}
+		},
+	}
+	for name, tamper := range tamperings {
+		update, err := beaconClient.FinalityUpdate()
+		if err != nil {
+			t.Fatalf("could not fetch finality update. Cause: %s", err)
+		}
+		tamper(update)
+		if _, err = validator.SubmitUpdate(&common.L1FinalityUpdate{Updates: []*beacon.LightClientUpdate{update}}); err == nil {
+			t.Errorf("expected update with %s to be rejected", name)
+		}
+	}
+
+	update, err := beaconClient.FinalityUpdate()
+	if err != nil {
+		t.Fatalf("could not fetch finality update. Cause: %s", err)
+	}
+	if status := submitFinalityUpdate(t, validator, &common.L1FinalityUpdate{Updates: []*beacon.LightClientUpdate{update}}); status.FinalizedHeight != 7 {
+		t.Fatalf("expected the block at height 7 to be finalized, got status %+v", status)
+	}
+}
+
+// Returns a validator that trusts the beacon block that includes the L1 block at the given height, along with a client
+// for the beacon chain the stand-in beacon makes up for the chain.
+func newTestFinalityValidator(t *testing.T, chain *fakeExecutionChain, config eth2network.StandInBeaconConfig, trustedHeight uint64) (*L1FinalityValidator, ethadapter.BeaconClient) {
+	standIn := eth2network.NewStandInBeacon(chain, config)
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+
+	trustedRoot, err := standIn.BlockRoot(trustedHeight)
+	if err != nil {
+		t.Fatalf("could not get root of trusted beacon block. Cause: %s", err)
+	}
+	return NewL1FinalityValidator(standIn.Config(), trustedRoot, gethlog.New()), ethadapter.NewBeaconClient(server.URL, 10*time.Second)
+}
+
+func submitFinalityUpdate(t *testing.T, validator *L1FinalityValidator, update *common.L1FinalityUpdate) *common.L1FinalityStatus {
+	status, err := validator.SubmitUpdate(update)
+	if err != nil {
+		t.Fatalf("could not submit finality update. Cause: %s", err)
+	}
+	return status
+}
+
+func encodeHeaders(t *testing.T, headers ...*types.Header) []hexutil.Bytes {
+	encodedHeaders := make([]hexutil.Bytes, len(headers))
+	for i, header := range headers {
+		encodedHeader, err := rlp.EncodeToBytes(common.NewL1Header(header))
+		if err != nil {
+			t.Fatalf("could not encode header. Cause: %s", err)
+		}
+		encodedHeaders[i] = encodedHeader
+	}
+	return encodedHeaders
+}
+
+// testBlock is a real L1 block, along with the light client header of the beacon block that includes it (see the
+// test blocks of the beacon package).
+type testBlock struct {
+	Header          beacon.LightClientHeader `json:"header"`
+	ExecutionHeader hexutil.Bytes            `json:"execution_header"`
+	Transactions    []hexutil.Bytes          `json:"transactions"`
+}
+
+func readTestBlock(t *testing.T, file string) *testBlock {
+	data, err := os.ReadFile(filepath.Join("..", "..", "common", "beacon", "testdata", file))
+	if err != nil {
+		t.Fatalf("could not read test block. Cause: %s", err)
+	}
+	var block testBlock
+	if err = json.Unmarshal(data, &block); err != nil {
+		t.Fatalf("could not decode test block. Cause: %s", err)
+	}
+	return &block
+}
+
+// Returns the L1 block, without the header fields the block type cannot hold.
+func (b *testBlock) block(t *testing.T) *types.Block {
+	var header common.L1Header
+	if err := rlp.DecodeBytes(b.ExecutionHeader, &header); err != nil {
+		t.Fatalf("could not decode execution header. Cause: %s", err)
+	}
+	transactions := make(types.Transactions, len(b.Transactions))
+	for i, encoded := range b.Transactions {
+		transactions[i] = new(types.Transaction)
+		if err := transactions[i].UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("could not decode transaction. Cause: %s", err)
+		}
+	}
+	return types.NewBlockWithHeader(&types.Header{
+		ParentHash:  header.ParentHash,
+		UncleHash:   header.UncleHash,
+		Coinbase:    header.Coinbase,
+		Root:        header.Root,
+		TxHash:      header.TxHash,
+		ReceiptHash: header.ReceiptHash,
+		Bloom:       header.Bloom,
+		Difficulty:  header.Difficulty,
+		Number:      header.Number,
+		GasLimit:    header.GasLimit,
+		GasUsed:     header.GasUsed,
+		Time:        header.Time,
+		Extra:       header.Extra,
+		MixDigest:   header.MixDigest,
+		Nonce:       header.Nonce,
+		BaseFee:     header.BaseFee,
+	}).WithBody(transactions, nil)
+}
+
+// fakeExecutionChain is a chain of empty post-merge L1 blocks.
+type fakeExecutionChain struct {
+	blocks []*types.Block
+}
+
+func newFakeExecutionChain(length int) *fakeExecutionChain {
+	blocks := make([]*types.Block, length)
+	parentHash := gethcommon.Hash{}
+	for i := range blocks {
+		header := &types.Header{
+			ParentHash: parentHash,
+			Number:     big.NewInt(int64(i)),
+			Difficulty: big.NewInt(0),
+			Time:       uint64(i),
+		}
+		blocks[i] = types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil))
+		parentHash = blocks[i].Hash()
+	}
+	return &fakeExecutionChain{blocks: blocks}
+}
+
+func (c *fakeExecutionChain) BlockNumber() (uint64, error) {
+	return uint64(len(c.blocks) - 1), nil
+}
+
+func (c *fakeExecutionChain) BlockByNumber(n *big.Int) (*types.Block, error) {
+	if !n.IsUint64() || n.Uint64() >= uint64(len(c.blocks)) {
+		return nil, fmt.Errorf("no block at height %s", n)
+	}
+	return c.blocks[n.Uint64()], nil
+}
@@ -45,6 +45,7 @@ type ObscuroChain struct {
 
 	storage              db.Storage
 	l1Blockchain         *gethcore.BlockChain
+	l1Finality           *L1FinalityValidator
 	mempool              mempool.Manager
 	genesis              *genesis.Genesis
 	crossChainProcessors *crosschain.Processors
@@ -53,6 +54,9 @@ type ObscuroChain struct {
 	blockProcessingMutex sync.Mutex
 	logger               gethlog.Logger
 
+	finalizedL1Block     *types.Block // the latest L1 block the host reported as finalized (unused if l1Finality is set)
+	finalizedL1BlockLock sync.RWMutex
+
 	// Gas usage values
 	// TODO use the ethconfig.Config instead
 	GlobalGasCap uint64
@@ -64,6 +68,7 @@ func New(
 	nodeType common.NodeType,
 	storage db.Storage,
 	l1Blockchain *gethcore.BlockChain,
+	l1Finality *L1FinalityValidator,
 	crossChainProcessors *crosschain.Processors,
 	mempool mempool.Manager,
 	privateKey *ecdsa.PrivateKey,
@@ -77,6 +82,7 @@ func New(
 		nodeType:             nodeType,
 		storage:              storage,
 		l1Blockchain:         l1Blockchain,
+		l1Finality:           l1Finality,
 		mempool:              mempool,
 		crossChainProcessors: crossChainProcessors,
 		enclavePrivateKey:    privateKey,
@@ -304,6 +310,11 @@ func (oc *ObscuroChain) insertBlockIntoL1Chain(block *types.Block, isLatest bool
 			return nil, fmt.Errorf("block was invalid: %w", err)
 		}
 	}
+	if oc.l1Finality != nil {
+		if err := oc.l1Finality.Validate(block); err != nil {
+			return nil, fmt.Errorf("block was not finalized: %w", err)
+		}
+	}
 	// todo: this is minimal L1 tracking/validation, and should be removed when we are using geth's blockchain or lightchain structures for validation
 	prevL1Head, err := oc.storage.FetchHeadBlock()
 
@@ -468,9 +479,10 @@ func (oc *ObscuroChain) produceGenesisBatch(blkHash common.L1RootHash) (*core.Ba
 // This is where transactions are executed and the state is calculated.
 // Obscuro includes a message bus embedded in the platform, and this method is responsible for transferring messages as well.
 // The batch can be a final batch as received from peers or the batch under construction.
-func (oc *ObscuroChain) processState(batch *core.Batch, txs []*common.L2Tx, stateDB *state.StateDB) (common.L2RootHash, []*common.L2Tx, []*types.Receipt, []*types.Receipt) {
+func (oc *ObscuroChain) processState(batch *core.Batch, txs []*common.L2Tx, stateDB *state.StateDB) (common.L2RootHash, []*common.L2Tx, []*types.Receipt, []*types.Receipt, map[common.TxHash]error) {
 	var executedTransactions []*common.L2Tx
 	var txReceipts []*types.Receipt
+	excludedTransactions := map[common.TxHash]error{}
 
 	txResults := evm.ExecuteTransactions(txs, stateDB, batch.Header, oc.storage, oc.chainConfig, 0, oc.logger)
 	for _, tx := range txs {
@@ -484,6 +496,9 @@ func (oc *ObscuroChain) processState(batch *core.Batch, txs []*common.L2Tx, stat
 			txReceipts = append(txReceipts, rec)
 		} else {
 			// Exclude all errors
+			if txErr, ok := result.(error); ok {
+				excludedTransactions[tx.Hash()] = txErr
+			}
 			oc.logger.Info(fmt.Sprintf("Excluding transaction %s from batch b_%d. Cause: %s", tx.Hash().Hex(), common.ShortHash(*batch.Hash()), result))
 		}
 	}
@@ -552,7 +567,7 @@ func (oc *ObscuroChain) processState(batch *core.Batch, txs []*common.L2Tx, stat
 	sort.Sort(sortByTxIndex(txReceipts))
 
 	// todo - handle the tx execution logs
-	return rootHash, executedTransactions, txReceipts, synthReceipts
+	return rootHash, executedTransactions, txReceipts, synthReceipts, excludedTransactions
 }
 
 // ResyncStateDB can be called to ensure stateDB data is available for the canonical L2 batch chain
@@ -646,7 +661,7 @@ func (oc *ObscuroChain) isInternallyValidBatch(batch *core.Batch) (types.Receipt
 	}
 
 	// calculate the state to compare with what is in the batch
-	rootHash, executedTxs, txReceipts, depositReceipts := oc.processState(batch, batch.Transactions, stateDB)
+	rootHash, executedTxs, txReceipts, depositReceipts, _ := oc.processState(batch, batch.Transactions, stateDB)
 	if len(executedTxs) != len(batch.Transactions) {
 		return nil, fmt.Errorf("all transactions that are included in a batch must be executed")
 	}
@@ -693,7 +708,7 @@ func (oc *ObscuroChain) getTxReceipts(batch *core.Batch) ([]*types.Receipt, erro
 	}
 
 	// calculate the state to compare with what is in the batch
-	_, _, txReceipts, _ := oc.processState(batch, batch.Transactions, stateDB) //nolint:dogsled
+	_, _, txReceipts, _, _ := oc.processState(batch, batch.Transactions, stateDB) //nolint:dogsled
 	return txReceipts, nil
 }
 
@@ -740,7 +755,7 @@ func (oc *ObscuroChain) CheckSequencerSignature(headerHash *gethcommon.Hash, agg
 	return nil
 }
 
-// Retrieves the batch with the given height, with special handling for earliest/latest/pending .
+// Retrieves the batch with the given height, with special handling for earliest/latest/pending/safe/finalized.
 func (oc *ObscuroChain) getBatch(height gethrpc.BlockNumber) (*core.Batch, error) {
 	var batch *core.Batch
 	switch height {
@@ -759,6 +774,18 @@ func (oc *ObscuroChain) getBatch(height gethrpc.BlockNumber) (*core.Batch, error
 			return nil, fmt.Errorf("batch with requested height %d was not found. Cause: %w", height, err)
 		}
 		batch = headBatch
+	case common.SafeBlockNumber:
+		safeBatch, err := oc.safeBatch()
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve safe batch. Cause: %w", err)
+		}
+		batch = safeBatch
+	case common.FinalizedBlockNumber:
+		finalizedBatch, err := oc.finalizedBatch()
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve finalized batch. Cause: %w", err)
+		}
+		batch = finalizedBatch
 	default:
 		maybeBatch, err := oc.storage.FetchBatchByHeight(uint64(height))
 		if err != nil {
@@ -801,11 +828,27 @@ func (oc *ObscuroChain) produceBatch(block *types.Block, genesisBatchStored bool
 		return nil, fmt.Errorf("could not create stateDB. Cause: %w", err)
 	}
 
-	rootHash, successfulTxs, txReceipts, depositReceipts := oc.processState(batch, newBatchTxs, newBatchState)
+	rootHash, successfulTxs, txReceipts, depositReceipts, excludedTxs := oc.processState(batch, newBatchTxs, newBatchState)
 
 	batch.Header.Root = rootHash
 	batch.Transactions = successfulTxs
 
+	// Transactions that can never be executed are dropped, so that the sender learns their fate. Others (e.g. those
+	// whose nonce is too high) remain in the mempool, as they may succeed in a later batch.
+	for _, tx := range newBatchTxs {
+		txErr, excluded := excludedTxs[tx.Hash()]
+		if !excluded {
+			continue
+		}
+		if !isPermanentTxFailure(txErr) {
+			oc.mempool.RecordExclusion(tx.Hash(), txErr.Error())
+			continue
+		}
+		if err = oc.mempool.DropTx(tx, txErr.Error()); err != nil {
+			return nil, fmt.Errorf("could not drop transaction %s from mempool. Cause: %w", tx.Hash(), err)
+		}
+	}
+
 	crossChainMessages, err := oc.crossChainProcessors.Local.ExtractOutboundMessages(txReceipts)
 	if err != nil {
 		oc.logger.Crit("Extracting messages L2->L1 failed", err, log.CmpKey, log.CrossChainCmp)
@@ -847,6 +890,16 @@ func (oc *ObscuroChain) produceBatch(block *types.Block, genesisBatchStored bool
 	return batch, nil
 }
 
+// Indicates whether a transaction failed for a reason that will not change in a later batch. A sender's lack of funds is
+// not permanent, since the sender may be funded by a deposit.
+func isPermanentTxFailure(err error) bool {
+	return errors.Is(err, gethcore.ErrNonceTooLow) ||
+		errors.Is(err, gethcore.ErrNonceMax) ||
+		errors.Is(err, gethcore.ErrIntrinsicGas) ||
+		errors.Is(err, gethcore.ErrTxTypeNotSupported) ||
+		errors.Is(err, gethcore.ErrSenderNoEOA)
+}
+
 // Returns the state of the chain at height
 // TODO make this cacheable
 func (oc *ObscuroChain) getChainStateAtBlock(blockNumber *gethrpc.BlockNumber) (*state.StateDB, error) {
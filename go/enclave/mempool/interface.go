@@ -13,6 +13,13 @@ type Manager interface {
 	AddMempoolTx(tx *common.L2Tx) error
 	// RemoveMempoolTxs removes transactions that are considered immune to re-orgs (i.e. over X batches deep).
 	RemoveMempoolTxs(batch *core.Batch, resolver db.BatchResolver) error
+	// DropTx removes a transaction that can never be included in a batch from the mempool, recording the reason
+	DropTx(tx *common.L2Tx, reason string) error
+	// RecordExclusion records why a transaction that remains in the mempool was excluded from the latest batch
+	RecordExclusion(hash common.L2TxHash, reason string)
+	// FetchMempoolTx returns the transaction with the given hash, or nil if it is not in the mempool, along with the
+	// reason it was excluded from the latest batch, if any
+	FetchMempoolTx(hash common.L2TxHash) (*common.L2Tx, string)
 	// CurrentTxs Returns the transactions that should be included in the current batch
 	CurrentTxs(head *core.Batch, resolver db.BatchResolver) ([]*common.L2Tx, error)
 }
@@ -29,14 +29,28 @@ type mempoolManager struct {
 	mpMutex        sync.RWMutex // Controls access to `mempool`
 	obscuroChainID int64
 	mempool        map[gethcommon.Hash]*common.L2Tx
+	exclusions     map[gethcommon.Hash]string // Why transactions in the mempool were excluded from the latest batch
+	storage        db.MempoolStorage          // Persists the mempool so pending transactions survive an enclave restart
 }
 
-func New(chainID int64) Manager {
+// New returns a mempool that is pre-populated with the transactions persisted before the enclave last stopped
+func New(chainID int64, storage db.MempoolStorage) (Manager, error) {
+	persistedTxs, err := storage.FetchMempoolTxs()
+	if err != nil {
+		return nil, fmt.Errorf("could not load persisted mempool. Cause: %w", err)
+	}
+	mempool := make(map[gethcommon.Hash]*common.L2Tx, len(persistedTxs))
+	for _, tx := range persistedTxs {
+		mempool[tx.Hash()] = tx
+	}
+
 	return &mempoolManager{
-		mempool:        make(map[gethcommon.Hash]*common.L2Tx),
+		mempool:        mempool,
+		exclusions:     map[gethcommon.Hash]string{},
 		obscuroChainID: chainID,
 		mpMutex:        sync.RWMutex{},
-	}
+		storage:        storage,
+	}, nil
 }
 
 func (db *mempoolManager) AddMempoolTx(tx *common.L2Tx) error {
@@ -46,6 +60,9 @@ func (db *mempoolManager) AddMempoolTx(tx *common.L2Tx) error {
 	if err != nil {
 		return err
 	}
+	if err = db.storage.StoreMempoolTx(tx); err != nil {
+		return err
+	}
 	db.mempool[tx.Hash()] = tx
 	return nil
 }
@@ -75,13 +92,49 @@ func (db *mempoolManager) RemoveMempoolTxs(batch *core.Batch, resolver db.BatchR
 		_, f := toRemove[txHash]
 		if !f {
 			newMempool[txHash] = tx
+			continue
 		}
+		if err = db.storage.DeleteMempoolTx(txHash); err != nil {
+			return err
+		}
+		delete(db.exclusions, txHash)
 	}
 	db.mempool = newMempool
 
 	return nil
 }
 
+func (db *mempoolManager) DropTx(tx *common.L2Tx, reason string) error {
+	db.mpMutex.Lock()
+	defer db.mpMutex.Unlock()
+
+	if err := db.storage.StoreDroppedTx(tx, reason); err != nil {
+		return fmt.Errorf("could not record dropped transaction. Cause: %w", err)
+	}
+	if err := db.storage.DeleteMempoolTx(tx.Hash()); err != nil {
+		return err
+	}
+	delete(db.mempool, tx.Hash())
+	delete(db.exclusions, tx.Hash())
+	return nil
+}
+
+func (db *mempoolManager) RecordExclusion(hash common.L2TxHash, reason string) {
+	db.mpMutex.Lock()
+	defer db.mpMutex.Unlock()
+
+	if _, found := db.mempool[hash]; found {
+		db.exclusions[hash] = reason
+	}
+}
+
+func (db *mempoolManager) FetchMempoolTx(hash common.L2TxHash) (*common.L2Tx, string) {
+	db.mpMutex.RLock()
+	defer db.mpMutex.RUnlock()
+
+	return db.mempool[hash], db.exclusions[hash]
+}
+
 // Returns all transactions in the batch `HeightCommittedBlocks` deep.
 func txsXBatchesAgo(initialBatch *core.Batch, resolver db.BatchResolver) (map[gethcommon.Hash]gethcommon.Hash, error) {
 	blocksDeep := 0
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"sort"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
@@ -142,18 +141,18 @@ func (re *rollupManager) ProcessL1Block(br *common.BlockAndReceipts) ([]*core.Ro
 	return re.processRollups(br)
 }
 
-// extractRollups - returns a list of the rollups published in this block
-func (re *rollupManager) extractRollups(br *common.BlockAndReceipts, blockResolver db.BlockResolver) []*core.Rollup {
+// extractRollups - returns a list of the rollups published in this block, in the order the management contract added them
+func (re *rollupManager) extractRollups(br *common.BlockAndReceipts, blockResolver db.BlockResolver) ([]*core.Rollup, error) {
 	rollups := make([]*core.Rollup, 0)
 	b := br.Block
 
-	for _, tx := range *br.SuccessfulTransactions() {
-		// go through all rollup transactions
-		t := re.MgmtContractLib.DecodeTx(tx)
-		if t == nil {
-			continue
-		}
+	l1Txs, err := mgmtcontractlib.DecodeBlock(re.MgmtContractLib, b, *br.Receipts, re.logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode management contract transactions. Cause: %w", err)
+	}
 
+	// go through all rollup transactions
+	for _, t := range l1Txs {
 		rolTx, ok := t.(*ethadapter.L1RollupTx)
 		if !ok {
 			continue
@@ -161,8 +160,8 @@ func (re *rollupManager) extractRollups(br *common.BlockAndReceipts, blockResolv
 
 		r, err := common.DecodeRollup(rolTx.Rollup)
 		if err != nil {
-			re.logger.Crit("could not decode rollup.", log.ErrKey, err)
-			return nil
+			re.logger.Error("Could not decode rollup.", log.ErrKey, err)
+			continue
 		}
 
 		// Ignore rollups created with proofs from different L1 blocks
@@ -176,12 +175,7 @@ func (re *rollupManager) extractRollups(br *common.BlockAndReceipts, blockResolv
 		}
 	}
 
-	sort.Slice(rollups, func(i, j int) bool {
-		// Ascending order sort.
-		return rollups[i].Header.Number.Cmp(rollups[j].Header.Number) < 0
-	})
-
-	return rollups
+	return rollups, nil
 }
 
 // Validates and stores the rollup in a given block.
@@ -194,7 +188,10 @@ func (re *rollupManager) processRollups(br *common.BlockAndReceipts) ([]*core.Ro
 		return nil, fmt.Errorf("unexpected error retrieving latest rollup for block %s. Cause: %w", block.Hash(), err)
 	}
 
-	rollups := re.extractRollups(br, re.storage)
+	rollups, err := re.extractRollups(br, re.storage)
+	if err != nil {
+		return nil, err
+	}
 
 	// If this is the first rollup we've ever received, we check that it's the genesis rollup.
 	if latestRollup == nil && len(rollups) != 0 && !rollups[0].IsGenesis() {
@@ -206,16 +203,23 @@ func (re *rollupManager) processRollups(br *common.BlockAndReceipts) ([]*core.Ro
 	}
 
 	blockHash := block.Hash()
-	for idx, rollup := range rollups {
+	acceptedRollups := make([]*core.Rollup, 0, len(rollups))
+	for _, rollup := range rollups {
+		previousRollup := latestRollup
+		if len(acceptedRollups) != 0 {
+			previousRollup = acceptedRollups[len(acceptedRollups)-1]
+		}
+		if previousRollup != nil && isCompetingRollup(rollup, previousRollup) {
+			re.logger.Warn("Ignoring competing rollup that does not extend the head rollup.",
+				"rollup", rollup.Hash(), "number", rollup.Number(), "head_rollup", previousRollup.Hash(), "head_number", previousRollup.Number())
+			continue
+		}
+
 		if err = re.l2chain.CheckSequencerSignature(rollup.Hash(), &rollup.Header.Agg, rollup.Header.R, rollup.Header.S); err != nil {
 			return nil, fmt.Errorf("rollup signature was invalid. Cause: %w", err)
 		}
 
-		if !rollup.IsGenesis() {
-			previousRollup := latestRollup
-			if idx != 0 {
-				previousRollup = rollups[idx-1]
-			}
+		if previousRollup != nil {
 			if err = re.checkRollupsCorrectlyChained(rollup, previousRollup); err != nil {
 				return nil, err
 			}
@@ -227,20 +231,25 @@ func (re *rollupManager) processRollups(br *common.BlockAndReceipts) ([]*core.Ro
 			}
 		}
 
-		if err = re.storage.StoreRollup(rollup); err != nil {
+		if err = re.storage.StoreRollup(rollup, blockHash); err != nil {
 			return nil, fmt.Errorf("could not store rollup. Cause: %w", err)
 		}
+		acceptedRollups = append(acceptedRollups, rollup)
+	}
+
+	if len(acceptedRollups) == 0 {
+		return nil, nil
 	}
 
 	// we record the latest rollup published against this L1 block hash
-	rollupHash := rollups[len(rollups)-1].Header.Hash()
+	rollupHash := acceptedRollups[len(acceptedRollups)-1].Header.Hash()
 
 	err = re.storage.UpdateHeadRollup(&blockHash, &rollupHash)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update head rollup - %w", err)
 	}
 
-	return rollups, nil
+	return acceptedRollups, nil
 }
 
 // getLatestRollupBeforeBlock - Given a block, returns the latest rollup in the canonical chain for that block (excluding those in the block itself).
@@ -270,6 +279,13 @@ func (re *rollupManager) getLatestRollupBeforeBlock(block *common.L1Block) (*cor
 	}
 }
 
+// Follows the management contract's rollup tree by replaying the contract's fork-choice rule over the rollups it added,
+// in the order it added them. A rollup that the contract does not make the head rollup competes with the canonical
+// chain and is ignored.
+func isCompetingRollup(rollup *core.Rollup, headRollup *core.Rollup) bool {
+	return !common.ExtendsHeadRollup(rollup.Header, headRollup.Header)
+}
+
 // Checks that the rollup:
 //   - Has a number exactly 1 higher than the previous rollup
 //   - Links to the previous rollup by hash
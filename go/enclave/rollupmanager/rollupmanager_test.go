@@ -0,0 +1,39 @@
+package rollupmanager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestForkChoiceIgnoresRollupsThatDoNotExtendTheHead(t *testing.T) {
+	head := newRollup(5, gethcommon.Hash{4})
+
+	testCases := map[string]struct {
+		rollup    *core.Rollup
+		competing bool
+	}{
+		"extends the head":           {newRollup(6, *head.Hash()), false},
+		"same number as the head":    {newRollup(5, gethcommon.Hash{4}), true},
+		"older than the head":        {newRollup(4, gethcommon.Hash{3}), true},
+		"builds on a competing head": {newRollup(6, gethcommon.Hash{5}), true},
+		"leaves a gap":               {newRollup(7, gethcommon.Hash{6}), true},
+		// as for the management contract, numbers are not a fork-choice matter; they are checked when checking the
+		// rollups are chained
+		"misnumbered but extends the head": {newRollup(7, *head.Hash()), false},
+	}
+
+	for name, tc := range testCases {
+		if competing := isCompetingRollup(tc.rollup, head); competing != tc.competing {
+			t.Errorf("%s: expected competing to be %t, got %t", name, tc.competing, competing)
+		}
+	}
+}
+
+func newRollup(number int64, parentHash gethcommon.Hash) *core.Rollup {
+	return &core.Rollup{Header: &common.RollupHeader{Number: big.NewInt(number), ParentHash: parentHash}}
+}
@@ -5,8 +5,6 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	"github.com/obscuronet/go-obscuro/go/common"
-
 	"github.com/ethereum/go-ethereum/accounts"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -98,16 +96,24 @@ func (rpc *EncryptionManager) EncryptWithViewingKey(address gethcommon.Address,
 	return encryptedBytes, nil
 }
 
-// AuthenticateSubscriptionRequest checks that a subscription request is authenticated correctly.
-func (rpc *EncryptionManager) AuthenticateSubscriptionRequest(subscription common.LogSubscription) error {
-	accountHashBytes := subscription.Account.Hash().Bytes()
+// AuthenticateSubscriptionRequest checks that a subscription request for the given account is authenticated correctly,
+// i.e. that the signature over the account was produced by the account's viewing key.
+func (rpc *EncryptionManager) AuthenticateSubscriptionRequest(account *gethcommon.Address, signature *[]byte) error {
+	if account == nil || signature == nil {
+		return fmt.Errorf("subscription request did not specify its account and signature")
+	}
+	accountHashBytes := account.Hash().Bytes()
 
-	recoveredViewingPublicKey, err := crypto.SigToPub(accountHashBytes, *subscription.Signature)
+	recoveredViewingPublicKey, err := crypto.SigToPub(accountHashBytes, *signature)
 	if err != nil {
 		return fmt.Errorf("could not recover viewing public key from signature to authenticate subscription. Cause: %w", err)
 	}
 
-	viewingPublicKey := rpc.viewingKeys[*subscription.Account].ExportECDSA()
+	viewingKey, found := rpc.viewingKeys[*account]
+	if !found {
+		return fmt.Errorf("no viewing key is registered for account %s to authenticate subscription", account)
+	}
+	viewingPublicKey := viewingKey.ExportECDSA()
 	if !viewingPublicKey.Equal(recoveredViewingPublicKey) {
 		return fmt.Errorf("viewing key used to authenticate subscription did not match viewing key stored by enclave")
 	}
@@ -122,8 +122,11 @@ func (s *RPCServer) SubmitL1Block(_ context.Context, request *generated.SubmitBl
 }
 
 func (s *RPCServer) SubmitTx(_ context.Context, request *generated.SubmitTxRequest) (*generated.SubmitTxResponse, error) {
-	encryptedHash, err := s.enclave.SubmitTx(request.EncryptedTx)
-	return &generated.SubmitTxResponse{EncryptedHash: encryptedHash}, err
+	response, err := s.enclave.SubmitTx(request.EncryptedTx)
+	if err != nil {
+		return nil, err
+	}
+	return rpc.ToSubmitTxResponseMsg(response)
 }
 
 func (s *RPCServer) SubmitBatch(_ context.Context, request *generated.SubmitBatchRequest) (*generated.SubmitBatchResponse, error) {
@@ -131,6 +134,26 @@ func (s *RPCServer) SubmitBatch(_ context.Context, request *generated.SubmitBatc
 	return &generated.SubmitBatchResponse{}, s.enclave.SubmitBatch(batch)
 }
 
+func (s *RPCServer) SubmitL1Finality(_ context.Context, request *generated.SubmitL1FinalityRequest) (*generated.SubmitL1FinalityResponse, error) {
+	var update common.L1FinalityUpdate
+	if err := json.Unmarshal(request.EncodedUpdate, &update); err != nil {
+		return nil, fmt.Errorf("could not decode L1 finality update. Cause: %w", err)
+	}
+	status, err := s.enclave.SubmitL1Finality(&update)
+	if err != nil {
+		return nil, err
+	}
+	encodedStatus, err := json.Marshal(status)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode L1 finality status. Cause: %w", err)
+	}
+	return &generated.SubmitL1FinalityResponse{EncodedStatus: encodedStatus}, nil
+}
+
+func (s *RPCServer) SubmitFinalizedL1Block(_ context.Context, request *generated.SubmitFinalizedL1BlockRequest) (*generated.SubmitFinalizedL1BlockResponse, error) {
+	return &generated.SubmitFinalizedL1BlockResponse{}, s.enclave.SubmitFinalizedL1Block(gethcommon.BytesToHash(request.BlockHash))
+}
+
 func (s *RPCServer) ExecuteOffChainTransaction(_ context.Context, request *generated.OffChainRequest) (*generated.OffChainResponse, error) {
 	result, err := s.enclave.ExecuteOffChainTransaction(request.EncryptedParams)
 	if err != nil {
@@ -154,8 +177,9 @@ func (s *RPCServer) GetTransactionCount(_ context.Context, request *generated.Ge
 }
 
 func (s *RPCServer) Stop(context.Context, *generated.StopRequest) (*generated.StopResponse, error) {
-	defer s.grpcServer.GracefulStop()
 	err := s.enclave.Stop()
+	// GracefulStop waits for the in-flight requests to complete, including this one, so it must not block the response
+	go s.grpcServer.GracefulStop()
 	return &generated.StopResponse{}, err
 }
 
@@ -175,6 +199,14 @@ func (s *RPCServer) GetTransactionReceipt(_ context.Context, request *generated.
 	return &generated.GetTransactionReceiptResponse{EncryptedTxReceipt: encryptedTxReceipt}, nil
 }
 
+func (s *RPCServer) GetTransactionStatus(_ context.Context, request *generated.GetTransactionStatusRequest) (*generated.GetTransactionStatusResponse, error) {
+	encryptedTxStatus, err := s.enclave.GetTransactionStatus(request.EncryptedParams)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.GetTransactionStatusResponse{EncryptedTxStatus: encryptedTxStatus}, nil
+}
+
 func (s *RPCServer) AddViewingKey(_ context.Context, request *generated.AddViewingKeyRequest) (*generated.AddViewingKeyResponse, error) {
 	err := s.enclave.AddViewingKey(request.ViewingKey, request.Signature)
 	if err != nil {
@@ -203,7 +235,12 @@ func (s *RPCServer) GetCode(_ context.Context, request *generated.GetCodeRequest
 }
 
 func (s *RPCServer) Subscribe(_ context.Context, req *generated.SubscribeRequest) (*generated.SubscribeResponse, error) {
-	err := s.enclave.Subscribe(gethrpc.ID(req.Id), req.EncryptedSubscription)
+	encryptedLogs, err := s.enclave.Subscribe(gethrpc.ID(req.Id), req.EncryptedSubscription)
+	return &generated.SubscribeResponse{EncryptedLogs: encryptedLogs}, err
+}
+
+func (s *RPCServer) SubscribePendingTxs(_ context.Context, req *generated.SubscribeRequest) (*generated.SubscribeResponse, error) {
+	err := s.enclave.SubscribePendingTxs(gethrpc.ID(req.Id), req.EncryptedSubscription)
 	return &generated.SubscribeResponse{}, err
 }
 
@@ -0,0 +1,124 @@
+package enclave
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// The number of L1 blocks an elected responder has to respond to a secret request, before the next attested node in
+// line responds instead.
+const secretResponseTimeoutBlocks = 10
+
+// Records the node that initialised the network secret as the first attested node. As in the management contract, the
+// network secret can only be initialised once.
+func (e *enclaveImpl) processSecretInitialisation(tx *ethadapter.L1InitializeSecretTx) error {
+	attestedNodes, err := e.storage.FetchAttestedNodes()
+	if err != nil {
+		return fmt.Errorf("could not retrieve attested nodes. Cause: %w", err)
+	}
+	if len(attestedNodes) > 0 {
+		return fmt.Errorf("network secret was already initialised by %s", attestedNodes[0])
+	}
+	if tx.AggregatorID == nil {
+		return fmt.Errorf("secret initialisation has no aggregator ID")
+	}
+	return e.storage.StoreAttestedNodes([]gethcommon.Address{*tx.AggregatorID})
+}
+
+// Records the requester as attested if the management contract accepts the response - that is, if it comes from an
+// attested node and is signed by it - and stops tracking the request.
+func (e *enclaveImpl) processSecretResponse(tx *ethadapter.L1RespondSecretTx) error {
+	attestedNodes, err := e.storage.FetchAttestedNodes()
+	if err != nil {
+		return fmt.Errorf("could not retrieve attested nodes. Cause: %w", err)
+	}
+	if !containsAddress(attestedNodes, tx.AttesterID) {
+		return fmt.Errorf("attester %s is not attested", tx.AttesterID)
+	}
+	if err = tx.VerifySignature(); err != nil {
+		return err
+	}
+
+	if err = e.storage.DeleteSecretRequest(tx.RequesterID); err != nil {
+		return err
+	}
+	// Several elected nodes may respond to the same request.
+	if containsAddress(attestedNodes, tx.RequesterID) {
+		return nil
+	}
+	e.logger.Info("Node was attested.", "requester", tx.RequesterID, "attester", tx.AttesterID)
+	return e.storage.StoreAttestedNodes(append(attestedNodes, tx.RequesterID))
+}
+
+// Returns the responses to the secret requests that are still outstanding once it is this enclave's turn to respond.
+func (e *enclaveImpl) produceSecretResponses(block *types.Block) ([]*common.ProducedSecretResponse, error) {
+	requests, err := e.storage.FetchSecretRequests()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve secret requests. Cause: %w", err)
+	}
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	attestedNodes, err := e.storage.FetchAttestedNodes()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attested nodes. Cause: %w", err)
+	}
+
+	var responses []*common.ProducedSecretResponse
+	for _, request := range requests {
+		rank, elected := responderRank(request.RequestBlockHash, request.RequesterID, attestedNodes, e.config.HostID)
+		if !elected || block.NumberU64() < request.RequestBlockNumber+uint64(rank)*secretResponseTimeoutBlocks {
+			continue
+		}
+
+		e.logger.Info("Responding to secret request.", "requester", request.RequesterID, "responderRank", rank)
+		responses = append(responses, &common.ProducedSecretResponse{
+			Secret:      request.Secret,
+			RequesterID: request.RequesterID,
+			HostAddress: request.HostAddress,
+		})
+		// If our response is not included, the next node in line responds once its turn comes.
+		if err = e.storage.DeleteSecretRequest(request.RequesterID); err != nil {
+			return responses, err
+		}
+	}
+	return responses, nil
+}
+
+// Returns the position of the node in the order in which the attested nodes respond to a secret request, or false if
+// the node is not attested or is the requester. The order is a shuffle of the attested nodes seeded by the request's L1 block hash and
+// requester, so that every enclave agrees on it, and the work of responding is spread across the attested nodes.
+func responderRank(requestBlockHash gethcommon.Hash, requester gethcommon.Address, attestedNodes []gethcommon.Address, nodeID gethcommon.Address) (int, bool) {
+	if nodeID == requester || !containsAddress(attestedNodes, nodeID) {
+		return 0, false
+	}
+
+	ownScore := responderScore(requestBlockHash, requester, nodeID)
+	rank := 0
+	for _, node := range attestedNodes {
+		if node != requester && bytes.Compare(responderScore(requestBlockHash, requester, node), ownScore) < 0 {
+			rank++
+		}
+	}
+	return rank, true
+}
+
+func responderScore(requestBlockHash gethcommon.Hash, requester gethcommon.Address, node gethcommon.Address) []byte {
+	return crypto.Keccak256(requestBlockHash.Bytes(), requester.Bytes(), node.Bytes())
+}
+
+func containsAddress(addresses []gethcommon.Address, address gethcommon.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package enclave
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestAttestedNodesAreEachGivenADistinctResponderRank(t *testing.T) {
+	requester := gethcommon.HexToAddress("0x10")
+	attestedNodes := []gethcommon.Address{
+		gethcommon.HexToAddress("0x1"), gethcommon.HexToAddress("0x2"), gethcommon.HexToAddress("0x3"), gethcommon.HexToAddress("0x4"),
+	}
+
+	orders := map[string]bool{}
+	for _, blockHash := range []gethcommon.Hash{gethcommon.HexToHash("0xa"), gethcommon.HexToHash("0xb"), gethcommon.HexToHash("0xc")} {
+		order := make([]gethcommon.Address, len(attestedNodes))
+		for _, node := range attestedNodes {
+			rank, elected := responderRank(blockHash, requester, attestedNodes, node)
+			if !elected || rank >= len(attestedNodes) || order[rank] != (gethcommon.Address{}) {
+				t.Fatalf("expected node %s to be given a distinct rank, got %d", node, rank)
+			}
+			order[rank] = node
+		}
+		orders[order[0].Hex()+order[1].Hex()+order[2].Hex()] = true
+	}
+	if len(orders) == 1 {
+		t.Fatal("expected the responder order to depend on the request's block hash")
+	}
+
+	if _, elected := responderRank(gethcommon.HexToHash("0xa"), requester, attestedNodes, gethcommon.HexToAddress("0x5")); elected {
+		t.Fatal("expected a node that is not attested not to be elected")
+	}
+	if _, elected := responderRank(gethcommon.HexToHash("0xa"), requester, append(attestedNodes, requester), requester); elected {
+		t.Fatal("expected the requester not to be elected")
+	}
+}
@@ -0,0 +1,103 @@
+package ethadapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	lightClientPath        = "/eth/v1/beacon/light_client/"
+	maxErrorResponseLength = 512 // the number of bytes of an error response that are included in the error
+)
+
+// BeaconClient defines the interface for fetching the light client data of the L1's beacon chain from a beacon node
+type BeaconClient interface {
+	Bootstrap(blockRoot gethcommon.Hash) (*beacon.LightClientBootstrap, error)     // fetches the bootstrap for a trusted beacon block
+	Updates(startPeriod uint64, count uint64) ([]*beacon.LightClientUpdate, error) // fetches the sync committee updates of consecutive periods (fewer are returned if the beacon node does not have them yet)
+	FinalityUpdate() (*beacon.LightClientUpdate, error)                            // fetches the update of the latest finalized block
+}
+
+// versionedResponse is how the beacon node API wraps the light client data, along with the fork the data is for.
+type versionedResponse struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type beaconHTTPClient struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewBeaconClient returns a client for the beacon node API served at the given URL.
+func NewBeaconClient(url string, timeout time.Duration) BeaconClient {
+	return &beaconHTTPClient{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *beaconHTTPClient) Bootstrap(blockRoot gethcommon.Hash) (*beacon.LightClientBootstrap, error) {
+	var response versionedResponse
+	if err := c.get("bootstrap/"+blockRoot.Hex(), &response); err != nil {
+		return nil, err
+	}
+	var bootstrap beacon.LightClientBootstrap
+	if err := json.Unmarshal(response.Data, &bootstrap); err != nil {
+		return nil, fmt.Errorf("could not decode light client bootstrap. Cause: %w", err)
+	}
+	return &bootstrap, nil
+}
+
+func (c *beaconHTTPClient) Updates(startPeriod uint64, count uint64) ([]*beacon.LightClientUpdate, error) {
+	var responses []versionedResponse
+	if err := c.get(fmt.Sprintf("updates?start_period=%d&count=%d", startPeriod, count), &responses); err != nil {
+		return nil, err
+	}
+	updates := make([]*beacon.LightClientUpdate, len(responses))
+	for i, response := range responses {
+		var update beacon.LightClientUpdate
+		if err := json.Unmarshal(response.Data, &update); err != nil {
+			return nil, fmt.Errorf("could not decode light client update. Cause: %w", err)
+		}
+		updates[i] = &update
+	}
+	return updates, nil
+}
+
+func (c *beaconHTTPClient) FinalityUpdate() (*beacon.LightClientUpdate, error) {
+	var response versionedResponse
+	if err := c.get("finality_update", &response); err != nil {
+		return nil, err
+	}
+	var update beacon.LightClientUpdate
+	if err := json.Unmarshal(response.Data, &update); err != nil {
+		return nil, fmt.Errorf("could not decode light client finality update. Cause: %w", err)
+	}
+	return &update, nil
+}
+
+// Fetches the light client endpoint at the given route, and decodes its JSON response into result.
+func (c *beaconHTTPClient) get(route string, result interface{}) error {
+	resp, err := c.httpClient.Get(c.url + lightClientPath + route)
+	if err != nil {
+		return fmt.Errorf("could not reach beacon node. Cause: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorResponseLength))
+		return fmt.Errorf("beacon node returned status %d for %s: %s", resp.StatusCode, route, strings.TrimSpace(string(body)))
+	}
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("could not decode beacon node response for %s. Cause: %w", route, err)
+	}
+	return nil
+}
@@ -63,6 +63,11 @@ func (e *EthBlockProvider) IsLive(h gethcommon.Hash) bool {
 	return err == nil && h == l1Head.Hash()
 }
 
+// Receipts always returns false, as the EthBlockProvider only fetches blocks
+func (e *EthBlockProvider) Receipts(gethcommon.Hash) (types.Receipts, bool) {
+	return nil, false
+}
+
 // streamBlocks is the main loop. It should be run in a separate go routine. It will stream catch-up blocks from requested height until it
 // reaches the latest live block, then it will block until next live block arrives
 // It blocks when:
@@ -151,16 +156,21 @@ func (e *EthBlockProvider) fetchNextCanonicalBlock(ctx context.Context, fromHeig
 }
 
 func (e *EthBlockProvider) latestCanonAncestor(blkHash gethcommon.Hash) (*types.Block, error) {
-	blk, err := e.ethClient.BlockByHash(blkHash)
+	return latestCanonAncestor(e.ethClient, blkHash)
+}
+
+// latestCanonAncestor walks back from the given block to the most recent block that is on the canonical chain
+func latestCanonAncestor(ethClient EthClient, blkHash gethcommon.Hash) (*types.Block, error) {
+	blk, err := ethClient.BlockByHash(blkHash)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch L1 block with hash=%s - %w", blkHash, err)
 	}
-	canonAtSameHeight, err := e.ethClient.BlockByNumber(blk.Number())
+	canonAtSameHeight, err := ethClient.BlockByNumber(blk.Number())
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch L1 block at height=%d - %w", blk.Number(), err)
 	}
 	if blk.Hash() != canonAtSameHeight.Hash() {
-		return e.latestCanonAncestor(blk.ParentHash())
+		return latestCanonAncestor(ethClient, blk.ParentHash())
 	}
 	return blk, nil
 }
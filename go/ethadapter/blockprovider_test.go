@@ -13,67 +13,84 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/host"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestBlockProviderHappyPath_LiveStream(t *testing.T) {
-	mockEthClient := mockEthClient(3)
-	blockProvider := setupBlockProvider(mockEthClient)
+	for name, setupBlockProvider := range blockProviders {
+		blockProvider := setupBlockProvider(mockEthClient(3))
 
-	blkStream, err := blockProvider.StartStreamingFromHeight(big.NewInt(3))
-	if err != nil {
-		t.Error(err)
-	}
-	defer blkStream.Stop()
-	blkCount := 0
-
-	for blkCount < 3 {
-		select {
-		case blk := <-blkStream.Stream:
-			if blk != nil {
-				blkCount++
-			}
+		blkStream, err := blockProvider.StartStreamingFromHeight(big.NewInt(3))
+		if err != nil {
+			t.Error(err)
+		}
+		blkCount := 0
+
+		for blkCount < 3 {
+			select {
+			case blk := <-blkStream.Stream:
+				if blk != nil {
+					blkCount++
+				}
 
-		case <-time.After(3 * time.Second): // shouldn't have >1sec delay between blocks in this test
-			t.Errorf("expected 3 blocks from stream but got %d", blkCount)
+			case <-time.After(3 * time.Second): // shouldn't have >1sec delay between blocks in this test
+				t.Fatalf("%s: expected 3 blocks from stream but got %d", name, blkCount)
+			}
 		}
+		blkStream.Stop()
 	}
 }
 
 func TestBlockProviderHappyPath_HistoricThenStream(t *testing.T) {
-	mockEthClient := mockEthClient(3)
-	blockProvider := setupBlockProvider(mockEthClient)
+	for name, setupBlockProvider := range blockProviders {
+		blockProvider := setupBlockProvider(mockEthClient(3))
 
-	blkStream, err := blockProvider.StartStreamingFromHeight(big.NewInt(1))
-	if err != nil {
-		t.Error(err)
-	}
-	defer blkStream.Stop()
-	blkCount := 0
-
-	for blkCount < 3 {
-		select {
-		case blk := <-blkStream.Stream:
-			if blk != nil {
-				blkCount++
-			}
+		blkStream, err := blockProvider.StartStreamingFromHeight(big.NewInt(1))
+		if err != nil {
+			t.Error(err)
+		}
+		blkCount := 0
+
+		for blkCount < 3 {
+			select {
+			case blk := <-blkStream.Stream:
+				if blk != nil {
+					blkCount++
+				}
 
-		case <-time.After(3 * time.Second): // shouldn't have >1sec delay between blocks in this test
-			t.Errorf("expected 3 blocks from stream but got %d", blkCount)
+			case <-time.After(3 * time.Second): // shouldn't have >1sec delay between blocks in this test
+				t.Fatalf("%s: expected 3 blocks from stream but got %d", name, blkCount)
+			}
 		}
+		blkStream.Stop()
 	}
 }
 
+// The block provider implementations covered by the tests.
+var blockProviders = map[string]func(mockEthClient EthClient) host.ReconnectingBlockProvider{
+	"subscription": func(mockEthClient EthClient) host.ReconnectingBlockProvider {
+		blockProvider := setupBlockProvider(mockEthClient)
+		return &blockProvider
+	},
+	"polling": func(mockEthClient EthClient) host.ReconnectingBlockProvider {
+		return NewPollingBlockProvider(mockEthClient, 100*time.Millisecond, testLogger())
+	},
+}
+
 func setupBlockProvider(mockEthClient EthClient) EthBlockProvider {
-	logger := log.New(log.HostCmp, int(gethlog.LvlInfo), log.SysOut, log.NodeIDKey, "test")
 	blockProvider := EthBlockProvider{
 		ethClient: mockEthClient,
-		logger:    logger,
+		logger:    testLogger(),
 	}
 	return blockProvider
 }
 
+func testLogger() gethlog.Logger {
+	return log.New(log.HostCmp, int(gethlog.LvlInfo), log.SysOut, log.NodeIDKey, "test")
+}
+
 func mockEthClient(liveStreamingStart int) EthClient {
 	mockClient := &ethClientMock{
 		ctx:               context.TODO(),
@@ -167,6 +184,38 @@ func (e *ethClientMock) BlockByNumber(num *big.Int) (*types.Block, error) {
 }
 
 func (e *ethClientMock) BlockNumber() (uint64, error) {
+	head, err := e.FetchHeadBlock()
+	if err != nil {
+		return 0, err
+	}
+	return head.NumberU64(), nil
+}
+
+func (e *ethClientMock) BlocksWithReceipts(from *big.Int, count uint64) ([]*types.Block, []types.Receipts, error) {
+	var blocks []*types.Block
+	var receipts []types.Receipts
+	for i := int(from.Int64()); i < int(from.Int64())+int(count); i++ {
+		block, found := e.blksByNum[i]
+		if !found {
+			break
+		}
+		blocks = append(blocks, block)
+		receipts = append(receipts, types.Receipts{})
+	}
+	return blocks, receipts, nil
+}
+
+func (e *ethClientMock) BlockReceipts(block *types.Block) (types.Receipts, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (e *ethClientMock) FinalizedHeader() (*types.Header, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (e *ethClientMock) L1HeaderByNumber(n *big.Int) (*common.L1Header, error) {
 	// TODO implement me
 	panic("implement me")
 }
@@ -233,6 +282,11 @@ func (e *ethClientMock) EstimateGasAndGasPrice(txData types.TxData, from gethcom
 	return txData, nil
 }
 
+func (e *ethClientMock) EstimateDynamicFeeTx(txData types.TxData, from gethcommon.Address) (*types.DynamicFeeTx, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 type ethSubscriptionMock struct {
 	mock.Mock
 	cancel context.CancelFunc
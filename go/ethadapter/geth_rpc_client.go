@@ -3,8 +3,11 @@ package ethadapter
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -18,37 +21,55 @@ import (
 	"github.com/ethereum/go-ethereum"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 const (
 	connRetryMaxWait  = 10 * time.Minute // after this duration, we will stop retrying to connect and return the failure
 	connRetryInterval = 500 * time.Millisecond
+
+	methodNotFoundCode = -32601 // the JSON-RPC error code for calls to methods the node does not support
 )
 
 // gethRPCClient implements the EthClient interface and allows connection to a real ethereum node
 type gethRPCClient struct {
-	client  *ethclient.Client  // the underlying eth rpc client
-	l2ID    gethcommon.Address // the address of the Obscuro node this client is dedicated to
-	timeout time.Duration      // the timeout for connecting to, or communicating with, the L1 node
-	logger  gethlog.Logger
+	client    *ethclient.Client  // the underlying eth rpc client
+	rpcClient *rpc.Client        // the raw rpc client underlying the eth rpc client, for batch requests
+	l2ID      gethcommon.Address // the address of the Obscuro node this client is dedicated to
+	timeout   time.Duration      // the timeout for connecting to, or communicating with, the L1 node
+	logger    gethlog.Logger
+
+	blockReceiptsUnsupported int32 // set if the node does not support `eth_getBlockReceipts`; accessed atomically
 }
 
 // NewEthClient instantiates a new ethadapter.EthClient that connects to an ethereum node
 func NewEthClient(ipaddress string, port uint, timeout time.Duration, l2ID gethcommon.Address, logger gethlog.Logger) (EthClient, error) {
-	client, err := connect(ipaddress, port, timeout)
+	return NewEthClientFromURL(fmt.Sprintf("ws://%s:%d", ipaddress, port), timeout, l2ID, logger)
+}
+
+// NewEthClientFromURL instantiates a new ethadapter.EthClient that connects to the ethereum node at the given URL (e.g.
+// `ws://127.0.0.1:8546` or `https://eth.example.com`)
+func NewEthClientFromURL(url string, timeout time.Duration, l2ID gethcommon.Address, logger gethlog.Logger) (EthClient, error) {
+	rpcClient, err := connect(url, timeout)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to the eth node - %w", err)
 	}
 
-	logger.Trace(fmt.Sprintf("Initialized eth node connection - addr: %s port: %d", ipaddress, port))
+	logger.Trace(fmt.Sprintf("Initialized eth node connection - url: %s", url))
+	return newGethRPCClient(rpcClient, l2ID, timeout, logger), nil
+}
+
+func newGethRPCClient(rpcClient *rpc.Client, l2ID gethcommon.Address, timeout time.Duration, logger gethlog.Logger) *gethRPCClient {
 	return &gethRPCClient{
-		client:  client,
-		l2ID:    l2ID,
-		timeout: timeout,
-		logger:  logger,
-	}, nil
+		client:    ethclient.NewClient(rpcClient),
+		rpcClient: rpcClient,
+		l2ID:      l2ID,
+		timeout:   timeout,
+		logger:    logger,
+	}
 }
 
 func (e *gethRPCClient) FetchHeadBlock() (*types.Block, error) {
@@ -160,6 +181,21 @@ func (e *gethRPCClient) BlockListener() (chan *types.Header, ethereum.Subscripti
 	return ch, sub
 }
 
+// SubscribeNewHead subscribes to new head blocks, without retrying.
+func (e *gethRPCClient) SubscribeNewHead(ch chan<- *types.Header) (ethereum.Subscription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	return e.client.SubscribeNewHead(ctx, ch)
+}
+
+func (e *gethRPCClient) ChainID() (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	return e.client.ChainID(ctx)
+}
+
 func (e *gethRPCClient) BlockNumber() (uint64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
 	defer cancel()
@@ -174,6 +210,42 @@ func (e *gethRPCClient) BlockByNumber(n *big.Int) (*types.Block, error) {
 	return e.client.BlockByNumber(ctx, n)
 }
 
+// FinalizedHeader fetches the header tagged `finalized`, which the eth client of the version of Geth we use cannot
+// request. Nodes that do not follow a beacon chain have no finalized block.
+func (e *gethRPCClient) FinalizedHeader() (*types.Header, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	var header *types.Header
+	if err := e.rpcClient.CallContext(ctx, &header, "eth_getBlockByNumber", "finalized", false); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+// L1HeaderByNumber fetches the header as JSON, since the header type of the eth client drops the fields added after the
+// London fork, and checks that it hashes to the block hash, so that a header with fields it does not know of is refused.
+func (e *gethRPCClient) L1HeaderByNumber(n *big.Int) (*common.L1Header, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	var header *rpcHeader
+	if err := e.rpcClient.CallContext(ctx, &header, "eth_getBlockByNumber", hexutil.EncodeBig(n), false); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	l1Header := header.l1Header()
+	if hash := l1Header.Hash(); hash != header.Hash {
+		return nil, fmt.Errorf("header of block %s hashes to %s, it may have fields that are not supported", header.Hash, hash)
+	}
+	return l1Header, nil
+}
+
 func (e *gethRPCClient) BlockByHash(hash gethcommon.Hash) (*types.Block, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
 	defer cancel()
@@ -181,6 +253,138 @@ func (e *gethRPCClient) BlockByHash(hash gethcommon.Hash) (*types.Block, error)
 	return e.client.BlockByHash(ctx, hash)
 }
 
+// BlocksWithReceipts fetches the blocks, and then their receipts, with one batch request each. The receipts are fetched
+// with `eth_getBlockReceipts` if the node supports it, and with `eth_getTransactionReceipt` otherwise.
+func (e *gethRPCClient) BlocksWithReceipts(from *big.Int, count uint64) ([]*types.Block, []types.Receipts, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	rawBlocks := make([]json.RawMessage, count)
+	batch := make([]rpc.BatchElem, count)
+	for i := range batch {
+		number := new(big.Int).Add(from, new(big.Int).SetUint64(uint64(i)))
+		batch[i] = rpc.BatchElem{Method: "eth_getBlockByNumber", Args: []interface{}{hexutil.EncodeBig(number), true}, Result: &rawBlocks[i]}
+	}
+	if err := e.rpcClient.BatchCallContext(ctx, batch); err != nil {
+		return nil, nil, fmt.Errorf("could not fetch blocks. Cause: %w", err)
+	}
+
+	var blocks []*types.Block
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, nil, fmt.Errorf("could not fetch block %s. Cause: %w", elem.Args[0], elem.Error)
+		}
+		if len(rawBlocks[i]) == 0 || string(rawBlocks[i]) == "null" {
+			// we have gone past the head
+			break
+		}
+		block, err := e.decodeBlock(rawBlocks[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not decode block %s. Cause: %w", elem.Args[0], err)
+		}
+		blocks = append(blocks, block)
+	}
+
+	receipts, err := e.blockReceipts(ctx, blocks)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blocks, receipts, nil
+}
+
+// BlockReceipts fetches the block's receipts with a single request, using `eth_getBlockReceipts` if the node supports
+// it, and a batch of `eth_getTransactionReceipt` calls otherwise.
+func (e *gethRPCClient) BlockReceipts(block *types.Block) (types.Receipts, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	receipts, err := e.blockReceipts(ctx, []*types.Block{block})
+	if err != nil {
+		return nil, err
+	}
+	return receipts[0], nil
+}
+
+// Decodes a block returned by `eth_getBlockByNumber`. As for geth's eth client, the block hash is not checked against
+// the header, since the header type may lag behind the fields of newer forks.
+func (e *gethRPCClient) decodeBlock(raw json.RawMessage) (*types.Block, error) {
+	var header *types.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+	var body struct {
+		Hash         gethcommon.Hash      `json:"hash"`
+		Transactions []*types.Transaction `json:"transactions"`
+		UncleHashes  []gethcommon.Hash    `json:"uncles"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, err
+	}
+	if len(body.UncleHashes) > 0 {
+		// uncles are not included in the response, so we leave these blocks to the eth client, which fetches them
+		return e.BlockByHash(body.Hash)
+	}
+	return types.NewBlockWithHeader(header).WithBody(body.Transactions, nil), nil
+}
+
+func (e *gethRPCClient) blockReceipts(ctx context.Context, blocks []*types.Block) ([]types.Receipts, error) {
+	receipts := make([]types.Receipts, len(blocks))
+
+	if atomic.LoadInt32(&e.blockReceiptsUnsupported) == 0 {
+		batch := make([]rpc.BatchElem, len(blocks))
+		for i, block := range blocks {
+			batch[i] = rpc.BatchElem{Method: "eth_getBlockReceipts", Args: []interface{}{block.Hash()}, Result: &receipts[i]}
+		}
+		err := e.rpcClient.BatchCallContext(ctx, batch)
+		for _, elem := range batch {
+			if err == nil {
+				err = elem.Error
+			}
+		}
+		var rpcErr rpc.Error
+		if err == nil || !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != methodNotFoundCode {
+			if err != nil {
+				return nil, fmt.Errorf("could not fetch block receipts. Cause: %w", err)
+			}
+			for i, block := range blocks {
+				if len(receipts[i]) != len(block.Transactions()) {
+					return nil, fmt.Errorf("received %d receipts for the %d transactions of block %s", len(receipts[i]), len(block.Transactions()), block.Hash())
+				}
+			}
+			return receipts, nil
+		}
+		e.logger.Info("L1 node does not support eth_getBlockReceipts, fetching receipts by transaction instead.")
+		atomic.StoreInt32(&e.blockReceiptsUnsupported, 1)
+	}
+
+	var batch []rpc.BatchElem
+	for i, block := range blocks {
+		receipts[i] = make(types.Receipts, len(block.Transactions()))
+		for j, tx := range block.Transactions() {
+			batch = append(batch, rpc.BatchElem{Method: "eth_getTransactionReceipt", Args: []interface{}{tx.Hash()}, Result: &receipts[i][j]})
+		}
+	}
+	if len(batch) == 0 {
+		return receipts, nil
+	}
+	if err := e.rpcClient.BatchCallContext(ctx, batch); err != nil {
+		return nil, fmt.Errorf("could not fetch receipts. Cause: %w", err)
+	}
+	for _, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("could not fetch receipt for transaction %s. Cause: %w", elem.Args[0], elem.Error)
+		}
+	}
+	for i := range receipts {
+		for j, receipt := range receipts[i] {
+			if receipt == nil {
+				return nil, fmt.Errorf("receipt not found for transaction %s", blocks[i].Transactions()[j].Hash())
+			}
+		}
+	}
+	return receipts, nil
+}
+
 func (e *gethRPCClient) CallContract(msg ethereum.CallMsg) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
 	defer cancel()
@@ -228,11 +432,56 @@ func (e *gethRPCClient) EstimateGasAndGasPrice(txData types.TxData, from gethcom
 	}, nil
 }
 
-func connect(ipaddress string, port uint, connectionTimeout time.Duration) (*ethclient.Client, error) {
+func (e *gethRPCClient) EstimateDynamicFeeTx(txData types.TxData, from gethcommon.Address) (*types.DynamicFeeTx, error) {
+	unEstimatedTx := types.NewTx(txData)
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	chainID, err := e.client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain ID. Cause: %w", err)
+	}
+	head, err := e.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head block. Cause: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, fmt.Errorf("L1 does not support EIP-1559 transactions")
+	}
+	gasTipCap, err := e.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not suggest gas tip cap. Cause: %w", err)
+	}
+	gasLimit, err := e.client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    unEstimatedTx.To(),
+		Value: unEstimatedTx.Value(),
+		Data:  unEstimatedTx.Data(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not estimate gas. Cause: %w", err)
+	}
+
+	// As for geth's transactor, the fee cap leaves room for the base fee to double before the transaction is mined.
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), gasTipCap)
+
+	return &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     unEstimatedTx.Nonce(),
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gasLimit,
+		To:        unEstimatedTx.To(),
+		Value:     unEstimatedTx.Value(),
+		Data:      unEstimatedTx.Data(),
+	}, nil
+}
+
+func connect(url string, connectionTimeout time.Duration) (*rpc.Client, error) {
 	var err error
-	var c *ethclient.Client
+	var c *rpc.Client
 	for start := time.Now(); time.Since(start) < connectionTimeout; time.Sleep(time.Second) {
-		c, err = ethclient.Dial(fmt.Sprintf("ws://%s:%d", ipaddress, port))
+		c, err = rpc.Dial(url)
 		if err == nil {
 			break
 		}
@@ -240,3 +489,55 @@ func connect(ipaddress string, port uint, connectionTimeout time.Duration) (*eth
 
 	return c, err
 }
+
+// rpcHeader is a block header as returned by `eth_getBlockByNumber`.
+type rpcHeader struct {
+	Hash             gethcommon.Hash    `json:"hash"`
+	ParentHash       gethcommon.Hash    `json:"parentHash"`
+	UncleHash        gethcommon.Hash    `json:"sha3Uncles"`
+	Coinbase         gethcommon.Address `json:"miner"`
+	Root             gethcommon.Hash    `json:"stateRoot"`
+	TxHash           gethcommon.Hash    `json:"transactionsRoot"`
+	ReceiptHash      gethcommon.Hash    `json:"receiptsRoot"`
+	Bloom            types.Bloom        `json:"logsBloom"`
+	Difficulty       *hexutil.Big       `json:"difficulty"`
+	Number           *hexutil.Big       `json:"number"`
+	GasLimit         hexutil.Uint64     `json:"gasLimit"`
+	GasUsed          hexutil.Uint64     `json:"gasUsed"`
+	Time             hexutil.Uint64     `json:"timestamp"`
+	Extra            hexutil.Bytes      `json:"extraData"`
+	MixDigest        gethcommon.Hash    `json:"mixHash"`
+	Nonce            types.BlockNonce   `json:"nonce"`
+	BaseFee          *hexutil.Big       `json:"baseFeePerGas"`
+	WithdrawalsHash  *gethcommon.Hash   `json:"withdrawalsRoot"`
+	BlobGasUsed      *hexutil.Uint64    `json:"blobGasUsed"`
+	ExcessBlobGas    *hexutil.Uint64    `json:"excessBlobGas"`
+	ParentBeaconRoot *gethcommon.Hash   `json:"parentBeaconBlockRoot"`
+	RequestsHash     *gethcommon.Hash   `json:"requestsHash"`
+}
+
+func (h *rpcHeader) l1Header() *common.L1Header {
+	return &common.L1Header{
+		ParentHash:       h.ParentHash,
+		UncleHash:        h.UncleHash,
+		Coinbase:         h.Coinbase,
+		Root:             h.Root,
+		TxHash:           h.TxHash,
+		ReceiptHash:      h.ReceiptHash,
+		Bloom:            h.Bloom,
+		Difficulty:       (*big.Int)(h.Difficulty),
+		Number:           (*big.Int)(h.Number),
+		GasLimit:         uint64(h.GasLimit),
+		GasUsed:          uint64(h.GasUsed),
+		Time:             uint64(h.Time),
+		Extra:            h.Extra,
+		MixDigest:        h.MixDigest,
+		Nonce:            h.Nonce,
+		BaseFee:          (*big.Int)(h.BaseFee),
+		WithdrawalsHash:  h.WithdrawalsHash,
+		BlobGasUsed:      (*uint64)(h.BlobGasUsed),
+		ExcessBlobGas:    (*uint64)(h.ExcessBlobGas),
+		ParentBeaconRoot: h.ParentBeaconRoot,
+		RequestsHash:     h.RequestsHash,
+	}
+}
@@ -0,0 +1,77 @@
+package ethadapter
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestBlocksWithReceiptsFallsBackToTransactionReceipts(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21_000, To: &gethcommon.Address{1}})
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash(), Logs: []*types.Log{}}
+	genesis := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0)})
+	withTx := types.NewBlock(&types.Header{Number: big.NewInt(1), ParentHash: genesis.Hash()}, []*types.Transaction{tx}, nil, []*types.Receipt{receipt}, trie.NewStackTrie(nil))
+	withoutTx := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2), ParentHash: withTx.Hash()})
+	// the service does not support `eth_getBlockReceipts`, like older L1 nodes
+	service := &fakeEthService{blocks: []*types.Block{genesis, withTx, withoutTx}, receipts: map[gethcommon.Hash]*types.Receipt{tx.Hash(): receipt}}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatalf("could not register service. Cause: %s", err)
+	}
+	defer server.Stop()
+	client := newGethRPCClient(rpc.DialInProc(server), gethcommon.Address{}, time.Second, gethlog.New())
+
+	blocks, receipts, err := client.BlocksWithReceipts(big.NewInt(1), 5)
+	if err != nil {
+		t.Fatalf("could not fetch blocks. Cause: %s", err)
+	}
+	if len(blocks) != 2 || blocks[0].Hash() != withTx.Hash() || blocks[1].Hash() != withoutTx.Hash() {
+		t.Fatalf("expected the two blocks up to the head, got %d blocks", len(blocks))
+	}
+	if len(receipts) != 2 || len(receipts[0]) != 1 || receipts[0][0].TxHash != tx.Hash() || len(receipts[1]) != 0 {
+		t.Fatalf("expected the receipts of the two blocks, got %v", receipts)
+	}
+	if client.blockReceiptsUnsupported == 0 {
+		t.Error("expected the client to remember that eth_getBlockReceipts is not supported")
+	}
+}
+
+// fakeEthService serves the `eth` namespace calls needed to fetch blocks and receipts, for a fixed chain.
+type fakeEthService struct {
+	blocks   []*types.Block
+	receipts map[gethcommon.Hash]*types.Receipt
+}
+
+func (s *fakeEthService) GetBlockByNumber(number hexutil.Big, _ bool) (map[string]interface{}, error) {
+	n := (*big.Int)(&number).Int64()
+	if n >= int64(len(s.blocks)) {
+		return nil, nil //nolint:nilnil
+	}
+	block := s.blocks[n]
+
+	headerJSON, err := json.Marshal(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(headerJSON, &fields); err != nil {
+		return nil, err
+	}
+	fields["transactions"] = block.Transactions()
+	fields["uncles"] = []gethcommon.Hash{}
+	return fields, nil
+}
+
+func (s *fakeEthService) GetTransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	return s.receipts[hash], nil
+}
@@ -22,6 +22,8 @@ type EthClient interface {
 	BlockNumber() (uint64, error)                                                 // retrieves the number of the head block
 	BlockByHash(id gethcommon.Hash) (*types.Block, error)                         // retrieves a block given a hash
 	BlockByNumber(n *big.Int) (*types.Block, error)                               // retrieves a block given a number - returns head block if n is nil
+	FinalizedHeader() (*types.Header, error)                                      // retrieves the header of the latest block the node considers finalized
+	L1HeaderByNumber(n *big.Int) (*common.L1Header, error)                        // retrieves the header of the block at the given height, including the fields added after the London fork
 	SendTransaction(signedTx *types.Transaction) error                            // issues an ethereum transaction (expects signed tx)
 	TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error)              // fetches the ethereum transaction receipt
 	Nonce(address gethcommon.Address) (uint64, error)                             // fetches the account nonce to use in the next transaction
@@ -35,7 +37,11 @@ type EthClient interface {
 
 	CallContract(msg ethereum.CallMsg) ([]byte, error) // Runs the provided call message on the latest block.
 
-	EstimateGasAndGasPrice(txData types.TxData, from gethcommon.Address) (types.TxData, error) // Estimates the gas and the gas price for a given tx payload
+	BlocksWithReceipts(from *big.Int, count uint64) ([]*types.Block, []types.Receipts, error) // Fetches up to count consecutive blocks from the given height, with their receipts, in as few requests as possible. Fewer blocks are returned if the head is reached.
+	BlockReceipts(block *types.Block) (types.Receipts, error)                                 // Fetches the receipts of all the block's transactions, in a single request.
+
+	EstimateGasAndGasPrice(txData types.TxData, from gethcommon.Address) (types.TxData, error)      // Estimates the gas and the gas price for a given tx payload
+	EstimateDynamicFeeTx(txData types.TxData, from gethcommon.Address) (*types.DynamicFeeTx, error) // Estimates the gas and the EIP-1559 fees for a given tx payload
 
 	Stop() // tries to cleanly stop the client and release any resources
 
@@ -35,6 +35,38 @@ type L1RespondSecretTx struct {
 
 // Sign signs the payload with a given private key
 func (l *L1RespondSecretTx) Sign(privateKey *ecdsa.PrivateKey) *L1RespondSecretTx {
+	// sign the hash
+	signedHash, err := crypto.Sign(l.signedHash().Bytes(), privateKey)
+	if err != nil {
+		return nil
+	}
+	// the management contract expects the ECDSA recovery id to be 27 or 28
+	signedHash[crypto.RecoveryIDOffset] += 27
+	l.AttesterSig = signedHash
+	return l
+}
+
+// VerifySignature checks that the payload was signed by the attester, as the management contract does when asked to
+// verify the attester.
+func (l *L1RespondSecretTx) VerifySignature() error {
+	if len(l.AttesterSig) != crypto.SignatureLength {
+		return fmt.Errorf("attester signature has length %d, expected %d", len(l.AttesterSig), crypto.SignatureLength)
+	}
+	sig := append([]byte{}, l.AttesterSig...)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(l.signedHash().Bytes(), sig)
+	if err != nil {
+		return fmt.Errorf("could not recover attester public key. Cause: %w", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != l.AttesterID {
+		return fmt.Errorf("secret response was signed by %s rather than attester %s", signer, l.AttesterID)
+	}
+	return nil
+}
+
+// Returns the hash the attester signs, matching the management contract's `abi.encodePacked` of the payload, prefixed
+// as an Ethereum signed message.
+func (l *L1RespondSecretTx) signedHash() gethcommon.Hash {
 	var data []byte
 	data = append(data, l.AttesterID.Bytes()...)
 	data = append(data, l.RequesterID.Bytes()...)
@@ -44,22 +76,20 @@ func (l *L1RespondSecretTx) Sign(privateKey *ecdsa.PrivateKey) *L1RespondSecretT
 	// form the data
 	msg := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(data), string(data))
 	// hash the data
-	hashedData := crypto.Keccak256Hash([]byte(msg))
-	// sign the hash
-	signedHash, err := crypto.Sign(hashedData.Bytes(), privateKey)
-	if err != nil {
-		return nil
-	}
-	// remove ECDSA recovery id
-	signedHash = signedHash[:len(signedHash)-1]
-	l.AttesterSig = signedHash
-	return l
+	return crypto.Keccak256Hash([]byte(msg))
 }
 
 type L1RequestSecretTx struct {
 	Attestation common.EncodedAttestationReport
 }
 
+// L1HostRegisteredTx is produced when the management contract adds a host's address to the network, either when the
+// network secret is initialized or when a secret request is responded to.
+type L1HostRegisteredTx struct {
+	HostID      gethcommon.Address
+	HostAddress string
+}
+
 type L1InitializeSecretTx struct {
 	AggregatorID  *gethcommon.Address
 	InitialSecret []byte
@@ -0,0 +1,41 @@
+package ethadapter
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestRespondSecretTxSignatureIsVerified(t *testing.T) {
+	attesterKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key. Cause: %s", err)
+	}
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key. Cause: %s", err)
+	}
+	newTx := func() *L1RespondSecretTx {
+		return &L1RespondSecretTx{
+			Secret:      []byte("secret"),
+			RequesterID: crypto.PubkeyToAddress(otherKey.PublicKey),
+			AttesterID:  crypto.PubkeyToAddress(attesterKey.PublicKey),
+			HostAddress: "127.0.0.1:10000",
+		}
+	}
+
+	if err = newTx().Sign(attesterKey).VerifySignature(); err != nil {
+		t.Fatalf("expected signature to be valid. Cause: %s", err)
+	}
+	if err = newTx().Sign(otherKey).VerifySignature(); err == nil {
+		t.Fatal("expected signature from another key to be rejected")
+	}
+	tamperedTx := newTx().Sign(attesterKey)
+	tamperedTx.HostAddress = "127.0.0.1:20000"
+	if err = tamperedTx.VerifySignature(); err == nil {
+		t.Fatal("expected signature over another payload to be rejected")
+	}
+	if err = newTx().VerifySignature(); err == nil {
+		t.Fatal("expected missing signature to be rejected")
+	}
+}
@@ -8,6 +8,15 @@ const (
 	RequestSecretMethod    = "RequestNetworkSecret"
 	InitializeSecretMethod = "InitializeNetworkSecret" //#nosec
 	GetHostAddressesMethod = "GetHostAddresses"
+
+	HasSecondCousinForkMethod   = "HasSecondCousinFork"
+	IsWithdrawalAvailableMethod = "IsWithdrawalAvailable"
+
+	RollupAddedEvent              = "RollupAdded"
+	NetworkSecretInitializedEvent = "NetworkSecretInitialized" //#nosec
+	NetworkSecretRequestedEvent   = "NetworkSecretRequested"   //#nosec
+	NetworkSecretRespondedEvent   = "NetworkSecretResponded"   //#nosec
+	HostRegisteredEvent           = "HostRegistered"
 )
 
 var MgmtContractABI = ManagementContract.ManagementContractMetaData.ABI
@@ -24,24 +24,73 @@ import (
 const methodBytesLen = 4
 
 // MgmtContractLib provides methods for creating ethereum transactions by providing an L1Transaction, creating call
-// messages for call requests, and converting ethereum transactions into L1Transactions.
+// messages for call requests, and converting ethereum transactions and receipts into L1Transactions.
 type MgmtContractLib interface {
 	CreateRollup(t *ethadapter.L1RollupTx, nonce uint64) types.TxData
 	CreateRequestSecret(tx *ethadapter.L1RequestSecretTx, nonce uint64) types.TxData
 	CreateRespondSecret(tx *ethadapter.L1RespondSecretTx, nonce uint64, verifyAttester bool) types.TxData
 	CreateInitializeSecret(tx *ethadapter.L1InitializeSecretTx, nonce uint64) types.TxData
 	GetHostAddresses() (ethereum.CallMsg, error)
-
-	// DecodeTx receives a *types.Transaction and converts it to an common.L1Transaction
-	DecodeTx(tx *types.Transaction) ethadapter.L1Transaction
+	// HasSecondCousinFork creates a call message asking whether the management contract's rollup tree contains
+	// competing rollups at least two levels deep. The response is decoded by DecodeBoolCallResponse.
+	HasSecondCousinFork() (ethereum.CallMsg, error)
+	// IsWithdrawalAvailable creates a call message asking whether the management contract allows withdrawals, which it
+	// stops doing once it detects a fork. The response is decoded by DecodeBoolCallResponse.
+	IsWithdrawalAvailable() (ethereum.CallMsg, error)
+
+	// DecodeTx receives a *types.Transaction and converts its calldata to an common.L1Transaction. Returns nil if the
+	// transaction is not a call to the management contract that Obscuro needs to process.
+	DecodeTx(tx *types.Transaction) (ethadapter.L1Transaction, error)
+	// DecodeReceipt converts the events emitted by the management contract in a receipt into common.L1Transactions.
+	DecodeReceipt(receipt *types.Receipt) ([]ethadapter.L1Transaction, error)
 	// DecodeCallResponse unpacks a call response into a slice of strings.
 	DecodeCallResponse(callResponse []byte) ([][]string, error)
+	// DecodeBoolCallResponse unpacks the response to a call that returns a single bool.
+	DecodeBoolCallResponse(callResponse []byte) (bool, error)
 	GetContractAddr() *gethcommon.Address
 }
 
+// DecodeBlock returns the management contract transactions in the block, in order, given the receipts of all the
+// block's transactions. Only successful transactions are considered. The transactions are taken from the events the
+// management contract emitted, which also covers calls made through other contracts (e.g. proxies or multisigs). A
+// transaction that emitted no events (e.g. because the contract predates the events) has its calldata decoded
+// instead. Transactions that cannot be decoded are logged and skipped.
+func DecodeBlock(lib MgmtContractLib, block *types.Block, receipts types.Receipts, logger gethlog.Logger) ([]ethadapter.L1Transaction, error) {
+	txs := block.Transactions()
+	if len(txs) != len(receipts) {
+		return nil, fmt.Errorf("block b_%d has %d transactions but %d receipts", common.ShortHash(block.Hash()), len(txs), len(receipts))
+	}
+
+	var l1Txs []ethadapter.L1Transaction
+	for i, tx := range txs {
+		if receipts[i].Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+
+		decoded, err := lib.DecodeReceipt(receipts[i])
+		if err != nil {
+			logger.Warn("Could not decode management contract events.", log.TxKey, tx.Hash(), log.ErrKey, err)
+			continue
+		}
+		if len(decoded) == 0 {
+			t, err := lib.DecodeTx(tx)
+			if err != nil {
+				logger.Warn("Could not decode management contract transaction.", log.TxKey, tx.Hash(), log.ErrKey, err)
+				continue
+			}
+			if t != nil {
+				decoded = append(decoded, t)
+			}
+		}
+		l1Txs = append(l1Txs, decoded...)
+	}
+	return l1Txs, nil
+}
+
 type contractLibImpl struct {
 	addr        *gethcommon.Address
 	contractABI abi.ABI
+	events      map[gethcommon.Hash]abi.Event // The events Obscuro processes, by ID
 	logger      gethlog.Logger
 }
 
@@ -51,9 +100,16 @@ func NewMgmtContractLib(addr *gethcommon.Address, logger gethlog.Logger) MgmtCon
 		panic(err)
 	}
 
+	events := map[gethcommon.Hash]abi.Event{}
+	for _, name := range []string{RollupAddedEvent, NetworkSecretInitializedEvent, NetworkSecretRequestedEvent, NetworkSecretRespondedEvent, HostRegisteredEvent} {
+		event := contractABI.Events[name]
+		events[event.ID] = event
+	}
+
 	return &contractLibImpl{
 		addr:        addr,
 		contractABI: contractABI,
+		events:      events,
 		logger:      logger,
 	}
 }
@@ -62,46 +118,95 @@ func (c *contractLibImpl) GetContractAddr() *gethcommon.Address {
 	return c.addr
 }
 
-func (c *contractLibImpl) DecodeTx(tx *types.Transaction) ethadapter.L1Transaction {
+func (c *contractLibImpl) DecodeTx(tx *types.Transaction) (ethadapter.L1Transaction, error) {
 	if tx.To() == nil || tx.To().Hex() != c.addr.Hex() || len(tx.Data()) == 0 {
-		return nil
+		return nil, nil //nolint:nilnil
+	}
+	if len(tx.Data()) < methodBytesLen {
+		return nil, fmt.Errorf("calldata is too short to contain a method ID")
 	}
 	method, err := c.contractABI.MethodById(tx.Data()[:methodBytesLen])
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("could not find management contract method. Cause: %w", err)
 	}
 
 	contractCallData := map[string]interface{}{}
+	switch method.Name {
+	case AddRollupMethod, RespondSecretMethod, RequestSecretMethod, InitializeSecretMethod:
+		if err = method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:]); err != nil {
+			return nil, fmt.Errorf("could not unpack %s calldata. Cause: %w", method.Name, err)
+		}
+	default:
+		return nil, nil //nolint:nilnil
+	}
+
 	switch method.Name {
 	case AddRollupMethod:
-		if err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[4:]); err != nil {
-			panic(err)
+		return unpackRollupTx(contractCallData, "_rollupData")
+	case RespondSecretMethod:
+		return unpackRespondSecretTx(contractCallData)
+	case RequestSecretMethod:
+		return unpackRequestSecretTx(contractCallData, "requestReport")
+	default: // InitializeSecretMethod
+		return unpackInitSecretTx(contractCallData, "_aggregatorID", "_initSecret", "_hostAddress", "_genesisAttestation")
+	}
+}
+
+func (c *contractLibImpl) DecodeReceipt(receipt *types.Receipt) ([]ethadapter.L1Transaction, error) {
+	var l1Txs []ethadapter.L1Transaction
+	for _, l := range receipt.Logs {
+		if l.Address != *c.addr || len(l.Topics) == 0 {
+			continue
 		}
-		callData, found := contractCallData["_rollupData"]
+		event, found := c.events[l.Topics[0]]
 		if !found {
-			panic("call data not found for rollupData")
+			continue
 		}
-		zipped := Base64DecodeFromString(callData.(string))
-		rollup, err := Decompress(zipped)
+		t, err := c.decodeLog(&event, l)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("could not decode %s event. Cause: %w", event.Name, err)
 		}
+		l1Txs = append(l1Txs, t)
+	}
+	return l1Txs, nil
+}
 
-		return &ethadapter.L1RollupTx{
-			Rollup: rollup,
+// Converts a log of one of the management contract events Obscuro processes into an L1Transaction.
+func (c *contractLibImpl) decodeLog(event *abi.Event, l *types.Log) (ethadapter.L1Transaction, error) {
+	eventData := map[string]interface{}{}
+	if err := c.contractABI.UnpackIntoMap(eventData, event.Name, l.Data); err != nil {
+		return nil, fmt.Errorf("could not unpack event data. Cause: %w", err)
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
 		}
-
-	case RespondSecretMethod:
-		return c.unpackRespondSecretTx(tx, method, contractCallData)
-
-	case RequestSecretMethod:
-		return c.unpackRequestSecretTx(tx, method, contractCallData)
-
-	case InitializeSecretMethod:
-		return c.unpackInitSecretTx(tx, method, contractCallData)
 	}
-
-	return nil
+	if err := abi.ParseTopicsIntoMap(eventData, indexed, l.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("could not unpack event topics. Cause: %w", err)
+	}
+
+	switch event.Name {
+	case RollupAddedEvent:
+		return unpackRollupTx(eventData, "rollupData")
+	case NetworkSecretInitializedEvent:
+		return unpackInitSecretTx(eventData, "aggregatorID", "initSecret", "hostAddress", "genesisAttestation")
+	case NetworkSecretRequestedEvent:
+		return unpackRequestSecretTx(eventData, "requestReport")
+	case NetworkSecretRespondedEvent:
+		return unpackRespondSecretTx(eventData)
+	default: // HostRegisteredEvent
+		hostID, err := addressArg(eventData, "hostID")
+		if err != nil {
+			return nil, err
+		}
+		hostAddress, err := stringArg(eventData, "hostAddress")
+		if err != nil {
+			return nil, err
+		}
+		return &ethadapter.L1HostRegisteredTx{HostID: hostID, HostAddress: hostAddress}, nil
+	}
 }
 
 func (c *contractLibImpl) CreateRollup(t *ethadapter.L1RollupTx, nonce uint64) types.TxData {
@@ -206,6 +311,38 @@ func (c *contractLibImpl) GetHostAddresses() (ethereum.CallMsg, error) {
 	return ethereum.CallMsg{To: c.addr, Data: data}, nil
 }
 
+func (c *contractLibImpl) HasSecondCousinFork() (ethereum.CallMsg, error) {
+	return c.viewCallMsg(HasSecondCousinForkMethod)
+}
+
+func (c *contractLibImpl) IsWithdrawalAvailable() (ethereum.CallMsg, error) {
+	return c.viewCallMsg(IsWithdrawalAvailableMethod)
+}
+
+func (c *contractLibImpl) viewCallMsg(method string) (ethereum.CallMsg, error) {
+	data, err := c.contractABI.Pack(method)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("could not pack the call data. Cause: %w", err)
+	}
+	return ethereum.CallMsg{To: c.addr, Data: data}, nil
+}
+
+func (c *contractLibImpl) DecodeBoolCallResponse(callResponse []byte) (bool, error) {
+	// both bool getters share the same outputs
+	unpackedResponse, err := c.contractABI.Unpack(IsWithdrawalAvailableMethod, callResponse)
+	if err != nil {
+		return false, fmt.Errorf("could not unpack call response. Cause: %w", err)
+	}
+	if len(unpackedResponse) != 1 {
+		return false, fmt.Errorf("expected a single value in call response, got %d", len(unpackedResponse))
+	}
+	value, ok := unpackedResponse[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("could not convert call response to bool")
+	}
+	return value, nil
+}
+
 func (c *contractLibImpl) DecodeCallResponse(callResponse []byte) ([][]string, error) {
 	unpackedResponse, err := c.contractABI.Unpack(GetHostAddressesMethod, callResponse)
 	if err != nil {
@@ -225,94 +362,134 @@ func (c *contractLibImpl) DecodeCallResponse(callResponse []byte) ([][]string, e
 	return unpackedResponseStrings, nil
 }
 
-func (c *contractLibImpl) unpackInitSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1InitializeSecretTx {
-	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+func unpackRollupTx(args map[string]interface{}, rollupDataArg string) (*ethadapter.L1RollupTx, error) {
+	rollupData, err := stringArg(args, rollupDataArg)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	callData, found := contractCallData["_genesisAttestation"]
-	if !found {
-		panic("call data not found for requestReport")
+	zipped, err := Base64DecodeFromString(rollupData)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode rollup data. Cause: %w", err)
+	}
+	rollup, err := Decompress(zipped)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress rollup data. Cause: %w", err)
 	}
+	return &ethadapter.L1RollupTx{
+		Rollup: rollup,
+	}, nil
+}
 
-	att := Base64DecodeFromString(callData.(string))
+func unpackInitSecretTx(args map[string]interface{}, aggregatorIDArg, initSecretArg, hostAddressArg, attestationArg string) (*ethadapter.L1InitializeSecretTx, error) {
+	aggregatorID, err := addressArg(args, aggregatorIDArg)
+	if err != nil {
+		return nil, err
+	}
+	initSecret, err := bytesArg(args, initSecretArg)
+	if err != nil {
+		return nil, err
+	}
+	hostAddress, err := stringArg(args, hostAddressArg)
+	if err != nil {
+		return nil, err
+	}
+	attestation, err := stringArg(args, attestationArg)
+	if err != nil {
+		return nil, err
+	}
+	att, err := Base64DecodeFromString(attestation)
 	if err != nil {
-		c.logger.Crit("could not decode genesis attestation request.", log.ErrKey, err)
+		return nil, fmt.Errorf("could not decode genesis attestation. Cause: %w", err)
 	}
 
-	// todo - add the other fields
 	return &ethadapter.L1InitializeSecretTx{
-		Attestation: att,
-	}
+		AggregatorID:  &aggregatorID,
+		InitialSecret: initSecret,
+		HostAddress:   hostAddress,
+		Attestation:   att,
+	}, nil
 }
 
-func (c *contractLibImpl) unpackRequestSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1RequestSecretTx {
-	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+func unpackRequestSecretTx(args map[string]interface{}, requestReportArg string) (*ethadapter.L1RequestSecretTx, error) {
+	requestReport, err := stringArg(args, requestReportArg)
 	if err != nil {
-		panic(err)
-	}
-	callData, found := contractCallData["requestReport"]
-	if !found {
-		panic("call data not found for requestReport")
+		return nil, err
 	}
-
-	att := Base64DecodeFromString(callData.(string))
+	att, err := Base64DecodeFromString(requestReport)
 	if err != nil {
-		c.logger.Crit("could not decode attestation request.", log.ErrKey, err)
+		return nil, fmt.Errorf("could not decode attestation request. Cause: %w", err)
 	}
 	return &ethadapter.L1RequestSecretTx{
 		Attestation: att,
-	}
+	}, nil
 }
 
-func (c *contractLibImpl) unpackRespondSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *ethadapter.L1RespondSecretTx {
-	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+// The RespondNetworkSecret method and the NetworkSecretResponded event share their argument names.
+func unpackRespondSecretTx(args map[string]interface{}) (*ethadapter.L1RespondSecretTx, error) {
+	requesterAddr, err := addressArg(args, "requesterID")
 	if err != nil {
-		c.logger.Crit("could not unpack transaction.", log.ErrKey, err)
+		return nil, err
 	}
-
-	requesterData, found := contractCallData["requesterID"]
-	if !found {
-		c.logger.Crit("call data not found for requesterID")
+	attesterAddr, err := addressArg(args, "attesterID")
+	if err != nil {
+		return nil, err
 	}
-	requesterAddr, ok := requesterData.(gethcommon.Address)
-	if !ok {
-		c.logger.Crit("could not decode requester data")
+	responseSecretBytes, err := bytesArg(args, "responseSecret")
+	if err != nil {
+		return nil, err
+	}
+	hostAddressString, err := stringArg(args, "hostAddress")
+	if err != nil {
+		return nil, err
+	}
+	attesterSigBytes, err := bytesArg(args, "attesterSig")
+	if err != nil {
+		return nil, err
 	}
 
-	attesterData, found := contractCallData["attesterID"]
+	return &ethadapter.L1RespondSecretTx{
+		AttesterID:  attesterAddr,
+		RequesterID: requesterAddr,
+		AttesterSig: attesterSigBytes,
+		Secret:      responseSecretBytes,
+		HostAddress: hostAddressString,
+	}, nil
+}
+
+func addressArg(args map[string]interface{}, name string) (gethcommon.Address, error) {
+	arg, found := args[name]
 	if !found {
-		c.logger.Crit("call data not found for attesterID")
+		return gethcommon.Address{}, fmt.Errorf("argument %s not found", name)
 	}
-	attesterAddr, ok := attesterData.(gethcommon.Address)
+	addr, ok := arg.(gethcommon.Address)
 	if !ok {
-		c.logger.Crit("could not decode attester data")
+		return gethcommon.Address{}, fmt.Errorf("argument %s is not an address", name)
 	}
+	return addr, nil
+}
 
-	responseSecretData, found := contractCallData["responseSecret"]
+func bytesArg(args map[string]interface{}, name string) ([]byte, error) {
+	arg, found := args[name]
 	if !found {
-		c.logger.Crit("call data not found for responseSecret")
+		return nil, fmt.Errorf("argument %s not found", name)
 	}
-	responseSecretBytes, ok := responseSecretData.([]uint8)
+	b, ok := arg.([]byte)
 	if !ok {
-		c.logger.Crit("could not decode responseSecret data")
+		return nil, fmt.Errorf("argument %s is not a byte slice", name)
 	}
+	return b, nil
+}
 
-	hostAddressData, found := contractCallData["hostAddress"]
+func stringArg(args map[string]interface{}, name string) (string, error) {
+	arg, found := args[name]
 	if !found {
-		c.logger.Crit("call data not found for hostAddress")
+		return "", fmt.Errorf("argument %s not found", name)
 	}
-	hostAddressString, ok := hostAddressData.(string)
+	str, ok := arg.(string)
 	if !ok {
-		c.logger.Crit("could not decode hostAddress data")
-	}
-
-	return &ethadapter.L1RespondSecretTx{
-		AttesterID:  attesterAddr,
-		RequesterID: requesterAddr,
-		Secret:      responseSecretBytes[:],
-		HostAddress: hostAddressString,
+		return "", fmt.Errorf("argument %s is not a string", name)
 	}
+	return str, nil
 }
 
 // base64EncodeToString encodes a byte array to a string
@@ -334,12 +511,8 @@ func compress(in []byte) ([]byte, error) {
 }
 
 // Base64DecodeFromString decodes a string to a byte array
-func Base64DecodeFromString(in string) []byte {
-	bytesStr, err := base64.StdEncoding.DecodeString(in)
-	if err != nil {
-		panic(err)
-	}
-	return bytesStr
+func Base64DecodeFromString(in string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(in)
 }
 
 // Decompress the byte array using gzip
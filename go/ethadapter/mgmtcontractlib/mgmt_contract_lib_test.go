@@ -0,0 +1,131 @@
+package mgmtcontractlib
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	contractAddr = gethcommon.HexToAddress("0x1000")
+	attesterID   = gethcommon.HexToAddress("0x2000")
+	requesterID  = gethcommon.HexToAddress("0x3000")
+)
+
+func TestDecodeBlockReadsEventsAndFallsBackToCalldata(t *testing.T) {
+	lib := NewMgmtContractLib(&contractAddr, gethlog.New()).(*contractLibImpl)
+
+	zipped, err := compress([]byte("rollup"))
+	if err != nil {
+		t.Fatalf("could not compress rollup. Cause: %s", err)
+	}
+	// a rollup published through another contract, so only visible through its event
+	rollupTx := types.NewTx(&types.LegacyTx{To: &attesterID})
+	rollupReceipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{
+		eventLog(t, lib, RollupAddedEvent, gethcommon.Hash{1}, attesterID, base64EncodeToString(zipped)),
+	}}
+	// a secret response, which also registers the requester's host
+	respondTx := types.NewTx(&types.LegacyTx{To: &contractAddr})
+	respondReceipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{
+		eventLog(t, lib, NetworkSecretRespondedEvent, attesterID, requesterID, []byte("sig"), []byte("secret"), "host:1000"),
+		eventLog(t, lib, HostRegisteredEvent, requesterID, "host:1000"),
+	}}
+	// a secret request to a contract that predates the events
+	requestTx := types.NewTx(lib.CreateRequestSecret(&ethadapter.L1RequestSecretTx{Attestation: []byte("report")}, 0))
+	requestReceipt := &types.Receipt{Status: types.ReceiptStatusSuccessful}
+	// a failed secret request
+	failedTx := types.NewTx(lib.CreateRequestSecret(&ethadapter.L1RequestSecretTx{Attestation: []byte("failed")}, 1))
+	failedReceipt := &types.Receipt{Status: types.ReceiptStatusFailed}
+
+	block := types.NewBlockWithHeader(&types.Header{}).WithBody([]*types.Transaction{rollupTx, respondTx, requestTx, failedTx}, nil)
+	l1Txs, err := DecodeBlock(lib, block, types.Receipts{rollupReceipt, respondReceipt, requestReceipt, failedReceipt}, gethlog.New())
+	if err != nil {
+		t.Fatalf("could not decode block. Cause: %s", err)
+	}
+	if len(l1Txs) != 4 {
+		t.Fatalf("expected 4 management contract transactions, got %d", len(l1Txs))
+	}
+
+	if rollup, ok := l1Txs[0].(*ethadapter.L1RollupTx); !ok || string(rollup.Rollup) != "rollup" {
+		t.Errorf("expected rollup, got %+v", l1Txs[0])
+	}
+	if respond, ok := l1Txs[1].(*ethadapter.L1RespondSecretTx); !ok || respond.AttesterID != attesterID ||
+		respond.RequesterID != requesterID || string(respond.Secret) != "secret" || respond.HostAddress != "host:1000" {
+		t.Errorf("expected secret response, got %+v", l1Txs[1])
+	}
+	if host, ok := l1Txs[2].(*ethadapter.L1HostRegisteredTx); !ok || host.HostID != requesterID || host.HostAddress != "host:1000" {
+		t.Errorf("expected host registration, got %+v", l1Txs[2])
+	}
+	if request, ok := l1Txs[3].(*ethadapter.L1RequestSecretTx); !ok || !bytes.Equal(request.Attestation, []byte("report")) {
+		t.Errorf("expected secret request, got %+v", l1Txs[3])
+	}
+
+	if _, err = DecodeBlock(lib, block, types.Receipts{rollupReceipt}, gethlog.New()); err == nil {
+		t.Error("expected mismatched receipts to be rejected")
+	}
+}
+
+func TestDecodeTxReturnsErrorForMalformedCalldata(t *testing.T) {
+	lib := NewMgmtContractLib(&contractAddr, gethlog.New())
+
+	for name, data := range map[string][]byte{
+		"unknown method":   {1, 2, 3, 4},
+		"truncated method": {1, 2},
+		"truncated args":   types.NewTx(lib.CreateRequestSecret(&ethadapter.L1RequestSecretTx{Attestation: []byte("report")}, 0)).Data()[:10],
+	} {
+		if _, err := lib.DecodeTx(types.NewTx(&types.LegacyTx{To: &contractAddr, Data: data})); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestDecodeBoolCallResponse(t *testing.T) {
+	lib := NewMgmtContractLib(&contractAddr, gethlog.New()).(*contractLibImpl)
+
+	for _, expected := range []bool{true, false} {
+		response, err := lib.contractABI.Methods[HasSecondCousinForkMethod].Outputs.Pack(expected)
+		if err != nil {
+			t.Fatalf("could not pack response. Cause: %s", err)
+		}
+		value, err := lib.DecodeBoolCallResponse(response)
+		if err != nil {
+			t.Fatalf("could not decode response. Cause: %s", err)
+		}
+		if value != expected {
+			t.Errorf("expected %t, got %t", expected, value)
+		}
+	}
+
+	if _, err := lib.DecodeBoolCallResponse([]byte{1}); err == nil {
+		t.Error("expected a malformed response to be rejected")
+	}
+}
+
+// Creates a log for the given management contract event, as emitted by the contract.
+func eventLog(t *testing.T, lib *contractLibImpl, name string, args ...interface{}) *types.Log {
+	event := lib.contractABI.Events[name]
+	topics := []gethcommon.Hash{event.ID}
+	var nonIndexedArgs []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexedArgs = append(nonIndexedArgs, args[i])
+			continue
+		}
+		indexedTopics, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			t.Fatalf("could not make %s topic. Cause: %s", name, err)
+		}
+		topics = append(topics, indexedTopics[0][0])
+	}
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexedArgs...)
+	if err != nil {
+		t.Fatalf("could not pack %s event. Cause: %s", name, err)
+	}
+	return &types.Log{Address: contractAddr, Topics: topics, Data: data}
+}
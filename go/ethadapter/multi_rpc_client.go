@@ -0,0 +1,609 @@
+package ethadapter
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const (
+	l1HealthCheckInterval = 5 * time.Second // how often the endpoints of a multi-endpoint client are health-checked
+	maxL1HeadLag          = 5               // an endpoint whose head is more blocks than this behind the best head is lagging
+	maxL1HeadStaleness    = 2 * time.Minute // an endpoint whose head has not advanced for this long has stalled
+	crossCheckDepth       = 6               // how far below the lowest head block hashes are compared, to stay clear of reorgs
+)
+
+// l1Provider is an EthClient for a single L1 node, with the additional calls needed to health-check it and to
+// subscribe to it without blocking.
+type l1Provider interface {
+	EthClient
+	ChainID() (*big.Int, error)
+	SubscribeNewHead(ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// l1Endpoint tracks the health of one of the L1 nodes of a multiRPCClient.
+type l1Endpoint struct {
+	address    string
+	provider   l1Provider
+	healthy    bool
+	disagrees  bool      // whether the endpoint's blocks contradict those of the other endpoints
+	head       uint64    // the height of the endpoint's head at the last health check
+	headMoved  time.Time // when the endpoint's head last advanced
+	lastStatus string    // the reason the endpoint was last considered unhealthy, to only log changes
+}
+
+// multiRPCClient implements the EthClient interface on top of several L1 nodes, so that the host keeps following the
+// L1 if one of them lags, dies or serves blocks the others disagree with.
+//
+// Writes, and reads whose result depends on the node's view of the chain (e.g. the head, or a block by number), go to
+// the primary endpoint, which is the first healthy endpoint in order of preference, and fail over to the next healthy
+// endpoint on error. Reads whose result is verified against the request (e.g. a block by hash, or the receipts of a
+// block) are spread across the healthy endpoints. Transaction receipts cannot be verified without fetching the receipts
+// of the whole block, so they are read from the primary endpoint.
+type multiRPCClient struct {
+	endpoints []*l1Endpoint // in order of preference
+	chainID   *big.Int
+	l2ID      gethcommon.Address
+	logger    gethlog.Logger
+
+	lock     sync.RWMutex
+	primary  *l1Endpoint
+	failover chan struct{} // closed, and replaced, whenever the primary endpoint changes
+
+	nextRead uint64 // round-robin counter for the reads that are spread across endpoints
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewMultiEthClient instantiates a new ethadapter.EthClient that connects to the ethereum nodes at the given URLs,
+// failing over between them.
+func NewMultiEthClient(urls []string, timeout time.Duration, chainID int64, l2ID gethcommon.Address, logger gethlog.Logger) (EthClient, error) {
+	var endpoints []*l1Endpoint
+	for _, url := range urls {
+		rpcClient, err := connect(url, timeout)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Could not connect to L1 node at %s, it will not be used.", url), log.ErrKey, err)
+			continue
+		}
+		endpoints = append(endpoints, &l1Endpoint{
+			address:  url,
+			provider: newGethRPCClient(rpcClient, l2ID, timeout, logger),
+		})
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("unable to connect to any of the eth nodes %v", urls)
+	}
+
+	m := newMultiRPCClient(endpoints, big.NewInt(chainID), l2ID, logger)
+	m.checkHealth()
+	go m.monitorHealth()
+
+	logger.Info(fmt.Sprintf("Initialized connections to %d eth nodes", len(endpoints)))
+	return m, nil
+}
+
+func newMultiRPCClient(endpoints []*l1Endpoint, chainID *big.Int, l2ID gethcommon.Address, logger gethlog.Logger) *multiRPCClient {
+	for _, endpoint := range endpoints {
+		// endpoints are trusted until they are checked
+		endpoint.healthy = true
+		endpoint.headMoved = time.Now()
+	}
+	return &multiRPCClient{
+		endpoints: endpoints,
+		chainID:   chainID,
+		l2ID:      l2ID,
+		logger:    logger,
+		primary:   endpoints[0],
+		failover:  make(chan struct{}),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (m *multiRPCClient) BlockNumber() (uint64, error) {
+	var number uint64
+	err := m.write(func(p l1Provider) error {
+		var err error
+		number, err = p.BlockNumber()
+		return err
+	})
+	return number, err
+}
+
+func (m *multiRPCClient) BlockByHash(hash gethcommon.Hash) (*types.Block, error) {
+	var block *types.Block
+	err := m.read(func(p l1Provider) error {
+		var err error
+		block, err = p.BlockByHash(hash)
+		if err == nil && block.Hash() != hash {
+			return fmt.Errorf("requested block %s but received block %s", hash, block.Hash())
+		}
+		return err
+	})
+	return block, err
+}
+
+func (m *multiRPCClient) BlockByNumber(n *big.Int) (*types.Block, error) {
+	var block *types.Block
+	err := m.write(func(p l1Provider) error {
+		var err error
+		block, err = p.BlockByNumber(n)
+		return err
+	})
+	return block, err
+}
+
+func (m *multiRPCClient) FinalizedHeader() (*types.Header, error) {
+	var header *types.Header
+	err := m.write(func(p l1Provider) error {
+		var err error
+		header, err = p.FinalizedHeader()
+		return err
+	})
+	return header, err
+}
+
+func (m *multiRPCClient) L1HeaderByNumber(n *big.Int) (*common.L1Header, error) {
+	var header *common.L1Header
+	err := m.write(func(p l1Provider) error {
+		var err error
+		header, err = p.L1HeaderByNumber(n)
+		return err
+	})
+	return header, err
+}
+
+func (m *multiRPCClient) SendTransaction(signedTx *types.Transaction) error {
+	return m.write(func(p l1Provider) error {
+		return p.SendTransaction(signedTx)
+	})
+}
+
+func (m *multiRPCClient) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	err := m.write(func(p l1Provider) error {
+		var err error
+		receipt, err = p.TransactionReceipt(hash)
+		return err
+	})
+	return receipt, err
+}
+
+func (m *multiRPCClient) Nonce(account gethcommon.Address) (uint64, error) {
+	var nonce uint64
+	err := m.write(func(p l1Provider) error {
+		var err error
+		nonce, err = p.Nonce(account)
+		return err
+	})
+	return nonce, err
+}
+
+func (m *multiRPCClient) BalanceAt(account gethcommon.Address, blockNumber *big.Int) (*big.Int, error) {
+	var balance *big.Int
+	err := m.write(func(p l1Provider) error {
+		var err error
+		balance, err = p.BalanceAt(account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (m *multiRPCClient) Info() Info {
+	return Info{
+		L2ID: m.l2ID,
+	}
+}
+
+func (m *multiRPCClient) FetchHeadBlock() (*types.Block, error) {
+	block, err := m.BlockByNumber(nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch head block. Cause: %w", err)
+	}
+	return block, nil
+}
+
+func (m *multiRPCClient) BlocksBetween(startingBlock *types.Block, lastBlock *types.Block) []*types.Block {
+	return m.currentPrimary().provider.BlocksBetween(startingBlock, lastBlock)
+}
+
+func (m *multiRPCClient) IsBlockAncestor(block *types.Block, maybeAncestor common.L1RootHash) bool {
+	return m.currentPrimary().provider.IsBlockAncestor(block, maybeAncestor)
+}
+
+// BlockListener streams the heads of the primary endpoint. If the primary endpoint changes, or its subscription fails,
+// the listener resubscribes to the new primary endpoint, so the stream continues across failovers.
+func (m *multiRPCClient) BlockListener() (chan *types.Header, ethereum.Subscription) {
+	// as for the single-endpoint client, the buffer provides resilience in case of intermittent RPC or processing issues
+	ch := make(chan *types.Header, 100)
+	sub := &failoverSubscription{unsubscribed: make(chan struct{}), err: make(chan error)}
+	go m.forwardHeads(ch, sub)
+	return ch, sub
+}
+
+func (m *multiRPCClient) BlocksWithReceipts(from *big.Int, count uint64) ([]*types.Block, []types.Receipts, error) {
+	var blocks []*types.Block
+	var receipts []types.Receipts
+	err := m.write(func(p l1Provider) error {
+		var err error
+		blocks, receipts, err = p.BlocksWithReceipts(from, count)
+		return err
+	})
+	return blocks, receipts, err
+}
+
+func (m *multiRPCClient) BlockReceipts(block *types.Block) (types.Receipts, error) {
+	var receipts types.Receipts
+	err := m.read(func(p l1Provider) error {
+		var err error
+		receipts, err = p.BlockReceipts(block)
+		if err != nil {
+			return err
+		}
+		return verifyReceipts(block, receipts)
+	})
+	return receipts, err
+}
+
+func (m *multiRPCClient) CallContract(msg ethereum.CallMsg) ([]byte, error) {
+	var result []byte
+	err := m.write(func(p l1Provider) error {
+		var err error
+		result, err = p.CallContract(msg)
+		return err
+	})
+	return result, err
+}
+
+func (m *multiRPCClient) EstimateGasAndGasPrice(txData types.TxData, from gethcommon.Address) (types.TxData, error) {
+	var estimated types.TxData
+	err := m.write(func(p l1Provider) error {
+		var err error
+		estimated, err = p.EstimateGasAndGasPrice(txData, from)
+		return err
+	})
+	return estimated, err
+}
+
+func (m *multiRPCClient) EstimateDynamicFeeTx(txData types.TxData, from gethcommon.Address) (*types.DynamicFeeTx, error) {
+	var estimated *types.DynamicFeeTx
+	err := m.write(func(p l1Provider) error {
+		var err error
+		estimated, err = p.EstimateDynamicFeeTx(txData, from)
+		return err
+	})
+	return estimated, err
+}
+
+func (m *multiRPCClient) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+		for _, endpoint := range m.endpoints {
+			endpoint.provider.Stop()
+		}
+	})
+}
+
+// EthClient returns the underlying client of the current primary endpoint.
+func (m *multiRPCClient) EthClient() *ethclient.Client {
+	return m.currentPrimary().provider.EthClient()
+}
+
+// Runs the call against the usable endpoints in order of preference, until one succeeds.
+func (m *multiRPCClient) write(call func(l1Provider) error) error {
+	var err error
+	for _, endpoint := range m.usableEndpoints() {
+		if err = call(endpoint.provider); err == nil {
+			return nil
+		}
+		m.logger.Debug("L1 node failed to serve request, trying next node.", "endpoint", endpoint.address, log.ErrKey, err)
+	}
+	return err
+}
+
+// Runs the call against the usable endpoints in turn, starting from the next endpoint in the round-robin, until one
+// succeeds.
+func (m *multiRPCClient) read(call func(l1Provider) error) error {
+	endpoints := m.usableEndpoints()
+	start := int(atomic.AddUint64(&m.nextRead, 1) % uint64(len(endpoints)))
+	var err error
+	for i := range endpoints {
+		endpoint := endpoints[(start+i)%len(endpoints)]
+		if err = call(endpoint.provider); err == nil {
+			return nil
+		}
+		m.logger.Debug("L1 node failed to serve request, trying next node.", "endpoint", endpoint.address, log.ErrKey, err)
+	}
+	return err
+}
+
+// Returns the healthy endpoints in order of preference. If no endpoint is healthy, all the endpoints are returned, as
+// trying an unhealthy endpoint beats not trying at all.
+func (m *multiRPCClient) usableEndpoints() []*l1Endpoint {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var healthy []*l1Endpoint
+	for _, endpoint := range m.endpoints {
+		if endpoint.healthy {
+			healthy = append(healthy, endpoint)
+		}
+	}
+	if len(healthy) == 0 {
+		return m.endpoints
+	}
+	return healthy
+}
+
+func (m *multiRPCClient) currentPrimary() *l1Endpoint {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.primary
+}
+
+func (m *multiRPCClient) monitorHealth() {
+	ticker := time.NewTicker(l1HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.checkHealth()
+		case <-m.stopCh:
+			return
+		}
+	}
+}
+
+// Checks that each endpoint is on the expected chain and that its head is fresh, then that the healthy endpoints
+// agree on the chain, and elects the primary endpoint. Only the health-checking goroutine updates the endpoints, so
+// the L1 nodes are queried without holding the lock.
+func (m *multiRPCClient) checkHealth() {
+	statuses := make([]string, len(m.endpoints))
+	heads := make([]uint64, len(m.endpoints))
+	var bestHead uint64
+	for i, endpoint := range m.endpoints {
+		heads[i], statuses[i] = m.probe(endpoint)
+		if statuses[i] == "" && heads[i] > bestHead {
+			bestHead = heads[i]
+		}
+	}
+
+	now := time.Now()
+	for i, endpoint := range m.endpoints {
+		if statuses[i] != "" {
+			continue
+		}
+		if heads[i] > endpoint.head {
+			endpoint.head = heads[i]
+			endpoint.headMoved = now
+		}
+		switch {
+		case heads[i]+maxL1HeadLag < bestHead:
+			statuses[i] = fmt.Sprintf("head %d is more than %d blocks behind head %d", heads[i], maxL1HeadLag, bestHead)
+		case now.Sub(endpoint.headMoved) > maxL1HeadStaleness:
+			statuses[i] = fmt.Sprintf("head %d has not advanced for %s", heads[i], now.Sub(endpoint.headMoved).Round(time.Second))
+		}
+	}
+
+	m.crossCheck(heads, statuses)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var primary *l1Endpoint
+	for i, endpoint := range m.endpoints {
+		endpoint.healthy = statuses[i] == ""
+		if statuses[i] != endpoint.lastStatus {
+			if endpoint.healthy {
+				m.logger.Info("L1 node is healthy again.", "endpoint", endpoint.address)
+			} else {
+				m.logger.Warn("L1 node is unhealthy. Cause: "+statuses[i], "endpoint", endpoint.address)
+			}
+			endpoint.lastStatus = statuses[i]
+		}
+		if endpoint.healthy && primary == nil {
+			primary = endpoint
+		}
+	}
+	if primary == nil {
+		m.logger.Error("No healthy L1 node. Keeping the current primary L1 node.", "endpoint", m.primary.address)
+		return
+	}
+	if primary != m.primary {
+		m.logger.Warn("Failing over to another L1 node.", "from", m.primary.address, "to", primary.address)
+		m.primary = primary
+		close(m.failover)
+		m.failover = make(chan struct{})
+	}
+}
+
+// Returns the height of the endpoint's head, or the reason the endpoint is unhealthy.
+func (m *multiRPCClient) probe(endpoint *l1Endpoint) (uint64, string) {
+	chainID, err := endpoint.provider.ChainID()
+	if err != nil {
+		return 0, fmt.Sprintf("could not retrieve chain ID - %s", err)
+	}
+	if chainID.Cmp(m.chainID) != 0 {
+		return 0, fmt.Sprintf("chain ID %d does not match expected chain ID %d", chainID, m.chainID)
+	}
+	head, err := endpoint.provider.BlockByNumber(nil)
+	if err != nil {
+		return 0, fmt.Sprintf("could not retrieve head block - %s", err)
+	}
+	return head.NumberU64(), ""
+}
+
+// Compares the hashes of the healthy endpoints' blocks at a height that is deep enough not to be reorged. The endpoints
+// outside the largest group of agreeing endpoints are marked as unhealthy. Ties are broken in favour of the group
+// containing the most-preferred endpoint.
+func (m *multiRPCClient) crossCheck(heads []uint64, statuses []string) {
+	var lowestHead uint64
+	var checked []int
+	for i := range m.endpoints {
+		if statuses[i] != "" {
+			continue
+		}
+		if len(checked) == 0 || heads[i] < lowestHead {
+			lowestHead = heads[i]
+		}
+		checked = append(checked, i)
+	}
+	if len(checked) < 2 || lowestHead < crossCheckDepth {
+		return
+	}
+	height := big.NewInt(int64(lowestHead - crossCheckDepth))
+
+	hashes := map[int]gethcommon.Hash{}
+	votes := map[gethcommon.Hash]int{}
+	for _, i := range checked {
+		block, err := m.endpoints[i].provider.BlockByNumber(height)
+		if err != nil {
+			statuses[i] = fmt.Sprintf("could not retrieve block %d - %s", height, err)
+			continue
+		}
+		hashes[i] = block.Hash()
+		votes[block.Hash()]++
+	}
+
+	var agreedHash gethcommon.Hash
+	for _, i := range checked {
+		if hash, found := hashes[i]; found && votes[hash] > votes[agreedHash] {
+			agreedHash = hash
+		}
+	}
+	for i, hash := range hashes {
+		disagrees := hash != agreedHash
+		if disagrees {
+			statuses[i] = fmt.Sprintf("block %d has hash %s, but the other L1 nodes agree on hash %s", height, hash, agreedHash)
+			if !m.endpoints[i].disagrees {
+				m.logger.Error("L1 node serves blocks that contradict the other L1 nodes.", "endpoint", m.endpoints[i].address,
+					log.BlockHeightKey, height, log.BlockHashKey, hash)
+			}
+		}
+		m.endpoints[i].disagrees = disagrees
+	}
+}
+
+// Forwards the heads of the primary endpoint to the channel until the subscription is cancelled, resubscribing
+// whenever the primary endpoint changes or its subscription fails.
+func (m *multiRPCClient) forwardHeads(ch chan *types.Header, sub *failoverSubscription) {
+	for {
+		m.lock.RLock()
+		primary, failover := m.primary, m.failover
+		m.lock.RUnlock()
+
+		heads := make(chan *types.Header)
+		primarySub, err := primary.provider.SubscribeNewHead(heads)
+		if err != nil {
+			m.logger.Warn("Could not subscribe for new head blocks.", "endpoint", primary.address, log.ErrKey, err)
+			select {
+			case <-time.After(connRetryInterval):
+			case <-failover:
+			case <-sub.unsubscribed:
+				return
+			case <-m.stopCh:
+				return
+			}
+			continue
+		}
+
+		resubscribe := m.forwardUntilInterrupted(heads, primarySub, failover, ch, sub)
+		primarySub.Unsubscribe()
+		if !resubscribe {
+			return
+		}
+	}
+}
+
+// Forwards the heads from the primary endpoint's subscription. Returns whether the listener should resubscribe.
+func (m *multiRPCClient) forwardUntilInterrupted(heads chan *types.Header, primarySub ethereum.Subscription, failover chan struct{}, ch chan *types.Header, sub *failoverSubscription) bool {
+	for {
+		select {
+		case head := <-heads:
+			select {
+			case ch <- head:
+			case <-sub.unsubscribed:
+				return false
+			case <-m.stopCh:
+				return false
+			}
+		case err := <-primarySub.Err():
+			m.logger.Warn("L1 block subscription failed, resubscribing.", log.ErrKey, err)
+			return true
+		case <-failover:
+			m.logger.Info("Resubscribing to new head blocks after failing over to another L1 node.")
+			return true
+		case <-sub.unsubscribed:
+			return false
+		case <-m.stopCh:
+			return false
+		}
+	}
+}
+
+// failoverSubscription is the ethereum.Subscription returned by multiRPCClient.BlockListener. It never fails, since
+// failures of the underlying subscriptions are handled by resubscribing.
+type failoverSubscription struct {
+	unsubscribed chan struct{}
+	err          chan error
+	once         sync.Once
+}
+
+func (s *failoverSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.unsubscribed)
+		close(s.err)
+	})
+}
+
+func (s *failoverSubscription) Err() <-chan error {
+	return s.err
+}
+
+// Checks that the receipts are those of the block's transactions, as committed to by the block's receipt root.
+func verifyReceipts(block *types.Block, receipts types.Receipts) error {
+	if len(receipts) != len(block.Transactions()) {
+		return fmt.Errorf("block %s has %d transactions but received %d receipts", block.Hash(), len(block.Transactions()), len(receipts))
+	}
+	for i, tx := range block.Transactions() {
+		if receipts[i].TxHash != tx.Hash() {
+			return fmt.Errorf("receipt %d of block %s is for transaction %s, not %s", i, block.Hash(), receipts[i].TxHash, tx.Hash())
+		}
+	}
+	if receiptHash := types.DeriveSha(receipts, trie.NewStackTrie(nil)); receiptHash != block.ReceiptHash() {
+		return fmt.Errorf("receipts of block %s have root %s, but the block's receipt root is %s", block.Hash(), receiptHash, block.ReceiptHash())
+	}
+	return nil
+}
+
+// L1NodeURL returns the URL of the L1 node at the given address, which is either a URL (e.g. `https://eth.example.com`),
+// a host:port pair, or a host, in which case the default port is used. Nodes not given by URL are connected to over
+// websockets.
+func L1NodeURL(address string, defaultPort uint) (string, error) {
+	if strings.Contains(address, "://") {
+		return address, nil
+	}
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		// the address has no port
+		return fmt.Sprintf("ws://%s:%d", address, defaultPort), nil //nolint:nilerr
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return "", fmt.Errorf("invalid port in L1 node address %s. Cause: %w", address, err)
+	}
+	return fmt.Sprintf("ws://%s:%d", host, port), nil
+}
@@ -0,0 +1,208 @@
+package ethadapter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const testL1ChainID = 1337
+
+func TestMultiRPCClientFailsOverFromUnhealthyEndpoints(t *testing.T) {
+	chain := newFakeL1Chain(20, 20)
+
+	testCases := map[string]*fakeL1Provider{
+		"unreachable":    {chainID: testL1ChainID, blocks: chain, down: true},
+		"wrong chain":    {chainID: testL1ChainID + 1, blocks: chain},
+		"lagging behind": {chainID: testL1ChainID, blocks: chain[:20-maxL1HeadLag-1]},
+	}
+
+	for name, unhealthy := range testCases {
+		healthy := &fakeL1Provider{chainID: testL1ChainID, blocks: chain}
+		client := newTestMultiRPCClient(unhealthy, healthy)
+
+		client.checkHealth()
+
+		if primary := client.currentPrimary().provider; primary != healthy {
+			t.Errorf("%s: expected to fail over to the healthy endpoint", name)
+		}
+		head, err := client.FetchHeadBlock()
+		if err != nil {
+			t.Fatalf("%s: could not fetch head block. Cause: %s", name, err)
+		}
+		if head.Hash() != chain[19].Hash() {
+			t.Errorf("%s: expected head %s, got %s", name, chain[19].Hash(), head.Hash())
+		}
+	}
+}
+
+func TestMultiRPCClientExcludesEndpointsThatContradictTheOthers(t *testing.T) {
+	chain := newFakeL1Chain(20, 20)
+	lying := &fakeL1Provider{chainID: testL1ChainID, blocks: newFakeL1Chain(20, 10)}
+	honest := []*fakeL1Provider{{chainID: testL1ChainID, blocks: chain}, {chainID: testL1ChainID, blocks: chain}}
+	client := newTestMultiRPCClient(lying, honest[0], honest[1])
+
+	client.checkHealth()
+
+	if primary := client.currentPrimary().provider; primary == lying {
+		t.Error("expected the endpoint contradicting the others not to be the primary")
+	}
+	for _, endpoint := range client.usableEndpoints() {
+		if endpoint.provider == lying {
+			t.Error("expected the endpoint contradicting the others not to be used")
+		}
+	}
+
+	// the endpoint is used again once it agrees with the others
+	lying.blocks = chain
+	client.checkHealth()
+	if primary := client.currentPrimary().provider; primary != lying {
+		t.Error("expected the endpoint to be the primary again")
+	}
+}
+
+func TestMultiRPCClientBlockListenerSurvivesFailover(t *testing.T) {
+	chain := newFakeL1Chain(20, 20)
+	first := &fakeL1Provider{chainID: testL1ChainID, blocks: chain, heads: make(chan *types.Header)}
+	second := &fakeL1Provider{chainID: testL1ChainID, blocks: chain, heads: make(chan *types.Header)}
+	client := newTestMultiRPCClient(first, second)
+	defer client.Stop()
+
+	heads, sub := client.BlockListener()
+	defer sub.Unsubscribe()
+
+	first.heads <- chain[18].Header()
+	awaitHead(t, heads, chain[18])
+
+	first.down = true
+	client.checkHealth()
+
+	second.heads <- chain[19].Header()
+	awaitHead(t, heads, chain[19])
+}
+
+func TestMultiRPCClientRejectsReceiptsThatDoNotMatchTheBlock(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21_000, To: &gethcommon.Address{1}})
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21_000, TxHash: tx.Hash(), Logs: []*types.Log{}}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1)}, []*types.Transaction{tx}, nil, []*types.Receipt{receipt}, trie.NewStackTrie(nil))
+
+	forgedReceipt := &types.Receipt{Status: types.ReceiptStatusFailed, CumulativeGasUsed: 21_000, TxHash: tx.Hash(), Logs: []*types.Log{}}
+	lying := &fakeL1Provider{chainID: testL1ChainID, receipts: types.Receipts{forgedReceipt}}
+	honest := &fakeL1Provider{chainID: testL1ChainID, receipts: types.Receipts{receipt}}
+	client := newTestMultiRPCClient(lying, honest)
+
+	// whichever endpoint the read starts from, the forged receipts are never returned
+	for i := 0; i < 2; i++ {
+		receipts, err := client.BlockReceipts(block)
+		if err != nil {
+			t.Fatalf("could not fetch block receipts. Cause: %s", err)
+		}
+		if receipts[0].Status != types.ReceiptStatusSuccessful {
+			t.Fatal("expected receipts that do not match the block's receipt root to be rejected")
+		}
+	}
+
+	honest.receipts = types.Receipts{forgedReceipt}
+	if _, err := client.BlockReceipts(block); err == nil {
+		t.Fatal("expected an error if no endpoint serves receipts that match the block's receipt root")
+	}
+}
+
+func newTestMultiRPCClient(providers ...*fakeL1Provider) *multiRPCClient {
+	endpoints := make([]*l1Endpoint, len(providers))
+	for i, provider := range providers {
+		endpoints[i] = &l1Endpoint{address: string(rune('a' + i)), provider: provider}
+	}
+	return newMultiRPCClient(endpoints, big.NewInt(testL1ChainID), gethcommon.Address{}, gethlog.New())
+}
+
+func awaitHead(t *testing.T, heads chan *types.Header, expected *types.Block) {
+	select {
+	case head := <-heads:
+		if head.Hash() != expected.Hash() {
+			t.Fatalf("expected head %s, got %s", expected.Hash(), head.Hash())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for head %s", expected.Hash())
+	}
+}
+
+// Creates a chain of the given length, whose blocks from the given height onwards differ from those of other chains.
+func newFakeL1Chain(length int, forkHeight int) []*types.Block {
+	blocks := make([]*types.Block, length)
+	parentHash := gethcommon.Hash{}
+	for i := range blocks {
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: parentHash}
+		if i >= forkHeight {
+			header.Extra = []byte("fork")
+		}
+		blocks[i] = types.NewBlockWithHeader(header)
+		parentHash = blocks[i].Hash()
+	}
+	return blocks
+}
+
+// fakeL1Provider serves a fixed chain.
+type fakeL1Provider struct {
+	*gethRPCClient // nil, so the calls the multiRPCClient should not make panic
+	chainID        int64
+	blocks         []*types.Block
+	down           bool
+	heads          chan *types.Header // the heads to stream to subscribers
+	receipts       types.Receipts     // the receipts to serve for any block
+}
+
+func (p *fakeL1Provider) ChainID() (*big.Int, error) {
+	if p.down {
+		return nil, errors.New("connection refused")
+	}
+	return big.NewInt(p.chainID), nil
+}
+
+func (p *fakeL1Provider) BlockByNumber(n *big.Int) (*types.Block, error) {
+	if p.down {
+		return nil, errors.New("connection refused")
+	}
+	if n == nil {
+		return p.blocks[len(p.blocks)-1], nil
+	}
+	if n.Int64() >= int64(len(p.blocks)) {
+		return nil, ethereum.NotFound
+	}
+	return p.blocks[n.Int64()], nil
+}
+
+func (p *fakeL1Provider) SubscribeNewHead(ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if p.down {
+		return nil, errors.New("connection refused")
+	}
+	sub := &failoverSubscription{unsubscribed: make(chan struct{}), err: make(chan error)}
+	go func() {
+		for {
+			select {
+			case head := <-p.heads:
+				ch <- head
+			case <-sub.unsubscribed:
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func (p *fakeL1Provider) BlockReceipts(*types.Block) (types.Receipts, error) {
+	if p.down {
+		return nil, errors.New("connection refused")
+	}
+	return p.receipts, nil
+}
+
+func (p *fakeL1Provider) Stop() {}
@@ -0,0 +1,205 @@
+package ethadapter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/host"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	maxPolledBlocks      = 20  // the maximum number of blocks fetched in a single batch
+	polledReceiptsToKeep = 128 // the number of streamed blocks whose receipts are kept for the consumer
+)
+
+func NewPollingBlockProvider(ethClient EthClient, pollInterval time.Duration, logger gethlog.Logger) *PollingBlockProvider {
+	return &PollingBlockProvider{
+		ethClient:    ethClient,
+		pollInterval: pollInterval,
+		logger:       logger,
+		receipts:     map[gethcommon.Hash]types.Receipts{},
+	}
+}
+
+// PollingBlockProvider streams blocks from the ethereum L1 client in the same order as the EthBlockProvider, but polls
+// the client for new blocks instead of subscribing to them, for L1 nodes that are only reachable over HTTP, or that
+// throttle or drop websocket subscriptions. Blocks are fetched in batches along with their receipts, and the receipts
+// of the most recently streamed blocks are kept so the consumer does not have to fetch them again.
+type PollingBlockProvider struct {
+	ethClient    EthClient
+	pollInterval time.Duration
+	logger       gethlog.Logger
+
+	receiptsLock  sync.RWMutex
+	receipts      map[gethcommon.Hash]types.Receipts
+	receiptsOrder []gethcommon.Hash // the hashes of the blocks whose receipts are kept, oldest first
+}
+
+// StartStreamingFromHash will look up the hash block, find the appropriate height (LCA if there have been forks) and
+// then call StartStreamingFromHeight from there
+func (p *PollingBlockProvider) StartStreamingFromHash(latestHash gethcommon.Hash) (*host.BlockStream, error) {
+	ancestorBlk, err := latestCanonAncestor(p.ethClient, latestHash)
+	if err != nil {
+		return nil, err
+	}
+	return p.StartStreamingFromHeight(ancestorBlk.Number())
+}
+
+// StartStreamingFromHeight will start streaming from the given height
+// returning the fresh channel (the next block will be the requested height) and a cancel function to kill the stream
+func (p *PollingBlockProvider) StartStreamingFromHeight(height *big.Int) (*host.BlockStream, error) {
+	// block heights start at 1
+	if height.Cmp(one) < 0 {
+		height = one
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	streamCh := make(chan *types.Block)
+	go p.streamBlocks(ctx, new(big.Int).Set(height), streamCh)
+
+	return &host.BlockStream{Stream: streamCh, Stop: cancel}, nil
+}
+
+func (p *PollingBlockProvider) IsLive(h gethcommon.Hash) bool {
+	l1Head, err := p.ethClient.FetchHeadBlock()
+	return err == nil && h == l1Head.Hash()
+}
+
+func (p *PollingBlockProvider) Receipts(hash gethcommon.Hash) (types.Receipts, bool) {
+	p.receiptsLock.RLock()
+	defer p.receiptsLock.RUnlock()
+	receipts, found := p.receipts[hash]
+	return receipts, found
+}
+
+// streamBlocks is the main loop. It should be run in a separate go routine. It streams the blocks from the requested
+// height, polling for new blocks once it has caught up with the head.
+func (p *PollingBlockProvider) streamBlocks(ctx context.Context, nextHeight *big.Int, streamCh chan *types.Block) {
+	var latestSent *types.Header // most recently sent block
+
+	for {
+		blocks, receipts, err := p.fetchNextCanonicalBlocks(ctx, nextHeight, latestSent)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			p.logger.Warn("unexpected error while preparing blocks to stream, will retry after the poll interval", log.ErrKey, err)
+			if !p.sleep(ctx) {
+				return
+			}
+			continue
+		}
+
+		for i, block := range blocks {
+			p.keepReceipts(block.Hash(), receipts[i])
+			p.logger.Trace("blockProvider streaming block", "height", block.Number(), "hash", block.Hash())
+			select {
+			case streamCh <- block: // we block here until consumer takes it
+			case <-ctx.Done():
+				return
+			}
+			latestSent = block.Header()
+		}
+		nextHeight = increment(new(big.Int).Set(latestSent.Number))
+	}
+}
+
+// fetchNextCanonicalBlocks waits until the L1 head reaches the given height, then returns the next canonical blocks the
+// consumer needs to see, along with their receipts. If there has been a fork since the latest block that was sent,
+// the blocks start after the most recent canonical block that was sent.
+func (p *PollingBlockProvider) fetchNextCanonicalBlocks(ctx context.Context, nextHeight *big.Int, latestSent *types.Header) ([]*types.Block, []types.Receipts, error) {
+	head, err := p.awaitHeight(ctx, nextHeight.Uint64())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blocks, receipts, err := p.ethClient.BlocksWithReceipts(nextHeight, batchSize(nextHeight, head))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch blocks from height=%d - %w", nextHeight, err)
+	}
+	if len(blocks) == 0 {
+		return nil, nil, fmt.Errorf("no block found at height=%d", nextHeight)
+	}
+
+	if latestSent != nil && blocks[0].ParentHash() != latestSent.Hash() {
+		latestCanon, err := latestCanonAncestor(p.ethClient, latestSent.Hash())
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not find ancestor on canonical chain for hash=%s - %w", latestSent.Hash(), err)
+		}
+		forkHeight := increment(new(big.Int).Set(latestCanon.Number()))
+		blocks, receipts, err = p.ethClient.BlocksWithReceipts(forkHeight, batchSize(forkHeight, head))
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not fetch blocks after canon fork branch, height=%d - %w", forkHeight, err)
+		}
+		if len(blocks) == 0 {
+			return nil, nil, fmt.Errorf("no block found after canon fork branch, height=%d", forkHeight)
+		}
+	}
+
+	// the head may have been reorged while the batch was fetched, so we only keep the blocks that are chained
+	for i := 1; i < len(blocks); i++ {
+		if blocks[i].ParentHash() != blocks[i-1].Hash() {
+			return blocks[:i], receipts[:i], nil
+		}
+	}
+	return blocks, receipts, nil
+}
+
+// Polls the L1 head until it reaches the given height, and returns the head height.
+func (p *PollingBlockProvider) awaitHeight(ctx context.Context, height uint64) (uint64, error) {
+	for {
+		head, err := p.ethClient.BlockNumber()
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve head block number - %w", err)
+		}
+		if head >= height {
+			return head, nil
+		}
+		if !p.sleep(ctx) {
+			return 0, fmt.Errorf("context closed before block was received")
+		}
+	}
+}
+
+// Waits for the poll interval. Returns false if the context is closed first.
+func (p *PollingBlockProvider) sleep(ctx context.Context) bool {
+	select {
+	case <-time.After(p.pollInterval):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (p *PollingBlockProvider) keepReceipts(hash gethcommon.Hash, receipts types.Receipts) {
+	p.receiptsLock.Lock()
+	defer p.receiptsLock.Unlock()
+	if _, found := p.receipts[hash]; found {
+		return
+	}
+	p.receipts[hash] = receipts
+	p.receiptsOrder = append(p.receiptsOrder, hash)
+	if len(p.receiptsOrder) > polledReceiptsToKeep {
+		delete(p.receipts, p.receiptsOrder[0])
+		p.receiptsOrder = p.receiptsOrder[1:]
+	}
+}
+
+// Returns the number of blocks to fetch from the given height, up to the head.
+func batchSize(from *big.Int, head uint64) uint64 {
+	if head < from.Uint64() {
+		return 1
+	}
+	size := head - from.Uint64() + 1
+	if size > maxPolledBlocks {
+		return maxPolledBlocks
+	}
+	return size
+}
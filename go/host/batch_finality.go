@@ -0,0 +1,108 @@
+package host
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+)
+
+// Records which batches are safe and finalized once the L1 block has been submitted to the enclave. The batches
+// included in the latest canonical rollup published up to the block are safe, and those included in the latest
+// canonical rollup published up to the latest finalized L1 block are finalized.
+func (h *host) updateBatchFinality(block *types.Block, rollups []*common.ExtRollup) error {
+	if err := h.updateSafeBatch(block, rollups); err != nil {
+		return err
+	}
+
+	finalizedHeader := h.finalizedL1Header(block)
+	if finalizedHeader == nil {
+		return nil
+	}
+	finalizedHash := finalizedHeader.Hash()
+	if h.l1Finality == nil && finalizedHash != h.submittedFinalizedL1Block {
+		// an enclave that validates L1 blocks against the beacon chain knows every block it ingests is finalized
+		if err := h.enclaveClient.SubmitFinalizedL1Block(finalizedHash); err != nil {
+			h.logger.Warn("Could not submit finalized L1 block to the enclave.", log.BlockHashKey, finalizedHash, log.ErrKey, err)
+		} else {
+			h.submittedFinalizedL1Block = finalizedHash
+		}
+	}
+
+	finalizedRollup, err := h.db.GetBlockHeadRollup(finalizedHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// no rollup had been published by the finalized block
+			return nil
+		}
+		return fmt.Errorf("could not retrieve head rollup of finalized L1 block. Cause: %w", err)
+	}
+	if err = h.db.SetFinalizedBatch(finalizedRollup.HeadBatchHash); err != nil {
+		return fmt.Errorf("could not store finalized batch. Cause: %w", err)
+	}
+	return nil
+}
+
+// Applies the fork-choice rule of the management contract and the enclave to the rollups published in the block, and
+// records the head batch of the resulting head rollup as the safe batch.
+func (h *host) updateSafeBatch(block *types.Block, rollups []*common.ExtRollup) error {
+	headRollup, err := h.db.GetBlockHeadRollup(block.ParentHash())
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not retrieve head rollup of parent block. Cause: %w", err)
+	}
+	for _, rollup := range rollups {
+		if extendsRollup(rollup.Header, headRollup) {
+			headRollup = rollup.Header
+		}
+	}
+	if headRollup == nil {
+		return nil
+	}
+
+	if err = h.db.AddBlockHeadRollup(block.Hash(), headRollup); err != nil {
+		return err
+	}
+	if err = h.db.SetSafeBatch(headRollup.HeadBatchHash); err != nil {
+		return fmt.Errorf("could not store safe batch. Cause: %w", err)
+	}
+	return nil
+}
+
+// Returns the header of the latest finalized L1 block up to the given block, or nil if the L1 node does not report a
+// finalized block.
+func (h *host) finalizedL1Header(block *types.Block) *types.Header {
+	if h.l1Finality != nil {
+		// the block was proven to be finalized before it was submitted to the enclave
+		return block.Header()
+	}
+
+	// we only ask the L1 node again once the blocks we process catch up with the finalized block
+	if h.l1FinalizedHeader == nil || h.l1FinalizedHeader.Number.Uint64() < block.NumberU64() {
+		header, err := h.ethClient.FinalizedHeader()
+		if err != nil {
+			h.logger.Debug("Could not fetch finalized L1 block.", log.ErrKey, err)
+		} else {
+			h.l1FinalizedHeader = header
+		}
+	}
+	if h.l1FinalizedHeader == nil {
+		return nil
+	}
+	if h.l1FinalizedHeader.Number.Uint64() >= block.NumberU64() {
+		// the block is canonical and not after the finalized block, so it is finalized
+		return block.Header()
+	}
+	return h.l1FinalizedHeader
+}
+
+// Returns whether the rollup becomes the new head rollup. The host may not have processed the L1 blocks the earlier
+// rollups were published in, so without a head rollup any rollup is taken.
+func extendsRollup(rollup *common.RollupHeader, headRollup *common.RollupHeader) bool {
+	if headRollup == nil {
+		return true
+	}
+	return common.ExtendsHeadRollup(rollup, headRollup)
+}
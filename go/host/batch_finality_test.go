@@ -0,0 +1,144 @@
+package host
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/host/db"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestSafeBatchFollowsCanonicalRollups(t *testing.T) {
+	chain := newFinalizingL1Chain(5)
+	h, _ := newBatchFinalityTestHost(t, chain)
+	batches := storeTestBatches(t, h.db, 4)
+
+	genesis := testRollup(0, nil, batches[0])
+	processTestBlock(t, h, chain.block(1), genesis)
+	assertBatchHeader(t, "safe", h.db.GetSafeBatchHeader, batches[0])
+
+	// a rollup that does not extend the head rollup competes with it, and is ignored
+	next := testRollup(1, genesis, batches[2])
+	competing := testRollup(1, &common.ExtRollup{Header: &common.RollupHeader{Number: big.NewInt(0)}}, batches[3])
+	processTestBlock(t, h, chain.block(2), competing, next)
+	assertBatchHeader(t, "safe", h.db.GetSafeBatchHeader, batches[2])
+
+	// the head rollup is carried over blocks without rollups
+	processTestBlock(t, h, chain.block(3))
+	assertBatchHeader(t, "safe", h.db.GetSafeBatchHeader, batches[2])
+	processTestBlock(t, h, chain.block(4), testRollup(2, next, batches[3]))
+	assertBatchHeader(t, "safe", h.db.GetSafeBatchHeader, batches[3])
+}
+
+func TestFinalizedBatchFollowsFinalizedL1Block(t *testing.T) {
+	chain := newFinalizingL1Chain(5)
+	h, enclave := newBatchFinalityTestHost(t, chain)
+	batches := storeTestBatches(t, h.db, 2)
+
+	genesis := testRollup(0, nil, batches[0])
+	processTestBlock(t, h, chain.block(1), genesis)
+	if _, err := h.db.GetFinalizedBatchHeader(); err == nil {
+		t.Fatal("expected no batch to be finalized before any L1 block is")
+	}
+
+	// the batches of the rollup in the block after the finalized block are safe, but not finalized
+	chain.finalized = 1
+	processTestBlock(t, h, chain.block(2), testRollup(1, genesis, batches[1]))
+	assertBatchHeader(t, "safe", h.db.GetSafeBatchHeader, batches[1])
+	assertBatchHeader(t, "finalized", h.db.GetFinalizedBatchHeader, batches[0])
+	if enclave.finalized != chain.block(1).Hash() {
+		t.Fatalf("expected the finalized L1 block to be submitted to the enclave, got %s", enclave.finalized)
+	}
+
+	// a block below the finalized block is finalized itself
+	chain.finalized = 4
+	processTestBlock(t, h, chain.block(3))
+	assertBatchHeader(t, "finalized", h.db.GetFinalizedBatchHeader, batches[1])
+	if enclave.finalized != chain.block(3).Hash() {
+		t.Fatalf("expected the processed block to be submitted to the enclave as finalized, got %s", enclave.finalized)
+	}
+}
+
+func newBatchFinalityTestHost(t *testing.T, chain *finalizingL1Chain) (*host, *fakeFinalizedBlockEnclave) {
+	t.Helper()
+	enclave := &fakeFinalizedBlockEnclave{}
+	return &host{
+		ethClient:     chain,
+		enclaveClient: enclave,
+		db:            db.NewInMemoryDB(nil, nil),
+		logger:        gethlog.New(),
+	}, enclave
+}
+
+func processTestBlock(t *testing.T, h *host, block *types.Block, rollups ...*common.ExtRollup) {
+	t.Helper()
+	if err := h.updateBatchFinality(block, rollups); err != nil {
+		t.Fatalf("could not update safe and finalized batches. Cause: %s", err)
+	}
+}
+
+// Stores the given number of consecutive batches, and returns their headers.
+func storeTestBatches(t *testing.T, database *db.DB, count int) []*common.BatchHeader {
+	t.Helper()
+	headers := make([]*common.BatchHeader, count)
+	for i := range headers {
+		headers[i] = &common.BatchHeader{Number: big.NewInt(int64(i))}
+		if err := database.AddBatchHeader(&common.ExtBatch{Header: headers[i]}); err != nil {
+			t.Fatalf("could not store batch. Cause: %s", err)
+		}
+	}
+	return headers
+}
+
+// Returns a rollup with the given number, built on the given parent rollup, whose head batch is the given batch.
+func testRollup(number int64, parent *common.ExtRollup, headBatch *common.BatchHeader) *common.ExtRollup {
+	header := &common.RollupHeader{Number: big.NewInt(number), HeadBatchHash: headBatch.Hash()}
+	if parent != nil {
+		header.ParentHash = parent.Header.Hash()
+	}
+	return &common.ExtRollup{Header: header}
+}
+
+func assertBatchHeader(t *testing.T, name string, get func() (*common.BatchHeader, error), expected *common.BatchHeader) {
+	t.Helper()
+	header, err := get()
+	if err != nil {
+		t.Fatalf("could not retrieve %s batch. Cause: %s", name, err)
+	}
+	if header.Hash() != expected.Hash() {
+		t.Fatalf("expected %s batch to be batch %d, got batch %d", name, expected.Number, header.Number)
+	}
+}
+
+// finalizingL1Chain is a chain of empty L1 blocks that reports the block at a set height as finalized.
+type finalizingL1Chain struct {
+	*fakeL1Chain
+	finalized int // the height of the finalized block, or -1 if no block is finalized
+}
+
+func newFinalizingL1Chain(length int) *finalizingL1Chain {
+	return &finalizingL1Chain{fakeL1Chain: newFakeL1Chain(length), finalized: -1}
+}
+
+func (c *finalizingL1Chain) FinalizedHeader() (*types.Header, error) {
+	if c.finalized < 0 {
+		return nil, ethereum.NotFound
+	}
+	return c.block(c.finalized).Header(), nil
+}
+
+// fakeFinalizedBlockEnclave records the finalized L1 block the host submits.
+type fakeFinalizedBlockEnclave struct {
+	common.Enclave
+	finalized gethcommon.Hash
+}
+
+func (e *fakeFinalizedBlockEnclave) SubmitFinalizedL1Block(blockHash gethcommon.Hash) error {
+	e.finalized = blockHash
+	return nil
+}
@@ -27,10 +27,14 @@ type HostConfigToml struct {
 	EnclaveRPCAddress         string
 	P2PBindAddress            string
 	P2PPublicAddress          string
-	L1NodeHost                string
+	L1NodeHosts               []string
 	L1NodeWebsocketPort       uint
 	EnclaveRPCTimeout         int
 	L1RPCTimeout              int
+	L1PollInterval            int
+	L1BeaconURL               string
+	L1MaxFeePerGasGwei        uint64
+	L1MaxTxReplacements       uint64
 	P2PConnectionTimeout      int
 	ManagementContractAddress string
 	LogLevel                  int
@@ -44,6 +48,10 @@ type HostConfigToml struct {
 	MetricsHTTPPort           uint
 	UseInMemoryDB             bool
 	LevelDBPath               string
+	EnclaveRecordingPath      string
+	HealthMaxL1Lag            uint64
+	HealthMaxBatchLag         uint64
+	HealthMaxPeerSilence      int
 }
 
 // ParseConfig returns a config.HostInputConfig based on either the file identified by the `config` flag, or the flags with
@@ -61,10 +69,15 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	enclaveRPCAddress := flag.String(enclaveRPCAddressName, cfg.EnclaveRPCAddress, flagUsageMap[enclaveRPCAddressName])
 	p2pBindAddress := flag.String(p2pBindAddressName, cfg.P2PBindAddress, flagUsageMap[p2pBindAddressName])
 	p2pPublicAddress := flag.String(p2pPublicAddressName, cfg.P2PPublicAddress, flagUsageMap[p2pPublicAddressName])
-	l1NodeHost := flag.String(l1NodeHostName, cfg.L1NodeHost, flagUsageMap[l1NodeHostName])
+	l1NodeHosts := newStringListFlag(cfg.L1NodeHosts)
+	flag.Var(l1NodeHosts, l1NodeHostName, flagUsageMap[l1NodeHostName])
 	l1NodePort := flag.Uint64(l1NodePortName, uint64(cfg.L1NodeWebsocketPort), flagUsageMap[l1NodePortName])
 	enclaveRPCTimeoutSecs := flag.Uint64(enclaveRPCTimeoutSecsName, uint64(cfg.EnclaveRPCTimeout.Seconds()), flagUsageMap[enclaveRPCTimeoutSecsName])
 	l1RPCTimeoutSecs := flag.Uint64(l1RPCTimeoutSecsName, uint64(cfg.L1RPCTimeout.Seconds()), flagUsageMap[l1RPCTimeoutSecsName])
+	l1PollIntervalMs := flag.Uint64(l1PollIntervalMsName, uint64(cfg.L1PollInterval.Milliseconds()), flagUsageMap[l1PollIntervalMsName])
+	l1BeaconURL := flag.String(l1BeaconURLName, cfg.L1BeaconURL, flagUsageMap[l1BeaconURLName])
+	l1MaxFeePerGasGwei := flag.Uint64(l1MaxFeePerGasGweiName, cfg.L1MaxFeePerGasGwei, flagUsageMap[l1MaxFeePerGasGweiName])
+	l1MaxTxReplacements := flag.Uint64(l1MaxTxReplacementsName, cfg.L1MaxTxReplacements, flagUsageMap[l1MaxTxReplacementsName])
 	p2pConnectionTimeoutSecs := flag.Uint64(p2pConnectionTimeoutSecsName, uint64(cfg.P2PConnectionTimeout.Seconds()), flagUsageMap[p2pConnectionTimeoutSecsName])
 	managementContractAddress := flag.String(managementContractAddrName, cfg.ManagementContractAddress.Hex(), flagUsageMap[managementContractAddrName])
 	logLevel := flag.Int(logLevelName, cfg.LogLevel, flagUsageMap[logLevelName])
@@ -78,6 +91,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	metricsHTPPPort := flag.Uint(metricsHTTPPortName, cfg.MetricsHTTPPort, flagUsageMap[metricsHTTPPortName])
 	useInMemoryDB := flag.Bool(useInMemoryDBName, cfg.UseInMemoryDB, flagUsageMap[useInMemoryDBName])
 	levelDBPath := flag.String(levelDBPathName, cfg.LevelDBPath, flagUsageMap[levelDBPathName])
+	enclaveRecordingPath := flag.String(enclaveRecordingPathName, cfg.EnclaveRecordingPath, flagUsageMap[enclaveRecordingPathName])
+	healthMaxL1Lag := flag.Uint64(healthMaxL1LagName, cfg.HealthMaxL1Lag, flagUsageMap[healthMaxL1LagName])
+	healthMaxBatchLag := flag.Uint64(healthMaxBatchLagName, cfg.HealthMaxBatchLag, flagUsageMap[healthMaxBatchLagName])
+	healthMaxPeerSilenceSecs := flag.Uint64(healthMaxPeerSilenceSecsName, uint64(cfg.HealthMaxPeerSilence.Seconds()), flagUsageMap[healthMaxPeerSilenceSecsName])
 
 	flag.Parse()
 
@@ -100,10 +117,14 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	cfg.EnclaveRPCAddress = *enclaveRPCAddress
 	cfg.P2PBindAddress = *p2pBindAddress
 	cfg.P2PPublicAddress = *p2pPublicAddress
-	cfg.L1NodeHost = *l1NodeHost
+	cfg.L1NodeHosts = l1NodeHosts.values
 	cfg.L1NodeWebsocketPort = uint(*l1NodePort)
 	cfg.EnclaveRPCTimeout = time.Duration(*enclaveRPCTimeoutSecs) * time.Second
 	cfg.L1RPCTimeout = time.Duration(*l1RPCTimeoutSecs) * time.Second
+	cfg.L1PollInterval = time.Duration(*l1PollIntervalMs) * time.Millisecond
+	cfg.L1BeaconURL = *l1BeaconURL
+	cfg.L1MaxFeePerGasGwei = *l1MaxFeePerGasGwei
+	cfg.L1MaxTxReplacements = *l1MaxTxReplacements
 	cfg.P2PConnectionTimeout = time.Duration(*p2pConnectionTimeoutSecs) * time.Second
 	cfg.ManagementContractAddress = gethcommon.HexToAddress(*managementContractAddress)
 	cfg.PrivateKeyString = *privateKeyStr
@@ -117,6 +138,10 @@ func ParseConfig() (*config.HostInputConfig, error) {
 	cfg.MetricsHTTPPort = *metricsHTPPPort
 	cfg.UseInMemoryDB = *useInMemoryDB
 	cfg.LevelDBPath = *levelDBPath
+	cfg.EnclaveRecordingPath = *enclaveRecordingPath
+	cfg.HealthMaxL1Lag = *healthMaxL1Lag
+	cfg.HealthMaxBatchLag = *healthMaxBatchLag
+	cfg.HealthMaxPeerSilence = time.Duration(*healthMaxPeerSilenceSecs) * time.Second
 
 	return cfg, nil
 }
@@ -150,10 +175,14 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		EnclaveRPCAddress:         tomlConfig.EnclaveRPCAddress,
 		P2PBindAddress:            tomlConfig.P2PBindAddress,
 		P2PPublicAddress:          tomlConfig.P2PPublicAddress,
-		L1NodeHost:                tomlConfig.L1NodeHost,
+		L1NodeHosts:               tomlConfig.L1NodeHosts,
 		L1NodeWebsocketPort:       tomlConfig.L1NodeWebsocketPort,
 		EnclaveRPCTimeout:         time.Duration(tomlConfig.EnclaveRPCTimeout) * time.Second,
 		L1RPCTimeout:              time.Duration(tomlConfig.L1RPCTimeout) * time.Second,
+		L1PollInterval:            time.Duration(tomlConfig.L1PollInterval) * time.Millisecond,
+		L1BeaconURL:               tomlConfig.L1BeaconURL,
+		L1MaxFeePerGasGwei:        tomlConfig.L1MaxFeePerGasGwei,
+		L1MaxTxReplacements:       tomlConfig.L1MaxTxReplacements,
 		P2PConnectionTimeout:      time.Duration(tomlConfig.P2PConnectionTimeout) * time.Second,
 		ManagementContractAddress: gethcommon.HexToAddress(tomlConfig.ManagementContractAddress),
 		LogLevel:                  tomlConfig.LogLevel,
@@ -167,5 +196,9 @@ func fileBasedConfig(configPath string) (*config.HostInputConfig, error) {
 		MetricsHTTPPort:           tomlConfig.MetricsHTTPPort,
 		UseInMemoryDB:             tomlConfig.UseInMemoryDB,
 		LevelDBPath:               tomlConfig.LevelDBPath,
+		EnclaveRecordingPath:      tomlConfig.EnclaveRecordingPath,
+		HealthMaxL1Lag:            tomlConfig.HealthMaxL1Lag,
+		HealthMaxBatchLag:         tomlConfig.HealthMaxBatchLag,
+		HealthMaxPeerSilence:      time.Duration(tomlConfig.HealthMaxPeerSilence) * time.Second,
 	}, nil
 }
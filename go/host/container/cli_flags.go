@@ -1,5 +1,7 @@
 package container
 
+import "strings"
+
 // Flag names.
 const (
 	configName                   = "config"
@@ -16,6 +18,10 @@ const (
 	l1NodePortName               = "l1NodePort"
 	enclaveRPCTimeoutSecsName    = "enclaveRPCTimeoutSecs"
 	l1RPCTimeoutSecsName         = "l1RPCTimeoutSecs"
+	l1PollIntervalMsName         = "l1PollIntervalMs"
+	l1BeaconURLName              = "l1BeaconURL"
+	l1MaxFeePerGasGweiName       = "l1MaxFeePerGasGwei"
+	l1MaxTxReplacementsName      = "l1MaxTxReplacements"
 	p2pConnectionTimeoutSecsName = "p2pConnectionTimeoutSecs"
 	managementContractAddrName   = "managementContractAddress"
 	logLevelName                 = "logLevel"
@@ -29,6 +35,10 @@ const (
 	metricsHTTPPortName          = "metricsHTTPPort"
 	useInMemoryDBName            = "useInMemoryDB"
 	levelDBPathName              = "levelDBPath"
+	enclaveRecordingPathName     = "enclaveRecordingPath"
+	healthMaxL1LagName           = "healthMaxL1Lag"
+	healthMaxBatchLagName        = "healthMaxBatchLag"
+	healthMaxPeerSilenceSecsName = "healthMaxPeerSilenceSecs"
 )
 
 // Returns a map of the flag usages.
@@ -45,10 +55,14 @@ func getFlagUsageMap() map[string]string {
 		enclaveRPCAddressName:        "The address to use to connect to the Obscuro enclave service",
 		p2pBindAddressName:           "The address where the p2p server is bound to. Defaults to 0.0.0.0:10000",
 		p2pPublicAddressName:         "The P2P address where the other servers should connect to. Defaults to 127.0.0.1:10000",
-		l1NodeHostName:               "The network host on which to connect to the Ethereum client",
+		l1NodeHostName:               "The network host on which to connect to the Ethereum client, optionally as a host:port pair or as a URL (e.g. https://eth.example.com). Repeat the flag to fail over between several clients",
 		l1NodePortName:               "The port on which to connect to the Ethereum client",
 		enclaveRPCTimeoutSecsName:    "The timeout for host <-> enclave RPC communication",
 		l1RPCTimeoutSecsName:         "The timeout for connecting to, and communicating with, the Ethereum client",
+		l1PollIntervalMsName:         "The interval at which to poll the Ethereum client for new blocks, for clients reachable over HTTP only or that drop websocket subscriptions (Defaults to 0, to subscribe to new blocks over websockets)",
+		l1BeaconURLName:              "The URL of the L1 beacon node to fetch light client updates from, if the enclave validates L1 blocks against the beacon chain",
+		l1MaxFeePerGasGweiName:       "The maximum fee per gas, in gwei, that the host's L1 transactions are sent or replaced with (Defaults to 500)",
+		l1MaxTxReplacementsName:      "The maximum number of times a stuck L1 transaction is replaced with higher fees (Defaults to 10)",
 		p2pConnectionTimeoutSecsName: "The timeout for host <-> host P2P messaging",
 		managementContractAddrName:   "The management contract address on the L1",
 		logLevelName:                 "The verbosity level of logs. (Defaults to Info)",
@@ -61,5 +75,33 @@ func getFlagUsageMap() map[string]string {
 		metricsHTTPPortName:          "The port on which the metrics are served (Defaults to 0.0.0.0:14000)",
 		useInMemoryDBName:            "Whether the host will use an in-memory DB rather than persist data",
 		levelDBPathName:              "Filepath for the levelDB persistence dir (can be empty if a throwaway file in /tmp/ is acceptable or if using InMemory DB)",
+		enclaveRecordingPathName:     "File to record the L1 blocks and batches submitted to the enclave to, for replay with the enclavereplay tool (Defaults to no recording)",
+		healthMaxL1LagName:           "The number of L1 blocks the enclave can be behind the L1 head before the node is reported as not ready",
+		healthMaxBatchLagName:        "The number of batches the node can be behind the sequencer before it is reported as not ready",
+		healthMaxPeerSilenceSecsName: "The time without a message from a known peer before the node is reported as not ready (0 to disable)",
+	}
+}
+
+// stringListFlag is a flag.Value for a flag that can be repeated to give several values. The default values are
+// replaced, rather than extended, by the first use of the flag.
+type stringListFlag struct {
+	values []string
+	isSet  bool
+}
+
+func newStringListFlag(defaults []string) *stringListFlag {
+	return &stringListFlag{values: defaults}
+}
+
+func (f *stringListFlag) String() string {
+	return strings.Join(f.values, ",")
+}
+
+func (f *stringListFlag) Set(value string) error {
+	if !f.isSet {
+		f.values = nil
+		f.isSet = true
 	}
+	f.values = append(f.values, value)
+	return nil
 }
@@ -1,6 +1,7 @@
 package container
 
 import (
+	"flag"
 	"os"
 	"path"
 	"reflect"
@@ -93,3 +94,16 @@ func TestConfigFlagsMatchConfigFields(t *testing.T) {
 		t.Fatalf("config file supports the following fields: %s, but there are CLI flags for the following fields: %s", cfgFields, cliFlags)
 	}
 }
+
+func TestL1NodeHostFlagCanBeRepeated(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	l1NodeHosts := newStringListFlag([]string{"127.0.0.1"})
+	flags.Var(l1NodeHosts, l1NodeHostName, "")
+
+	if err := flags.Parse([]string{"--" + l1NodeHostName, "eth-1", "--" + l1NodeHostName, "eth-2:8547"}); err != nil {
+		t.Fatalf("could not parse flags. Cause: %s", err)
+	}
+	if expected := []string{"eth-1", "eth-2:8547"}; !reflect.DeepEqual(l1NodeHosts.values, expected) {
+		t.Fatalf("expected L1 node hosts %s, got %s", expected, l1NodeHosts.values)
+	}
+}
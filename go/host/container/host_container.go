@@ -2,9 +2,11 @@ package container
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/enclaverecording"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/common/metrics"
 	"github.com/obscuronet/go-obscuro/go/config"
@@ -91,23 +93,33 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig) *HostConta
 	cfg.ID = ethWallet.Address()
 
 	fmt.Println("Connecting to L1 network...")
-	l1Client, err := ethadapter.NewEthClient(cfg.L1NodeHost, cfg.L1NodeWebsocketPort, cfg.L1RPCTimeout, cfg.ID, logger)
-	if err != nil {
-		logger.Crit("could not create Ethereum client.", log.ErrKey, err)
+	l1NodeURLs := make([]string, len(cfg.L1NodeHosts))
+	for i, l1NodeHost := range cfg.L1NodeHosts {
+		l1NodeURL, err := ethadapter.L1NodeURL(l1NodeHost, cfg.L1NodeWebsocketPort)
+		if err != nil {
+			logger.Crit("invalid L1 node host.", log.ErrKey, err)
+		}
+		l1NodeURLs[i] = l1NodeURL
+	}
+	var l1Client ethadapter.EthClient
+	var err error
+	if len(l1NodeURLs) == 1 {
+		l1Client, err = ethadapter.NewEthClientFromURL(l1NodeURLs[0], cfg.L1RPCTimeout, cfg.ID, logger)
+	} else {
+		l1Client, err = ethadapter.NewMultiEthClient(l1NodeURLs, cfg.L1RPCTimeout, cfg.L1ChainID, cfg.ID, logger)
 	}
-
-	// update the wallet nonce
-	nonce, err := l1Client.Nonce(ethWallet.Address())
 	if err != nil {
-		logger.Crit("could not retrieve Ethereum account nonce.", log.ErrKey, err)
+		logger.Crit("could not create Ethereum client.", log.ErrKey, err)
 	}
-	ethWallet.SetNonce(nonce)
 
 	// set the Host ID as the Public Key Address
 	cfg.ID = ethWallet.Address()
 
 	fmt.Println("Connecting to the enclave...")
-	enclaveClient := enclaverpc.NewClient(cfg, logger)
+	var enclaveClient common.Enclave = enclaverpc.NewClient(cfg, logger)
+	if cfg.EnclaveRecordingPath != "" {
+		enclaveClient = newRecordingEnclaveClient(cfg, enclaveClient, logger)
+	}
 	p2pLogger := logger.New(log.CmpKey, log.P2PCmp)
 	metricsService := metrics.New(cfg.MetricsEnabled, cfg.MetricsHTTPPort, logger)
 	aggP2P := p2p.NewSocketP2PLayer(cfg, p2pLogger, metricsService.Registry())
@@ -118,6 +130,24 @@ func NewHostContainerFromConfig(parsedConfig *config.HostInputConfig) *HostConta
 	return NewHostContainer(cfg, aggP2P, l1Client, enclaveClient, mgmtContractLib, ethWallet, rpcServer, logger, metricsService)
 }
 
+// newRecordingEnclaveClient wraps the enclave client so that the L1 blocks and batches submitted to the enclave are
+// recorded to the file at cfg.EnclaveRecordingPath
+func newRecordingEnclaveClient(cfg *config.HostConfig, enclaveClient common.Enclave, logger gethlog.Logger) common.Enclave {
+	recorder, err := enclaverecording.NewRecorder(cfg.EnclaveRecordingPath, enclaverecording.Session{
+		HostID:                    cfg.ID,
+		NodeType:                  cfg.NodeType.String(),
+		L1ChainID:                 uint64(cfg.L1ChainID),
+		ObscuroChainID:            uint64(cfg.ObscuroChainID),
+		ManagementContractAddress: cfg.ManagementContractAddress,
+		StartTime:                 uint64(time.Now().Unix()),
+	})
+	if err != nil {
+		logger.Crit("could not start recording enclave inputs.", log.ErrKey, err)
+	}
+	logger.Info(fmt.Sprintf("Recording enclave inputs to %s", cfg.EnclaveRecordingPath))
+	return enclaverecording.NewRecordingEnclave(enclaveClient, recorder, logger)
+}
+
 // NewHostContainer builds a host container with dependency injection rather than from config.
 // Useful for testing etc. (want to be able to pass in logger, and also have option to mock out dependencies)
 func NewHostContainer(
@@ -181,5 +211,10 @@ func NewHostContainer(
 		})
 	}
 
+	if cfg.HasClientRPCHTTP {
+		rpcServer.RegisterHandler("healthz", clientapi.LivenessPath, clientapi.NewLivenessHandler(h))
+		rpcServer.RegisterHandler("readyz", clientapi.ReadinessPath, clientapi.NewReadinessHandler(h))
+	}
+
 	return hostContainer
 }
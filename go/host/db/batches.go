@@ -26,6 +26,28 @@ func (db *DB) GetHeadBatchHeader() (*common.BatchHeader, error) {
 	return db.readBatchHeader(*headBatchHash)
 }
 
+// GetSafeBatchHeader returns the header of the latest batch included in a canonical rollup on the L1.
+func (db *DB) GetSafeBatchHeader() (*common.BatchHeader, error) {
+	return db.readBatchHeaderAt(safeBatch)
+}
+
+// SetSafeBatch records the hash of the latest batch included in a canonical rollup on the L1.
+func (db *DB) SetSafeBatch(hash gethcommon.Hash) error {
+	return db.kvStore.Put(safeBatch, hash.Bytes())
+}
+
+// GetFinalizedBatchHeader returns the header of the latest batch included in a canonical rollup published in a
+// finalized L1 block.
+func (db *DB) GetFinalizedBatchHeader() (*common.BatchHeader, error) {
+	return db.readBatchHeaderAt(finalizedBatch)
+}
+
+// SetFinalizedBatch records the hash of the latest batch included in a canonical rollup published in a finalized L1
+// block.
+func (db *DB) SetFinalizedBatch(hash gethcommon.Hash) error {
+	return db.kvStore.Put(finalizedBatch, hash.Bytes())
+}
+
 // GetBatchHeader returns the batch header given the hash.
 func (db *DB) GetBatchHeader(hash gethcommon.Hash) (*common.BatchHeader, error) {
 	return db.readBatchHeader(hash)
@@ -170,6 +192,15 @@ func (db *DB) readHeadBatchHash() (*gethcommon.Hash, error) {
 	return &h, nil
 }
 
+// Retrieves the header of the batch whose hash is stored at the given key.
+func (db *DB) readBatchHeaderAt(key []byte) (*common.BatchHeader, error) {
+	value, err := db.kvStore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return db.readBatchHeader(gethcommon.BytesToHash(value))
+}
+
 // Stores a batch header into the database.
 func (db *DB) writeBatchHeader(header *common.BatchHeader) error {
 	// Write the encoded header
@@ -21,7 +21,12 @@ var (
 	batchNumberPrefix    = []byte("bn")
 	batchPrefix          = []byte("bp")
 	batchTxHashesPrefix  = []byte("bt")
+	finalizedBatch       = []byte("fb")
 	headBatch            = []byte("hb")
+	headRollupPrefix     = []byte("hr")
+	pendingL1TxPrefix    = []byte("l1")
+	blockRollupsPrefix   = []byte("r")
+	safeBatch            = []byte("sb")
 	totalTransactionsKey = []byte("t")
 )
 
@@ -44,6 +49,14 @@ func (db *DB) Stop() error {
 	return nil
 }
 
+// HealthCheck returns an error if the database cannot be read from
+func (db *DB) HealthCheck() error {
+	if _, err := db.kvStore.Has(headBatch); err != nil {
+		return fmt.Errorf("could not read from database - %w", err)
+	}
+	return nil
+}
+
 func CreateDBFromConfig(cfg *config.HostConfig, regMetrics gethmetrics.Registry, logger gethlog.Logger) (*DB, error) {
 	if err := validateDBConf(cfg); err != nil {
 		return nil, err
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// DB methods relating to the L1 transactions the host has sent.
+
+// PendingL1Tx is an L1 transaction the host has sent, but that has not yet been included in a block.
+type PendingL1Tx struct {
+	Tx           *types.Transaction // The latest signed version of the transaction
+	PriorHashes  []gethcommon.Hash  // The hashes of the versions it replaced, any of which may still be included instead
+	Description  string             // What the transaction does, e.g. "rollup"
+	CreatedAt    uint64             // The Unix time the transaction was first sent
+	SentAt       uint64             // The Unix time the latest version was broadcast, or zero if broadcasting failed
+	Replacements uint64             // The number of times the transaction has been replaced with higher fees
+}
+
+// GetPendingL1Txs returns the pending L1 transactions, in nonce order.
+func (db *DB) GetPendingL1Txs() ([]*PendingL1Tx, error) {
+	it := db.kvStore.NewIterator(pendingL1TxPrefix, nil)
+	defer it.Release()
+
+	var txs []*PendingL1Tx
+	for it.Next() {
+		var tx PendingL1Tx
+		if err := rlp.DecodeBytes(it.Value(), &tx); err != nil {
+			return nil, fmt.Errorf("could not decode pending L1 transaction. Cause: %w", err)
+		}
+		txs = append(txs, &tx)
+	}
+	return txs, it.Error()
+}
+
+// AddPendingL1Tx stores a pending L1 transaction, replacing any stored transaction with the same nonce.
+func (db *DB) AddPendingL1Tx(tx *PendingL1Tx) error {
+	data, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return fmt.Errorf("could not encode pending L1 transaction. Cause: %w", err)
+	}
+	if err = db.kvStore.Put(pendingL1TxKey(tx.Tx.Nonce()), data); err != nil {
+		return fmt.Errorf("could not store pending L1 transaction. Cause: %w", err)
+	}
+	return nil
+}
+
+// RemovePendingL1Tx removes the pending L1 transaction with the given nonce.
+func (db *DB) RemovePendingL1Tx(nonce uint64) error {
+	return db.kvStore.Delete(pendingL1TxKey(nonce))
+}
+
+// pendingL1TxKey = pendingL1TxPrefix + nonce (big-endian, so that the transactions are iterated in nonce order)
+func pendingL1TxKey(nonce uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, nonce)
+	return append(append([]byte{}, pendingL1TxPrefix...), enc...)
+}
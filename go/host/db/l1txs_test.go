@@ -0,0 +1,38 @@
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestPendingL1TxsAreRetrievedInNonceOrder(t *testing.T) {
+	db := NewInMemoryDB(nil, nil)
+	for _, nonce := range []uint64{300, 2, 1} {
+		tx := &PendingL1Tx{
+			Tx:          types.NewTx(&types.DynamicFeeTx{Nonce: nonce, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2)}),
+			PriorHashes: []gethcommon.Hash{gethcommon.HexToHash("0x1")},
+			Description: "rollup",
+		}
+		if err := db.AddPendingL1Tx(tx); err != nil {
+			t.Fatalf("could not add pending L1 tx. Cause: %s", err)
+		}
+	}
+	if err := db.RemovePendingL1Tx(2); err != nil {
+		t.Fatalf("could not remove pending L1 tx. Cause: %s", err)
+	}
+
+	txs, err := db.GetPendingL1Txs()
+	if err != nil {
+		t.Fatalf("could not retrieve pending L1 txs. Cause: %s", err)
+	}
+	if len(txs) != 2 || txs[0].Tx.Nonce() != 1 || txs[1].Tx.Nonce() != 300 {
+		t.Fatalf("pending L1 txs were not retrieved in nonce order")
+	}
+	if txs[0].Description != "rollup" || len(txs[0].PriorHashes) != 1 || txs[0].Tx.GasFeeCap().Uint64() != 2 {
+		t.Fatalf("pending L1 tx was not stored correctly")
+	}
+}
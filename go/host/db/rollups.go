@@ -0,0 +1,92 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// DB methods relating to rollups.
+
+// GetBlockRollups returns the rollups published in the L1 block with the given hash.
+func (db *DB) GetBlockRollups(blockHash gethcommon.Hash) ([]*common.PublicRollup, error) {
+	return db.readBlockRollups(db.kvStore, blockHash)
+}
+
+// AddBlockRollups stores the rollups published in the L1 block with the given hash.
+func (db *DB) AddBlockRollups(blockHash gethcommon.Hash, rollups []*common.PublicRollup) error {
+	if err := db.writeBlockRollups(db.kvStore, blockHash, rollups); err != nil {
+		return fmt.Errorf("could not write rollups for block %s. Cause: %w", blockHash, err)
+	}
+	return nil
+}
+
+// GetBlockHeadRollup returns the header of the latest canonical rollup published in the L1 block with the given hash or
+// its ancestors.
+func (db *DB) GetBlockHeadRollup(blockHash gethcommon.Hash) (*common.RollupHeader, error) {
+	data, err := db.kvStore.Get(blockHeadRollupKey(blockHash))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	header := new(common.RollupHeader)
+	if err = rlp.DecodeBytes(data, header); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// AddBlockHeadRollup stores the header of the latest canonical rollup published in the L1 block with the given hash or
+// its ancestors.
+func (db *DB) AddBlockHeadRollup(blockHash gethcommon.Hash, header *common.RollupHeader) error {
+	data, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return fmt.Errorf("could not encode head rollup for block %s. Cause: %w", blockHash, err)
+	}
+	if err = db.kvStore.Put(blockHeadRollupKey(blockHash), data); err != nil {
+		return fmt.Errorf("could not write head rollup for block %s. Cause: %w", blockHash, err)
+	}
+	return nil
+}
+
+// blockHeadRollupKey = headRollupPrefix + block hash
+func blockHeadRollupKey(blockHash gethcommon.Hash) []byte {
+	return append(headRollupPrefix, blockHash.Bytes()...)
+}
+
+// headerKey = blockRollupsPrefix + block hash
+func blockRollupsKey(blockHash gethcommon.Hash) []byte {
+	return append(blockRollupsPrefix, blockHash.Bytes()...)
+}
+
+// Stores the rollups published in a block into the database.
+func (db *DB) writeBlockRollups(w ethdb.KeyValueWriter, blockHash gethcommon.Hash, rollups []*common.PublicRollup) error {
+	data, err := rlp.EncodeToBytes(rollups)
+	if err != nil {
+		return err
+	}
+	return w.Put(blockRollupsKey(blockHash), data)
+}
+
+// Retrieves the rollups published in the block with the given hash.
+func (db *DB) readBlockRollups(r ethdb.KeyValueReader, blockHash gethcommon.Hash) ([]*common.PublicRollup, error) {
+	data, err := r.Get(blockRollupsKey(blockHash))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	var rollups []*common.PublicRollup
+	if err = rlp.DecodeBytes(data, &rollups); err != nil {
+		return nil, err
+	}
+	return rollups, nil
+}
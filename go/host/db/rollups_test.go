@@ -0,0 +1,64 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestCanStoreAndRetrieveBlockRollups(t *testing.T) {
+	db := NewInMemoryDB(nil, nil)
+	blockHash := gethcommon.HexToHash("0x1")
+	rollup := &common.PublicRollup{
+		Header:      &common.RollupHeader{Number: big.NewInt(batchNumber)},
+		L1Block:     blockHash,
+		BatchHashes: []common.L2RootHash{gethcommon.HexToHash("0x2"), gethcommon.HexToHash("0x3")},
+	}
+
+	err := db.AddBlockRollups(blockHash, []*common.PublicRollup{rollup})
+	if err != nil {
+		t.Errorf("could not add block rollups. Cause: %s", err)
+	}
+
+	rollups, err := db.GetBlockRollups(blockHash)
+	if err != nil {
+		t.Errorf("stored block rollups but could not retrieve them. Cause: %s", err)
+	}
+	if len(rollups) != 1 || rollups[0].Header.Hash() != rollup.Header.Hash() || len(rollups[0].BatchHashes) != 2 {
+		t.Errorf("block rollups were not stored correctly")
+	}
+}
+
+func TestUnknownBlockRollupsReturnsNotFound(t *testing.T) {
+	db := NewInMemoryDB(nil, nil)
+
+	_, err := db.GetBlockRollups(gethcommon.HexToHash("0x1"))
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("did not store block rollups but was able to retrieve them")
+	}
+}
+
+func TestCanStoreAndRetrieveBlockHeadRollup(t *testing.T) {
+	db := NewInMemoryDB(nil, nil)
+	blockHash := gethcommon.HexToHash("0x1")
+	header := &common.RollupHeader{Number: big.NewInt(batchNumber), HeadBatchHash: gethcommon.HexToHash("0x2")}
+
+	if _, err := db.GetBlockHeadRollup(blockHash); !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("did not store block head rollup but was able to retrieve it")
+	}
+	if err := db.AddBlockHeadRollup(blockHash, header); err != nil {
+		t.Errorf("could not add block head rollup. Cause: %s", err)
+	}
+	headRollup, err := db.GetBlockHeadRollup(blockHash)
+	if err != nil {
+		t.Errorf("stored block head rollup but could not retrieve it. Cause: %s", err)
+	}
+	if headRollup.Hash() != header.Hash() {
+		t.Errorf("block head rollup was not stored correctly")
+	}
+}
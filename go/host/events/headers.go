@@ -0,0 +1,93 @@
+package events
+
+import (
+	"sync"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+)
+
+// HeaderEventManager manages the routing of new batch headers and rollup headers to their subscribers.
+//
+// Headers are sent to subscribers without blocking, so that a slow subscriber cannot stall the host. A subscriber whose
+// channel is full misses the header.
+type HeaderEventManager struct {
+	batchSubscriptions  map[rpc.ID]chan *common.BatchHeader
+	rollupSubscriptions map[rpc.ID]chan *common.RollupHeader
+	subscriptionMutex   *sync.RWMutex
+	logger              gethlog.Logger
+}
+
+func NewHeaderEventManager(logger gethlog.Logger) HeaderEventManager {
+	return HeaderEventManager{
+		batchSubscriptions:  map[rpc.ID]chan *common.BatchHeader{},
+		rollupSubscriptions: map[rpc.ID]chan *common.RollupHeader{},
+		subscriptionMutex:   &sync.RWMutex{},
+		logger:              logger,
+	}
+}
+
+// AddBatchSubscription adds a subscription to the headers of new batches.
+func (h *HeaderEventManager) AddBatchSubscription(id rpc.ID, batchHeadersCh chan *common.BatchHeader) {
+	h.subscriptionMutex.Lock()
+	defer h.subscriptionMutex.Unlock()
+
+	h.batchSubscriptions[id] = batchHeadersCh
+}
+
+// AddRollupSubscription adds a subscription to the headers of published rollups.
+func (h *HeaderEventManager) AddRollupSubscription(id rpc.ID, rollupHeadersCh chan *common.RollupHeader) {
+	h.subscriptionMutex.Lock()
+	defer h.subscriptionMutex.Unlock()
+
+	h.rollupSubscriptions[id] = rollupHeadersCh
+}
+
+// RemoveSubscription removes a subscription from the set of managed subscriptions, and closes its channel. It returns
+// false if there is no subscription with the given ID.
+func (h *HeaderEventManager) RemoveSubscription(id rpc.ID) bool {
+	h.subscriptionMutex.Lock()
+	defer h.subscriptionMutex.Unlock()
+
+	if ch, found := h.batchSubscriptions[id]; found {
+		close(ch)
+		delete(h.batchSubscriptions, id)
+		return true
+	}
+	if ch, found := h.rollupSubscriptions[id]; found {
+		close(ch)
+		delete(h.rollupSubscriptions, id)
+		return true
+	}
+	return false
+}
+
+// SendBatchHeaderToSubscribers distributes the header of a new batch to the subscribed clients.
+func (h *HeaderEventManager) SendBatchHeaderToSubscribers(header *common.BatchHeader) {
+	h.subscriptionMutex.RLock()
+	defer h.subscriptionMutex.RUnlock()
+
+	for id, ch := range h.batchSubscriptions {
+		select {
+		case ch <- header:
+		default:
+			h.logger.Warn("Subscriber is not keeping up, dropping batch header.", log.SubIDKey, id, "batch", header.Hash())
+		}
+	}
+}
+
+// SendRollupHeaderToSubscribers distributes the header of a published rollup to the subscribed clients.
+func (h *HeaderEventManager) SendRollupHeaderToSubscribers(header *common.RollupHeader) {
+	h.subscriptionMutex.RLock()
+	defer h.subscriptionMutex.RUnlock()
+
+	for id, ch := range h.rollupSubscriptions {
+		select {
+		case ch <- header:
+		default:
+			h.logger.Warn("Subscriber is not keeping up, dropping rollup header.", log.SubIDKey, id, "rollup", header.Hash())
+		}
+	}
+}
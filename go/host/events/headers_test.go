@@ -0,0 +1,62 @@
+package events
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestBatchHeadersAreSentToSubscribers(t *testing.T) {
+	manager := NewHeaderEventManager(gethlog.New())
+	batchHeaders := make(chan *common.BatchHeader, 1)
+	rollupHeaders := make(chan *common.RollupHeader, 1)
+	manager.AddBatchSubscription("batches", batchHeaders)
+	manager.AddRollupSubscription("rollups", rollupHeaders)
+
+	header := &common.BatchHeader{Number: big.NewInt(1)}
+	manager.SendBatchHeaderToSubscribers(header)
+
+	if received := <-batchHeaders; received != header {
+		t.Fatalf("expected batch header %d, got %d", header.Number, received.Number)
+	}
+	if len(rollupHeaders) != 0 {
+		t.Fatal("rollup subscription received a batch header")
+	}
+}
+
+func TestHeadersAreDroppedForSlowSubscribers(t *testing.T) {
+	manager := NewHeaderEventManager(gethlog.New())
+	rollupHeaders := make(chan *common.RollupHeader, 1)
+	manager.AddRollupSubscription("rollups", rollupHeaders)
+
+	first := &common.RollupHeader{Number: big.NewInt(1)}
+	manager.SendRollupHeaderToSubscribers(first)
+	// the subscriber's channel is full, so this must not block
+	manager.SendRollupHeaderToSubscribers(&common.RollupHeader{Number: big.NewInt(2)})
+
+	if received := <-rollupHeaders; received != first {
+		t.Fatalf("expected rollup header %d, got %d", first.Number, received.Number)
+	}
+}
+
+func TestRemovingSubscriptionClosesChannel(t *testing.T) {
+	manager := NewHeaderEventManager(gethlog.New())
+	batchHeaders := make(chan *common.BatchHeader, 1)
+	manager.AddBatchSubscription("batches", batchHeaders)
+
+	if !manager.RemoveSubscription("batches") {
+		t.Fatal("expected subscription to be found")
+	}
+	if _, open := <-batchHeaders; open {
+		t.Fatal("expected subscription channel to be closed")
+	}
+	if manager.RemoveSubscription(rpc.ID("batches")) {
+		t.Fatal("expected subscription to have been removed")
+	}
+	// sending after the subscription is removed must not panic on the closed channel
+	manager.SendBatchHeaderToSubscribers(&common.BatchHeader{Number: big.NewInt(1)})
+}
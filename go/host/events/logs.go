@@ -8,7 +8,7 @@ import (
 	"github.com/obscuronet/go-obscuro/go/common"
 )
 
-// LogEventManager manages the routing of logs back to their subscribers.
+// LogEventManager manages the routing of logs and pending transaction hashes back to their subscribers.
 type LogEventManager struct {
 	subscriptions     map[rpc.ID]*subscription // The channels that logs are sent to, one per subscription
 	subscriptionMutex *sync.RWMutex
@@ -45,12 +45,21 @@ func (l *LogEventManager) RemoveSubscription(id rpc.ID) {
 
 // SendLogsToSubscribers distributes logs to subscribed clients.
 func (l *LogEventManager) SendLogsToSubscribers(result *common.BlockSubmissionResponse) {
-	for id, encryptedLogs := range result.SubscribedLogs {
-		logSub, found := l.getSubscriptionThreadsafe(id)
+	l.sendToSubscribers(result.SubscribedLogs)
+}
+
+// SendTxHashesToSubscribers distributes the hash of a submitted transaction to the subscribed clients.
+func (l *LogEventManager) SendTxHashesToSubscribers(result *common.TxSubmissionResponse) {
+	l.sendToSubscribers(result.SubscribedTxHashes)
+}
+
+func (l *LogEventManager) sendToSubscribers(encryptedEventsByID map[rpc.ID][]byte) {
+	for id, encryptedEvents := range encryptedEventsByID {
+		sub, found := l.getSubscriptionThreadsafe(id)
 		if !found {
 			continue
 		}
-		logSub.ch <- encryptedLogs
+		sub.ch <- encryptedEvents
 	}
 }
 
@@ -65,5 +74,5 @@ func (l *LogEventManager) getSubscriptionThreadsafe(subID rpc.ID) (*subscription
 
 // Pairs the latest seen rollup for a log subscription with the channel on which new logs should be sent.
 type subscription struct {
-	ch chan []byte // The channel that logs for this subscription are sent to.
+	ch chan []byte // The channel that logs (or transaction hashes) for this subscription are sent to.
 }
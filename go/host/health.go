@@ -0,0 +1,171 @@
+package host
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+// HealthCheck returns whether the host, enclave and DB are healthy, along with the status of each of the node's
+// components
+func (h *host) HealthCheck() (*hostcommon.HealthCheck, error) {
+	enclaveHealth := h.enclaveHealth()
+	p2pStatus := h.p2p.Status()
+	l1Status := h.l1Status()
+	dbStatus := h.dbStatus()
+	batchStatus := h.batchStatus()
+	l1TxStatus := h.l1TxManager.Status()
+	rollupStatus := h.rollupForks.getStatus()
+
+	// The host only needs restarting if it has stopped or can no longer use its database. The other components either
+	// recover by themselves (e.g. the host reconnects to the L1) or are not fixed by restarting the host (e.g. the
+	// enclave), so they only affect the node's readiness
+	live := atomic.LoadInt32(h.stopHostInterrupt) == 0 && dbStatus.Healthy
+	ready := live && enclaveHealth.EnclaveHealthy && p2pStatus.Healthy && l1Status.Healthy && batchStatus.Healthy &&
+		l1TxStatus.Healthy
+
+	return &hostcommon.HealthCheck{
+		HealthCheckHost: &hostcommon.HealthCheckHost{
+			P2PStatus:    p2pStatus,
+			L1Status:     l1Status,
+			DBStatus:     dbStatus,
+			BatchStatus:  batchStatus,
+			L1TxStatus:   l1TxStatus,
+			RollupStatus: rollupStatus,
+		},
+		HealthCheckEnclave: enclaveHealth,
+		// Overall health is achieved when the p2p layer and the enclave are healthy
+		OverallHealth: p2pStatus.Healthy && enclaveHealth.EnclaveHealthy,
+		Live:          live,
+		Ready:         ready,
+	}, nil
+}
+
+func (h *host) enclaveHealth() *hostcommon.HealthCheckEnclave {
+	health := &hostcommon.HealthCheckEnclave{L1Head: h.progress.getEnclaveL1Head()}
+
+	status, err := h.enclaveClient.Status()
+	health.Status = status.String()
+	if err != nil {
+		health.Error = fmt.Sprintf("could not retrieve enclave status - %s", err)
+		return health
+	}
+
+	// check the enclave health, which in turn checks the DB health
+	enclaveHealthy, err := h.enclaveClient.HealthCheck()
+	if err != nil {
+		h.logger.Error("unable to HealthCheck enclave", log.ErrKey, err)
+		health.Error = fmt.Sprintf("could not check enclave health - %s", err)
+		return health
+	}
+	if !enclaveHealthy {
+		health.Error = "enclave reported itself as unhealthy"
+		return health
+	}
+	if status != common.Running {
+		health.Error = fmt.Sprintf("enclave is not running, its status is %s", status)
+		return health
+	}
+
+	health.EnclaveHealthy = true
+	return health
+}
+
+func (h *host) l1Status() *hostcommon.L1Status {
+	status := &hostcommon.L1Status{}
+
+	l1Head, err := h.ethClient.FetchHeadBlock()
+	if err != nil {
+		status.Error = fmt.Sprintf("could not fetch L1 head block - %s", err)
+		return status
+	}
+	status.Connected = true
+	status.HeadHeight = l1Head.NumberU64()
+
+	enclaveL1Head := h.progress.getEnclaveL1Head()
+	if enclaveL1Head == (gethcommon.Hash{}) {
+		status.Error = "enclave has not ingested any L1 blocks yet"
+		return status
+	}
+	enclaveHeadHeight, err := h.l1BlockHeight(enclaveL1Head)
+	if err != nil {
+		status.Error = fmt.Sprintf("could not retrieve enclave's L1 head block %s - %s", enclaveL1Head, err)
+		return status
+	}
+	status.EnclaveHeadHeight = enclaveHeadHeight
+
+	if status.HeadHeight > status.EnclaveHeadHeight {
+		status.Lag = status.HeadHeight - status.EnclaveHeadHeight
+	}
+	if status.Lag > h.config.HealthMaxL1Lag {
+		status.Error = fmt.Sprintf("enclave is %d L1 blocks behind the L1 head, the maximum is %d", status.Lag, h.config.HealthMaxL1Lag)
+		return status
+	}
+
+	status.Healthy = true
+	return status
+}
+
+// l1BlockHeight returns the height of the L1 block with the given hash, looking it up on the L1 node if the host hasn't
+// stored it (e.g. the enclave reported an L1 head the host processed before its database was wiped)
+func (h *host) l1BlockHeight(hash gethcommon.Hash) (uint64, error) {
+	header, err := h.db.GetBlockHeader(hash)
+	if err == nil {
+		return header.Number.Uint64(), nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return 0, err
+	}
+	block, err := h.ethClient.BlockByHash(hash)
+	if err != nil {
+		return 0, err
+	}
+	return block.NumberU64(), nil
+}
+
+func (h *host) dbStatus() *hostcommon.DBStatus {
+	if err := h.db.HealthCheck(); err != nil {
+		return &hostcommon.DBStatus{Error: err.Error()}
+	}
+	return &hostcommon.DBStatus{Healthy: true}
+}
+
+func (h *host) batchStatus() *hostcommon.BatchStatus {
+	status := &hostcommon.BatchStatus{}
+
+	headBatch, err := h.db.GetHeadBatchHeader()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		status.Error = fmt.Sprintf("could not retrieve head batch - %s", err)
+		return status
+	}
+	if headBatch != nil {
+		status.HeadBatchHeight = headBatch.Number.Uint64()
+	}
+
+	// the sequencer is never behind itself
+	status.SequencerHeadBatchHeight = status.HeadBatchHeight
+	if h.config.NodeType != common.Sequencer {
+		// if no batch has been received from the sequencer yet there is nothing to compare against
+		if sequencerHeight := h.progress.getSequencerHeadBatchHeight(); sequencerHeight != nil {
+			status.SequencerHeadBatchHeight = sequencerHeight.Uint64()
+		}
+	}
+
+	if status.SequencerHeadBatchHeight > status.HeadBatchHeight {
+		status.Lag = status.SequencerHeadBatchHeight - status.HeadBatchHeight
+	}
+	if status.Lag > h.config.HealthMaxBatchLag {
+		status.Error = fmt.Sprintf("node is %d batches behind the sequencer, the maximum is %d", status.Lag, h.config.HealthMaxBatchLag)
+		return status
+	}
+
+	status.Healthy = true
+	return status
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/naoina/toml"
@@ -23,7 +24,10 @@ import (
 	"github.com/obscuronet/go-obscuro/go/host/batchmanager"
 	"github.com/obscuronet/go-obscuro/go/host/db"
 	"github.com/obscuronet/go-obscuro/go/host/events"
+	"github.com/obscuronet/go-obscuro/go/host/txmanager"
 	"github.com/obscuronet/go-obscuro/go/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -32,14 +36,17 @@ import (
 )
 
 const (
-	// Attempts to broadcast the rollup transaction to the L1. Worst-case, equates to 7 seconds, plus time per request.
+	// Attempts to hand the rollup transaction to the L1 tx manager. Worst-case, equates to 7 seconds, plus time per request.
 	l1TxTriesRollup = 3
-	// Attempts to send secret initialisation, request or response transactions to the L1. Worst-case, equates to 63 seconds, plus time per request.
+	// Attempts to hand secret initialisation, request or response transactions to the L1 tx manager. Worst-case, equates
+	// to 63 seconds, plus time per request.
 	l1TxTriesSecret = 7
 
 	maxWaitForL1Receipt       = 100 * time.Second
-	retryIntervalForL1Receipt = 10 * time.Second
 	blockStreamWarningTimeout = 30 * time.Second
+
+	// The max number of transactions from peers that are held while the enclave is unavailable (e.g. restarting)
+	maxUnsubmittedP2PTxs = 10_000
 )
 
 // Implementation of host.Host.
@@ -57,16 +64,32 @@ type host struct {
 	bootstrappingComplete *int32 // Marks when the host is done bootstrapping
 
 	l1BlockProvider hostcommon.ReconnectingBlockProvider
+	l1Receipts      *l1ReceiptsFetcher
+	l1Finality      *l1FinalityFeeder               // nil unless the enclave validates L1 blocks against the beacon chain
 	txP2PCh         chan common.EncryptedTx         // The channel that new transactions from peers are sent to
 	batchP2PCh      chan common.EncodedBatchMsg     // The channel that new batches from peers are sent to
 	batchRequestCh  chan common.EncodedBatchRequest // The channel that batch requests from peers are sent to
 
+	// Transactions from peers that could not be submitted because the enclave was unavailable, they are resubmitted in
+	// order once the enclave is back (only accessed from the main processing loop)
+	unsubmittedP2PTxs []common.EncryptedTx
+
+	// The latest finalized L1 block the L1 node reported, and the one last submitted to the enclave (only accessed from
+	// the main processing loop)
+	l1FinalizedHeader         *types.Header
+	submittedFinalizedL1Block gethcommon.Hash
+
+	progress    *progressTracker   // The enclave's and the sequencer's progress, for the health checks and sync status
+	rollupForks *rollupForkMonitor // The state of the management contract's rollup tree, for the health checks
+
 	db *db.DB // Stores the host's publicly-available data
 
-	mgmtContractLib mgmtcontractlib.MgmtContractLib // Library to handle Management Contract lib operations
-	ethWallet       wallet.Wallet                   // Wallet used to issue ethereum transactions
-	logEventManager events.LogEventManager
-	batchManager    *batchmanager.BatchManager
+	mgmtContractLib    mgmtcontractlib.MgmtContractLib // Library to handle Management Contract lib operations
+	ethWallet          wallet.Wallet                   // Wallet used to issue ethereum transactions
+	logEventManager    events.LogEventManager
+	headerEventManager events.HeaderEventManager
+	batchManager       *batchmanager.BatchManager
+	l1TxManager        *txmanager.TxManager
 
 	logger gethlog.Logger
 
@@ -103,22 +126,32 @@ func NewHost(
 		bootstrappingComplete: new(int32),
 
 		// incoming data
-		l1BlockProvider: ethadapter.NewEthBlockProvider(ethClient, logger),
+		l1BlockProvider: newL1BlockProvider(config, ethClient, logger),
 		txP2PCh:         make(chan common.EncryptedTx),
 		batchP2PCh:      make(chan common.EncodedBatchMsg),
 		batchRequestCh:  make(chan common.EncodedBatchRequest),
 
+		progress:    &progressTracker{},
+		rollupForks: newRollupForkMonitor(regMetrics),
+
 		// Initialize the host DB
 		db: database,
 
-		mgmtContractLib: mgmtContractLib, // library that provides a handler for Management Contract
-		ethWallet:       ethWallet,       // the host's ethereum wallet
-		logEventManager: events.NewLogEventManager(logger),
-		batchManager:    batchmanager.NewBatchManager(database, config.P2PPublicAddress),
+		mgmtContractLib:    mgmtContractLib, // library that provides a handler for Management Contract
+		ethWallet:          ethWallet,       // the host's ethereum wallet
+		logEventManager:    events.NewLogEventManager(logger),
+		headerEventManager: events.NewHeaderEventManager(logger),
+		batchManager:       batchmanager.NewBatchManager(database, config.P2PPublicAddress),
+		l1TxManager:        txmanager.New(ethClient, ethWallet, database, gweiToWei(config.L1MaxFeePerGasGwei), config.L1MaxTxReplacements, logger),
 
 		logger:         logger,
 		metricRegistry: regMetrics,
 	}
+	host.l1Receipts = newL1ReceiptsFetcher(ethClient, host.l1BlockProvider)
+	if config.L1BeaconURL != "" {
+		beaconClient := ethadapter.NewBeaconClient(config.L1BeaconURL, config.L1RPCTimeout)
+		host.l1Finality = newL1FinalityFeeder(beaconClient, ethClient, enclaveClient, host.stopHostInterrupt, logger)
+	}
 
 	var prof *profiler.Profiler
 	if config.ProfilerEnabled {
@@ -135,6 +168,14 @@ func NewHost(
 	return host
 }
 
+// Returns the provider that streams the L1 blocks, polling the L1 node for them if a poll interval is configured.
+func newL1BlockProvider(config *config.HostConfig, ethClient ethadapter.EthClient, logger gethlog.Logger) hostcommon.ReconnectingBlockProvider {
+	if config.L1PollInterval > 0 {
+		return ethadapter.NewPollingBlockProvider(ethClient, config.L1PollInterval, logger)
+	}
+	return ethadapter.NewEthBlockProvider(ethClient, logger)
+}
+
 // Start validates the host config and starts the Host in a go routine - immediately returns after
 func (h *host) Start() error {
 	h.validateConfig()
@@ -145,6 +186,12 @@ func (h *host) Start() error {
 	}
 	h.logger.Info("Host started with following config", log.CfgKey, string(tomlConfig))
 
+	h.recordStartingBatchHeight()
+
+	if err = h.l1TxManager.Start(); err != nil {
+		return fmt.Errorf("could not start L1 tx manager. Cause: %w", err)
+	}
+
 	go func() {
 		// wait for the Enclave to be available
 		enclStatus := h.waitForEnclave()
@@ -198,14 +245,12 @@ func (h *host) generateAndBroadcastSecret() error {
 		InitialSecret: secret,
 		HostAddress:   h.config.P2PPublicAddress,
 	}
-	initialiseSecretTx := h.mgmtContractLib.CreateInitializeSecret(l1tx, h.ethWallet.GetNonceAndIncrement())
-	initialiseSecretTx, err = h.ethClient.EstimateGasAndGasPrice(initialiseSecretTx, h.ethWallet.Address())
-	if err != nil {
-		h.ethWallet.SetNonce(h.ethWallet.GetNonce() - 1)
-		return err
-	}
+	initialiseSecretTx := h.mgmtContractLib.CreateInitializeSecret(l1tx, txmanager.UnassignedNonce)
 	// we block here until we confirm a successful receipt. It is important this is published before the initial rollup.
-	err = h.signAndBroadcastL1Tx(initialiseSecretTx, l1TxTriesSecret, true)
+	sentTx, err := h.sendL1Tx(initialiseSecretTx, "initialise secret", l1TxTriesSecret)
+	if err == nil {
+		_, err = sentTx.Wait(maxWaitForL1Receipt)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to initialise enclave secret. Cause: %w", err)
 	}
@@ -228,10 +273,11 @@ func (h *host) EnclaveClient() common.Enclave {
 func (h *host) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRawTx) (common.EncryptedResponseSendRawTx, error) {
 	encryptedTx := common.EncryptedTx(encryptedParams)
 
-	encryptedResponse, err := h.enclaveClient.SubmitTx(encryptedTx)
+	response, err := h.enclaveClient.SubmitTx(encryptedTx)
 	if err != nil {
 		return nil, fmt.Errorf("could not submit transaction. Cause: %w", err)
 	}
+	h.logEventManager.SendTxHashesToSubscribers(response)
 
 	if h.config.NodeType != common.Sequencer {
 		err = h.p2p.SendTxToSequencer(encryptedTx)
@@ -240,7 +286,7 @@ func (h *host) SubmitAndBroadcastTx(encryptedParams common.EncryptedParamsSendRa
 		}
 	}
 
-	return encryptedResponse, nil
+	return response.EncryptedHash, nil
 }
 
 func (h *host) ReceiveTx(tx common.EncryptedTx) {
@@ -255,16 +301,40 @@ func (h *host) ReceiveBatchRequest(batchRequest common.EncodedBatchRequest) {
 	h.batchRequestCh <- batchRequest
 }
 
-func (h *host) Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogsCh chan []byte) error {
-	err := h.EnclaveClient().Subscribe(id, encryptedLogSubscription)
+func (h *host) Subscribe(id rpc.ID, encryptedLogSubscription common.EncryptedParamsLogSubscription, matchedLogsCh chan []byte) (common.EncryptedLogs, error) {
+	// We add the subscription before creating it in the enclave, so that no logs produced after the enclave computes
+	// the replayed historical logs are missed.
+	h.logEventManager.AddSubscription(id, matchedLogsCh)
+	historicalLogs, err := h.EnclaveClient().Subscribe(id, encryptedLogSubscription)
+	if err != nil {
+		h.logEventManager.RemoveSubscription(id)
+		return nil, fmt.Errorf("could not create subscription with enclave. Cause: %w", err)
+	}
+	return historicalLogs, nil
+}
+
+func (h *host) SubscribePendingTxs(id rpc.ID, encryptedParams common.EncryptedParamsPendingTxSubscription, txHashesCh chan []byte) error {
+	err := h.EnclaveClient().SubscribePendingTxs(id, encryptedParams)
 	if err != nil {
 		return fmt.Errorf("could not create subscription with enclave. Cause: %w", err)
 	}
-	h.logEventManager.AddSubscription(id, matchedLogsCh)
+	h.logEventManager.AddSubscription(id, txHashesCh)
 	return nil
 }
 
+func (h *host) SubscribeNewHeads(id rpc.ID, batchHeadersCh chan *common.BatchHeader) {
+	h.headerEventManager.AddBatchSubscription(id, batchHeadersCh)
+}
+
+func (h *host) SubscribeRollups(id rpc.ID, rollupHeadersCh chan *common.RollupHeader) {
+	h.headerEventManager.AddRollupSubscription(id, rollupHeadersCh)
+}
+
 func (h *host) Unsubscribe(id rpc.ID) {
+	// header subscriptions are managed by the host alone, so there is nothing to terminate on the enclave
+	if h.headerEventManager.RemoveSubscription(id) {
+		return
+	}
 	err := h.EnclaveClient().Unsubscribe(id)
 	if err != nil {
 		h.logger.Error("could not terminate subscription", log.SubIDKey, id, log.ErrKey, err)
@@ -289,6 +359,7 @@ func (h *host) Stop() {
 	// Leave some time for all processing to finish before exiting the main loop.
 	time.Sleep(time.Second)
 	h.exitHostCh <- true
+	h.l1TxManager.Stop()
 
 	if err := h.db.Stop(); err != nil {
 		h.logger.Error("could not stop DB - %w", err)
@@ -297,29 +368,6 @@ func (h *host) Stop() {
 	h.logger.Info("Host shut down successfully.")
 }
 
-// HealthCheck returns whether the host, enclave and DB are healthy
-func (h *host) HealthCheck() (*hostcommon.HealthCheck, error) {
-	// check the enclave health, which in turn checks the DB health
-	enclaveHealthy, err := h.enclaveClient.HealthCheck()
-	if err != nil {
-		// simplest iteration, log the error and just return that it's not healthy
-		h.logger.Error("unable to HealthCheck enclave", log.ErrKey, err)
-	}
-
-	// Overall health is achieved when all parts are healthy
-	obscuroNodeHealth := h.p2p.HealthCheck() && enclaveHealthy
-
-	return &hostcommon.HealthCheck{
-		HealthCheckHost: &hostcommon.HealthCheckHost{
-			P2PStatus: h.p2p.Status(),
-		},
-		HealthCheckEnclave: &hostcommon.HealthCheckEnclave{
-			EnclaveHealthy: enclaveHealthy,
-		},
-		OverallHealth: obscuroNodeHealth,
-	}, nil
-}
-
 // Waits for enclave to be available, printing a wait message every two seconds.
 func (h *host) waitForEnclave() common.Status {
 	counter := 0
@@ -354,6 +402,7 @@ func (h *host) startProcessing() {
 			h.logger.Crit("unable to stream l1 blocks for enclave", log.ErrKey, err)
 		}
 	}
+	blockStream = h.l1Receipts.prefetch(blockStream)
 
 	// use the roundInterrupt as a signaling mechanism for interrupting block processing
 	// stops processing the current round if a new block arrives
@@ -372,13 +421,15 @@ func (h *host) startProcessing() {
 			if err != nil {
 				// handle the error, replace the blockStream if necessary (e.g. if stream needs resetting based on enclave's reported L1 head)
 				blockStream = h.handleProcessBlockErr(b, blockStream, err)
+			} else {
+				// the enclave is available, so we can hand it any transactions it missed while it was not
+				h.resubmitP2PTxs()
 			}
 
 		case tx := <-h.txP2PCh:
 			// todo: discard p2p messages if enclave won't be able to make use of them (e.g. we're way behind L1 head)
-			if _, err := h.enclaveClient.SubmitTx(tx); err != nil {
-				h.logger.Warn("Could not submit transaction. ", log.ErrKey, err)
-			}
+			h.resubmitP2PTxs()
+			h.submitP2PTx(tx)
 
 		// TODO - #718 - Adopt a similar approach to blockStream, where we have a BatchProvider that streams new batches.
 		case batchMsg := <-h.batchP2PCh:
@@ -398,6 +449,56 @@ func (h *host) startProcessing() {
 	}
 }
 
+// submitP2PTx submits a transaction received from a peer to the enclave. If the enclave is unavailable the transaction
+// is held to be resubmitted later rather than dropped, as dropping it would leave a nonce gap that stalls all the
+// sender's later transactions
+func (h *host) submitP2PTx(tx common.EncryptedTx) {
+	if len(h.unsubmittedP2PTxs) > 0 {
+		// preserve the order in which the transactions were received
+		h.holdP2PTx(tx)
+		return
+	}
+	response, err := h.enclaveClient.SubmitTx(tx)
+	if err == nil {
+		h.logEventManager.SendTxHashesToSubscribers(response)
+		return
+	}
+	if status.Code(err) == codes.Unavailable {
+		h.holdP2PTx(tx)
+		return
+	}
+	h.logger.Warn("Could not submit transaction. ", log.ErrKey, err)
+}
+
+func (h *host) holdP2PTx(tx common.EncryptedTx) {
+	if len(h.unsubmittedP2PTxs) >= maxUnsubmittedP2PTxs {
+		h.logger.Warn("Could not submit transaction. Enclave unavailable and too many transactions held.")
+		return
+	}
+	h.unsubmittedP2PTxs = append(h.unsubmittedP2PTxs, tx)
+}
+
+// resubmitP2PTxs submits the transactions held while the enclave was unavailable, stopping at the first one that can
+// still not be submitted for that reason
+func (h *host) resubmitP2PTxs() {
+	if len(h.unsubmittedP2PTxs) == 0 {
+		return
+	}
+	for len(h.unsubmittedP2PTxs) > 0 {
+		response, err := h.enclaveClient.SubmitTx(h.unsubmittedP2PTxs[0])
+		if err != nil && status.Code(err) == codes.Unavailable {
+			return
+		}
+		if err != nil {
+			h.logger.Warn("Could not resubmit transaction. ", log.ErrKey, err)
+		} else {
+			h.logEventManager.SendTxHashesToSubscribers(response)
+		}
+		h.unsubmittedP2PTxs = h.unsubmittedP2PTxs[1:]
+	}
+	h.logger.Info("Resubmitted all transactions held while the enclave was unavailable.")
+}
+
 func (h *host) handleProcessBlockErr(processedBlock *types.Block, stream *hostcommon.BlockStream, err error) *hostcommon.BlockStream {
 	var rejErr *common.BlockRejectError
 	if !errors.As(err, &rejErr) {
@@ -407,6 +508,9 @@ func (h *host) handleProcessBlockErr(processedBlock *types.Block, stream *hostco
 		return stream
 	}
 	h.logger.Info("Block rejected by enclave.", log.ErrKey, rejErr, log.BlockHashKey, processedBlock.Hash(), log.BlockHeightKey, processedBlock.Number())
+	if rejErr.L1Head != (gethcommon.Hash{}) {
+		h.progress.setEnclaveL1Head(rejErr.L1Head)
+	}
 	if errors.Is(rejErr, common.ErrBlockAlreadyProcessed) {
 		// resetting stream after rejection for duplicate is a possible optimisation in future but it's rarely an expensive case and
 		// it's a risky optimisation (need to ensure it can't get stuck in a loop)
@@ -427,7 +531,7 @@ func (h *host) handleProcessBlockErr(processedBlock *types.Block, stream *hostco
 		return stream
 	}
 	stream.Stop() // cancel the previous stream and return the replacement
-	return replacementStream
+	return h.l1Receipts.prefetch(replacementStream)
 }
 
 // activates the given interrupt (atomically) and returns a new interrupt
@@ -444,24 +548,64 @@ func (h *host) processL1Block(block *types.Block, isLatestBlock bool) error {
 		return nil
 	}
 
-	h.processL1BlockTransactions(block)
+	if h.l1Finality != nil {
+		// the enclave only accepts the block once it is proven to be finalized
+		if err := h.l1Finality.ensureFinalized(block); err != nil {
+			return fmt.Errorf("could not prove block b_%d is finalized. Cause: %w", common.ShortHash(block.Hash()), err)
+		}
+	}
+
+	receipts, err := h.l1Receipts.receipts(block)
+	if err != nil {
+		return fmt.Errorf("could not retrieve receipts of block b_%d. Cause: %w", common.ShortHash(block.Hash()), err)
+	}
+	rollups, err := h.processL1BlockTransactions(block, receipts)
+	if err != nil {
+		return fmt.Errorf("could not process transactions of block b_%d. Cause: %w", common.ShortHash(block.Hash()), err)
+	}
 
 	// submit each block to the enclave for ingestion plus validation
-	blockSubmissionResponse, err := h.enclaveClient.SubmitL1Block(*block, h.extractReceipts(block), isLatestBlock)
+	blockSubmissionResponse, err := h.enclaveClient.SubmitL1Block(*block, receipts, isLatestBlock)
 	if err != nil {
+		if h.l1Finality != nil {
+			// the enclave may have restarted and lost the finalized blocks it had been proven
+			h.l1Finality.reset()
+		}
 		return fmt.Errorf("did not ingest block b_%d. Cause: %w", common.ShortHash(block.Hash()), err)
 	}
+	h.progress.setEnclaveL1Head(block.Hash())
+	if len(rollups) > 0 {
+		publicRollups := make([]*common.PublicRollup, len(rollups))
+		for i, rollup := range rollups {
+			publicRollups[i] = rollup.ToPublicRollup(block.Hash())
+		}
+		if err = h.db.AddBlockRollups(block.Hash(), publicRollups); err != nil {
+			return fmt.Errorf("submitted block to enclave but could not store its rollups. Cause: %w", err)
+		}
+	}
+	if err = h.updateBatchFinality(block, rollups); err != nil {
+		return fmt.Errorf("submitted block to enclave but could not update the safe and finalized batches. Cause: %w", err)
+	}
 	err = h.db.AddBlockHeader(block.Header())
 	if err != nil {
 		return fmt.Errorf("submitted block to enclave but could not store the block processing result. Cause: %w", err)
 	}
 
 	h.logEventManager.SendLogsToSubscribers(blockSubmissionResponse)
+	for _, rollup := range rollups {
+		h.headerEventManager.SendRollupHeaderToSubscribers(rollup.Header)
+	}
+	if len(rollups) > 0 {
+		h.checkRollupForks(block.NumberU64())
+	}
 
 	err = h.publishSharedSecretResponses(blockSubmissionResponse.ProducedSecretResponses)
 	if err != nil {
 		h.logger.Error("failed to publish response to secret request", log.ErrKey, err)
 	}
+	for _, rejection := range blockSubmissionResponse.RejectedSecretRequests {
+		h.logger.Warn("Enclave refused to share the network secret.", "requester", rejection.RequesterID, "reason", rejection.Reason)
+	}
 
 	// If we're not the sequencer, we do not need to publish and distribute batches or rollups.
 	if h.config.NodeType != common.Sequencer {
@@ -480,23 +624,39 @@ func (h *host) processL1Block(block *types.Block, isLatestBlock bool) error {
 	return nil
 }
 
-// Looks at each transaction in the block, and kicks off special handling for the transaction if needed.
-func (h *host) processL1BlockTransactions(b *types.Block) {
-	for _, tx := range b.Transactions() {
-		t := h.mgmtContractLib.DecodeTx(tx)
-		if t == nil {
-			continue
-		}
+// Looks at each management contract transaction in the block, and kicks off special handling for the transaction if
+// needed. Returns the rollups published in the block.
+func (h *host) processL1BlockTransactions(b *types.Block, receipts types.Receipts) ([]*common.ExtRollup, error) {
+	l1Txs, err := mgmtcontractlib.DecodeBlock(h.mgmtContractLib, b, receipts, h.logger)
+	if err != nil {
+		return nil, err
+	}
 
-		// node received a secret response, we should make sure our p2p addresses are up-to-date
-		if _, ok := t.(*ethadapter.L1RespondSecretTx); ok {
-			err := h.refreshP2PPeerList()
+	var rollups []*common.ExtRollup
+	hostsChanged := false
+	for _, t := range l1Txs {
+		switch l1Tx := t.(type) {
+		case *ethadapter.L1RollupTx:
+			rollup, err := common.DecodeRollup(l1Tx.Rollup)
 			if err != nil {
-				h.logger.Error("Failed to update p2p peer list", log.ErrKey, err)
+				h.logger.Warn("Could not decode rollup published to the L1.", log.ErrKey, err)
 				continue
 			}
+			rollups = append(rollups, rollup)
+
+		// a host was added to the network (the secret response is the signal for contracts that predate the events)
+		case *ethadapter.L1HostRegisteredTx, *ethadapter.L1RespondSecretTx:
+			hostsChanged = true
+		}
+	}
+
+	// we make sure our p2p addresses are up-to-date
+	if hostsChanged {
+		if err = h.refreshP2PPeerList(); err != nil {
+			h.logger.Error("Failed to update p2p peer list", log.ErrKey, err)
 		}
 	}
+	return rollups, nil
 }
 
 // Publishes a rollup to the L1.
@@ -519,20 +679,9 @@ func (h *host) publishRollup(producedRollup *common.ExtRollup) {
 			return string(header[:])
 		}}, "rollup_hash", producedRollup.Header.Hash().Hex())
 
-	rollupTx := h.mgmtContractLib.CreateRollup(tx, h.ethWallet.GetNonceAndIncrement())
-	rollupTx, err = h.ethClient.EstimateGasAndGasPrice(rollupTx, h.ethWallet.Address())
-	if err != nil {
-		// todo review this nonce management approach
-		h.ethWallet.SetNonce(h.ethWallet.GetNonce() - 1)
-		h.logger.Error("could not estimate rollup tx", log.ErrKey, err)
-		return
-	}
-
-	// fire-and-forget (track the receipt asynchronously)
-	// TODO - #718 - Now we have a single sequencer, it is problematic if rollup publication fails. Handle this case
-	//  better.
-	err = h.signAndBroadcastL1Tx(rollupTx, l1TxTriesRollup, true)
-	if err != nil {
+	rollupTx := h.mgmtContractLib.CreateRollup(tx, txmanager.UnassignedNonce)
+	// The L1 tx manager keeps rebroadcasting the rollup, with higher fees if needed, until it is included.
+	if _, err = h.sendL1Tx(rollupTx, "rollup", l1TxTriesRollup); err != nil {
 		h.logger.Error("could not issue rollup tx", log.ErrKey, err)
 	}
 }
@@ -542,6 +691,8 @@ func (h *host) storeAndDistributeBatch(producedBatch *common.ExtBatch) {
 	err := h.db.AddBatchHeader(producedBatch)
 	if err != nil {
 		h.logger.Error("could not store batch", log.ErrKey, err)
+	} else {
+		h.headerEventManager.SendBatchHeaderToSubscribers(producedBatch.Header)
 	}
 
 	batchMsg := hostcommon.BatchMsg{
@@ -554,69 +705,19 @@ func (h *host) storeAndDistributeBatch(producedBatch *common.ExtBatch) {
 	}
 }
 
-// `tries` is the number of times to attempt broadcasting the transaction.
-// if awaitReceipt is true then this method will block and synchronously wait to check the receipt, otherwise it is fire
-// and forget and the receipt tracking will happen in a separate go-routine
-func (h *host) signAndBroadcastL1Tx(tx types.TxData, tries uint64, awaitReceipt bool) error {
-	var err error
-	tx, err = h.ethClient.EstimateGasAndGasPrice(tx, h.ethWallet.Address())
-	if err != nil {
-		return fmt.Errorf("unable to estimate gas limit and gas price - %w", err)
-	}
-
-	signedTx, err := h.ethWallet.SignTransaction(tx)
-	if err != nil {
+// Hands the transaction to the L1 tx manager, which tracks it until it is included in a block. `tries` is the number of
+// times to attempt this, as the transaction's gas cannot be estimated while the L1 node is unavailable.
+func (h *host) sendL1Tx(txData types.TxData, description string, tries uint64) (*txmanager.SentTx, error) {
+	var sentTx *txmanager.SentTx
+	err := retry.Do(func() error {
+		var err error
+		sentTx, err = h.l1TxManager.Send(txData, description)
 		return err
-	}
-
-	err = retry.Do(func() error {
-		return h.ethClient.SendTransaction(signedTx)
 	}, retry.NewDoublingBackoffStrategy(time.Second, tries)) // doubling retry wait (3 tries = 7sec, 7 tries = 63sec)
 	if err != nil {
-		return fmt.Errorf("broadcasting L1 transaction failed after %d tries. Cause: %w", tries, err)
-	}
-	h.logger.Trace("L1 transaction sent successfully, watching for receipt.")
-
-	if awaitReceipt {
-		// block until receipt is found and then return
-		return h.waitForReceipt(signedTx.Hash())
+		return nil, fmt.Errorf("could not send L1 transaction after %d tries. Cause: %w", tries, err)
 	}
-
-	// else just watch for receipt asynchronously and log if it fails
-	go func() {
-		// todo: consider how to handle the various ways that L1 transactions could fail to improve node operator QoL
-		err = h.waitForReceipt(signedTx.Hash())
-		if err != nil {
-			h.logger.Error("L1 transaction failed", log.ErrKey, err)
-		}
-	}()
-
-	return nil
-}
-
-func (h *host) waitForReceipt(txHash common.TxHash) error {
-	var receipt *types.Receipt
-	var err error
-	err = retry.Do(
-		func() error {
-			receipt, err = h.ethClient.TransactionReceipt(txHash)
-			if err != nil {
-				// adds more info on the error
-				return fmt.Errorf("unable to get receipt for tx: %s - %w", txHash.Hex(), err)
-			}
-			return err
-		},
-		retry.NewTimeoutStrategy(maxWaitForL1Receipt, retryIntervalForL1Receipt),
-	)
-	if err != nil {
-		return fmt.Errorf("receipt for L1 transaction never found despite 'successful' broadcast - %w", err)
-	}
-
-	if err == nil && receipt.Status != types.ReceiptStatusSuccessful {
-		return fmt.Errorf("unsuccessful receipt found for published L1 transaction, status=%d", receipt.Status)
-	}
-	h.logger.Trace("Successful L1 transaction receipt found.", log.BlockHeightKey, receipt.BlockNumber, log.BlockHashKey, receipt.BlockHash)
-	return nil
+	return sentTx, nil
 }
 
 // This method implements the procedure by which a node obtains the secret
@@ -644,15 +745,13 @@ func (h *host) requestSecret() error {
 	if err != nil {
 		panic(fmt.Errorf("could not fetch head L1 block. Cause: %w", err))
 	}
-	requestSecretTx := h.mgmtContractLib.CreateRequestSecret(l1tx, h.ethWallet.GetNonceAndIncrement())
-	requestSecretTx, err = h.ethClient.EstimateGasAndGasPrice(requestSecretTx, h.ethWallet.Address())
+	requestSecretTx := h.mgmtContractLib.CreateRequestSecret(l1tx, txmanager.UnassignedNonce)
+	// we wait until the secret req transaction has succeeded before we start polling for the secret
+	sentTx, err := h.sendL1Tx(requestSecretTx, "secret request", l1TxTriesSecret)
 	if err != nil {
-		h.ethWallet.SetNonce(h.ethWallet.GetNonce() - 1)
 		return err
 	}
-	// we wait until the secret req transaction has succeeded before we start polling for the secret
-	err = h.signAndBroadcastL1Tx(requestSecretTx, l1TxTriesSecret, true)
-	if err != nil {
+	if _, err = sentTx.Wait(maxWaitForL1Receipt); err != nil {
 		return err
 	}
 
@@ -679,33 +778,21 @@ func (h *host) handleStoreSecretTx(t *ethadapter.L1RespondSecretTx) bool {
 }
 
 func (h *host) publishSharedSecretResponses(scrtResponses []*common.ProducedSecretResponse) error {
-	var err error
-
+	// The enclave only produces responses to the requests this node was elected to respond to.
 	for _, scrtResponse := range scrtResponses {
-		// todo: implement proper protocol so only one host responds to this secret requests initially
-		// 	for now we just have the genesis host respond until protocol implemented
-		if !h.config.IsGenesis {
-			h.logger.Trace("Not genesis node, not publishing response to secret request.",
-				"requester", scrtResponse.RequesterID)
-			return nil
-		}
-
-		l1tx := &ethadapter.L1RespondSecretTx{
+		l1tx := (&ethadapter.L1RespondSecretTx{
 			Secret:      scrtResponse.Secret,
 			RequesterID: scrtResponse.RequesterID,
 			AttesterID:  h.config.ID,
 			HostAddress: scrtResponse.HostAddress,
+		}).Sign(h.ethWallet.PrivateKey())
+		if l1tx == nil {
+			return fmt.Errorf("could not sign secret response for %s", scrtResponse.RequesterID)
 		}
-		// TODO review: l1tx.Sign(a.attestationPubKey) doesn't matter as the waitSecret will process a tx that was reverted
-		respondSecretTx := h.mgmtContractLib.CreateRespondSecret(l1tx, h.ethWallet.GetNonceAndIncrement(), false)
-		respondSecretTx, err = h.ethClient.EstimateGasAndGasPrice(respondSecretTx, h.ethWallet.Address())
-		if err != nil {
-			h.ethWallet.SetNonce(h.ethWallet.GetNonce() - 1)
-			return err
-		}
+		respondSecretTx := h.mgmtContractLib.CreateRespondSecret(l1tx, txmanager.UnassignedNonce, true)
 		h.logger.Trace("Broadcasting secret response L1 tx.", "requester", scrtResponse.RequesterID)
-		// fire-and-forget (track the receipt asynchronously)
-		err = h.signAndBroadcastL1Tx(respondSecretTx, l1TxTriesSecret, false)
+		// fire-and-forget (the L1 tx manager tracks the receipt)
+		_, err := h.sendL1Tx(respondSecretTx, "secret response", l1TxTriesSecret)
 		if err != nil {
 			return fmt.Errorf("could not broadcast secret response. Cause %w", err)
 		}
@@ -748,36 +835,12 @@ func (h *host) refreshP2PPeerList() error {
 	return nil
 }
 
-// TODO: Perhaps extract only relevant logs. There were missing ones when requesting
-// the logs filtered from geth.
-func (h *host) extractReceipts(block *types.Block) types.Receipts {
-	receipts := make(types.Receipts, 0)
-
-	for _, transaction := range block.Transactions() {
-		receipt, err := h.ethClient.TransactionReceipt(transaction.Hash())
-
-		if err != nil || receipt == nil {
-			h.logger.Error("Problem with retrieving the receipt on the host!", log.ErrKey, err, log.CmpKey, log.CrossChainCmp)
-			continue
-		}
-
-		h.logger.Trace(fmt.Sprintf("Adding receipt[%d] for block %d, TX: %d",
-			receipt.Status,
-			common.ShortHash(block.Hash()),
-			common.ShortHash(transaction.Hash())),
-			log.CmpKey, log.CrossChainCmp)
-
-		receipts = append(receipts, receipt)
-	}
-
-	return receipts
-}
-
 func (h *host) awaitSecret(fromHeight *big.Int) error {
 	blkStream, err := h.l1BlockProvider.StartStreamingFromHeight(fromHeight)
 	if err != nil {
 		return err
 	}
+	blkStream = h.l1Receipts.prefetch(blkStream)
 	defer blkStream.Stop()
 
 	for {
@@ -799,11 +862,17 @@ func (h *host) awaitSecret(fromHeight *big.Int) error {
 }
 
 func (h *host) checkBlockForSecretResponse(block *types.Block) bool {
-	for _, tx := range block.Transactions() {
-		t := h.mgmtContractLib.DecodeTx(tx)
-		if t == nil {
-			continue
-		}
+	receipts, err := h.l1Receipts.receipts(block)
+	if err != nil {
+		h.logger.Warn("Could not retrieve receipts to check block for secret response.", log.ErrKey, err)
+		return false
+	}
+	l1Txs, err := mgmtcontractlib.DecodeBlock(h.mgmtContractLib, block, receipts, h.logger)
+	if err != nil {
+		h.logger.Warn("Could not check block for secret response.", log.ErrKey, err)
+		return false
+	}
+	for _, t := range l1Txs {
 		if scrtTx, ok := t.(*ethadapter.L1RespondSecretTx); ok {
 			ok := h.handleStoreSecretTx(scrtTx)
 			if ok {
@@ -830,6 +899,8 @@ func (h *host) handleBatches(encodedBatchMsg *common.EncodedBatchMsg) error {
 	}
 
 	for _, batch := range batchMsg.Batches {
+		h.progress.receivedSequencerBatch(batch.Header.Number)
+
 		// TODO - #718 - Consider moving to a model where the enclave manages the entire state, to avoid inconsistency.
 
 		// If we do not have the block the batch is tied to, we skip processing the batches for now. We'll catch them
@@ -869,6 +940,7 @@ func (h *host) handleBatches(encodedBatchMsg *common.EncodedBatchMsg) error {
 		if err = h.db.AddBatchHeader(batch); err != nil {
 			return fmt.Errorf("could not store batch header. Cause: %w", err)
 		}
+		h.headerEventManager.SendBatchHeaderToSubscribers(batch.Header)
 	}
 
 	return nil
@@ -907,3 +979,7 @@ func (h *host) validateConfig() {
 		h.logger.Crit("the host must specify a public P2P address")
 	}
 }
+
+func gweiToWei(gwei uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(gwei), big.NewInt(params.GWei))
+}
@@ -0,0 +1,173 @@
+package host
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	l1FinalityPollInterval = 12 * time.Second // the interval at which the beacon node is polled for a later finalized block (one slot)
+	maxL1FinalityHeaders   = 1000             // the maximum number of L1 headers sent to the enclave in a single update
+	maxL1FinalityUpdates   = 128              // the maximum number of sync committee updates requested at once, as in the beacon node API
+)
+
+var errNotFinalized = errors.New("block is not finalized yet")
+
+// l1FinalityFeeder proves to an enclave that validates L1 blocks against the beacon chain that the L1 blocks the host
+// submits to it are finalized. It feeds the enclave the light client data of the beacon chain, and the headers of the
+// L1 blocks behind the finalized blocks the light client data proves.
+type l1FinalityFeeder struct {
+	beaconClient  ethadapter.BeaconClient
+	ethClient     ethadapter.EthClient
+	enclaveClient common.Enclave
+	stopInterrupt *int32 // set when the host stops
+	pollInterval  time.Duration
+	logger        gethlog.Logger
+
+	status *common.L1FinalityStatus // the status the enclave last returned (nil if it must be fetched again)
+}
+
+func newL1FinalityFeeder(beaconClient ethadapter.BeaconClient, ethClient ethadapter.EthClient, enclaveClient common.Enclave, stopInterrupt *int32, logger gethlog.Logger) *l1FinalityFeeder {
+	return &l1FinalityFeeder{
+		beaconClient:  beaconClient,
+		ethClient:     ethClient,
+		enclaveClient: enclaveClient,
+		stopInterrupt: stopInterrupt,
+		pollInterval:  l1FinalityPollInterval,
+		logger:        logger,
+	}
+}
+
+// Feeds the enclave until it accepts the block, waiting for the beacon chain to finalize the block if needed.
+func (f *l1FinalityFeeder) ensureFinalized(block *types.Block) error {
+	height := block.NumberU64()
+	if f.status == nil {
+		if err := f.submit(&common.L1FinalityUpdate{}); err != nil {
+			return err
+		}
+	}
+
+	for !f.status.Covers(height) {
+		if atomic.LoadInt32(f.stopInterrupt) == 1 {
+			return errors.New("host is stopping")
+		}
+
+		var update *common.L1FinalityUpdate
+		var err error
+		switch {
+		case !f.status.Bootstrapped:
+			update, err = f.bootstrapUpdate()
+		case height > f.status.FinalizedHeight:
+			update, err = f.finalityUpdate(height)
+		default:
+			update, err = f.headersUpdate(f.status.LowestHeight, height)
+		}
+		if errors.Is(err, errNotFinalized) {
+			f.logger.Trace("Waiting for the L1 block to be finalized.", log.BlockHeightKey, height, "finalizedHeight", f.status.FinalizedHeight)
+			time.Sleep(f.pollInterval)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err = f.submit(update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Forgets the enclave's status, so that it is fetched again (e.g. because the enclave may have restarted).
+func (f *l1FinalityFeeder) reset() {
+	f.status = nil
+}
+
+func (f *l1FinalityFeeder) submit(update *common.L1FinalityUpdate) error {
+	status, err := f.enclaveClient.SubmitL1Finality(update)
+	if err != nil {
+		f.status = nil
+		return fmt.Errorf("could not submit L1 finality update to the enclave. Cause: %w", err)
+	}
+	f.status = status
+	return nil
+}
+
+func (f *l1FinalityFeeder) bootstrapUpdate() (*common.L1FinalityUpdate, error) {
+	bootstrap, err := f.beaconClient.Bootstrap(f.status.BootstrapRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch light client bootstrap for beacon block %s. Cause: %w", f.status.BootstrapRoot, err)
+	}
+	return &common.L1FinalityUpdate{Bootstrap: bootstrap}, nil
+}
+
+// Returns the latest finality update if it finalizes the block at the given height, preceded by the sync committee
+// updates the enclave needs to verify it.
+func (f *l1FinalityFeeder) finalityUpdate(height uint64) (*common.L1FinalityUpdate, error) {
+	finalityUpdate, err := f.beaconClient.FinalityUpdate()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch light client finality update. Cause: %w", err)
+	}
+	if finalityUpdate.FinalizedHeader == nil || finalityUpdate.FinalizedHeader.Execution == nil ||
+		uint64(finalityUpdate.FinalizedHeader.Execution.BlockNumber) < height {
+		return nil, errNotFinalized
+	}
+
+	signaturePeriod := beacon.SyncCommitteePeriod(uint64(finalityUpdate.SignatureSlot))
+	if signaturePeriod <= f.status.Period {
+		return &common.L1FinalityUpdate{Updates: []*beacon.LightClientUpdate{finalityUpdate}}, nil
+	}
+
+	// the enclave learns the sync committee of each period from the update of the previous period
+	count := signaturePeriod - f.status.Period
+	if count > maxL1FinalityUpdates {
+		count = maxL1FinalityUpdates
+	}
+	updates, err := f.beaconClient.Updates(f.status.Period, count)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch light client updates from period %d. Cause: %w", f.status.Period, err)
+	}
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("beacon node has no light client update for period %d", f.status.Period)
+	}
+	if uint64(len(updates)) == signaturePeriod-f.status.Period {
+		updates = append(updates, finalityUpdate)
+	}
+	return &common.L1FinalityUpdate{Updates: updates}, nil
+}
+
+// Returns the headers of the blocks from just below the lowest height the enclave has the finalized block of, down to
+// the target height, so that the enclave learns the blocks behind the finalized block.
+func (f *l1FinalityFeeder) headersUpdate(lowestHeight uint64, targetHeight uint64) (*common.L1FinalityUpdate, error) {
+	lowest := targetHeight
+	if lowestHeight-lowest > maxL1FinalityHeaders {
+		lowest = lowestHeight - maxL1FinalityHeaders
+	}
+
+	headers := make([]hexutil.Bytes, 0, lowestHeight-lowest)
+	for above := lowestHeight; above > lowest; above-- {
+		number := above - 1
+		header, err := f.ethClient.L1HeaderByNumber(new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch L1 header at height %d. Cause: %w", number, err)
+		}
+		encodedHeader, err := rlp.EncodeToBytes(header)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode L1 header at height %d. Cause: %w", number, err)
+		}
+		headers = append(headers, encodedHeader)
+	}
+	return &common.L1FinalityUpdate{Headers: headers}, nil
+}
@@ -0,0 +1,160 @@
+package host
+
+import (
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/enclave/l2chain"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/integration/eth2network"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+func TestL1FinalityFeederProvesBlocksToEnclave(t *testing.T) {
+	// with 4096 slots per block, each sync committee period spans two blocks, so the feeder has to feed the sync
+	// committee updates of several periods
+	chain := newFakeL1Chain(12)
+	feeder, enclave := newTestFinalityFeeder(t, chain, eth2network.StandInBeaconConfig{SlotsPerBlock: 4096}, 1)
+
+	for height := 1; height <= 10; height++ {
+		block := chain.block(height)
+		if err := feeder.ensureFinalized(block); err != nil {
+			t.Fatalf("could not prove block at height %d is finalized. Cause: %s", height, err)
+		}
+		if err := enclave.validator.Validate(block); err != nil {
+			t.Fatalf("expected enclave to accept block at height %d. Cause: %s", height, err)
+		}
+	}
+
+	// the next block is only finalized once the chain grows
+	done := make(chan error)
+	go func() {
+		done <- feeder.ensureFinalized(chain.block(11))
+	}()
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("expected feeder to wait for the block to be finalized, got result %v", err)
+	default:
+	}
+	chain.grow()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("could not prove block is finalized. Cause: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("feeder did not notice the block was finalized")
+	}
+	if err := enclave.validator.Validate(chain.block(11)); err != nil {
+		t.Fatalf("expected enclave to accept block. Cause: %s", err)
+	}
+}
+
+func TestL1FinalityFeederStopsWaitingWhenHostStops(t *testing.T) {
+	chain := newFakeL1Chain(5)
+	feeder, _ := newTestFinalityFeeder(t, chain, eth2network.StandInBeaconConfig{}, 1)
+
+	done := make(chan error)
+	go func() {
+		done <- feeder.ensureFinalized(chain.block(4))
+	}()
+	time.Sleep(50 * time.Millisecond)
+	triggerInterrupt(feeder.stopInterrupt)
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected feeder to fail once the host stops")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("feeder did not stop waiting when the host stopped")
+	}
+}
+
+// Returns a feeder for an enclave that trusts the beacon block that includes the L1 block at the given height.
+func newTestFinalityFeeder(t *testing.T, chain *fakeL1Chain, config eth2network.StandInBeaconConfig, trustedHeight uint64) (*l1FinalityFeeder, *fakeFinalityEnclave) {
+	standIn := eth2network.NewStandInBeacon(chain, config)
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+
+	trustedRoot, err := standIn.BlockRoot(trustedHeight)
+	if err != nil {
+		t.Fatalf("could not get root of trusted beacon block. Cause: %s", err)
+	}
+	enclave := &fakeFinalityEnclave{validator: l2chain.NewL1FinalityValidator(standIn.Config(), trustedRoot, gethlog.New())}
+	feeder := newL1FinalityFeeder(ethadapter.NewBeaconClient(server.URL, 10*time.Second), chain, enclave, new(int32), gethlog.New())
+	feeder.pollInterval = 10 * time.Millisecond
+	return feeder, enclave
+}
+
+// fakeFinalityEnclave validates L1 blocks against the beacon chain, like an enclave configured with a beacon checkpoint.
+type fakeFinalityEnclave struct {
+	common.Enclave
+	validator *l2chain.L1FinalityValidator
+}
+
+func (e *fakeFinalityEnclave) SubmitL1Finality(update *common.L1FinalityUpdate) (*common.L1FinalityStatus, error) {
+	return e.validator.SubmitUpdate(update)
+}
+
+// fakeL1Chain is a chain of empty post-merge L1 blocks that can be grown.
+type fakeL1Chain struct {
+	ethClient
+	lock   sync.Mutex
+	blocks []*types.Block
+}
+
+func newFakeL1Chain(length int) *fakeL1Chain {
+	chain := &fakeL1Chain{}
+	for i := 0; i < length; i++ {
+		chain.grow()
+	}
+	return chain
+}
+
+func (c *fakeL1Chain) grow() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	header := &types.Header{Number: big.NewInt(int64(len(c.blocks))), Difficulty: big.NewInt(0)}
+	if len(c.blocks) > 0 {
+		header.ParentHash = c.blocks[len(c.blocks)-1].Hash()
+	}
+	c.blocks = append(c.blocks, types.NewBlock(header, nil, nil, nil, trie.NewStackTrie(nil)))
+}
+
+func (c *fakeL1Chain) block(height int) *types.Block {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.blocks[height]
+}
+
+func (c *fakeL1Chain) BlockNumber() (uint64, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return uint64(len(c.blocks) - 1), nil
+}
+
+func (c *fakeL1Chain) BlockByNumber(n *big.Int) (*types.Block, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !n.IsUint64() || n.Uint64() >= uint64(len(c.blocks)) {
+		return nil, fmt.Errorf("no block at height %s", n)
+	}
+	return c.blocks[n.Uint64()], nil
+}
+
+func (c *fakeL1Chain) L1HeaderByNumber(n *big.Int) (*common.L1Header, error) {
+	block, err := c.BlockByNumber(n)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewL1Header(block.Header()), nil
+}
@@ -0,0 +1,125 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+
+	lru "github.com/hashicorp/golang-lru"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+const (
+	receiptsCacheSize     = 256 // the number of L1 blocks whose receipts are cached
+	receiptsPrefetchDepth = 8   // the number of upcoming L1 blocks whose receipts are fetched while the current block is processed
+)
+
+// l1ReceiptsFetcher fetches the receipts of the L1 blocks fed to the enclave, with a single request per block. Fetches
+// are cached, so that the receipts of the upcoming blocks in a block stream can be fetched in the background while the
+// current block is processed.
+type l1ReceiptsFetcher struct {
+	ethClient     ethadapter.EthClient
+	blockProvider hostcommon.ReconnectingBlockProvider
+
+	lock  sync.Mutex // ensures the receipts of a block are only fetched once
+	cache *lru.Cache // block hash -> *receiptsFetch
+}
+
+// receiptsFetch is the fetch of a block's receipts, which may still be in progress.
+type receiptsFetch struct {
+	done     chan struct{} // closed once the fetch completes
+	receipts types.Receipts
+	err      error
+}
+
+func newL1ReceiptsFetcher(ethClient ethadapter.EthClient, blockProvider hostcommon.ReconnectingBlockProvider) *l1ReceiptsFetcher {
+	cache, err := lru.New(receiptsCacheSize)
+	if err != nil {
+		// only possible if the size is not positive
+		panic(fmt.Sprintf("could not create L1 receipts cache. Cause: %s", err))
+	}
+	return &l1ReceiptsFetcher{
+		ethClient:     ethClient,
+		blockProvider: blockProvider,
+		cache:         cache,
+	}
+}
+
+// Returns the block's receipts, waiting for them to be fetched if needed.
+func (f *l1ReceiptsFetcher) receipts(block *types.Block) (types.Receipts, error) {
+	fetch := f.fetch(block)
+	<-fetch.done
+	if fetch.err != nil {
+		// so that the fetch is retried next time
+		f.cache.Remove(block.Hash())
+		return nil, fetch.err
+	}
+	return fetch.receipts, nil
+}
+
+// Returns the fetch of the block's receipts, starting it in the background if it is not cached.
+func (f *l1ReceiptsFetcher) fetch(block *types.Block) *receiptsFetch {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if cached, found := f.cache.Get(block.Hash()); found {
+		return cached.(*receiptsFetch)
+	}
+	fetch := &receiptsFetch{done: make(chan struct{})}
+	f.cache.Add(block.Hash(), fetch)
+	go func() {
+		defer close(fetch.done)
+		fetch.receipts, fetch.err = f.fetchReceipts(block)
+	}()
+	return fetch
+}
+
+// TODO: Perhaps extract only relevant logs. There were missing ones when requesting
+// the logs filtered from geth.
+func (f *l1ReceiptsFetcher) fetchReceipts(block *types.Block) (types.Receipts, error) {
+	if receipts, found := f.blockProvider.Receipts(block.Hash()); found {
+		return receipts, nil
+	}
+	if len(block.Transactions()) == 0 {
+		return types.Receipts{}, nil
+	}
+	receipts, err := f.ethClient.BlockReceipts(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch receipts of block %s. Cause: %w", block.Hash(), err)
+	}
+	return receipts, nil
+}
+
+// Wraps the block stream so that the receipts of up to receiptsPrefetchDepth upcoming blocks are fetched while the
+// current block is processed. Stopping the returned stream also stops the wrapped stream.
+func (f *l1ReceiptsFetcher) prefetch(stream *hostcommon.BlockStream) *hostcommon.BlockStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	prefetched := make(chan *types.Block, receiptsPrefetchDepth)
+
+	go func() {
+		for {
+			select {
+			case block := <-stream.Stream:
+				f.fetch(block)
+				select {
+				case prefetched <- block:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return &hostcommon.BlockStream{
+		Stream: prefetched,
+		Stop: func() {
+			cancel()
+			stream.Stop()
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package host
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+func TestReceiptsAreFetchedOncePerBlock(t *testing.T) {
+	client := &fakeReceiptsClient{}
+	fetcher := newL1ReceiptsFetcher(client, &fakeBlockProvider{})
+	block := blockWithTxs(1, 3)
+
+	for i := 0; i < 3; i++ {
+		receipts, err := fetcher.receipts(block)
+		if err != nil {
+			t.Fatalf("could not fetch receipts. Cause: %s", err)
+		}
+		if len(receipts) != 3 {
+			t.Fatalf("expected 3 receipts, got %d", len(receipts))
+		}
+	}
+	if client.fetches(block.Hash()) != 1 {
+		t.Errorf("expected the receipts to be fetched once, got %d fetches", client.fetches(block.Hash()))
+	}
+}
+
+func TestFailedReceiptsFetchesAreRetried(t *testing.T) {
+	client := &fakeReceiptsClient{failures: 1}
+	fetcher := newL1ReceiptsFetcher(client, &fakeBlockProvider{})
+	block := blockWithTxs(1, 1)
+
+	if _, err := fetcher.receipts(block); err == nil {
+		t.Fatal("expected the first fetch to fail")
+	}
+	if _, err := fetcher.receipts(block); err != nil {
+		t.Fatalf("expected the fetch to be retried. Cause: %s", err)
+	}
+}
+
+func TestReceiptsOfUpcomingBlocksArePrefetched(t *testing.T) {
+	client := &fakeReceiptsClient{}
+	fetcher := newL1ReceiptsFetcher(client, &fakeBlockProvider{})
+	blocks := make(chan *types.Block, 3)
+	for i := int64(1); i <= 3; i++ {
+		blocks <- blockWithTxs(i, 1)
+	}
+
+	stream := fetcher.prefetch(&hostcommon.BlockStream{Stream: blocks, Stop: func() {}})
+	defer stream.Stop()
+
+	// the first block is received, and the receipts of the blocks behind it are fetched while it is processed
+	first := <-stream.Stream
+	for i := int64(2); i <= 3; i++ {
+		hash := blockWithTxs(i, 1).Hash()
+		for start := time.Now(); client.fetches(hash) == 0; time.Sleep(10 * time.Millisecond) {
+			if time.Since(start) > 5*time.Second {
+				t.Fatalf("receipts of block %d were not prefetched", i)
+			}
+		}
+	}
+	if _, err := fetcher.receipts(first); err != nil {
+		t.Fatalf("could not fetch receipts. Cause: %s", err)
+	}
+}
+
+func blockWithTxs(number int64, txCount int) *types.Block {
+	txs := make([]*types.Transaction, txCount)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(i)})
+	}
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(number)}).WithBody(txs, nil)
+}
+
+type ethClient = ethadapter.EthClient
+
+// fakeReceiptsClient serves a successful receipt for each transaction, and counts the fetches per block.
+type fakeReceiptsClient struct {
+	ethClient // Not implemented, aside from the methods below
+
+	mu        sync.Mutex
+	failures  int // the number of fetches to fail before succeeding
+	fetchesBy map[gethcommon.Hash]int
+}
+
+func (c *fakeReceiptsClient) BlockReceipts(block *types.Block) (types.Receipts, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fetchesBy == nil {
+		c.fetchesBy = map[gethcommon.Hash]int{}
+	}
+	c.fetchesBy[block.Hash()]++
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("connection refused")
+	}
+	receipts := make(types.Receipts, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		receipts[i] = &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash()}
+	}
+	return receipts, nil
+}
+
+func (c *fakeReceiptsClient) fetches(hash gethcommon.Hash) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fetchesBy[hash]
+}
+
+// fakeBlockProvider never has the receipts of a block.
+type fakeBlockProvider struct {
+	hostcommon.ReconnectingBlockProvider // Not implemented, aside from the methods below
+}
+
+func (p *fakeBlockProvider) Receipts(gethcommon.Hash) (types.Receipts, bool) {
+	return nil, false
+}
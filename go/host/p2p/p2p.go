@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,10 +36,7 @@ const (
 	_receivedMessage          = "msg/inbound/success_received"
 )
 
-var (
-	_alertPeriod        = 5 * time.Minute
-	errUnknownSequencer = errors.New("sequencer address not known")
-)
+var errUnknownSequencer = errors.New("sequencer address not known")
 
 // A P2P message's type.
 type msgType uint8
@@ -59,6 +57,7 @@ func NewSocketP2PLayer(config *config.HostConfig, logger gethlog.Logger, metricR
 		p2pTimeout:      config.P2PConnectionTimeout,
 		logger:          logger,
 		peerTracker:     newPeerTracker(),
+		peerAlertPeriod: config.HealthMaxPeerSilence,
 		hostGauges:      map[string]map[string]gethmetrics.Gauge{},
 		metricsRegistry: metricReg,
 	}
@@ -73,6 +72,7 @@ type p2pImpl struct {
 	p2pTimeout        time.Duration
 	logger            gethlog.Logger
 	peerTracker       *peerTracker
+	peerAlertPeriod   time.Duration // peers we haven't received a message from in this period are reported as stale (0 to disable)
 	// hostGauges holds a map of gauges per host per event to track p2p metrics and health status
 	hostGauges      map[string]map[string]gethmetrics.Gauge
 	metricsRegistry gethmetrics.Registry
@@ -161,31 +161,9 @@ func (p *p2pImpl) Status() *host.P2PStatus {
 // HealthCheck returns whether the p2p is considered healthy
 // Currently it considers itself unhealthy
 // if there's more than 100 failures on a given fail type
-// if there's a known peer for which a message hasn't been received
+// if there's a known peer for which a message hasn't been received in the alert period
 func (p *p2pImpl) HealthCheck() bool {
-	currentStatus := p.status()
-
-	if currentStatus.FailedReceivedMessages >= _thresholdErrorFailure ||
-		currentStatus.FailedSendMessage >= _thresholdErrorFailure {
-		return false
-	}
-
-	var noMsgReceivedPeers []string
-	for peer, lastMsgTimestamp := range p.peerTracker.receivedMessagesByPeer() {
-		if time.Now().After(lastMsgTimestamp.Add(_alertPeriod)) {
-			noMsgReceivedPeers = append(noMsgReceivedPeers, peer)
-			p.logger.Warn("no message from peer in the alert period",
-				log.HostCmp, p.ourAddress,
-				"peer", peer,
-				"alertPeriod", _alertPeriod,
-			)
-		}
-	}
-	if len(noMsgReceivedPeers) > 0 { //nolint: gosimple
-		return false
-	}
-
-	return true
+	return p.status().Healthy
 }
 
 // Listens for connections and handles them in a separate goroutine.
@@ -317,6 +295,26 @@ func (p *p2pImpl) status() *host.P2PStatus {
 			}
 		}
 	}
+
+	status.Healthy = status.FailedReceivedMessages < _thresholdErrorFailure &&
+		status.FailedSendMessage < _thresholdErrorFailure
+
+	for peer, lastMsgTimestamp := range p.peerTracker.receivedMessagesByPeer() {
+		peerStatus := &host.PeerStatus{Address: peer, LastMessageTime: lastMsgTimestamp}
+		if p.peerAlertPeriod > 0 && time.Now().After(lastMsgTimestamp.Add(p.peerAlertPeriod)) {
+			peerStatus.Stale = true
+			status.Healthy = false
+			p.logger.Warn("no message from peer in the alert period",
+				log.HostCmp, p.ourAddress,
+				"peer", peer,
+				"alertPeriod", p.peerAlertPeriod,
+			)
+		}
+		status.Peers = append(status.Peers, peerStatus)
+	}
+	sort.Slice(status.Peers, func(i, j int) bool {
+		return status.Peers[i].Address < status.Peers[j].Address
+	})
 	return status
 }
 
@@ -0,0 +1,59 @@
+package host
+
+import (
+	"math/big"
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// progressTracker tracks the enclave's progress on the L1 and the sequencer's progress on the batch chain, as observed by
+// the host's main processing loop, so they can be reported by the health checks and sync status (which are served from
+// other goroutines)
+type progressTracker struct {
+	lock                     sync.RWMutex
+	enclaveL1Head            gethcommon.Hash
+	sequencerHeadBatchHeight *big.Int
+	startingBatchHeight      uint64 // the height of the host's head batch when it started
+}
+
+func (p *progressTracker) setEnclaveL1Head(hash gethcommon.Hash) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.enclaveL1Head = hash
+}
+
+func (p *progressTracker) getEnclaveL1Head() gethcommon.Hash {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.enclaveL1Head
+}
+
+// receivedSequencerBatch records the height of a batch received from the sequencer, if it is the highest seen so far
+func (p *progressTracker) receivedSequencerBatch(height *big.Int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.sequencerHeadBatchHeight == nil || height.Cmp(p.sequencerHeadBatchHeight) > 0 {
+		p.sequencerHeadBatchHeight = new(big.Int).Set(height)
+	}
+}
+
+// getSequencerHeadBatchHeight returns the height of the highest batch received from the sequencer, or nil if none has
+// been received
+func (p *progressTracker) getSequencerHeadBatchHeight() *big.Int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.sequencerHeadBatchHeight
+}
+
+func (p *progressTracker) setStartingBatchHeight(height uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.startingBatchHeight = height
+}
+
+func (p *progressTracker) getStartingBatchHeight() uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.startingBatchHeight
+}
@@ -0,0 +1,113 @@
+package host
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+// rollupForkMonitor tracks the management contract's view of the rollup tree, as checked by the host's main processing
+// loop after each L1 block containing rollups, so it can be reported by the health checks (which are served from other
+// goroutines)
+type rollupForkMonitor struct {
+	lock   sync.RWMutex
+	status hostcommon.RollupStatus
+
+	forkGauge        gethmetrics.Gauge
+	withdrawalsGauge gethmetrics.Gauge
+}
+
+func newRollupForkMonitor(regMetrics gethmetrics.Registry) *rollupForkMonitor {
+	return &rollupForkMonitor{
+		// until the contract is checked, we assume the rollup tree is fine
+		status:           hostcommon.RollupStatus{Healthy: true, WithdrawalsAvailable: true},
+		forkGauge:        gethmetrics.NewRegisteredGauge("host/l1/rollups/fork", regMetrics),
+		withdrawalsGauge: gethmetrics.NewRegisteredGauge("host/l1/withdrawals/available", regMetrics),
+	}
+}
+
+func (m *rollupForkMonitor) setStatus(status hostcommon.RollupStatus) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.status = status
+	m.forkGauge.Update(boolToGauge(status.ForkDetected))
+	m.withdrawalsGauge.Update(boolToGauge(status.WithdrawalsAvailable))
+}
+
+func (m *rollupForkMonitor) getStatus() *hostcommon.RollupStatus {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	status := m.status
+	return &status
+}
+
+// Checks whether the management contract's rollup tree contains competing rollups, and whether the contract has halted
+// withdrawals as a result. Called after processing an L1 block containing rollups, as only rollups change the tree.
+func (h *host) checkRollupForks(l1Height uint64) {
+	previous := h.rollupForks.getStatus()
+	status := hostcommon.RollupStatus{L1Height: l1Height}
+
+	forkDetected, err := h.callBoolGetter(h.mgmtContractLib.HasSecondCousinFork)
+	if err != nil {
+		status.Error = fmt.Sprintf("could not check management contract for rollup forks - %s", err)
+		h.rollupForks.setStatus(keepPreviousResult(status, previous))
+		return
+	}
+	withdrawalAvailable, err := h.callBoolGetter(h.mgmtContractLib.IsWithdrawalAvailable)
+	if err != nil {
+		status.Error = fmt.Sprintf("could not check management contract for withdrawal availability - %s", err)
+		h.rollupForks.setStatus(keepPreviousResult(status, previous))
+		return
+	}
+	status.ForkDetected = forkDetected
+	status.WithdrawalsAvailable = withdrawalAvailable
+
+	if forkDetected && !previous.ForkDetected {
+		h.logger.Error("Competing rollups detected in the management contract's rollup tree.", log.BlockHeightKey, l1Height)
+	}
+	if !withdrawalAvailable && previous.WithdrawalsAvailable {
+		h.logger.Error("Management contract has halted withdrawals because of a rollup fork.", log.BlockHeightKey, l1Height)
+	}
+
+	switch {
+	case forkDetected:
+		status.Error = "the management contract's rollup tree contains competing rollups"
+	case !withdrawalAvailable:
+		status.Error = "the management contract has halted withdrawals because of a rollup fork"
+	default:
+		status.Healthy = true
+	}
+	h.rollupForks.setStatus(status)
+}
+
+func (h *host) callBoolGetter(getter func() (ethereum.CallMsg, error)) (bool, error) {
+	msg, err := getter()
+	if err != nil {
+		return false, err
+	}
+	response, err := h.ethClient.CallContract(msg)
+	if err != nil {
+		return false, err
+	}
+	return h.mgmtContractLib.DecodeBoolCallResponse(response)
+}
+
+// keepPreviousResult reports a failed check against the last known state of the rollup tree
+func keepPreviousResult(status hostcommon.RollupStatus, previous *hostcommon.RollupStatus) hostcommon.RollupStatus {
+	status.L1Height = previous.L1Height
+	status.ForkDetected = previous.ForkDetected
+	status.WithdrawalsAvailable = previous.WithdrawalsAvailable
+	return status
+}
+
+func boolToGauge(value bool) int64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -49,6 +49,23 @@ func (api *EthereumAPI) BlockNumber() hexutil.Uint64 {
 	return hexutil.Uint64(header.Number.Uint64())
 }
 
+// Syncing returns false if the node is caught up with the sequencer's batches, or an object describing its progress
+// through the batches otherwise. The values are batch heights, as batches are the Obscuro equivalent of blocks.
+func (api *EthereumAPI) Syncing() (interface{}, error) {
+	status, err := api.host.SyncStatus()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve sync status. Cause: %w", err)
+	}
+	if !status.IsSyncing() {
+		return false, nil
+	}
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(status.StartingBatchHeight),
+		"currentBlock":  hexutil.Uint64(status.HeadBatchHeight),
+		"highestBlock":  hexutil.Uint64(status.SequencerHeadBatchHeight),
+	}, nil
+}
+
 // GetBalance returns the address's balance on the Obscuro network, encrypted with the viewing key corresponding to the
 // `address` field and encoded as hex.
 func (api *EthereumAPI) GetBalance(_ context.Context, encryptedParams common.EncryptedParamsGetBalance) (string, error) {
@@ -59,9 +76,10 @@ func (api *EthereumAPI) GetBalance(_ context.Context, encryptedParams common.Enc
 	return gethcommon.Bytes2Hex(encryptedBalance), nil
 }
 
-// GetBlockByNumber returns the header of the batch with the given height.
-func (api *EthereumAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, _ bool) (map[string]interface{}, error) {
-	batchHash, err := api.batchNumberToBatchHash(number)
+// GetBlockByNumber returns the header of the batch with the given height. The `safe` and `finalized` tags are
+// accepted as well.
+func (api *EthereumAPI) GetBlockByNumber(ctx context.Context, number common.BlockNumber, _ bool) (map[string]interface{}, error) {
+	batchHash, err := api.batchNumberToBatchHash(rpc.BlockNumber(number))
 	if err != nil {
 		return nil, fmt.Errorf("could not find batch with height %d. Cause: %w", number, err)
 	}
@@ -82,6 +100,12 @@ func (api *EthereumAPI) GasPrice(context.Context) (*hexutil.Big, error) {
 	return (*hexutil.Big)(big.NewInt(1)), nil
 }
 
+// MaxPriorityFeePerGas is a placeholder for an RPC method required by Geth's contract bindings for dynamic fee
+// transactions. Obscuro does not pay tips, so zero is returned.
+func (api *EthereumAPI) MaxPriorityFeePerGas(context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(0)), nil
+}
+
 // Call returns the result of executing the smart contract as a user, encrypted with the viewing key corresponding to
 // the `from` field and encoded as hex.
 func (api *EthereumAPI) Call(_ context.Context, encryptedParams common.EncryptedParamsCall) (string, error) {
@@ -234,6 +258,19 @@ func (api *EthereumAPI) batchNumberToBatchHash(batchNumber rpc.BlockNumber) (*ge
 		return &batchHash, nil
 	}
 
+	if batchNumber == common.SafeBlockNumber || batchNumber == common.FinalizedBlockNumber {
+		getHeader := api.host.DB().GetSafeBatchHeader
+		if batchNumber == common.FinalizedBlockNumber {
+			getHeader = api.host.DB().GetFinalizedBatchHeader
+		}
+		batchHeader, err := getHeader()
+		if err != nil {
+			return nil, err
+		}
+		batchHash := batchHeader.Hash()
+		return &batchHash, nil
+	}
+
 	if batchNumber == rpc.PendingBlockNumber {
 		// todo Dependent on the current pending batch - leaving it for a different iteration as it will need more thought
 		return nil, errutil.ErrNoImpl
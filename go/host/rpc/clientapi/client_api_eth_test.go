@@ -0,0 +1,53 @@
+package clientapi
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common/host"
+	"github.com/obscuronet/go-obscuro/integration/common/testlog"
+)
+
+type syncStatusStubHost struct {
+	host.Host
+	status *host.SyncStatus
+}
+
+func (h *syncStatusStubHost) SyncStatus() (*host.SyncStatus, error) {
+	return h.status, nil
+}
+
+func TestSyncingReturnsFalseWhenCaughtUpWithSequencer(t *testing.T) {
+	api := NewEthereumAPI(&syncStatusStubHost{status: &host.SyncStatus{HeadBatchHeight: 10, SequencerHeadBatchHeight: 10}}, testlog.Logger())
+
+	syncing, err := api.Syncing()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if syncing != false {
+		t.Errorf("expected node that is caught up not to be syncing, got %v", syncing)
+	}
+}
+
+func TestSyncingReturnsBatchProgressWhenBehindSequencer(t *testing.T) {
+	api := NewEthereumAPI(&syncStatusStubHost{status: &host.SyncStatus{
+		StartingBatchHeight:      2,
+		HeadBatchHeight:          5,
+		SequencerHeadBatchHeight: 10,
+	}}, testlog.Logger())
+
+	syncing, err := api.Syncing()
+	if err != nil {
+		t.Fatal(err)
+	}
+	progress, ok := syncing.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected sync progress, got %v", syncing)
+	}
+	expected := map[string]hexutil.Uint64{"startingBlock": 2, "currentBlock": 5, "highestBlock": 10}
+	for field, value := range expected {
+		if progress[field] != value {
+			t.Errorf("expected %s to be %d, got %v", field, value, progress[field])
+		}
+	}
+}
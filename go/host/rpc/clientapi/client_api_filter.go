@@ -16,6 +16,9 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// The number of headers buffered for a newHeads or rollups subscription before headers are dropped for that subscriber.
+const headerSubscriptionBufferSize = 32
+
 // FilterAPI exposes a subset of Geth's PublicFilterAPI operations.
 type FilterAPI struct {
 	host   host.Host
@@ -38,7 +41,7 @@ func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.Encrypted
 	subscription := notifier.CreateSubscription()
 
 	logsFromSubscription := make(chan []byte)
-	err := api.host.Subscribe(subscription.ID, encryptedParams, logsFromSubscription)
+	historicalLogs, err := api.host.Subscribe(subscription.ID, encryptedParams, logsFromSubscription)
 	if err != nil {
 		return nil, fmt.Errorf("could not subscribe for logs. Cause: %w", err)
 	}
@@ -54,6 +57,18 @@ func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.Encrypted
 		return nil, fmt.Errorf("could not send subscription ID to client on subscription %s", subscription.ID)
 	}
 
+	// Any replayed historical logs are sent before the logs received on the subscription, so that they arrive in order.
+	if len(historicalLogs) != 0 {
+		err = notifier.Notify(subscription.ID, common.IDAndEncLog{
+			SubID:  subscription.ID,
+			EncLog: historicalLogs,
+		})
+		if err != nil {
+			api.host.Unsubscribe(subscription.ID)
+			return nil, fmt.Errorf("could not send historical logs to client on subscription %s", subscription.ID)
+		}
+	}
+
 	go func() {
 		for {
 			select {
@@ -77,6 +92,113 @@ func (api *FilterAPI) Logs(ctx context.Context, encryptedParams common.Encrypted
 	return subscription, nil
 }
 
+// NewHeads returns a subscription to the headers of new batches, in the same format as eth_getBlockByHash.
+func (api *FilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+
+	batchHeaders := make(chan *common.BatchHeader, headerSubscriptionBufferSize)
+	api.host.SubscribeNewHeads(subscription.ID, batchHeaders)
+
+	go func() {
+		for {
+			select {
+			case batchHeader := <-batchHeaders:
+				err := notifier.Notify(subscription.ID, headerToMap(batchHeader))
+				if err != nil {
+					api.logger.Error("could not send batch header to client on subscription ", log.SubIDKey, subscription.ID)
+				}
+
+			case <-subscription.Err(): // client sent an unsubscribe request
+				api.host.Unsubscribe(subscription.ID)
+				return
+			}
+		}
+	}()
+
+	return subscription, nil
+}
+
+// Rollups returns a subscription to the headers of the rollups published to the L1.
+func (api *FilterAPI) Rollups(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+
+	rollupHeaders := make(chan *common.RollupHeader, headerSubscriptionBufferSize)
+	api.host.SubscribeRollups(subscription.ID, rollupHeaders)
+
+	go func() {
+		for {
+			select {
+			case rollupHeader := <-rollupHeaders:
+				err := notifier.Notify(subscription.ID, rollupHeader)
+				if err != nil {
+					api.logger.Error("could not send rollup header to client on subscription ", log.SubIDKey, subscription.ID)
+				}
+
+			case <-subscription.Err(): // client sent an unsubscribe request
+				api.host.Unsubscribe(subscription.ID)
+				return
+			}
+		}
+	}()
+
+	return subscription, nil
+}
+
+// NewPendingTransactions returns a subscription to the hashes of the subscribing account's transactions, as they are
+// submitted to this node. Each hash is encrypted with the account's viewing key.
+func (api *FilterAPI) NewPendingTransactions(ctx context.Context, encryptedParams common.EncryptedParamsPendingTxSubscription) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+
+	txHashesFromSubscription := make(chan []byte)
+	err := api.host.SubscribePendingTxs(subscription.ID, encryptedParams, txHashesFromSubscription)
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe for pending transactions. Cause: %w", err)
+	}
+
+	// As for log subscriptions, we send the ID of the newly-created subscription first.
+	err = notifier.Notify(subscription.ID, common.IDAndEncTxHash{
+		SubID: subscription.ID,
+	})
+	if err != nil {
+		api.host.Unsubscribe(subscription.ID)
+		return nil, fmt.Errorf("could not send subscription ID to client on subscription %s", subscription.ID)
+	}
+
+	go func() {
+		for {
+			select {
+			case encryptedTxHash := <-txHashesFromSubscription:
+				idAndEncTxHash := common.IDAndEncTxHash{
+					SubID:     subscription.ID,
+					EncTxHash: encryptedTxHash,
+				}
+				err = notifier.Notify(subscription.ID, idAndEncTxHash)
+				if err != nil {
+					api.logger.Error("could not send encrypted transaction hash to client on subscription ", log.SubIDKey, subscription.ID)
+				}
+
+			case <-subscription.Err(): // client sent an unsubscribe request
+				api.host.Unsubscribe(subscription.ID)
+				return
+			}
+		}
+	}()
+
+	return subscription, nil
+}
+
 // GetLogs returns the logs matching the filter.
 func (api *FilterAPI) GetLogs(_ context.Context, encryptedParams common.EncryptedParamsGetLogs) (string, error) {
 	encryptedResponse, err := api.host.EnclaveClient().GetLogs(encryptedParams)
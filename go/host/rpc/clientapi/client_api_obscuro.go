@@ -1,7 +1,12 @@
 package clientapi
 
 import (
+	"context"
+
+	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/host"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // ObscuroAPI implements Obscuro-specific JSON RPC operations.
@@ -24,3 +29,21 @@ func (api *ObscuroAPI) AddViewingKey(viewingKeyBytes []byte, signature []byte) e
 func (api *ObscuroAPI) Health() (*host.HealthCheck, error) {
 	return api.host.HealthCheck()
 }
+
+// SyncStatus returns the node's progress processing the L1 and L2 chains
+func (api *ObscuroAPI) SyncStatus() (*host.SyncStatus, error) {
+	return api.host.SyncStatus()
+}
+
+// GetTransactionStatus returns the progress of a transaction, encrypted with the viewing key of the transaction's sender.
+func (api *ObscuroAPI) GetTransactionStatus(_ context.Context, encryptedParams common.EncryptedParamsGetTxStatus) (*string, error) {
+	encryptedResponse, err := api.host.EnclaveClient().GetTransactionStatus(encryptedParams)
+	if err != nil {
+		return nil, err
+	}
+	if encryptedResponse == nil {
+		return nil, nil //nolint:nilnil
+	}
+	encryptedResponseHex := gethcommon.Bytes2Hex(encryptedResponse)
+	return &encryptedResponseHex, nil
+}
@@ -39,6 +39,23 @@ func (api *ObscuroScanAPI) GetBlockHeaderByHash(blockHash gethcommon.Hash) (*typ
 	return blockHeader, nil
 }
 
+// GetRollupsForBlock returns the rollups published in the L1 block with the given hash.
+func (api *ObscuroScanAPI) GetRollupsForBlock(blockHash gethcommon.Hash) ([]*common.PublicRollup, error) {
+	// We check the block is known, to distinguish a block without rollups from a block that has not been processed.
+	if _, err := api.GetBlockHeaderByHash(blockHash); err != nil {
+		return nil, err
+	}
+
+	rollups, err := api.host.DB().GetBlockRollups(blockHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return []*common.PublicRollup{}, nil
+		}
+		return nil, fmt.Errorf("could not retrieve rollups for block with hash %s. Cause: %w", blockHash, err)
+	}
+	return rollups, nil
+}
+
 // GetBatch returns the batch with the given hash. Unlike `EthereumAPI.GetBlockByHash()`, returns the full
 // `ExtBatch`, and not just the header.
 func (api *ObscuroScanAPI) GetBatch(batchHash gethcommon.Hash) (*common.ExtBatch, error) {
@@ -0,0 +1,56 @@
+package clientapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+const (
+	// LivenessPath is the path on which the node reports whether it is live (i.e. whether it should be restarted)
+	LivenessPath = "/healthz"
+	// ReadinessPath is the path on which the node reports whether it is ready to serve requests
+	ReadinessPath = "/readyz"
+)
+
+// HealthHandler serves the node's health check over HTTP, for use as a liveness or readiness probe. It responds with the
+// node's HealthCheck as JSON, with a 200 status if the probed condition holds and a 503 status otherwise.
+type HealthHandler struct {
+	host  host.Host
+	probe func(*host.HealthCheck) bool
+}
+
+// NewLivenessHandler returns a HealthHandler that reports whether the node is live
+func NewLivenessHandler(h host.Host) *HealthHandler {
+	return &HealthHandler{host: h, probe: func(health *host.HealthCheck) bool { return health.Live }}
+}
+
+// NewReadinessHandler returns a HealthHandler that reports whether the node is ready
+func NewReadinessHandler(h host.Host) *HealthHandler {
+	return &HealthHandler{host: h, probe: func(health *host.HealthCheck) bool { return health.Ready }}
+}
+
+func (h *HealthHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	health, err := h.host.HealthCheck()
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	if h.probe(health) {
+		resp.WriteHeader(http.StatusOK)
+	} else {
+		resp.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if req.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(resp).Encode(health)
+}
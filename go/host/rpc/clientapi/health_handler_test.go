@@ -0,0 +1,58 @@
+package clientapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+type healthStubHost struct {
+	host.Host
+	health *host.HealthCheck
+}
+
+func (h *healthStubHost) HealthCheck() (*host.HealthCheck, error) {
+	return h.health, nil
+}
+
+func TestHealthHandlersReportLivenessAndReadinessSeparately(t *testing.T) {
+	stub := &healthStubHost{health: &host.HealthCheck{
+		Live:  true,
+		Ready: false,
+		HealthCheckHost: &host.HealthCheckHost{
+			L1Status: &host.L1Status{Connected: true, Lag: 50, Error: "enclave is 50 L1 blocks behind the L1 head"},
+		},
+	}}
+
+	liveness := httptest.NewRecorder()
+	NewLivenessHandler(stub).ServeHTTP(liveness, httptest.NewRequest(http.MethodGet, LivenessPath, nil))
+	if liveness.Code != http.StatusOK {
+		t.Errorf("expected live node to respond %d to liveness probe, got %d", http.StatusOK, liveness.Code)
+	}
+
+	readiness := httptest.NewRecorder()
+	NewReadinessHandler(stub).ServeHTTP(readiness, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	if readiness.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected node that is not ready to respond %d to readiness probe, got %d", http.StatusServiceUnavailable, readiness.Code)
+	}
+
+	var health host.HealthCheck
+	if err := json.NewDecoder(readiness.Body).Decode(&health); err != nil {
+		t.Fatalf("could not decode health check response. Cause: %s", err)
+	}
+	if health.L1Status == nil || health.L1Status.Lag != 50 {
+		t.Errorf("expected the component status to be included in the response, got %+v", health.HealthCheckHost)
+	}
+}
+
+func TestHealthHandlerRejectsNonGetRequests(t *testing.T) {
+	resp := httptest.NewRecorder()
+	NewLivenessHandler(&healthStubHost{health: &host.HealthCheck{Live: true}}).
+		ServeHTTP(resp, httptest.NewRequest(http.MethodPost, LivenessPath, nil))
+	if resp.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected %d, got %d", http.StatusMethodNotAllowed, resp.Code)
+	}
+}
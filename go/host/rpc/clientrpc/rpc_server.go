@@ -1,6 +1,8 @@
 package clientrpc
 
 import (
+	"net/http"
+
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/obscuronet/go-obscuro/go/common/log"
@@ -18,6 +20,8 @@ type Server interface {
 	Start() error
 	Stop()
 	RegisterAPIs(apis []rpc.API)
+	// RegisterHandler serves the handler on the given path of the HTTP server (must be called before Start)
+	RegisterHandler(name, path string, handler http.Handler)
 }
 
 // An implementation of `host.Server` that reuses the Geth `node` package for client communication.
@@ -55,6 +59,10 @@ func (s *serverImpl) RegisterAPIs(apis []rpc.API) {
 	s.node.RegisterAPIs(apis)
 }
 
+func (s *serverImpl) RegisterHandler(name, path string, handler http.Handler) {
+	s.node.RegisterHandler(name, path, handler)
+}
+
 func (s *serverImpl) Start() error {
 	return s.node.Start()
 }
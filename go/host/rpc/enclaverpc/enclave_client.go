@@ -155,7 +155,7 @@ func (c *Client) SubmitL1Block(block types.Block, receipts types.Receipts, isLat
 	return blockSubmissionResponse, nil
 }
 
-func (c *Client) SubmitTx(tx common.EncryptedTx) (common.EncryptedResponseSendRawTx, error) {
+func (c *Client) SubmitTx(tx common.EncryptedTx) (*common.TxSubmissionResponse, error) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
@@ -163,7 +163,7 @@ func (c *Client) SubmitTx(tx common.EncryptedTx) (common.EncryptedResponseSendRa
 	if err != nil {
 		return nil, err
 	}
-	return response.EncryptedHash, err
+	return rpc.FromSubmitTxResponseMsg(response)
 }
 
 func (c *Client) SubmitBatch(batch *common.ExtBatch) error {
@@ -178,6 +178,37 @@ func (c *Client) SubmitBatch(batch *common.ExtBatch) error {
 	return nil
 }
 
+func (c *Client) SubmitL1Finality(update *common.L1FinalityUpdate) (*common.L1FinalityStatus, error) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	encodedUpdate, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode L1 finality update. Cause: %w", err)
+	}
+	response, err := c.protoClient.SubmitL1Finality(timeoutCtx, &generated.SubmitL1FinalityRequest{EncodedUpdate: encodedUpdate})
+	if err != nil {
+		return nil, fmt.Errorf("could not submit L1 finality update. Cause: %w", err)
+	}
+
+	var status common.L1FinalityStatus
+	if err = json.Unmarshal(response.EncodedStatus, &status); err != nil {
+		return nil, fmt.Errorf("could not decode L1 finality status. Cause: %w", err)
+	}
+	return &status, nil
+}
+
+func (c *Client) SubmitFinalizedL1Block(blockHash gethcommon.Hash) error {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	_, err := c.protoClient.SubmitFinalizedL1Block(timeoutCtx, &generated.SubmitFinalizedL1BlockRequest{BlockHash: blockHash.Bytes()})
+	if err != nil {
+		return fmt.Errorf("could not submit finalized L1 block. Cause: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) ExecuteOffChainTransaction(encryptedParams common.EncryptedParamsCall) (common.EncryptedResponseCall, error) {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -247,6 +278,17 @@ func (c *Client) GetTransactionReceipt(encryptedParams common.EncryptedParamsGet
 	return response.EncryptedTxReceipt, nil
 }
 
+func (c *Client) GetTransactionStatus(encryptedParams common.EncryptedParamsGetTxStatus) (common.EncryptedResponseGetTxStatus, error) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetTransactionStatus(timeoutCtx, &generated.GetTransactionStatusRequest{EncryptedParams: encryptedParams})
+	if err != nil {
+		return nil, err
+	}
+	return response.EncryptedTxStatus, nil
+}
+
 func (c *Client) AddViewingKey(viewingKeyBytes []byte, signature []byte) error {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
@@ -288,11 +330,25 @@ func (c *Client) GetCode(address gethcommon.Address, batchHash *gethcommon.Hash)
 	return resp.Code, nil
 }
 
-func (c *Client) Subscribe(id gethrpc.ID, encryptedParams common.EncryptedParamsLogSubscription) error {
+func (c *Client) Subscribe(id gethrpc.ID, encryptedParams common.EncryptedParamsLogSubscription) (common.EncryptedLogs, error) {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.Subscribe(timeoutCtx, &generated.SubscribeRequest{
+		Id:                    []byte(id),
+		EncryptedSubscription: encryptedParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.EncryptedLogs, nil
+}
+
+func (c *Client) SubscribePendingTxs(id gethrpc.ID, encryptedParams common.EncryptedParamsPendingTxSubscription) error {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), c.config.EnclaveRPCTimeout)
 	defer cancel()
 
-	_, err := c.protoClient.Subscribe(timeoutCtx, &generated.SubscribeRequest{
+	_, err := c.protoClient.SubscribePendingTxs(timeoutCtx, &generated.SubscribeRequest{
 		Id:                    []byte(id),
 		EncryptedSubscription: encryptedParams,
 	})
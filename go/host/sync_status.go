@@ -0,0 +1,61 @@
+package host
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+// SyncStatus returns the node's progress processing the L1 and L2 chains
+func (h *host) SyncStatus() (*hostcommon.SyncStatus, error) {
+	l1Head, err := h.ethClient.FetchHeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch L1 head block. Cause: %w", err)
+	}
+	status := &hostcommon.SyncStatus{
+		L1HeadHash:          l1Head.Hash(),
+		L1HeadHeight:        l1Head.NumberU64(),
+		StartingBatchHeight: h.progress.getStartingBatchHeight(),
+	}
+
+	if enclaveL1Head := h.progress.getEnclaveL1Head(); enclaveL1Head != (gethcommon.Hash{}) {
+		status.EnclaveL1HeadHash = enclaveL1Head
+		status.EnclaveL1HeadHeight, err = h.l1BlockHeight(enclaveL1Head)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve enclave's L1 head block %s. Cause: %w", enclaveL1Head, err)
+		}
+	}
+
+	headBatch, err := h.db.GetHeadBatchHeader()
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not retrieve head batch. Cause: %w", err)
+	}
+	if headBatch != nil {
+		status.HeadBatchHash = headBatch.Hash()
+		status.HeadBatchHeight = headBatch.Number.Uint64()
+	}
+
+	status.SequencerHeadBatchHeight = status.HeadBatchHeight
+	if h.config.NodeType != common.Sequencer {
+		if sequencerHeight := h.progress.getSequencerHeadBatchHeight(); sequencerHeight != nil && sequencerHeight.Uint64() > status.HeadBatchHeight {
+			status.SequencerHeadBatchHeight = sequencerHeight.Uint64()
+		}
+	}
+
+	return status, nil
+}
+
+// recordStartingBatchHeight records the height of the host's head batch as the height syncing started from
+func (h *host) recordStartingBatchHeight() {
+	headBatch, err := h.db.GetHeadBatchHeader()
+	if err != nil {
+		// the host has no batches yet, it is syncing from the start of the chain
+		return
+	}
+	h.progress.setStartingBatchHeight(headBatch.Number.Uint64())
+}
@@ -0,0 +1,474 @@
+package txmanager
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/host/db"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	hostcommon "github.com/obscuronet/go-obscuro/go/common/host"
+)
+
+const (
+	// UnassignedNonce is the nonce to create transactions for the tx manager with, as it assigns their nonces itself.
+	UnassignedNonce = 0
+
+	// How often the pending transactions are checked for inclusion.
+	monitorInterval = 2 * time.Second
+	// How long a transaction can go without being included before it is replaced with higher fees.
+	stuckTxTimeout = 60 * time.Second
+	// The percentage by which the fees are increased when a transaction is replaced. Geth only accepts a replacement
+	// whose fees are at least 10% higher.
+	feeBumpPercent = 12
+	// A transaction that has been pending for longer than this makes the tx manager unhealthy.
+	maxPendingTxAge = 10 * time.Minute
+	// The default maximum number of times a transaction is replaced with higher fees.
+	defaultMaxReplacements = 10
+)
+
+// The default maximum fee per gas the tx manager pays, in wei.
+var defaultMaxFeePerGas = big.NewInt(500 * params.GWei)
+
+// ErrTxReverted is returned when waiting for a transaction that was included in a block, but reverted.
+var ErrTxReverted = errors.New("L1 transaction reverted")
+
+// TxManager sends the host's transactions to the L1, and tracks them until they are included in a block. It owns the
+// nonces of the host's L1 account, and persists the pending transactions in the host DB so that they are still tracked
+// after a restart. A transaction that is not included in time (e.g. because it was underpriced) is replaced by one with
+// the same nonce and higher EIP-1559 fees, up to a maximum fee per gas and number of replacements.
+type TxManager struct {
+	ethClient ethadapter.EthClient
+	wallet    wallet.Wallet
+	db        *db.DB
+	logger    gethlog.Logger
+
+	mu        sync.Mutex // Controls access to the fields below, and to the pending transactions' state
+	nextNonce uint64
+	pending   map[uint64]*trackedTx // The transactions that have not been included in a block yet, by nonce
+	lastError string                // The latest failure to send a transaction, or revert, for the health check
+
+	wakeCh chan struct{} // Triggers a check of the pending transactions
+	stopCh chan struct{}
+
+	maxFeePerGas    *big.Int // The fee cap of a transaction is never raised above this
+	maxReplacements uint64   // A transaction is never replaced more than this many times
+	monitorInterval time.Duration
+	stuckTxTimeout  time.Duration
+	maxPendingTxAge time.Duration
+}
+
+// A pending transaction, and the outcome that its senders can wait for.
+type trackedTx struct {
+	*db.PendingL1Tx
+	done    chan struct{} // Closed once the transaction has been included in a block, or abandoned
+	receipt *types.Receipt
+	err     error
+	// Why the transaction could not be replaced when it needed to be, if it could not. Such a transaction is stuck until
+	// fees fall, or the operator intervenes.
+	replaceLimit string
+}
+
+// SentTx allows the sender of a transaction to wait for it to be included in a block.
+type SentTx struct {
+	tx *trackedTx
+}
+
+// New creates a tx manager that never raises the fee cap of a transaction above maxFeePerGas, or replaces a transaction
+// more than maxReplacements times. If either limit is zero, its default is used instead.
+func New(ethClient ethadapter.EthClient, wallet wallet.Wallet, db *db.DB, maxFeePerGas *big.Int, maxReplacements uint64, logger gethlog.Logger) *TxManager {
+	if maxFeePerGas == nil || maxFeePerGas.Sign() == 0 {
+		maxFeePerGas = defaultMaxFeePerGas
+	}
+	if maxReplacements == 0 {
+		maxReplacements = defaultMaxReplacements
+	}
+	return &TxManager{
+		ethClient:       ethClient,
+		wallet:          wallet,
+		db:              db,
+		logger:          logger,
+		pending:         map[uint64]*trackedTx{},
+		wakeCh:          make(chan struct{}, 1),
+		stopCh:          make(chan struct{}),
+		maxFeePerGas:    maxFeePerGas,
+		maxReplacements: maxReplacements,
+		monitorInterval: monitorInterval,
+		stuckTxTimeout:  stuckTxTimeout,
+		maxPendingTxAge: maxPendingTxAge,
+	}
+}
+
+// Start resumes tracking the transactions that were pending when the host stopped, resyncs the next nonce from the L1,
+// and starts monitoring the pending transactions.
+func (m *TxManager) Start() error {
+	persistedTxs, err := m.db.GetPendingL1Txs()
+	if err != nil {
+		return fmt.Errorf("could not retrieve pending L1 transactions. Cause: %w", err)
+	}
+	l1Nonce, err := m.ethClient.Nonce(m.wallet.Address())
+	if err != nil {
+		return fmt.Errorf("could not retrieve L1 account nonce. Cause: %w", err)
+	}
+
+	m.mu.Lock()
+	m.nextNonce = l1Nonce
+	for _, persistedTx := range persistedTxs {
+		nonce := persistedTx.Tx.Nonce()
+		// The L1 node does not know about transactions at or above its pending nonce (e.g. because it restarted), so
+		// they are broadcast again.
+		if nonce >= l1Nonce {
+			persistedTx.SentAt = 0
+		}
+		if nonce >= m.nextNonce {
+			m.nextNonce = nonce + 1
+		}
+		m.pending[nonce] = newTrackedTx(persistedTx)
+	}
+	m.mu.Unlock()
+
+	m.logger.Info("Started L1 tx manager.", "nextNonce", m.nextNonce, "pendingTxs", len(persistedTxs))
+	go m.monitor()
+	m.wake()
+	return nil
+}
+
+// Stop stops monitoring the pending transactions. They remain persisted, to be resumed on the next start.
+func (m *TxManager) Stop() {
+	close(m.stopCh)
+}
+
+// Send assigns the next nonce to the transaction, estimates its gas and EIP-1559 fees (capped at the maximum fee per
+// gas), then signs, persists and broadcasts it. The transaction is tracked until it is included in a block, and is replaced with higher fees if it
+// gets stuck. If the transaction cannot be broadcast, it is still tracked and broadcast again later.
+func (m *TxManager) Send(txData types.TxData, description string) (*SentTx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	estimatedTx, err := m.ethClient.EstimateDynamicFeeTx(txData, m.wallet.Address())
+	if err != nil {
+		return nil, fmt.Errorf("could not estimate %s transaction. Cause: %w", description, err)
+	}
+	estimatedTx.Nonce = m.nextNonce
+	m.capFees(estimatedTx)
+	signedTx, err := m.wallet.SignTransaction(estimatedTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign %s transaction. Cause: %w", description, err)
+	}
+
+	tx := newTrackedTx(&db.PendingL1Tx{Tx: signedTx, Description: description, CreatedAt: now()})
+	if err = m.db.AddPendingL1Tx(tx.PendingL1Tx); err != nil {
+		return nil, fmt.Errorf("could not persist %s transaction. Cause: %w", description, err)
+	}
+	m.pending[signedTx.Nonce()] = tx
+	m.nextNonce++
+
+	m.broadcast(tx)
+	m.wake()
+	return &SentTx{tx: tx}, nil
+}
+
+// Wait blocks until the transaction is included in a block, and returns its receipt. An error wrapping `ErrTxReverted`
+// is returned if the transaction reverted. If the transaction is not included within the timeout, an error is returned
+// but the transaction is still tracked.
+func (s *SentTx) Wait(timeout time.Duration) (*types.Receipt, error) {
+	select {
+	case <-s.tx.done:
+		return s.tx.receipt, s.tx.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("%s transaction with nonce %d was not included within %s", s.tx.Description, s.tx.Tx.Nonce(), timeout)
+	}
+}
+
+// Status returns the state of the pending transactions, for the health check.
+func (m *TxManager) Status() *hostcommon.L1TxStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status := &hostcommon.L1TxStatus{
+		Healthy:    true,
+		NextNonce:  m.nextNonce,
+		PendingTxs: len(m.pending),
+		LastError:  m.lastError,
+	}
+	for _, nonce := range m.sortedNonces() {
+		tx := m.pending[nonce]
+		status.Replacements += tx.Replacements
+		if tx.replaceLimit != "" {
+			status.CappedTxs++
+		}
+		if !status.Healthy {
+			continue
+		}
+		if tx.replaceLimit != "" {
+			status.Healthy = false
+			status.Error = fmt.Sprintf("%s transaction with nonce %d is stuck and cannot be replaced, as %s", tx.Description, nonce, tx.replaceLimit)
+		} else if age := time.Since(time.Unix(int64(tx.CreatedAt), 0)); age > m.maxPendingTxAge {
+			status.Healthy = false
+			status.Error = fmt.Sprintf("%s transaction with nonce %d has been pending for %s", tx.Description, nonce, age.Round(time.Second))
+		}
+	}
+	return status
+}
+
+func (m *TxManager) monitor() {
+	ticker := time.NewTicker(m.monitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+		case <-m.wakeCh:
+		}
+		m.checkPendingTxs()
+	}
+}
+
+// Resolves the pending transactions that have been included in a block, and broadcasts or replaces the others as
+// needed. The receipts are looked up without holding the lock, so that new transactions can be sent in the meantime.
+func (m *TxManager) checkPendingTxs() {
+	m.mu.Lock()
+	nonces := m.sortedNonces()
+	hashes := make(map[uint64][]gethcommon.Hash, len(nonces))
+	for _, nonce := range nonces {
+		hashes[nonce] = m.pending[nonce].hashes()
+	}
+	m.mu.Unlock()
+
+	receipts := make(map[uint64]*types.Receipt, len(nonces))
+	for _, nonce := range nonces {
+		receipts[nonce] = m.findReceipt(hashes[nonce])
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, nonce := range nonces {
+		tx, found := m.pending[nonce]
+		if !found {
+			continue
+		}
+		if receipt := receipts[nonce]; receipt != nil {
+			m.resolve(tx, receipt, nil)
+			continue
+		}
+
+		switch {
+		case tx.SentAt == 0:
+			m.broadcast(tx)
+		case time.Since(time.Unix(int64(tx.SentAt), 0)) > m.stuckTxTimeout && tx.replaceLimit == "":
+			m.logger.Warn("L1 transaction is stuck, replacing it with higher fees.", "description", tx.Description, "nonce", nonce, log.TxKey, tx.Tx.Hash())
+			m.replace(tx)
+		}
+	}
+}
+
+// Returns the receipt of whichever of the given versions of a transaction was included in a block, or nil if none was.
+func (m *TxManager) findReceipt(hashes []gethcommon.Hash) *types.Receipt {
+	for _, hash := range hashes {
+		receipt, err := m.ethClient.TransactionReceipt(hash)
+		if err == nil && receipt != nil {
+			return receipt
+		}
+	}
+	return nil
+}
+
+// Broadcasts the latest version of the transaction, and replaces it if it was underpriced.
+func (m *TxManager) broadcast(tx *trackedTx) {
+	if err := m.send(tx); err != nil && isUnderpriced(err) {
+		m.logger.Warn("L1 transaction was underpriced, replacing it with higher fees.", "description", tx.Description, "nonce", tx.Tx.Nonce(), log.ErrKey, err)
+		m.replace(tx)
+	}
+}
+
+// Sends the latest version of the transaction to the L1 node. Failures are handled by sending the transaction again on
+// the next check, or abandoning it if its nonce has been used by another transaction. Underpriced transactions are left
+// for the caller to handle.
+func (m *TxManager) send(tx *trackedTx) error {
+	err := m.ethClient.SendTransaction(tx.Tx)
+	if err == nil || isAlreadyKnown(err) {
+		tx.SentAt = now()
+		m.persist(tx)
+		m.logger.Trace("L1 transaction broadcast.", "description", tx.Description, "nonce", tx.Tx.Nonce(), log.TxKey, tx.Tx.Hash())
+		return nil
+	}
+
+	m.lastError = fmt.Sprintf("could not broadcast %s transaction with nonce %d - %s", tx.Description, tx.Tx.Nonce(), err)
+	switch {
+	case isNonceTooLow(err):
+		// One of the transaction's versions may have been included since we last checked.
+		if receipt := m.findReceipt(tx.hashes()); receipt != nil {
+			m.resolve(tx, receipt, nil)
+			return err
+		}
+		m.resolve(tx, nil, fmt.Errorf("nonce %d of %s transaction was used by another transaction", tx.Tx.Nonce(), tx.Description))
+	case isUnderpriced(err):
+		// The caller decides whether to replace the transaction.
+	default:
+		m.logger.Warn("Could not broadcast L1 transaction, will retry.", "description", tx.Description, "nonce", tx.Tx.Nonce(), log.ErrKey, err)
+	}
+	return err
+}
+
+// Replaces the transaction with a version that has the same nonce and higher fees, then sends it. If the replacement is
+// underpriced too, it is replaced again on the next check rather than straight away. A transaction that has reached the
+// maximum fee per gas or number of replacements is not replaced.
+func (m *TxManager) replace(tx *trackedTx) {
+	if limit := m.replaceLimit(tx); limit != "" {
+		if tx.replaceLimit == "" {
+			m.logger.Error("L1 transaction cannot be replaced with higher fees.", "description", tx.Description, "nonce", tx.Tx.Nonce(), "limit", limit)
+		}
+		tx.replaceLimit = limit
+		return
+	}
+
+	replacement := &types.DynamicFeeTx{
+		ChainID:   tx.Tx.ChainId(),
+		Nonce:     tx.Tx.Nonce(),
+		GasTipCap: bumpFee(tx.Tx.GasTipCap()),
+		GasFeeCap: bumpFee(tx.Tx.GasFeeCap()),
+		Gas:       tx.Tx.Gas(),
+		To:        tx.Tx.To(),
+		Value:     tx.Tx.Value(),
+		Data:      tx.Tx.Data(),
+	}
+	// If fees have risen by more than the bump, we use the current fees instead.
+	if estimatedTx, err := m.ethClient.EstimateDynamicFeeTx(replacement, m.wallet.Address()); err == nil {
+		replacement.GasTipCap = maxBig(replacement.GasTipCap, estimatedTx.GasTipCap)
+		replacement.GasFeeCap = maxBig(replacement.GasFeeCap, estimatedTx.GasFeeCap)
+	}
+	replacement.GasFeeCap = maxBig(replacement.GasFeeCap, replacement.GasTipCap)
+	m.capFees(replacement)
+
+	signedTx, err := m.wallet.SignTransaction(replacement)
+	if err != nil {
+		m.logger.Error("Could not sign replacement L1 transaction.", "description", tx.Description, log.ErrKey, err)
+		return
+	}
+
+	tx.PriorHashes = append(tx.PriorHashes, tx.Tx.Hash())
+	tx.Tx = signedTx
+	tx.Replacements++
+	tx.SentAt = 0
+	m.persist(tx)
+	if err = m.send(tx); err != nil && isUnderpriced(err) {
+		m.logger.Warn("Replacement L1 transaction was underpriced, will replace it again.", "description", tx.Description, "nonce", tx.Tx.Nonce(), log.ErrKey, err)
+	}
+}
+
+// Returns why the transaction cannot be replaced with higher fees, or the empty string if it can be.
+func (m *TxManager) replaceLimit(tx *trackedTx) string {
+	if tx.Replacements >= m.maxReplacements {
+		return fmt.Sprintf("it has been replaced the maximum of %d times", m.maxReplacements)
+	}
+	if tx.Tx.GasFeeCap().Cmp(m.maxFeePerGas) >= 0 {
+		return fmt.Sprintf("its fee cap has reached the maximum fee per gas of %s wei", m.maxFeePerGas)
+	}
+	return ""
+}
+
+// Lowers the transaction's fee cap to the maximum fee per gas, if it is above it.
+func (m *TxManager) capFees(tx *types.DynamicFeeTx) {
+	if tx.GasFeeCap.Cmp(m.maxFeePerGas) > 0 {
+		tx.GasFeeCap = m.maxFeePerGas
+	}
+	if tx.GasTipCap.Cmp(tx.GasFeeCap) > 0 {
+		tx.GasTipCap = tx.GasFeeCap
+	}
+}
+
+// Stops tracking the transaction, and notifies anyone waiting for it.
+func (m *TxManager) resolve(tx *trackedTx, receipt *types.Receipt, err error) {
+	if err == nil && receipt.Status != types.ReceiptStatusSuccessful {
+		err = fmt.Errorf("%w: %s transaction %s", ErrTxReverted, tx.Description, receipt.TxHash)
+	}
+	if err != nil {
+		m.lastError = err.Error()
+		m.logger.Error("L1 transaction failed.", "description", tx.Description, "nonce", tx.Tx.Nonce(), log.ErrKey, err)
+	} else {
+		m.logger.Trace("L1 transaction included.", "description", tx.Description, log.TxKey, receipt.TxHash, log.BlockHashKey, receipt.BlockHash)
+	}
+
+	if dbErr := m.db.RemovePendingL1Tx(tx.Tx.Nonce()); dbErr != nil {
+		m.logger.Error("Could not remove L1 transaction from the host DB.", log.ErrKey, dbErr)
+	}
+	delete(m.pending, tx.Tx.Nonce())
+	tx.receipt = receipt
+	tx.err = err
+	close(tx.done)
+}
+
+func (m *TxManager) persist(tx *trackedTx) {
+	if err := m.db.AddPendingL1Tx(tx.PendingL1Tx); err != nil {
+		m.logger.Error("Could not persist pending L1 transaction.", log.ErrKey, err)
+	}
+}
+
+func (m *TxManager) sortedNonces() []uint64 {
+	nonces := make([]uint64, 0, len(m.pending))
+	for nonce := range m.pending {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	return nonces
+}
+
+func (m *TxManager) wake() {
+	select {
+	case m.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func newTrackedTx(tx *db.PendingL1Tx) *trackedTx {
+	return &trackedTx{PendingL1Tx: tx, done: make(chan struct{})}
+}
+
+// Returns the hashes of all the versions of the transaction.
+func (t *trackedTx) hashes() []gethcommon.Hash {
+	return append(append([]gethcommon.Hash{}, t.PriorHashes...), t.Tx.Hash())
+}
+
+// Increases the fee by `feeBumpPercent`, rounding up.
+func bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+feeBumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	return bumped.Add(bumped, big.NewInt(1))
+}
+
+func maxBig(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+func now() uint64 {
+	return uint64(time.Now().Unix())
+}
+
+// The L1 node's errors are only available as strings, so we match on geth's error messages.
+func isAlreadyKnown(err error) bool {
+	return strings.Contains(err.Error(), "already known")
+}
+
+func isNonceTooLow(err error) bool {
+	return strings.Contains(err.Error(), "nonce too low")
+}
+
+func isUnderpriced(err error) bool {
+	return strings.Contains(err.Error(), "underpriced") || strings.Contains(err.Error(), "max fee per gas less than block base fee")
+}
@@ -0,0 +1,384 @@
+package txmanager
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/host/db"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const testTimeout = 5 * time.Second
+
+func TestStuckTxIsReplacedWithHigherFees(t *testing.T) {
+	client := newFakeL1Client(0)
+	client.includeTxs = false
+	m := newTestTxManager(t, client, db.NewInMemoryDB(nil, nil))
+	m.setStuckTxTimeout(0)
+
+	sentTx, err := m.Send(&types.LegacyTx{}, "rollup")
+	if err != nil {
+		t.Fatalf("could not send tx. Cause: %s", err)
+	}
+	firstTx := client.lastSentTx()
+	waitFor(t, func() bool { return client.sentTxCount() > 1 })
+
+	replacement := client.lastSentTx()
+	if replacement.Nonce() != firstTx.Nonce() || replacement.GasTipCap().Cmp(firstTx.GasTipCap()) <= 0 || replacement.GasFeeCap().Cmp(firstTx.GasFeeCap()) <= 0 {
+		t.Fatalf("expected replacement with the same nonce and higher fees")
+	}
+
+	// The original version of the transaction is included after all.
+	client.include(firstTx.Hash())
+	receipt, err := sentTx.Wait(testTimeout)
+	if err != nil {
+		t.Fatalf("expected tx to be included. Cause: %s", err)
+	}
+	if receipt.TxHash != firstTx.Hash() {
+		t.Fatalf("expected receipt of the original tx")
+	}
+	if status := m.Status(); status.PendingTxs != 0 || status.NextNonce != 1 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+}
+
+func TestUnderpricedTxIsReplaced(t *testing.T) {
+	client := newFakeL1Client(0)
+	m := newTestTxManager(t, client, db.NewInMemoryDB(nil, nil))
+	client.sendErrs = []error{errors.New("transaction underpriced")}
+
+	sentTx, err := m.Send(&types.LegacyTx{}, "rollup")
+	if err != nil {
+		t.Fatalf("could not send tx. Cause: %s", err)
+	}
+	if _, err = sentTx.Wait(testTimeout); err != nil {
+		t.Fatalf("expected tx to be included. Cause: %s", err)
+	}
+	// The underpriced tx was rejected, so only its replacement was accepted.
+	if client.sentTxCount() != 1 || client.lastSentTx().GasTipCap().Cmp(big.NewInt(suggestedTip)) <= 0 {
+		t.Fatalf("expected underpriced tx to be replaced with higher fees")
+	}
+}
+
+func TestPendingTxsAreResumedAfterRestart(t *testing.T) {
+	client := newFakeL1Client(3)
+	client.includeTxs = false
+	database := db.NewInMemoryDB(nil, nil)
+
+	m := newTestTxManager(t, client, database)
+	for i := 0; i < 2; i++ {
+		if _, err := m.Send(&types.LegacyTx{}, "rollup"); err != nil {
+			t.Fatalf("could not send tx. Cause: %s", err)
+		}
+	}
+	m.Stop()
+
+	// The L1 node has lost the transactions, e.g. because it restarted.
+	client.reset()
+	restarted := newTestTxManager(t, client, database)
+	if status := restarted.Status(); status.PendingTxs != 2 || status.NextNonce != 5 {
+		t.Fatalf("expected pending txs to be resumed, got status %+v", status)
+	}
+	waitFor(t, func() bool { return client.sentTxCount() == 2 })
+
+	client.setIncludeTxs(true)
+	waitFor(t, func() bool { return restarted.Status().PendingTxs == 0 })
+	if txs, err := database.GetPendingL1Txs(); err != nil || len(txs) != 0 {
+		t.Fatalf("expected included txs to be removed from the DB")
+	}
+}
+
+func TestRevertedTxIsReported(t *testing.T) {
+	client := newFakeL1Client(0)
+	client.revertTxs = true
+	m := newTestTxManager(t, client, db.NewInMemoryDB(nil, nil))
+
+	sentTx, err := m.Send(&types.LegacyTx{}, "secret request")
+	if err != nil {
+		t.Fatalf("could not send tx. Cause: %s", err)
+	}
+	if _, err = sentTx.Wait(testTimeout); !errors.Is(err, ErrTxReverted) {
+		t.Fatalf("expected tx to revert, got %v", err)
+	}
+	if status := m.Status(); status.LastError == "" {
+		t.Fatalf("expected reverted tx to be reported")
+	}
+}
+
+func TestReplacementsStopAtTheLimits(t *testing.T) {
+	tests := map[string]struct {
+		maxFeePerGas    int64
+		maxReplacements uint64
+	}{
+		"max replacements": {maxFeePerGas: 1_000_000, maxReplacements: 2},
+		"max fee per gas":  {maxFeePerGas: 4 * suggestedTip, maxReplacements: 100},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newFakeL1Client(0)
+			client.includeTxs = false
+			m := newTestTxManagerWithLimits(t, client, db.NewInMemoryDB(nil, nil), big.NewInt(test.maxFeePerGas), test.maxReplacements)
+			m.setStuckTxTimeout(0)
+
+			if _, err := m.Send(&types.LegacyTx{}, "rollup"); err != nil {
+				t.Fatalf("could not send tx. Cause: %s", err)
+			}
+			waitFor(t, func() bool { return m.Status().CappedTxs == 1 })
+
+			sentTxs := client.sentTxCount()
+			time.Sleep(10 * m.monitorInterval)
+			if client.sentTxCount() != sentTxs {
+				t.Fatal("expected no more replacements once the limit was reached")
+			}
+			if uint64(sentTxs) > test.maxReplacements+1 {
+				t.Fatalf("expected at most %d replacements, got %d", test.maxReplacements, sentTxs-1)
+			}
+			for _, tx := range client.sentTxsCopy() {
+				if tx.GasFeeCap().Int64() > test.maxFeePerGas || tx.GasTipCap().Cmp(tx.GasFeeCap()) > 0 {
+					t.Fatalf("tx fees exceed the maximum fee per gas")
+				}
+			}
+			if status := m.Status(); status.Healthy || status.Error == "" {
+				t.Fatalf("expected capped tx to be reported, got status %+v", status)
+			}
+		})
+	}
+}
+
+func TestRepeatedlyUnderpricedTxIsReplacedOncePerCheck(t *testing.T) {
+	client := newFakeL1Client(0)
+	maxReplacements := uint64(3)
+	m := newTestTxManagerWithLimits(t, client, db.NewInMemoryDB(nil, nil), big.NewInt(1_000_000), maxReplacements)
+	for i := 0; i < 100; i++ {
+		client.sendErrs = append(client.sendErrs, errors.New("replacement transaction underpriced"))
+	}
+
+	if _, err := m.Send(&types.LegacyTx{}, "rollup"); err != nil {
+		t.Fatalf("could not send tx. Cause: %s", err)
+	}
+	// The tx and a single replacement are sent straight away, and further replacements wait for the next checks.
+	if attempts := client.sendAttemptCount(); attempts != 2 {
+		t.Fatalf("expected 2 send attempts when sending the tx, got %d", attempts)
+	}
+	waitFor(t, func() bool { return m.Status().CappedTxs == 1 })
+	if status := m.Status(); status.Replacements != maxReplacements {
+		t.Fatalf("expected %d replacements, got %d", maxReplacements, status.Replacements)
+	}
+}
+
+func TestSendIsNotBlockedByReceiptLookups(t *testing.T) {
+	client := newFakeL1Client(0)
+	client.includeTxs = false
+	m := newTestTxManager(t, client, db.NewInMemoryDB(nil, nil))
+	if _, err := m.Send(&types.LegacyTx{}, "rollup"); err != nil {
+		t.Fatalf("could not send tx. Cause: %s", err)
+	}
+
+	unblock := client.blockReceipts()
+	defer close(unblock)
+	waitFor(t, func() bool { return client.blockedReceiptCount() > 0 })
+
+	sent := make(chan error, 1)
+	go func() {
+		_, err := m.Send(&types.LegacyTx{}, "rollup")
+		sent <- err
+	}()
+	select {
+	case err := <-sent:
+		if err != nil {
+			t.Fatalf("could not send tx. Cause: %s", err)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("sending a tx was blocked by a receipt lookup")
+	}
+}
+
+func newTestTxManager(t *testing.T, client *fakeL1Client, database *db.DB) *TxManager {
+	return newTestTxManagerWithLimits(t, client, database, nil, 0)
+}
+
+func newTestTxManagerWithLimits(t *testing.T, client *fakeL1Client, database *db.DB, maxFeePerGas *big.Int, maxReplacements uint64) *TxManager {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key. Cause: %s", err)
+	}
+	m := New(client, wallet.NewInMemoryWalletFromPK(big.NewInt(chainID), key, gethlog.New()), database, maxFeePerGas, maxReplacements, gethlog.New())
+	m.monitorInterval = 10 * time.Millisecond
+	if err = m.Start(); err != nil {
+		t.Fatalf("could not start tx manager. Cause: %s", err)
+	}
+	t.Cleanup(func() {
+		select {
+		case <-m.stopCh:
+		default:
+			m.Stop()
+		}
+	})
+	return m
+}
+
+func (m *TxManager) setStuckTxTimeout(timeout time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stuckTxTimeout = timeout
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(testTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition was not met in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+type ethClient = ethadapter.EthClient
+
+const (
+	chainID      = 1337
+	suggestedTip = 10
+)
+
+// A fake L1 client that records the transactions sent to it, and includes them in a block if `includeTxs` is set.
+type fakeL1Client struct {
+	ethClient // Not implemented, aside from the methods below
+
+	mu         sync.Mutex
+	nonce      uint64
+	includeTxs bool
+	revertTxs  bool
+	sendErrs   []error // Errors to return from the next calls to SendTransaction
+	sentTxs    []*types.Transaction
+	attempts   int // The number of calls to SendTransaction
+	included   map[gethcommon.Hash]bool
+
+	receiptsUnblocked chan struct{} // If set, calls to TransactionReceipt block until it is closed
+	blockedReceipts   int
+}
+
+func newFakeL1Client(nonce uint64) *fakeL1Client {
+	return &fakeL1Client{nonce: nonce, includeTxs: true, included: map[gethcommon.Hash]bool{}}
+}
+
+func (c *fakeL1Client) Nonce(gethcommon.Address) (uint64, error) {
+	return c.nonce, nil
+}
+
+func (c *fakeL1Client) EstimateDynamicFeeTx(txData types.TxData, _ gethcommon.Address) (*types.DynamicFeeTx, error) {
+	tx := types.NewTx(txData)
+	return &types.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		GasTipCap: big.NewInt(suggestedTip),
+		GasFeeCap: big.NewInt(3 * suggestedTip),
+		Gas:       21_000,
+		To:        tx.To(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	}, nil
+}
+
+func (c *fakeL1Client) SendTransaction(tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attempts++
+	if len(c.sendErrs) > 0 {
+		err := c.sendErrs[0]
+		c.sendErrs = c.sendErrs[1:]
+		return err
+	}
+	c.sentTxs = append(c.sentTxs, tx)
+	return nil
+}
+
+func (c *fakeL1Client) TransactionReceipt(hash gethcommon.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	if unblocked := c.receiptsUnblocked; unblocked != nil {
+		c.blockedReceipts++
+		c.mu.Unlock()
+		<-unblocked
+		c.mu.Lock()
+	}
+	defer c.mu.Unlock()
+	included := c.included[hash]
+	if !included && c.includeTxs {
+		for _, tx := range c.sentTxs {
+			included = included || tx.Hash() == hash
+		}
+	}
+	if !included {
+		return nil, ethereum.NotFound
+	}
+	status := types.ReceiptStatusSuccessful
+	if c.revertTxs {
+		status = types.ReceiptStatusFailed
+	}
+	return &types.Receipt{TxHash: hash, Status: status}, nil
+}
+
+func (c *fakeL1Client) include(hash gethcommon.Hash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.included[hash] = true
+}
+
+func (c *fakeL1Client) setIncludeTxs(includeTxs bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.includeTxs = includeTxs
+}
+
+func (c *fakeL1Client) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sentTxs = nil
+}
+
+func (c *fakeL1Client) sentTxCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.sentTxs)
+}
+
+func (c *fakeL1Client) lastSentTx() *types.Transaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sentTxs[len(c.sentTxs)-1]
+}
+
+func (c *fakeL1Client) sendAttemptCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.attempts
+}
+
+func (c *fakeL1Client) sentTxsCopy() []*types.Transaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*types.Transaction{}, c.sentTxs...)
+}
+
+// Makes calls to TransactionReceipt block until the returned channel is closed.
+func (c *fakeL1Client) blockReceipts() chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.receiptsUnblocked = make(chan struct{})
+	return c.receiptsUnblocked
+}
+
+func (c *fakeL1Client) blockedReceiptCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blockedReceipts
+}
@@ -6,28 +6,34 @@ import (
 
 // NodeConfigCLI represents the configurations passed into the node over CLI
 type NodeConfigCLI struct {
-	nodeType               string
-	isGenesis              bool
-	isSGXEnabled           bool
-	enclaveDockerImage     string
-	hostDockerImage        string
-	l1Host                 string
-	l1WSPort               int
-	hostP2PPort            int
-	hostP2PHost            string
-	hostP2PPublicAddr      string
-	enclaveHTTPPort        int
-	enclaveWSPort          int
-	privateKey             string
-	hostID                 string
-	sequencerID            string
-	managementContractAddr string
-	messageBusContractAddr string
-	pccsAddr               string
-	edgelessDBImage        string
-	hostHTTPPort           int
-	hostWSPort             int
-	nodeName               string
+	nodeType                string
+	isGenesis               bool
+	isSGXEnabled            bool
+	enclaveDockerImage      string
+	hostDockerImage         string
+	l1Host                  string
+	l1WSPort                int
+	hostP2PPort             int
+	hostP2PHost             string
+	hostP2PPublicAddr       string
+	enclaveHTTPPort         int
+	enclaveWSPort           int
+	privateKey              string
+	hostID                  string
+	sequencerID             string
+	managementContractAddr  string
+	messageBusContractAddr  string
+	pccsAddr                string
+	edgelessDBImage         string
+	hostHTTPPort            int
+	hostWSPort              int
+	nodeName                string
+	attestationPolicy       string
+	insecureSkipAttestation bool
+	l1BeaconURL             string
+	l1BeaconCheckpoint      string
+	l1BeaconGenesisRoot     string
+	l1BeaconForks           string
 }
 
 // ParseConfigCLI returns a NodeConfigCLI based the cli params and defaults.
@@ -57,6 +63,12 @@ func ParseConfigCLI() *NodeConfigCLI {
 	messageBusContractAddr := flag.String(messageBusContractAddrFlag, "", flagUsageMap[messageBusContractAddrFlag])
 	pccsAddr := flag.String(pccsAddrFlag, "", flagUsageMap[pccsAddrFlag])
 	edgelessDBImage := flag.String(edgelessDBImageFlag, "ghcr.io/edgelesssys/edgelessdb-sgx-4gb:v0.3.2", flagUsageMap[edgelessDBImageFlag])
+	attestationPolicy := flag.String(attestationPolicyFlag, "", flagUsageMap[attestationPolicyFlag])
+	insecureSkipAttestation := flag.Bool(insecureSkipAttestationFlag, false, flagUsageMap[insecureSkipAttestationFlag])
+	l1BeaconURL := flag.String(l1BeaconURLFlag, "", flagUsageMap[l1BeaconURLFlag])
+	l1BeaconCheckpoint := flag.String(l1BeaconCheckpointFlag, "", flagUsageMap[l1BeaconCheckpointFlag])
+	l1BeaconGenesisRoot := flag.String(l1BeaconGenesisRootFlag, "", flagUsageMap[l1BeaconGenesisRootFlag])
+	l1BeaconForks := flag.String(l1BeaconForksFlag, "", flagUsageMap[l1BeaconForksFlag])
 
 	flag.Parse()
 	cfg.nodeName = *nodeName
@@ -81,6 +93,12 @@ func ParseConfigCLI() *NodeConfigCLI {
 	cfg.edgelessDBImage = *edgelessDBImage
 	cfg.hostHTTPPort = *hostHTTPPort
 	cfg.hostWSPort = *hostWSPort
+	cfg.attestationPolicy = *attestationPolicy
+	cfg.insecureSkipAttestation = *insecureSkipAttestation
+	cfg.l1BeaconURL = *l1BeaconURL
+	cfg.l1BeaconCheckpoint = *l1BeaconCheckpoint
+	cfg.l1BeaconGenesisRoot = *l1BeaconGenesisRoot
+	cfg.l1BeaconForks = *l1BeaconForks
 
 	return cfg
 }
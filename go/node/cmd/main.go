@@ -30,6 +30,9 @@ func main() {
 		node.WithMessageBusContractAddress(cliConfig.messageBusContractAddr), // "0xFD03804faCA2538F4633B3EBdfEfc38adafa259B"),
 		node.WithPCCSAddr(cliConfig.pccsAddr),
 		node.WithEdgelessDBImage(cliConfig.edgelessDBImage),
+		node.WithAttestationPolicy(cliConfig.attestationPolicy),
+		node.WithInsecureSkipAttestation(cliConfig.insecureSkipAttestation),
+		node.WithL1Beacon(cliConfig.l1BeaconURL, cliConfig.l1BeaconCheckpoint, cliConfig.l1BeaconGenesisRoot, cliConfig.l1BeaconForks),
 	)
 
 	dockerNode, err := node.NewDockerNode(nodeCfg)
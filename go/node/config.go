@@ -27,6 +27,12 @@ type Config struct {
 	edgelessDBImage           string
 	enclaveDebug              bool
 	nodeName                  string
+	attestationPolicy         string
+	insecureSkipAttestation   bool
+	l1BeaconURL               string
+	l1BeaconCheckpoint        string
+	l1BeaconGenesisRoot       string
+	l1BeaconForks             string
 }
 
 func NewNodeConfig(opts ...Option) *Config {
@@ -170,3 +176,24 @@ func WithPCCSAddr(s string) Option {
 		c.pccsAddr = s
 	}
 }
+
+func WithAttestationPolicy(s string) Option {
+	return func(c *Config) {
+		c.attestationPolicy = s
+	}
+}
+
+func WithInsecureSkipAttestation(b bool) Option {
+	return func(c *Config) {
+		c.insecureSkipAttestation = b
+	}
+}
+
+func WithL1Beacon(url string, checkpoint string, genesisRoot string, forks string) Option {
+	return func(c *Config) {
+		c.l1BeaconURL = url
+		c.l1BeaconCheckpoint = checkpoint
+		c.l1BeaconGenesisRoot = genesisRoot
+		c.l1BeaconForks = forks
+	}
+}
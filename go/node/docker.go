@@ -60,6 +60,11 @@ func (d *DockerNode) startHost() error {
 		"-useInMemoryDB=true",
 	}
 
+	// only validate L1 blocks against the beacon chain if a checkpoint is defined
+	if d.cfg.l1BeaconCheckpoint != "" {
+		cmd = append(cmd, "-l1BeaconURL", d.cfg.l1BeaconURL)
+	}
+
 	exposedPorts := []int{
 		d.cfg.hostHTTPPort,
 		d.cfg.hostWSPort,
@@ -111,6 +116,19 @@ func (d *DockerNode) startEnclave() error {
 		"-logLevel", "2",
 	)
 
+	// only set the attestation policy if it's defined, so that the enclave's default policy applies otherwise
+	if d.cfg.attestationPolicy != "" {
+		cmd = append(cmd, "-attestationPolicy", d.cfg.attestationPolicy)
+	}
+
+	if d.cfg.l1BeaconCheckpoint != "" {
+		cmd = append(cmd,
+			"-l1BeaconCheckpoint", d.cfg.l1BeaconCheckpoint,
+			"-l1BeaconGenesisRoot", d.cfg.l1BeaconGenesisRoot,
+			"-l1BeaconForks", d.cfg.l1BeaconForks,
+		)
+	}
+
 	if d.cfg.sgxEnabled {
 		devices["/dev/sgx_enclave"] = "/dev/sgx_enclave"
 		devices["/dev/sgx_provision"] = "/dev/sgx_provision"
@@ -127,6 +145,9 @@ func (d *DockerNode) startEnclave() error {
 		cmd = append(cmd,
 			"-sqliteDBPath", "/data/sqlite.db",
 		)
+		if d.cfg.insecureSkipAttestation {
+			cmd = append(cmd, "-insecureSkipAttestation=true")
+		}
 	}
 
 	_, err := docker.StartNewContainer(d.cfg.nodeName+"-enclave", d.cfg.enclaveImage, cmd, exposedPorts, envs, devices)
@@ -2,7 +2,13 @@ package obsclient
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/event"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
@@ -17,6 +23,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/obscuronet/go-obscuro/go/rpc"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
 const (
@@ -25,8 +33,13 @@ const (
 	filterKeyToBlock   = "toBlock"
 	filterKeyAddress   = "address"
 	filterKeyTopics    = "topics"
+
+	txStatusPollInterval = time.Second
 )
 
+// ErrTxDropped is returned when waiting for a transaction that the sequencer drops from its mempool.
+var ErrTxDropped = errors.New("transaction was dropped")
+
 // AuthObsClient extends the functionality of the ObsClient for all methods that require encryption when communicating with the enclave
 // It is created with an EncRPCClient rather than basic RPC client so encryption/decryption is supported
 //
@@ -36,6 +49,12 @@ type AuthObsClient struct {
 	account gethcommon.Address
 }
 
+// The AuthObsClient can be used as the backend for the contract bindings generated by abigen.
+var (
+	_ bind.ContractBackend = (*AuthObsClient)(nil)
+	_ bind.DeployBackend   = (*AuthObsClient)(nil)
+)
+
 // NewAuthObsClient constructs an AuthObsClient for sensitive communication with an enclave.
 //
 // It requires an EncRPCClient specifically even though the AuthObsClient uses a Client interface in its struct because
@@ -52,12 +71,14 @@ func NewAuthObsClient(client *rpc.EncRPCClient) *AuthObsClient {
 // DialWithAuth will generate and sign a viewing key for given wallet, then initiate a connection with the RPC node and
 //
 //	register the viewing key
-func DialWithAuth(rpcurl string, wal wallet.Wallet, logger gethlog.Logger) (*AuthObsClient, error) {
+//
+// The node's attestation is checked before the viewing key is registered (see `rpc.NewEncRPCClient`).
+func DialWithAuth(rpcurl string, wal wallet.Wallet, logger gethlog.Logger, opts ...rpc.EncRPCClientOption) (*AuthObsClient, error) {
 	viewingKey, err := rpc.GenerateAndSignViewingKey(wal)
 	if err != nil {
 		return nil, err
 	}
-	encClient, err := rpc.NewEncNetworkClient(rpcurl, viewingKey, logger)
+	encClient, err := rpc.NewEncNetworkClient(rpcurl, viewingKey, logger, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +99,59 @@ func (ac *AuthObsClient) TransactionReceipt(ctx context.Context, txHash gethcomm
 	return &receipt, err
 }
 
+// TransactionStatus returns the progress of one of the transactions of the account registered on this client (due to
+// obscuro privacy restrictions, the status cannot be requested for other accounts' transactions). `ethereum.NotFound`
+// is returned if the node does not know about the transaction.
+func (ac *AuthObsClient) TransactionStatus(ctx context.Context, txHash gethcommon.Hash) (*common.TransactionStatus, error) {
+	var txStatus common.TransactionStatus
+	err := ac.rpcClient.CallContext(ctx, &txStatus, rpc.GetTransactionStatus, txHash)
+	if err != nil {
+		if errors.Is(err, rpc.ErrNilResponse) {
+			return nil, ethereum.NotFound
+		}
+		return nil, err
+	}
+	return &txStatus, nil
+}
+
+// WaitForTransactionStatus polls the node until the transaction has progressed at least as far as the target status,
+// and returns its status. A transaction the node does not know about yet is waited for. If the transaction is dropped,
+// an error wrapping `ErrTxDropped` and giving the reason is returned, so that the caller can decide whether to resubmit.
+func (ac *AuthObsClient) WaitForTransactionStatus(ctx context.Context, txHash gethcommon.Hash, target common.TxStatus) (*common.TransactionStatus, error) {
+	ticker := time.NewTicker(txStatusPollInterval)
+	defer ticker.Stop()
+
+	for {
+		txStatus, err := ac.TransactionStatus(ctx, txHash)
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+		if err == nil {
+			if txStatus.Status.Reached(target) {
+				return txStatus, nil
+			}
+			if txStatus.Status == common.TxStatusDropped {
+				return txStatus, fmt.Errorf("%w: %s", ErrTxDropped, txStatus.DropReason)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitMined waits for the transaction to be included in a batch, and returns its receipt. Unlike `bind.WaitMined`, it
+// returns an error wrapping `ErrTxDropped` instead of waiting forever if the transaction is dropped.
+func (ac *AuthObsClient) WaitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	if _, err := ac.WaitForTransactionStatus(ctx, tx.Hash(), common.TxStatusBatched); err != nil {
+		return nil, err
+	}
+	return ac.TransactionReceipt(ctx, tx.Hash())
+}
+
 // NonceAt retrieves the nonce for the account registered on this client (due to obscuro privacy restrictions,
 // nonce cannot be requested for other accounts)
 func (ac *AuthObsClient) NonceAt(ctx context.Context, blockNumber *big.Int) (uint64, error) {
@@ -89,10 +163,34 @@ func (ac *AuthObsClient) NonceAt(ctx context.Context, blockNumber *big.Int) (uin
 	return hexutil.DecodeUint64(result)
 }
 
+// CallContract executes the call and returns the decoded return data. If `msg.From` is not set, the call is made from
+// the account registered on this client, as the enclave encrypts the response with the sender's viewing key.
 func (ac *AuthObsClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
 	var hex string
-	err := ac.rpcClient.CallContext(ctx, &hex, rpc.Call, ToCallArg(msg), toBlockNumArg(blockNumber))
-	return []byte(hex), err
+	err := ac.rpcClient.CallContext(ctx, &hex, rpc.Call, ToCallArg(ac.withSender(msg)), toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Decode(hex)
+}
+
+// PendingCallContract executes the call against the head batch, as the node does not expose a pending state.
+func (ac *AuthObsClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return ac.CallContract(ctx, msg, nil)
+}
+
+// PendingCodeAt returns the code at the given address in the head batch, as the node does not expose a pending state.
+func (ac *AuthObsClient) PendingCodeAt(ctx context.Context, account gethcommon.Address) ([]byte, error) {
+	return ac.CodeAt(ctx, account, nil)
+}
+
+// PendingNonceAt retrieves the next nonce for the given account, which must be the account registered on this client
+// (due to obscuro privacy restrictions, nonce cannot be requested for other accounts)
+func (ac *AuthObsClient) PendingNonceAt(ctx context.Context, account gethcommon.Address) (uint64, error) {
+	if account != ac.account {
+		return 0, fmt.Errorf("cannot retrieve nonce for account %s with a client registered for account %s", account, ac.account)
+	}
+	return ac.NonceAt(ctx, big.NewInt(int64(gethrpc.PendingBlockNumber)))
 }
 
 func (ac *AuthObsClient) SendTransaction(ctx context.Context, signedTx *types.Transaction) error {
@@ -110,17 +208,65 @@ func (ac *AuthObsClient) BalanceAt(ctx context.Context, blockNumber *big.Int) (*
 	return hexutil.DecodeBig(result)
 }
 
-func (ac *AuthObsClient) SubscribeFilterLogs(ctx context.Context, filterCriteria filters.FilterCriteria, ch chan common.IDAndLog) (ethereum.Subscription, error) {
+// FilterLogs returns the logs matching the query that are visible to the account registered on this client.
+func (ac *AuthObsClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := ac.GetLogs(ctx, toFilterCriteriaJSON(query))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]types.Log, len(logs))
+	for i, l := range logs {
+		result[i] = *l
+	}
+	return result, nil
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the query that are visible to the account registered on this
+// client.
+func (ac *AuthObsClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	idAndLogCh := make(chan common.IDAndLog)
+	sub, err := ac.SubscribeFilterLogsWithID(ctx, filters.FilterCriteria(query), idAndLogCh)
+	if err != nil {
+		return nil, err
+	}
+
+	// We strip the subscription IDs from the logs before forwarding them.
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case idAndLog := <-idAndLogCh:
+				select {
+				case ch <- *idAndLog.Log:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// SubscribeFilterLogsWithID is as SubscribeFilterLogs, but each log is delivered along with the ID of the subscription.
+func (ac *AuthObsClient) SubscribeFilterLogsWithID(ctx context.Context, filterCriteria filters.FilterCriteria, ch chan common.IDAndLog) (ethereum.Subscription, error) {
 	filterCriteriaMap := map[string]interface{}{
 		filterKeyBlockHash: filterCriteria.BlockHash,
-		filterKeyFromBlock: filterCriteria.FromBlock,
-		filterKeyToBlock:   filterCriteria.ToBlock,
+		filterKeyFromBlock: (*hexutil.Big)(filterCriteria.FromBlock),
+		filterKeyToBlock:   (*hexutil.Big)(filterCriteria.ToBlock),
 		filterKeyAddress:   filterCriteria.Addresses,
 		filterKeyTopics:    filterCriteria.Topics,
 	}
 	return ac.rpcClient.Subscribe(ctx, nil, rpc.SubscribeNamespace, ch, rpc.SubscriptionTypeLogs, filterCriteriaMap)
 }
 
+// SubscribePendingTransactions subscribes to the hashes of the account's transactions, as they are submitted to the node.
+func (ac *AuthObsClient) SubscribePendingTransactions(ctx context.Context, ch chan common.IDAndTxHash) (ethereum.Subscription, error) {
+	return ac.rpcClient.Subscribe(ctx, nil, rpc.SubscribeNamespace, ch, rpc.SubscriptionTypePendingTransactions)
+}
+
 func (ac *AuthObsClient) GetLogs(ctx context.Context, filterCriteria common.FilterCriteriaJSON) ([]*types.Log, error) {
 	var logs []*types.Log
 	err := ac.rpcClient.CallContext(ctx, &logs, rpc.GetLogs, filterCriteria, ac.account)
@@ -131,9 +277,11 @@ func (ac *AuthObsClient) Address() gethcommon.Address {
 	return ac.account
 }
 
-func (ac *AuthObsClient) EstimateGas(ctx context.Context, msg *ethereum.CallMsg) (uint64, error) {
+// EstimateGas estimates the gas needed to execute the call. As for CallContract, if `msg.From` is not set, the account
+// registered on this client is used.
+func (ac *AuthObsClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
 	var result string
-	err := ac.rpcClient.CallContext(ctx, &result, rpc.EstimateGas, ToCallArg(*msg))
+	err := ac.rpcClient.CallContext(ctx, &result, rpc.EstimateGas, ToCallArg(ac.withSender(msg)))
 	if err != nil {
 		return 0, err
 	}
@@ -144,7 +292,7 @@ func (ac *AuthObsClient) EstimateGasAndGasPrice(txData types.TxData) types.TxDat
 	unEstimatedTx := types.NewTx(txData)
 	gasPrice := gethcommon.Big1 // constant gas price atm
 
-	gasLimit, err := ac.EstimateGas(context.Background(), &ethereum.CallMsg{
+	gasLimit, err := ac.EstimateGas(context.Background(), ethereum.CallMsg{
 		From:  ac.Address(),
 		To:    unEstimatedTx.To(),
 		Value: unEstimatedTx.Value(),
@@ -163,3 +311,11 @@ func (ac *AuthObsClient) EstimateGasAndGasPrice(txData types.TxData) types.TxDat
 		Data:     unEstimatedTx.Data(),
 	}
 }
+
+// Returns the message, with the sender set to the account registered on this client if it is not set already.
+func (ac *AuthObsClient) withSender(msg ethereum.CallMsg) ethereum.CallMsg {
+	if msg.From == (gethcommon.Address{}) {
+		msg.From = ac.account
+	}
+	return msg
+}
@@ -2,12 +2,19 @@ package obsclient
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	common2 "github.com/obscuronet/go-obscuro/go/common"
 )
 
 // These tests use a mocked RPC client, they test any transformations of the Go objects -> RPC params, as well as any
@@ -42,6 +49,115 @@ func TestNonceAt_ConvertsNilBlockNumberToLatest(t *testing.T) {
 	assert.Equal(t, uint64(2), nonce)
 }
 
+func TestCallContract_DefaultsSenderAndDecodesResult(t *testing.T) {
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	contract := common.HexToAddress("0x123")
+
+	// the call should be made from the client's account, since no sender was specified
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*string"), rpc.Call, []interface{}{ToCallArg(ethereum.CallMsg{From: testAcc, To: &contract}), "latest"},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*string) = "0x0102"
+	})
+
+	result, err := authClient.CallContract(testCtx, ethereum.CallMsg{To: &contract}, nil)
+
+	mockRPC.AssertExpectations(t)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, result)
+}
+
+func TestPendingNonceAt_RejectsOtherAccounts(t *testing.T) {
+	_, authClient := createAuthClientWithMockRPCClient()
+
+	_, err := authClient.PendingNonceAt(testCtx, common.HexToAddress("0x123"))
+
+	assert.NotNil(t, err)
+}
+
+func TestFilterLogs_ConvertsQueryToFilterCriteria(t *testing.T) {
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	topic := common.HexToHash("0x1")
+	fromBlock := gethrpc.BlockNumber(5)
+
+	// empty topic positions should be sent as wildcards
+	expectedCriteria := common2.FilterCriteriaJSON{
+		FromBlock: &fromBlock,
+		Addresses: []common.Address{testAcc},
+		Topics:    []interface{}{[]common.Hash{topic}, nil},
+	}
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*[]*types.Log"), rpc.GetLogs, []interface{}{expectedCriteria, testAcc},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*[]*types.Log) = []*types.Log{{Topics: []common.Hash{topic}}}
+	})
+
+	logs, err := authClient.FilterLogs(testCtx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(5),
+		Addresses: []common.Address{testAcc},
+		Topics:    [][]common.Hash{{topic}, {}},
+	})
+
+	mockRPC.AssertExpectations(t)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Log{{Topics: []common.Hash{topic}}}, logs)
+}
+
+func TestTransactionStatus_ReturnsNotFoundForUnknownTransaction(t *testing.T) {
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	txHash := common.HexToHash("0x1")
+
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.TransactionStatus"), rpc.GetTransactionStatus, []interface{}{txHash},
+	).Return(rpc.ErrNilResponse)
+
+	_, err := authClient.TransactionStatus(testCtx, txHash)
+
+	mockRPC.AssertExpectations(t)
+	assert.Equal(t, ethereum.NotFound, err)
+}
+
+func TestWaitForTransactionStatus_ReturnsDropReason(t *testing.T) {
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	txHash := common.HexToHash("0x1")
+
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.TransactionStatus"), rpc.GetTransactionStatus, []interface{}{txHash},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*common2.TransactionStatus) = common2.TransactionStatus{Status: common2.TxStatusDropped, DropReason: "nonce too low"}
+	})
+
+	txStatus, err := authClient.WaitForTransactionStatus(testCtx, txHash, common2.TxStatusBatched)
+
+	mockRPC.AssertExpectations(t)
+	assert.True(t, errors.Is(err, ErrTxDropped))
+	assert.Contains(t, err.Error(), "nonce too low")
+	assert.Equal(t, common2.TxStatusDropped, txStatus.Status)
+}
+
+func TestWaitForTransactionStatus_ReturnsOnceTargetIsPassed(t *testing.T) {
+	mockRPC, authClient := createAuthClientWithMockRPCClient()
+	txHash := common.HexToHash("0x1")
+
+	// a transaction that is already rolled up has been batched
+	mockRPC.On(
+		"CallContext",
+		testCtx, mock.AnythingOfType("*common.TransactionStatus"), rpc.GetTransactionStatus, []interface{}{txHash},
+	).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(1).(*common2.TransactionStatus) = common2.TransactionStatus{Status: common2.TxStatusRolledUp}
+	})
+
+	txStatus, err := authClient.WaitForTransactionStatus(testCtx, txHash, common2.TxStatusBatched)
+
+	mockRPC.AssertExpectations(t)
+	assert.Nil(t, err)
+	assert.Equal(t, common2.TxStatusRolledUp, txStatus.Status)
+}
+
 func createAuthClientWithMockRPCClient() (*rpcClientMock, *AuthObsClient) {
 	mockRPC := new(rpcClientMock)
 	authClient := &AuthObsClient{
@@ -5,9 +5,11 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/obscuronet/go-obscuro/go/common"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
 // utils for converting to RPC message format - mostly ported from geth client
@@ -53,5 +55,60 @@ func toBlockNumArg(number *big.Int) string {
 	if number.Cmp(pending) == 0 {
 		return "pending"
 	}
+	if number.Cmp(big.NewInt(int64(common.SafeBlockNumber))) == 0 {
+		return "safe"
+	}
+	if number.Cmp(big.NewInt(int64(common.FinalizedBlockNumber))) == 0 {
+		return "finalized"
+	}
 	return hexutil.EncodeBig(number)
 }
+
+// Converts a log query to the filter criteria format expected by the node.
+func toFilterCriteriaJSON(query ethereum.FilterQuery) common.FilterCriteriaJSON {
+	topics := make([]interface{}, len(query.Topics))
+	for i, topic := range query.Topics {
+		// An empty position matches any topic.
+		if len(topic) > 0 {
+			topics[i] = topic
+		}
+	}
+	return common.FilterCriteriaJSON{
+		BlockHash: query.BlockHash,
+		FromBlock: toBlockNum(query.FromBlock),
+		ToBlock:   toBlockNum(query.ToBlock),
+		Addresses: query.Addresses,
+		Topics:    topics,
+	}
+}
+
+func toBlockNum(number *big.Int) *gethrpc.BlockNumber {
+	if number == nil {
+		return nil
+	}
+	blockNum := gethrpc.BlockNumber(number.Int64())
+	return &blockNum
+}
+
+// Converts a batch header to a Geth header, for compatibility with Geth tooling. The Obscuro-specific fields are dropped,
+// so the resulting header's hash is not the batch's hash.
+func toGethHeader(h *common.BatchHeader) *types.Header {
+	return &types.Header{
+		ParentHash:  h.ParentHash,
+		UncleHash:   h.UncleHash,
+		Coinbase:    h.Coinbase,
+		Root:        h.Root,
+		TxHash:      h.TxHash,
+		ReceiptHash: h.ReceiptHash,
+		Bloom:       h.Bloom,
+		Difficulty:  h.Difficulty,
+		Number:      h.Number,
+		GasLimit:    h.GasLimit,
+		GasUsed:     h.GasUsed,
+		Time:        h.Time,
+		Extra:       h.Extra,
+		MixDigest:   h.MixDigest,
+		Nonce:       h.Nonce,
+		BaseFee:     h.BaseFee,
+	}
+}
@@ -1,10 +1,13 @@
 package obsclient
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/rpc"
 
@@ -74,9 +77,96 @@ func (oc *ObsClient) RollupHeaderByHash(hash gethcommon.Hash) (*common.BatchHead
 	return batchHeader, err
 }
 
+// HeaderByNumber returns the header of the batch with the given number, or of the head batch if number is nil, as a Geth
+// header (see `RollupHeaderByNumber` for the full batch header).
+func (oc *ObsClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var batchHeader *common.BatchHeader
+	err := oc.rpcClient.CallContext(ctx, &batchHeader, rpc.GetRollupByNumber, toBlockNumArg(number), false)
+	if err == nil && batchHeader == nil {
+		err = ethereum.NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toGethHeader(batchHeader), nil
+}
+
+// CodeAt returns the contract code at the given address, in the batch with the given number, or in the head batch if
+// number is nil.
+func (oc *ObsClient) CodeAt(ctx context.Context, contract gethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	var result hexutil.Bytes
+	err := oc.rpcClient.CallContext(ctx, &result, rpc.GetCode, contract, toBlockNumArg(blockNumber))
+	return result, err
+}
+
+// SuggestGasPrice retrieves the gas price to use for legacy transactions.
+func (oc *ObsClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	var result hexutil.Big
+	if err := oc.rpcClient.CallContext(ctx, &result, rpc.GasPrice); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
+// SuggestGasTipCap retrieves the gas tip cap to use for dynamic fee transactions.
+func (oc *ObsClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	var result hexutil.Big
+	if err := oc.rpcClient.CallContext(ctx, &result, rpc.MaxPriorityFeePerGas); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
 // Health returns the health of the node.
 func (oc *ObsClient) Health() (bool, error) {
 	var healthy *hostcommon.HealthCheck
 	err := oc.rpcClient.Call(&healthy, rpc.Health)
 	return healthy.OverallHealth, err
 }
+
+// SyncProgress retrieves the node's progress through the sequencer's batches, or nil if the node is caught up. The
+// block numbers it reports are batch heights.
+func (oc *ObsClient) SyncProgress() (*ethereum.SyncProgress, error) {
+	var raw json.RawMessage
+	if err := oc.rpcClient.Call(&raw, rpc.Syncing); err != nil {
+		return nil, err
+	}
+	// the node returns false if it is not syncing
+	var syncing bool
+	if err := json.Unmarshal(raw, &syncing); err == nil {
+		return nil, nil //nolint:nilnil
+	}
+	var progress struct {
+		StartingBlock hexutil.Uint64
+		CurrentBlock  hexutil.Uint64
+		HighestBlock  hexutil.Uint64
+	}
+	if err := json.Unmarshal(raw, &progress); err != nil {
+		return nil, err
+	}
+	return &ethereum.SyncProgress{
+		StartingBlock: uint64(progress.StartingBlock),
+		CurrentBlock:  uint64(progress.CurrentBlock),
+		HighestBlock:  uint64(progress.HighestBlock),
+	}, nil
+}
+
+// SyncStatus returns the node's progress processing the L1 and L2 chains.
+func (oc *ObsClient) SyncStatus() (*hostcommon.SyncStatus, error) {
+	var status *hostcommon.SyncStatus
+	err := oc.rpcClient.Call(&status, rpc.SyncStatus)
+	if err == nil && status == nil {
+		err = rpc.ErrNilResponse
+	}
+	return status, err
+}
+
+// SubscribeNewHeads subscribes to the headers of new batches.
+func (oc *ObsClient) SubscribeNewHeads(ctx context.Context, ch chan *common.BatchHeader) (ethereum.Subscription, error) {
+	return oc.rpcClient.Subscribe(ctx, nil, rpc.SubscribeNamespace, ch, rpc.SubscriptionTypeNewHeads)
+}
+
+// SubscribeRollups subscribes to the headers of the rollups published to the L1.
+func (oc *ObsClient) SubscribeRollups(ctx context.Context, ch chan *common.RollupHeader) (ethereum.Subscription, error) {
+	return oc.rpcClient.Subscribe(ctx, nil, rpc.SubscribeNamespace, ch, rpc.SubscriptionTypeRollups)
+}
@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
+)
+
+// AttestationVerifier checks the attestation of the node a client connects to. It is implemented by
+// `attestation.Verifier`, which verifies the attestation report using EGo.
+type AttestationVerifier interface {
+	Verify(att *common.AttestationReport) (*attestation.Result, error)
+}
+
+// EncRPCClientOption configures how an EncRPCClient is created.
+type EncRPCClientOption = func(c *encRPCClientConfig)
+
+type encRPCClientConfig struct {
+	verifier                AttestationVerifier
+	policy                  *attestation.Policy
+	insecureSkipAttestation bool
+}
+
+// WithAttestationPolicy sets the policy the node's attestation is checked against, using EGo to verify the attestation
+// report. The policy must pin the accepted unique IDs or signer ID, since otherwise an enclave running any code would
+// be trusted with the viewing keys.
+func WithAttestationPolicy(policy *attestation.Policy) EncRPCClientOption {
+	return func(c *encRPCClientConfig) {
+		c.policy = policy
+	}
+}
+
+// WithAttestationVerifier sets the verifier used to check the node's attestation, in place of the verifier for the
+// policy set by `WithAttestationPolicy`.
+func WithAttestationVerifier(verifier AttestationVerifier) EncRPCClientOption {
+	return func(c *encRPCClientConfig) {
+		c.verifier = verifier
+	}
+}
+
+// WithInsecureSkipAttestation disables the checking of the node's attestation. This is only safe for local development
+// networks, whose enclaves do not run in SGX and so cannot produce a verifiable attestation.
+func WithInsecureSkipAttestation(insecure bool) EncRPCClientOption {
+	return func(c *encRPCClientConfig) {
+		c.insecureSkipAttestation = insecure
+	}
+}
+
+// Returns the verifier the node's attestation is checked with. There is no default policy, since we cannot know which
+// enclave code the caller expects the node to run.
+func (c *encRPCClientConfig) attestationVerifier() (AttestationVerifier, error) {
+	if c.verifier != nil {
+		return c.verifier, nil
+	}
+	if c.policy == nil {
+		return nil, errors.New("an attestation policy is required to check the node's attestation, unless attestation is skipped for a local development network")
+	}
+	if !c.policy.PinsEnclaveIdentity() {
+		return nil, errors.New("the attestation policy must specify the accepted unique IDs or signer ID, otherwise an enclave running any code is accepted")
+	}
+	return attestation.NewVerifier(c.policy), nil
+}
+
+// Checks that the node's attestation passes the verifier, and that the enclave attested to the public key we encrypt
+// requests with. Otherwise, we could be sending requests to something other than a trusted enclave.
+func (c *EncRPCClient) verifyAttestation(verifier AttestationVerifier) error {
+	var att *common.AttestationReport
+	if err := c.obscuroClient.Call(&att, Attestation); err != nil {
+		return fmt.Errorf("could not retrieve node's attestation. Cause: %w", err)
+	}
+	if att == nil {
+		return fmt.Errorf("node returned an empty attestation")
+	}
+
+	result, err := verifier.Verify(att)
+	if err != nil {
+		return fmt.Errorf("could not verify node's attestation. Cause: %w", err)
+	}
+	if !result.Passed() {
+		return fmt.Errorf("node's attestation does not satisfy the attestation policy: %s", strings.Join(result.Violations, "; "))
+	}
+
+	rpcPubKey := crypto.CompressPubkey(c.enclavePublicKey.ExportECDSA())
+	if !bytes.Equal(att.RPCPubKey, rpcPubKey) {
+		return fmt.Errorf("node's enclave attested to RPC public key %s, but requests are encrypted with key %s",
+			hexutil.Encode(att.RPCPubKey), hexutil.Encode(rpcPubKey))
+	}
+	return nil
+}
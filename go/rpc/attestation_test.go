@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
+	"github.com/obscuronet/go-obscuro/integration/common/testlog"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestAttestationIsCheckedBeforeViewingKeyIsRegistered(t *testing.T) {
+	client := &attestationClient{att: &common.AttestationReport{RPCPubKey: gethcommon.Hex2Bytes(enclavePublicKeyHex)}}
+	verifier := &fixedVerifier{result: &attestation.Result{Violations: []string{"enclave is running in debug mode"}}}
+
+	_, err := NewEncRPCClient(client, nil, testlog.Logger(), WithAttestationVerifier(verifier))
+	if err == nil || !strings.Contains(err.Error(), "debug mode") {
+		t.Fatalf("expected client creation to fail due to the attestation policy, got %v", err)
+	}
+	if client.viewingKeyRegistered {
+		t.Fatal("viewing key was registered with a node whose attestation failed")
+	}
+}
+
+func TestAttestationPolicyMustPinEnclaveIdentity(t *testing.T) {
+	signerID := make([]byte, 32)
+	tests := map[string][]EncRPCClientOption{
+		"no policy":       nil,
+		"unpinned policy": {WithAttestationPolicy(&attestation.Policy{MinSecurityVersion: 1})},
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &attestationClient{att: &common.AttestationReport{RPCPubKey: gethcommon.Hex2Bytes(enclavePublicKeyHex)}}
+			if _, err := NewEncRPCClient(client, nil, testlog.Logger(), opts...); err == nil {
+				t.Fatal("expected client creation to fail without a policy that pins the enclave's identity")
+			}
+			if client.viewingKeyRegistered {
+				t.Fatal("viewing key was registered without checking the enclave's identity")
+			}
+		})
+	}
+
+	config := &encRPCClientConfig{policy: &attestation.Policy{SignerID: signerID}}
+	if _, err := config.attestationVerifier(); err != nil {
+		t.Fatalf("expected a policy with a signer ID to be accepted. Cause: %s", err)
+	}
+}
+
+func TestAttestationForAnotherRPCKeyIsRejected(t *testing.T) {
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key. Cause: %s", err)
+	}
+	client := &attestationClient{att: &common.AttestationReport{RPCPubKey: crypto.CompressPubkey(&otherKey.PublicKey)}}
+	encClient := &EncRPCClient{obscuroClient: client, enclavePublicKey: testEnclavePublicKey(t)}
+
+	if err = encClient.verifyAttestation(&fixedVerifier{result: &attestation.Result{}}); err == nil {
+		t.Fatal("expected attestation for another RPC public key to be rejected")
+	}
+
+	client.att.RPCPubKey = gethcommon.Hex2Bytes(enclavePublicKeyHex)
+	if err = encClient.verifyAttestation(&fixedVerifier{result: &attestation.Result{}}); err != nil {
+		t.Fatalf("expected attestation to pass. Cause: %s", err)
+	}
+}
+
+func testEnclavePublicKey(t *testing.T) *ecies.PublicKey {
+	pubKey, err := crypto.DecompressPubkey(gethcommon.Hex2Bytes(enclavePublicKeyHex))
+	if err != nil {
+		t.Fatalf("could not decompress enclave public key. Cause: %s", err)
+	}
+	return ecies.ImportECDSAPublic(pubKey)
+}
+
+// A verifier that returns a fixed result.
+type fixedVerifier struct {
+	result *attestation.Result
+}
+
+func (v *fixedVerifier) Verify(*common.AttestationReport) (*attestation.Result, error) {
+	return v.result, nil
+}
+
+// A client that returns a fixed attestation, and records whether a viewing key was registered.
+type attestationClient struct {
+	att                  *common.AttestationReport
+	viewingKeyRegistered bool
+}
+
+func (c *attestationClient) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+func (c *attestationClient) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	switch method {
+	case Attestation:
+		*result.(**common.AttestationReport) = c.att
+	case AddViewingKey:
+		c.viewingKeyRegistered = true
+	}
+	return nil
+}
+
+func (c *attestationClient) Subscribe(context.Context, interface{}, string, interface{}, ...interface{}) (*rpc.ClientSubscription, error) {
+	panic("not implemented")
+}
+
+func (c *attestationClient) Stop() {}
@@ -21,11 +21,17 @@ const (
 	SendRawTransaction    = "eth_sendRawTransaction"
 	EstimateGas           = "eth_estimateGas"
 	GetLogs               = "eth_getLogs"
+	GasPrice              = "eth_gasPrice"
+	MaxPriorityFeePerGas  = "eth_maxPriorityFeePerGas"
 	AddViewingKey         = "obscuro_addViewingKey"
+	GetTransactionStatus  = "obscuro_getTransactionStatus"
 	Health                = "obscuro_health"
+	SyncStatus            = "obscuro_syncStatus"
+	Syncing               = "eth_syncing"
 	GetBlockHeaderByHash  = "obscuroscan_getBlockHeaderByHash"
 	GetBatch              = "obscuroscan_getBatch"
 	GetBatchForTx         = "obscuroscan_getBatchForTx"
+	GetRollupsForBlock    = "obscuroscan_getRollupsForBlock"
 	GetLatestTxs          = "obscuroscan_getLatestTransactions"
 	GetTotalTxs           = "obscuroscan_getTotalTransactions"
 	Attestation           = "obscuroscan_attestation"
@@ -33,6 +39,10 @@ const (
 	Subscribe             = "eth_subscribe"
 	SubscribeNamespace    = "eth"
 	SubscriptionTypeLogs  = "logs"
+
+	SubscriptionTypeNewHeads            = "newHeads"
+	SubscriptionTypePendingTransactions = "newPendingTransactions"
+	SubscriptionTypeRollups             = "rollups" // Obscuro-specific, emits the headers of the rollups published to the L1
 )
 
 var ErrNilResponse = errors.New("nil response received from Obscuro node")
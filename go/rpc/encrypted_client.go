@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -32,6 +33,8 @@ const (
 	emptyFilterCriteria = "[]" // This is the value that gets passed for an empty filter criteria.
 )
 
+var errNoSubscriptionID = errors.New("did not receive the initial subscription response with the subscription ID")
+
 // SensitiveMethods for which the RPC requests and responses should be encrypted
 var SensitiveMethods = []string{
 	Call,
@@ -43,6 +46,7 @@ var SensitiveMethods = []string{
 	Subscribe,
 	EstimateGas,
 	GetLogs,
+	GetTransactionStatus,
 }
 
 // EncRPCClient is a Client wrapper that implements Client but also has extra functionality for managing viewing key registration and decryption
@@ -53,8 +57,16 @@ type EncRPCClient struct {
 	logger           gethlog.Logger
 }
 
-// NewEncRPCClient sets up a client with a viewing key for encrypted communication (this submits the VK to the enclave)
-func NewEncRPCClient(client Client, viewingKey *ViewingKey, logger gethlog.Logger) (*EncRPCClient, error) {
+// NewEncRPCClient sets up a client with a viewing key for encrypted communication (this submits the VK to the enclave).
+// Before any encrypted data is sent, the node's attestation is checked against the policy set by `WithAttestationPolicy`
+// (or by the verifier set by `WithAttestationVerifier`), and the client is not created if the check fails. A policy is
+// required unless the check is disabled by `WithInsecureSkipAttestation`.
+func NewEncRPCClient(client Client, viewingKey *ViewingKey, logger gethlog.Logger, opts ...EncRPCClientOption) (*EncRPCClient, error) {
+	config := &encRPCClientConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
 	// todo: this is a convenience for testnet but needs to replaced by a parameter and/or retrieved from the target host
 	enclPubECDSA, err := crypto.DecompressPubkey(gethcommon.Hex2Bytes(enclavePublicKeyHex))
 	if err != nil {
@@ -68,6 +80,19 @@ func NewEncRPCClient(client Client, viewingKey *ViewingKey, logger gethlog.Logge
 		viewingKey:       viewingKey,
 		logger:           logger,
 	}
+
+	if config.insecureSkipAttestation {
+		logger.Warn("WARNING - The node's attestation is not being checked. Only skip attestation for local development networks.")
+	} else {
+		var verifier AttestationVerifier
+		if verifier, err = config.attestationVerifier(); err != nil {
+			return nil, err
+		}
+		if err = encClient.verifyAttestation(verifier); err != nil {
+			return nil, err
+		}
+	}
+
 	err = encClient.registerViewingKey()
 	if err != nil {
 		return nil, err
@@ -130,16 +155,33 @@ func (c *EncRPCClient) CallContext(ctx context.Context, result interface{}, meth
 	return nil
 }
 
+// Subscribe creates a subscription of the type given by the first argument. Log subscriptions expect a channel of type
+// `chan common.IDAndLog`, pending transaction subscriptions a channel of type `chan common.IDAndTxHash`, and
+// subscriptions to public data (new heads and rollups) are passed through unencrypted. If a log or pending transaction
+// subscription fails, its error channel is closed once the error has been received.
+//
+// For log subscriptions, a `fromBlock` in the filter criteria requests a replay of the matching logs from that batch,
+// before new logs are delivered.
 func (c *EncRPCClient) Subscribe(ctx context.Context, result interface{}, namespace string, ch interface{}, args ...interface{}) (*rpc.ClientSubscription, error) {
 	if len(args) == 0 {
 		return nil, fmt.Errorf("subscription did not specify its type")
 	}
 
 	subscriptionType := args[0]
-	if subscriptionType != SubscriptionTypeLogs {
-		return nil, fmt.Errorf("only subscriptions of type %s are supported", SubscriptionTypeLogs)
+	switch subscriptionType {
+	case SubscriptionTypeLogs:
+		return c.subscribeLogs(ctx, result, namespace, ch, args)
+	case SubscriptionTypePendingTransactions:
+		return c.subscribePendingTxs(ctx, result, namespace, ch)
+	case SubscriptionTypeNewHeads, SubscriptionTypeRollups:
+		// These subscriptions are to public data, so there is nothing to encrypt.
+		return c.obscuroClient.Subscribe(ctx, result, namespace, ch, args...)
+	default:
+		return nil, fmt.Errorf("subscriptions of type %s are not supported", subscriptionType)
 	}
+}
 
+func (c *EncRPCClient) subscribeLogs(ctx context.Context, result interface{}, namespace string, ch interface{}, args []interface{}) (*rpc.ClientSubscription, error) {
 	logSubscription, err := c.createAuthenticatedLogSubscription(args)
 	if err != nil {
 		return nil, err
@@ -161,7 +203,7 @@ func (c *EncRPCClient) Subscribe(ctx context.Context, result interface{}, namesp
 		return nil, fmt.Errorf("expected a channel of type `chan types.Log`, got %T", ch)
 	}
 	clientChannel := make(chan common.IDAndEncLog)
-	subscription, err := c.obscuroClient.Subscribe(ctx, nil, namespace, clientChannel, subscriptionType, encryptedParams)
+	subscription, err := c.obscuroClient.Subscribe(ctx, nil, namespace, clientChannel, SubscriptionTypeLogs, encryptedParams)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +211,12 @@ func (c *EncRPCClient) Subscribe(ctx context.Context, result interface{}, namesp
 	// We need to return the subscription ID, to allow unsubscribing. However, the client API has already converted
 	// from a subscription ID to a subscription object under the hood, so we can't retrieve the subscription ID.
 	// To hack around this, we always return the subscription ID as the first message on the newly-created subscription.
-	err = c.setResultToSubID(clientChannel, result, subscription)
+	select {
+	case idAndEncLog := <-clientChannel:
+		err = c.setResultToSubID(idAndEncLog.SubID, idAndEncLog.EncLog, result)
+	case <-subscription.Err():
+		err = errNoSubscriptionID
+	}
 	if err != nil {
 		subscription.Unsubscribe()
 		return nil, err
@@ -180,6 +227,51 @@ func (c *EncRPCClient) Subscribe(ctx context.Context, result interface{}, namesp
 	return subscription, nil
 }
 
+func (c *EncRPCClient) subscribePendingTxs(ctx context.Context, result interface{}, namespace string, ch interface{}) (*rpc.ClientSubscription, error) {
+	accountSignature, err := c.signAccount()
+	if err != nil {
+		return nil, err
+	}
+	encodedPendingTxSubscription, err := rlp.EncodeToBytes(&common.PendingTxSubscription{
+		Account:   c.Account(),
+		Signature: &accountSignature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedParams, err := c.encryptParamBytes(encodedPendingTxSubscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt args for subscription in namespace %s - %w", namespace, err)
+	}
+
+	txHashCh, ok := ch.(chan common.IDAndTxHash)
+	if !ok {
+		return nil, fmt.Errorf("expected a channel of type `chan common.IDAndTxHash`, got %T", ch)
+	}
+	clientChannel := make(chan common.IDAndEncTxHash)
+	subscription, err := c.obscuroClient.Subscribe(ctx, nil, namespace, clientChannel, SubscriptionTypePendingTransactions, encryptedParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// As for log subscriptions, the subscription ID is the first message on the newly-created subscription.
+	select {
+	case idAndEncTxHash := <-clientChannel:
+		err = c.setResultToSubID(idAndEncTxHash.SubID, idAndEncTxHash.EncTxHash, result)
+	case <-subscription.Err():
+		err = errNoSubscriptionID
+	}
+	if err != nil {
+		subscription.Unsubscribe()
+		return nil, err
+	}
+
+	go c.forwardTxHashes(clientChannel, txHashCh, subscription)
+
+	return subscription, nil
+}
+
 func (c *EncRPCClient) forwardLogs(clientChannel chan common.IDAndEncLog, logCh chan common.IDAndLog, subscription *rpc.ClientSubscription) {
 	for {
 		select {
@@ -208,6 +300,8 @@ func (c *EncRPCClient) forwardLogs(clientChannel chan common.IDAndEncLog, logCh
 		case err := <-subscription.Err():
 			if err != nil {
 				c.logger.Error("subscription closed", log.ErrKey, err)
+				// The error is only delivered to one receiver, so we unsubscribe to close the error channel for any others.
+				subscription.Unsubscribe()
 			} else {
 				c.logger.Trace("subscription closed")
 			}
@@ -216,11 +310,48 @@ func (c *EncRPCClient) forwardLogs(clientChannel chan common.IDAndEncLog, logCh
 	}
 }
 
-func (c *EncRPCClient) createAuthenticatedLogSubscription(args []interface{}) (*common.LogSubscription, error) {
+func (c *EncRPCClient) forwardTxHashes(clientChannel chan common.IDAndEncTxHash, txHashCh chan common.IDAndTxHash, subscription *rpc.ClientSubscription) {
+	for {
+		select {
+		case idAndEncTxHash := <-clientChannel:
+			txHash, err := c.decryptResponse(idAndEncTxHash.EncTxHash)
+			if err != nil {
+				c.logger.Error("could not decrypt transaction hash received from subscription.", log.ErrKey, err)
+				continue
+			}
+
+			txHashCh <- common.IDAndTxHash{
+				SubID:  idAndEncTxHash.SubID,
+				TxHash: gethcommon.HexToHash(string(txHash)),
+			}
+
+		case err := <-subscription.Err():
+			if err != nil {
+				c.logger.Error("subscription closed", log.ErrKey, err)
+				// The error is only delivered to one receiver, so we unsubscribe to close the error channel for any others.
+				subscription.Unsubscribe()
+			} else {
+				c.logger.Trace("subscription closed")
+			}
+			return
+		}
+	}
+}
+
+// Signs the account address with the viewing key, to authenticate a subscription for the account.
+func (c *EncRPCClient) signAccount() ([]byte, error) {
 	accountSignature, err := crypto.Sign(c.Account().Hash().Bytes(), c.viewingKey.PrivateKey.ExportECDSA())
 	if err != nil {
 		return nil, fmt.Errorf("could not sign account address to authenticate subscription. Cause: %w", err)
 	}
+	return accountSignature, nil
+}
+
+func (c *EncRPCClient) createAuthenticatedLogSubscription(args []interface{}) (*common.LogSubscription, error) {
+	accountSignature, err := c.signAccount()
+	if err != nil {
+		return nil, err
+	}
 
 	logSubscription := &common.LogSubscription{
 		Account:   c.Account(),
@@ -255,24 +386,28 @@ func (c *EncRPCClient) createAuthenticatedLogSubscription(args []interface{}) (*
 		filterCriteria.BlockHash = &gethcommon.Hash{}
 	}
 
+	// A specific `fromBlock` requests a replay of the historical logs from that batch. The block range is otherwise
+	// ignored for subscriptions, and we clear it, since RLP cannot encode the negative numbers used for block tags.
+	if filterCriteria.FromBlock != nil && filterCriteria.FromBlock.Sign() >= 0 {
+		logSubscription.ReplayFromBatch = filterCriteria.FromBlock
+	}
+	filterCriteria.FromBlock = nil
+	filterCriteria.ToBlock = nil
+
 	logSubscription.Filter = &filterCriteria
 	return logSubscription, nil
 }
 
-func (c *EncRPCClient) setResultToSubID(clientChannel chan common.IDAndEncLog, result interface{}, subscription *rpc.ClientSubscription) error {
-	select {
-	case idAndEncLog := <-clientChannel:
-		if idAndEncLog.SubID == "" || idAndEncLog.EncLog != nil {
-			return fmt.Errorf("expected an initial subscription response with the subscription ID only")
-		}
-		if result != nil {
-			err := c.setResult([]byte(idAndEncLog.SubID), result)
-			if err != nil {
-				return fmt.Errorf("failed to extract result from subscription response: %w", err)
-			}
+// Sets the result to the subscription ID, given the initial subscription response (which carries no payload).
+func (c *EncRPCClient) setResultToSubID(subID rpc.ID, payload []byte, result interface{}) error {
+	if subID == "" || payload != nil {
+		return fmt.Errorf("expected an initial subscription response with the subscription ID only")
+	}
+	if result != nil {
+		err := c.setResult([]byte(subID), result)
+		if err != nil {
+			return fmt.Errorf("failed to extract result from subscription response: %w", err)
 		}
-	case <-subscription.Err():
-		return fmt.Errorf("did not receive the initial subscription response with the subscription ID")
 	}
 	return nil
 }
@@ -21,12 +21,12 @@ type networkClient struct {
 }
 
 // NewEncNetworkClient returns a network RPC client with Viewing Key encryption/decryption
-func NewEncNetworkClient(rpcAddress string, viewingKey *ViewingKey, logger gethlog.Logger) (*EncRPCClient, error) {
+func NewEncNetworkClient(rpcAddress string, viewingKey *ViewingKey, logger gethlog.Logger, opts ...EncRPCClientOption) (*EncRPCClient, error) {
 	rpcClient, err := NewNetworkClient(rpcAddress)
 	if err != nil {
 		return nil, err
 	}
-	encClient, err := NewEncRPCClient(rpcClient, viewingKey, logger)
+	encClient, err := NewEncRPCClient(rpcClient, viewingKey, logger, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +53,14 @@ func TestCanDeployLayer2ERC20Contract(t *testing.T) {
 	time.Sleep(2 * time.Second)
 
 	config := &contractdeployer.Config{
-		NodeHost:          network.Localhost,
-		NodePort:          uint(hostWSPort),
-		IsL1Deployment:    false,
-		PrivateKey:        contractDeployerPrivateKeyHex,
-		ChainID:           big.NewInt(integration.ObscuroChainID),
-		ContractName:      contractdeployer.Layer2Erc20Contract,
-		ConstructorParams: []string{erc20ParamOne, erc20ParamTwo, erc20ParamThree},
+		NodeHost:                network.Localhost,
+		NodePort:                uint(hostWSPort),
+		IsL1Deployment:          false,
+		PrivateKey:              contractDeployerPrivateKeyHex,
+		ChainID:                 big.NewInt(integration.ObscuroChainID),
+		ContractName:            contractdeployer.Layer2Erc20Contract,
+		ConstructorParams:       []string{erc20ParamOne, erc20ParamTwo, erc20ParamThree},
+		InsecureSkipAttestation: true, // the enclaves of the test network do not run in SGX
 	}
 
 	contractAddr, err := contractdeployer.Deploy(config, testlog.Logger())
@@ -103,13 +104,14 @@ func TestFaucetSendsFundsOnlyIfNeeded(t *testing.T) {
 	}
 
 	config := &contractdeployer.Config{
-		NodeHost:          network.Localhost,
-		NodePort:          uint(startPort + integration.DefaultHostRPCWSOffset),
-		IsL1Deployment:    false,
-		PrivateKey:        contractDeployerPrivateKeyHex,
-		ChainID:           big.NewInt(integration.ObscuroChainID),
-		ContractName:      contractdeployer.Layer2Erc20Contract,
-		ConstructorParams: []string{erc20ParamOne, erc20ParamTwo, erc20ParamThree},
+		NodeHost:                network.Localhost,
+		NodePort:                uint(startPort + integration.DefaultHostRPCWSOffset),
+		IsL1Deployment:          false,
+		PrivateKey:              contractDeployerPrivateKeyHex,
+		ChainID:                 big.NewInt(integration.ObscuroChainID),
+		ContractName:            contractdeployer.Layer2Erc20Contract,
+		ConstructorParams:       []string{erc20ParamOne, erc20ParamTwo, erc20ParamThree},
+		InsecureSkipAttestation: true, // the enclaves of the test network do not run in SGX
 	}
 
 	_, err = contractdeployer.Deploy(config, testlog.Logger())
@@ -167,7 +169,7 @@ func getClient(hostWSPort int, wallet wallet.Wallet) *rpc.EncRPCClient {
 	if err != nil {
 		panic(err)
 	}
-	client, err := rpc.NewEncNetworkClient(fmt.Sprintf("ws://%s:%d", network.Localhost, hostWSPort), viewingKey, testlog.Logger())
+	client, err := rpc.NewEncNetworkClient(fmt.Sprintf("ws://%s:%d", network.Localhost, hostWSPort), viewingKey, testlog.Logger(), rpc.WithInsecureSkipAttestation(true))
 	if err != nil {
 		panic(err)
 	}
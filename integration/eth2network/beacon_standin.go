@@ -0,0 +1,487 @@
+package eth2network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common/beacon"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	lightClientPath  = "/eth/v1/beacon/light_client/"
+	maxServedUpdates = 128 // the maximum number of sync committee updates served per request, as in the beacon node API
+
+	// the indices of the fields of the beacon state and block body the light client proofs are for
+	finalizedCheckpointIndex  = 20
+	currentSyncCommitteeIndex = 22
+	nextSyncCommitteeIndex    = 23
+	executionPayloadIndex     = 9
+)
+
+// the root of an empty list of withdrawals, which has room for 16 withdrawals
+var emptyWithdrawalsRoot = func() gethcommon.Hash {
+	var root gethcommon.Hash
+	for depth := 0; depth < 4; depth++ {
+		root = sha256.Sum256(append(root[:], root[:]...))
+	}
+	var length gethcommon.Hash
+	return sha256.Sum256(append(root[:], length[:]...))
+}()
+
+// ExecutionChain is the L1 chain the stand-in beacon finalizes.
+type ExecutionChain interface {
+	BlockNumber() (uint64, error)
+	BlockByNumber(n *big.Int) (*types.Block, error)
+}
+
+// StandInBeaconConfig configures the beacon chain the stand-in beacon makes up.
+type StandInBeaconConfig struct {
+	Port              int    // the port to serve the beacon node API on
+	FinalityDepth     uint64 // the number of blocks the finalized block is behind the head (defaults to 1)
+	SyncCommitteeSize int    // the number of members of each sync committee, a multiple of 8 (defaults to 32)
+	SlotsPerBlock     uint64 // the number of slots between L1 blocks, which can be raised to shorten sync committee periods (defaults to 1)
+	// the forks of the beacon chain, whose versions the sync committees sign with (defaults to every fork up to Capella
+	// activating at genesis)
+	Forks beacon.ForkSchedule
+}
+
+var defaultStandInForks = beacon.ForkSchedule{
+	{Name: beacon.Phase0, Version: beacon.ForkVersion{0x00, 0x00, 0x5e, 0x00}},
+	{Name: beacon.Altair, Version: beacon.ForkVersion{0x01, 0x00, 0x5e, 0x00}},
+	{Name: beacon.Bellatrix, Version: beacon.ForkVersion{0x02, 0x00, 0x5e, 0x00}},
+	{Name: beacon.Capella, Version: beacon.ForkVersion{0x03, 0x00, 0x5e, 0x00}},
+}
+
+// StandInBeacon serves the light client endpoints of the beacon node API for an L1 chain, making up a beacon chain
+// whose blocks include the L1 blocks one-to-one, signed by sync committees whose keys it generates. It lets the
+// enclave's validation of L1 blocks against the beacon chain be tested against L1 networks that are not run by a
+// beacon chain, or whose beacon nodes do not serve light client data (such as the Prysm version run by this package).
+//
+// The made-up beacon chain follows its own fork schedule, and serves the light client data of each fork, with the
+// execution payloads of the L1 blocks. The L1 blocks must be shaped like post-merge blocks (with no difficulty, nonce or
+// uncles, and at most 32 bytes of extra data) to be served as payloads. Since the L1 block type has no withdrawals or
+// blob gas fields, the payloads have no withdrawals and use no blob gas.
+type StandInBeacon struct {
+	chain  ExecutionChain
+	config StandInBeaconConfig
+	beacon beacon.Config
+	server *http.Server
+
+	lock       sync.Mutex
+	blocks     map[uint64]*standInBlock         // by L1 height
+	heights    map[gethcommon.Hash]uint64       // L1 heights by beacon block root
+	committees map[uint64]*standInSyncCommittee // by sync committee period
+}
+
+// standInBlock is a made-up beacon block, along with the proofs the light client endpoints serve.
+type standInBlock struct {
+	header                     beacon.LightClientHeader
+	root                       gethcommon.Hash
+	finalityBranch             []gethcommon.Hash
+	currentSyncCommitteeBranch []gethcommon.Hash
+	nextSyncCommitteeBranch    []gethcommon.Hash
+}
+
+type standInSyncCommittee struct {
+	committee *beacon.SyncCommittee
+	signer    *beacon.SecretKey // the aggregate of the members' keys
+}
+
+func NewStandInBeacon(chain ExecutionChain, config StandInBeaconConfig) *StandInBeacon {
+	if config.FinalityDepth == 0 {
+		config.FinalityDepth = 1
+	}
+	if config.SyncCommitteeSize == 0 {
+		config.SyncCommitteeSize = 32
+	}
+	if config.SlotsPerBlock == 0 {
+		config.SlotsPerBlock = 1
+	}
+	if config.Forks == nil {
+		config.Forks = defaultStandInForks
+	}
+	return &StandInBeacon{
+		chain:  chain,
+		config: config,
+		beacon: beacon.Config{
+			GenesisValidatorsRoot: sha256.Sum256([]byte("stand-in beacon")),
+			Forks:                 config.Forks,
+		},
+		blocks:     map[uint64]*standInBlock{},
+		heights:    map[gethcommon.Hash]uint64{},
+		committees: map[uint64]*standInSyncCommittee{},
+	}
+}
+
+// Config returns the identity of the beacon chain, which the enclave must be configured with.
+func (b *StandInBeacon) Config() beacon.Config {
+	return b.beacon
+}
+
+// BlockRoot returns the root of the beacon block that includes the L1 block at the given height, which the enclave can
+// be configured to trust.
+func (b *StandInBeacon) BlockRoot(height uint64) (gethcommon.Hash, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	block, err := b.block(height)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return block.root, nil
+}
+
+// Start serves the beacon node API on the configured port.
+func (b *StandInBeacon) Start() error {
+	b.server = &http.Server{Addr: fmt.Sprintf(":%d", b.config.Port), Handler: b, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := b.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	return nil
+}
+
+func (b *StandInBeacon) Stop() error {
+	if b.server == nil {
+		return nil
+	}
+	return b.server.Shutdown(context.Background())
+}
+
+// ServeHTTP serves the light client endpoints of the beacon node API.
+func (b *StandInBeacon) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	var data interface{}
+	var err error
+	switch route := strings.TrimPrefix(req.URL.Path, lightClientPath); {
+	case strings.HasPrefix(route, "bootstrap/"):
+		data, err = b.bootstrap(gethcommon.HexToHash(strings.TrimPrefix(route, "bootstrap/")))
+	case route == "updates":
+		data, err = b.updates(req.URL.Query().Get("start_period"), req.URL.Query().Get("count"))
+	case route == "finality_update":
+		data, err = b.finalityUpdate()
+	default:
+		http.NotFound(resp, req)
+		return
+	}
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(resp).Encode(data); err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+type versionedResponse struct {
+	Version string      `json:"version"`
+	Data    interface{} `json:"data"`
+}
+
+func (b *StandInBeacon) bootstrap(root gethcommon.Hash) (*versionedResponse, error) {
+	height, found := b.heights[root]
+	if !found {
+		return nil, fmt.Errorf("no block with root %s", root)
+	}
+	block := b.blocks[height]
+	committee, err := b.syncCommittee(b.period(height))
+	if err != nil {
+		return nil, err
+	}
+	return &versionedResponse{Version: b.forkName(block), Data: &beacon.LightClientBootstrap{
+		Header:                     block.header,
+		CurrentSyncCommittee:       *committee.committee,
+		CurrentSyncCommitteeBranch: block.currentSyncCommitteeBranch,
+	}}, nil
+}
+
+// Serves the update of each requested period, attested by the last block of the period that is at or below the head.
+func (b *StandInBeacon) updates(startPeriodParam, countParam string) ([]*versionedResponse, error) {
+	startPeriod, err := strconv.ParseUint(startPeriodParam, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start period. Cause: %w", err)
+	}
+	count, err := strconv.ParseUint(countParam, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid count. Cause: %w", err)
+	}
+	if count > maxServedUpdates {
+		count = maxServedUpdates
+	}
+	head, err := b.chain.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []*versionedResponse
+	for period := startPeriod; period < startPeriod+count; period++ {
+		attested := b.lastHeightBefore(period + 1)
+		if attested > head {
+			attested = head
+		}
+		if attested < b.config.FinalityDepth || b.period(attested) != period {
+			break
+		}
+		update, err := b.update(attested, true)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, &versionedResponse{Version: b.forkName(b.blocks[attested]), Data: update})
+	}
+	return updates, nil
+}
+
+func (b *StandInBeacon) finalityUpdate() (*versionedResponse, error) {
+	head, err := b.chain.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	if head < b.config.FinalityDepth {
+		return nil, errors.New("no block is finalized yet")
+	}
+	update, err := b.update(head, false)
+	if err != nil {
+		return nil, err
+	}
+	return &versionedResponse{Version: b.forkName(b.blocks[head]), Data: update}, nil
+}
+
+// Returns the update attested by the block at the given height, signed by the sync committee in the following slot.
+func (b *StandInBeacon) update(attestedHeight uint64, withNextSyncCommittee bool) (*beacon.LightClientUpdate, error) {
+	attested, err := b.block(attestedHeight)
+	if err != nil {
+		return nil, err
+	}
+	finalized, err := b.block(attestedHeight - b.config.FinalityDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureSlot := uint64(attested.header.Beacon.Slot) + 1
+	committee, err := b.syncCommittee(beacon.SyncCommitteePeriod(signatureSlot))
+	if err != nil {
+		return nil, err
+	}
+	signingRoot := b.beacon.SigningRoot(&attested.header.Beacon, signatureSlot)
+	signature, err := committee.signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, fmt.Errorf("could not sign block. Cause: %w", err)
+	}
+	bits := make([]byte, b.config.SyncCommitteeSize/8)
+	for i := range bits {
+		bits[i] = 0xff // all the members sign
+	}
+
+	update := &beacon.LightClientUpdate{
+		AttestedHeader:  attested.header,
+		FinalizedHeader: &finalized.header,
+		FinalityBranch:  attested.finalityBranch,
+		SyncAggregate:   beacon.SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: signature},
+		SignatureSlot:   beacon.QuotedUint64(signatureSlot),
+	}
+	if withNextSyncCommittee {
+		next, err := b.syncCommittee(b.period(attestedHeight) + 1)
+		if err != nil {
+			return nil, err
+		}
+		update.NextSyncCommittee = next.committee
+		update.NextSyncCommitteeBranch = attested.nextSyncCommitteeBranch
+	}
+	return update, nil
+}
+
+// Returns the made-up beacon block that includes the L1 block at the given height. The state of each block finalizes
+// the block FinalityDepth blocks behind it.
+func (b *StandInBeacon) block(height uint64) (*standInBlock, error) {
+	if block, found := b.blocks[height]; found {
+		return block, nil
+	}
+	l1Block, err := b.chain.BlockByNumber(new(big.Int).SetUint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch L1 block at height %d. Cause: %w", height, err)
+	}
+	fork := b.beacon.Forks.AtSlot(b.slot(height))
+	execution, err := executionPayloadHeader(l1Block, fork)
+	if err != nil {
+		return nil, err
+	}
+	// the body has 11 fields in the Capella fork, and one more in each of the Deneb and Electra forks
+	bodyFields := make([]gethcommon.Hash, 13)
+	var executionBranch []gethcommon.Hash
+	if execution != nil {
+		if bodyFields[executionPayloadIndex], err = execution.HashTreeRoot(fork); err != nil {
+			return nil, err
+		}
+	}
+	bodyRoot, branch := beacon.MerkleProof(bodyFields, executionPayloadIndex)
+	if execution != nil {
+		executionBranch = branch // light client headers before the Capella fork do not prove the payload
+	}
+
+	// the state has 28 fields in the Capella fork, and more than 32 from the Electra fork onwards
+	stateFields := make([]gethcommon.Hash, 28)
+	if fork.AtLeast(beacon.Electra) {
+		stateFields = make([]gethcommon.Hash, 37)
+	}
+	finalizedCheckpoint := beacon.Checkpoint{}
+	if height >= b.config.FinalityDepth {
+		finalized, err := b.block(height - b.config.FinalityDepth)
+		if err != nil {
+			return nil, err
+		}
+		finalizedCheckpoint.Epoch = finalized.header.Beacon.Slot / beacon.SlotsPerEpoch
+		finalizedCheckpoint.Root = finalized.root
+	}
+	stateFields[finalizedCheckpointIndex] = finalizedCheckpoint.HashTreeRoot()
+	for i, period := range map[int]uint64{currentSyncCommitteeIndex: b.period(height), nextSyncCommitteeIndex: b.period(height) + 1} {
+		committee, err := b.syncCommittee(period)
+		if err != nil {
+			return nil, err
+		}
+		if stateFields[i], err = committee.committee.HashTreeRoot(); err != nil {
+			return nil, err
+		}
+	}
+	stateRoot, checkpointBranch := beacon.MerkleProof(stateFields, finalizedCheckpointIndex)
+	_, currentSyncCommitteeBranch := beacon.MerkleProof(stateFields, currentSyncCommitteeIndex)
+	_, nextSyncCommitteeBranch := beacon.MerkleProof(stateFields, nextSyncCommitteeIndex)
+
+	var epochChunk gethcommon.Hash // the finalized root is the second field of the checkpoint, after the epoch
+	binary.LittleEndian.PutUint64(epochChunk[:], uint64(finalizedCheckpoint.Epoch))
+
+	block := &standInBlock{
+		header: beacon.LightClientHeader{
+			Beacon: beacon.BeaconBlockHeader{
+				Slot:       beacon.QuotedUint64(b.slot(height)),
+				ParentRoot: sha256.Sum256(l1Block.ParentHash().Bytes()), // not checked by light clients
+				StateRoot:  stateRoot,
+				BodyRoot:   bodyRoot,
+			},
+			Execution:       execution,
+			ExecutionBranch: executionBranch,
+		},
+		finalityBranch:             append([]gethcommon.Hash{epochChunk}, checkpointBranch...),
+		currentSyncCommitteeBranch: currentSyncCommitteeBranch,
+		nextSyncCommitteeBranch:    nextSyncCommitteeBranch,
+	}
+	block.root = block.header.Beacon.HashTreeRoot()
+	b.blocks[height] = block
+	b.heights[block.root] = height
+	return block, nil
+}
+
+// Returns the sync committee of the period, whose members' keys are derived from the period.
+func (b *StandInBeacon) syncCommittee(period uint64) (*standInSyncCommittee, error) {
+	if committee, found := b.committees[period]; found {
+		return committee, nil
+	}
+	keys := make([]*beacon.SecretKey, b.config.SyncCommitteeSize)
+	pubkeys := make([][]byte, len(keys))
+	committee := &beacon.SyncCommittee{}
+	for i := range keys {
+		seed := sha256.Sum256([]byte(fmt.Sprintf("stand-in sync committee member %d of period %d", i, period)))
+		key, err := beacon.NewSecretKey(seed[:])
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+		pubkeys[i] = key.PublicKey()
+		committee.Pubkeys = append(committee.Pubkeys, pubkeys[i])
+	}
+	aggregatePubkey, err := beacon.AggregatePublicKeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	committee.AggregatePubkey = aggregatePubkey
+
+	b.committees[period] = &standInSyncCommittee{committee: committee, signer: beacon.AggregateSecretKeys(keys)}
+	return b.committees[period], nil
+}
+
+func (b *StandInBeacon) slot(height uint64) uint64 {
+	return height * b.config.SlotsPerBlock
+}
+
+func (b *StandInBeacon) period(height uint64) uint64 {
+	return beacon.SyncCommitteePeriod(b.slot(height))
+}
+
+// Returns the height of the last L1 block that is signed before the given sync committee period starts.
+func (b *StandInBeacon) lastHeightBefore(period uint64) uint64 {
+	firstSlot := period * beacon.SlotsPerEpoch * beacon.EpochsPerSyncCommitteePeriod
+	// the block is signed in the slot after its own
+	return (firstSlot - 2) / b.config.SlotsPerBlock
+}
+
+func (b *StandInBeacon) forkName(block *standInBlock) string {
+	return b.beacon.Forks.AtSlot(uint64(block.header.Beacon.Slot)).Name
+}
+
+// Returns the execution payload header of the L1 block, as the light client headers of the given fork include it, or
+// nil if they do not include it.
+func executionPayloadHeader(block *types.Block, fork beacon.Fork) (*beacon.ExecutionPayloadHeader, error) {
+	if !fork.AtLeast(beacon.Capella) {
+		return nil, nil //nolint:nilnil
+	}
+	header := block.Header()
+	if header.Difficulty.Sign() != 0 || header.Nonce != (types.BlockNonce{}) || header.UncleHash != types.EmptyUncleHash || len(header.Extra) > 32 {
+		return nil, fmt.Errorf("L1 block at height %d is not shaped like a post-merge block", header.Number)
+	}
+
+	transactions := make([][]byte, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		encoded, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = encoded
+	}
+	transactionsRoot, err := beacon.TransactionsRoot(transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFee *beacon.QuotedUint256
+	if header.BaseFee != nil {
+		baseFee = (*beacon.QuotedUint256)(new(big.Int).Set(header.BaseFee))
+	}
+	execution := &beacon.ExecutionPayloadHeader{
+		ParentHash:       header.ParentHash,
+		FeeRecipient:     header.Coinbase,
+		StateRoot:        header.Root,
+		ReceiptsRoot:     header.ReceiptHash,
+		LogsBloom:        header.Bloom.Bytes(),
+		PrevRandao:       header.MixDigest,
+		BlockNumber:      beacon.QuotedUint64(header.Number.Uint64()),
+		GasLimit:         beacon.QuotedUint64(header.GasLimit),
+		GasUsed:          beacon.QuotedUint64(header.GasUsed),
+		Timestamp:        beacon.QuotedUint64(header.Time),
+		ExtraData:        header.Extra,
+		BaseFeePerGas:    baseFee,
+		BlockHash:        block.Hash(),
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  emptyWithdrawalsRoot,
+	}
+	if fork.AtLeast(beacon.Deneb) {
+		var noBlobGas beacon.QuotedUint64
+		execution.BlobGasUsed, execution.ExcessBlobGas = &noBlobGas, &noBlobGas
+	}
+	return execution, nil
+}
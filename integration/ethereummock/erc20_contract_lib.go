@@ -18,7 +18,11 @@ func (c *contractLib) CreateDepositTx(tx *ethadapter.L1DepositTx, nonce uint64)
 // Return only deposit transactions to the management contract
 func (c *contractLib) DecodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 	if bytes.Equal(tx.To().Bytes(), depositTxAddr.Bytes()) {
-		depositTx, ok := decodeTx(tx).(*ethadapter.L1DepositTx)
+		t, err := decodeTx(tx)
+		if err != nil {
+			return nil
+		}
+		depositTx, ok := t.(*ethadapter.L1DepositTx)
 		if !ok {
 			return nil
 		}
@@ -3,6 +3,7 @@ package ethereummock
 import (
 	"bytes"
 	"encoding/gob"
+	"fmt"
 
 	"github.com/obscuronet/go-obscuro/integration/datagenerator"
 
@@ -22,6 +23,9 @@ var (
 	storeSecretTxAddr      = datagenerator.RandomAddress()
 	requestSecretTxAddr    = datagenerator.RandomAddress()
 	initializeSecretTxAddr = datagenerator.RandomAddress()
+	hostAddressesCallAddr  = datagenerator.RandomAddress()
+	forkCallAddr           = datagenerator.RandomAddress()
+	withdrawalCallAddr     = datagenerator.RandomAddress()
 )
 
 // mockContractLib is an implementation of the mgmtcontractlib.MgmtContractLib
@@ -37,16 +41,22 @@ func (m *mockContractLib) GetContractAddr() *gethcommon.Address {
 	return &rollupTxAddr
 }
 
-func (m *mockContractLib) DecodeTx(tx *types.Transaction) ethadapter.L1Transaction {
+func (m *mockContractLib) DecodeTx(tx *types.Transaction) (ethadapter.L1Transaction, error) {
 	// Do not decode erc20 transactions, this is the responsibility
 	// of the erc20 contract lib.
 	if tx.To().Hex() == depositTxAddr.Hex() {
-		return nil
+		return nil, nil //nolint:nilnil
 	}
 
 	return decodeTx(tx)
 }
 
+// DecodeReceipt returns no transactions, as the mock L1 does not produce any logs. The transactions are decoded from
+// the calldata instead.
+func (m *mockContractLib) DecodeReceipt(*types.Receipt) ([]ethadapter.L1Transaction, error) {
+	return nil, nil
+}
+
 func (m *mockContractLib) CreateRollup(tx *ethadapter.L1RollupTx, nonce uint64) types.TxData {
 	return encodeTx(tx, nonce, rollupTxAddr)
 }
@@ -64,16 +74,45 @@ func (m *mockContractLib) CreateInitializeSecret(tx *ethadapter.L1InitializeSecr
 }
 
 func (m *mockContractLib) GetHostAddresses() (ethereum.CallMsg, error) {
-	return ethereum.CallMsg{}, nil
+	return ethereum.CallMsg{To: &hostAddressesCallAddr}, nil
+}
+
+func (m *mockContractLib) HasSecondCousinFork() (ethereum.CallMsg, error) {
+	return ethereum.CallMsg{To: &forkCallAddr}, nil
 }
 
-func (m *mockContractLib) DecodeCallResponse([]byte) ([][]string, error) {
-	return [][]string{{""}}, nil
+func (m *mockContractLib) IsWithdrawalAvailable() (ethereum.CallMsg, error) {
+	return ethereum.CallMsg{To: &withdrawalCallAddr}, nil
 }
 
-func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
+func (m *mockContractLib) DecodeBoolCallResponse(callResponse []byte) (bool, error) {
+	var value bool
+	if err := gob.NewDecoder(bytes.NewBuffer(callResponse)).Decode(&value); err != nil {
+		return false, fmt.Errorf("could not decode bool. Cause: %w", err)
+	}
+	return value, nil
+}
+
+func (m *mockContractLib) DecodeCallResponse(callResponse []byte) ([][]string, error) {
+	var hostAddresses []string
+	if err := gob.NewDecoder(bytes.NewBuffer(callResponse)).Decode(&hostAddresses); err != nil {
+		return nil, fmt.Errorf("could not decode host addresses. Cause: %w", err)
+	}
+	return [][]string{hostAddresses}, nil
+}
+
+// encodeHostAddresses is the mock equivalent of the management contract's host addresses getter response
+func encodeHostAddresses(hostAddresses []string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(hostAddresses); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTx(tx *types.Transaction) (ethadapter.L1Transaction, error) {
 	if len(tx.Data()) == 0 {
-		panic("Data cannot be 0 in the mock implementation")
+		return nil, fmt.Errorf("data cannot be empty in the mock implementation")
 	}
 
 	// prepare byte buffer
@@ -96,14 +135,14 @@ func decodeTx(tx *types.Transaction) ethadapter.L1Transaction {
 	case initializeSecretTxAddr.Hex():
 		t = &ethadapter.L1InitializeSecretTx{}
 	default:
-		panic("unexpected type")
+		return nil, fmt.Errorf("unexpected transaction type")
 	}
 
 	// decode to interface implementation
 	if err := dec.Decode(t); err != nil {
-		panic(err)
+		return nil, err
 	}
-	return t
+	return t, nil
 }
 
 func encodeTx(tx ethadapter.L1Transaction, nonce uint64, opType gethcommon.Address) types.TxData {
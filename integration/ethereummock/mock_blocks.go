@@ -11,6 +11,16 @@ import (
 var MockGenesisBlock = NewBlock(nil, common.HexToAddress("0x0"), []*types.Transaction{})
 
 func NewBlock(parent *types.Block, nodeID common.Address, txs []*types.Transaction) *types.Block {
+	return newBlock(parent, nodeID, txs, nil)
+}
+
+// NewForkBlock creates a block like NewBlock, but stamps `forkID` into the header's extra data, so that the blocks of a
+// scripted fork never share a hash with the blocks they replace, even when they contain the same transactions
+func NewForkBlock(parent *types.Block, nodeID common.Address, txs []*types.Transaction, forkID uint64) *types.Block {
+	return newBlock(parent, nodeID, txs, big.NewInt(0).SetUint64(forkID).Bytes())
+}
+
+func newBlock(parent *types.Block, nodeID common.Address, txs []*types.Transaction, extra []byte) *types.Block {
 	var parentHash common.Hash
 	var height uint64
 	if parent != nil {
@@ -31,7 +41,7 @@ func NewBlock(parent *types.Block, nodeID common.Address, txs []*types.Transacti
 		GasLimit:    0,
 		GasUsed:     0,
 		Time:        0,
-		Extra:       nil,
+		Extra:       extra,
 		MixDigest:   common.Hash{},
 		Nonce:       types.BlockNonce{},
 		BaseFee:     nil,
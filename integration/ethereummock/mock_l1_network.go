@@ -81,7 +81,10 @@ func printBlock(b *types.Block, m *Node) string {
 	for _, tx := range b.Transactions() {
 		t := m.erc20ContractLib.DecodeTx(tx)
 		if t == nil {
-			t = m.mgmtContractLib.DecodeTx(tx)
+			var err error
+			if t, err = m.mgmtContractLib.DecodeTx(tx); err != nil {
+				testlog.Logger().Crit("failed to decode transaction", log.ErrKey, err)
+			}
 		}
 
 		if t == nil {
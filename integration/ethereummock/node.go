@@ -2,6 +2,7 @@ package ethereummock
 
 import (
 	"bytes"
+	"encoding/gob"
 	"errors"
 	"fmt"
 	"math/big"
@@ -30,8 +31,12 @@ import (
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
 	"github.com/obscuronet/go-obscuro/go/ethadapter/erc20contractlib"
 	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
+	"github.com/obscuronet/go-obscuro/integration"
 )
 
+// the number of blocks behind the head that the mock considers finalized
+const mockFinalityDepth = 8
+
 type L1Network interface {
 	// BroadcastBlock - send the block and the parent to make sure there are no gaps
 	BroadcastBlock(b common.EncodedL1Block, p common.EncodedL1Block)
@@ -68,6 +73,7 @@ type Node struct {
 	exitCh       chan bool // the Node stops
 	exitMiningCh chan bool // the mining loop is notified to stop
 	interrupt    *int32
+	miningPaused *int32 // while set, the node still processes blocks from its peers but does not produce any
 
 	p2pCh       chan *types.Block       // this is where blocks received from peers are dropped
 	miningCh    chan *types.Block       // this is where blocks created by the mining setup of the current node are dropped
@@ -87,6 +93,20 @@ func (m *Node) EstimateGasAndGasPrice(txData types.TxData, from gethcommon.Addre
 	return txData, nil
 }
 
+// EstimateDynamicFeeTx returns the transaction with no fees, as the mock does not charge for gas
+func (m *Node) EstimateDynamicFeeTx(txData types.TxData, _ gethcommon.Address) (*types.DynamicFeeTx, error) {
+	tx := types.NewTx(txData)
+	return &types.DynamicFeeTx{
+		ChainID:   big.NewInt(integration.EthereumChainID),
+		Nonce:     tx.Nonce(),
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(0),
+		To:        tx.To(),
+		Value:     tx.Value(),
+		Data:      tx.Data(),
+	}, nil
+}
+
 func (m *Node) SendTransaction(tx *types.Transaction) error {
 	m.Network.BroadcastTx(tx)
 	return nil
@@ -99,6 +119,18 @@ func (m *Node) TransactionReceipt(_ gethcommon.Hash) (*types.Receipt, error) {
 	}, nil
 }
 
+func (m *Node) BlockReceipts(block *types.Block) (types.Receipts, error) {
+	receipts := make(types.Receipts, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		receipt, err := m.TransactionReceipt(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		receipts[i] = receipt
+	}
+	return receipts, nil
+}
+
 func (m *Node) Nonce(gethcommon.Address) (uint64, error) {
 	return 0, nil
 }
@@ -153,6 +185,54 @@ func (m *Node) BlockByNumber(n *big.Int) (*types.Block, error) {
 	return nil, ethereum.NotFound
 }
 
+// FinalizedHeader returns the header of the block mockFinalityDepth blocks behind the head, since the mock has no
+// beacon chain to finalize blocks.
+func (m *Node) FinalizedHeader() (*types.Header, error) {
+	blk, err := m.Resolver.FetchHeadBlock()
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, ethereum.NotFound
+		}
+		return nil, fmt.Errorf("could not retrieve head block. Cause: %w", err)
+	}
+	for i := 0; i < mockFinalityDepth && blk.ParentHash() != (common.L1RootHash{}); i++ {
+		blk, err = m.Resolver.FetchBlock(blk.ParentHash())
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve parent for block in chain. Cause: %w", err)
+		}
+	}
+	return blk.Header(), nil
+}
+
+func (m *Node) L1HeaderByNumber(n *big.Int) (*common.L1Header, error) {
+	blk, err := m.BlockByNumber(n)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewL1Header(blk.Header()), nil
+}
+
+func (m *Node) BlocksWithReceipts(from *big.Int, count uint64) ([]*types.Block, []types.Receipts, error) {
+	var blocks []*types.Block
+	var receipts []types.Receipts
+	for i := uint64(0); i < count; i++ {
+		blk, err := m.BlockByNumber(new(big.Int).Add(from, new(big.Int).SetUint64(i)))
+		if err != nil {
+			if errors.Is(err, ethereum.NotFound) {
+				break
+			}
+			return nil, nil, err
+		}
+		blkReceipts, err := m.BlockReceipts(blk)
+		if err != nil {
+			return nil, nil, err
+		}
+		blocks = append(blocks, blk)
+		receipts = append(receipts, blkReceipts)
+	}
+	return blocks, receipts, nil
+}
+
 func (m *Node) BlockByHash(id gethcommon.Hash) (*types.Block, error) {
 	blk, err := m.Resolver.FetchBlock(id)
 	if err != nil {
@@ -354,7 +434,7 @@ func (m *Node) startMining() {
 			common.ScheduleInterrupt(m.cfg.PowTime(), interrupt, func() {
 				toInclude := findNotIncludedTxs(canonicalBlock, mempool, m.Resolver, m.db)
 				// todo - iterate through the rollup transactions and include only the ones with the proof on the canonical chain
-				if atomic.LoadInt32(m.interrupt) == 1 {
+				if atomic.LoadInt32(m.interrupt) == 1 || atomic.LoadInt32(m.miningPaused) == 1 {
 					return
 				}
 
@@ -377,6 +457,31 @@ func (m *Node) BroadcastTx(tx types.TxData) {
 	m.Network.BroadcastTx(types.NewTx(tx))
 }
 
+// Head returns the current canonical head of the node
+func (m *Node) Head() *types.Block {
+	m.headInCh <- true
+	return <-m.headOutCh
+}
+
+// PauseMining stops the node from producing blocks until ResumeMining is called, blocks received from peers are still processed
+func (m *Node) PauseMining() {
+	atomic.StoreInt32(m.miningPaused, 1)
+}
+
+// ResumeMining restarts block production on top of the current head
+func (m *Node) ResumeMining() {
+	if atomic.CompareAndSwapInt32(m.miningPaused, 1, 0) && m.mining && atomic.LoadInt32(m.interrupt) == 0 {
+		m.canonicalCh <- m.Head()
+	}
+}
+
+// receiveBlocks processes the blocks in order, as if they had been received from a peer
+func (m *Node) receiveBlocks(blocks []*types.Block) {
+	for _, b := range blocks {
+		m.p2pCh <- b
+	}
+}
+
 func (m *Node) Stop() {
 	// block all requests
 	atomic.StoreInt32(m.interrupt, 1)
@@ -411,8 +516,143 @@ func (m *Node) BlocksBetween(blockA *types.Block, blockB *types.Block) []*types.
 	return result
 }
 
-func (m *Node) CallContract(ethereum.CallMsg) ([]byte, error) {
-	return nil, nil
+// CallContract supports the getters of the mock management contract. For the host addresses, it returns the P2P
+// addresses published by the hosts on the canonical chain, in the order they were accepted by the mock management
+// contract. For the fork and withdrawal getters, it replays the management contract's rollup tree.
+func (m *Node) CallContract(msg ethereum.CallMsg) ([]byte, error) {
+	if msg.To == nil {
+		return nil, nil
+	}
+	switch *msg.To {
+	case hostAddressesCallAddr:
+		return m.hostAddresses()
+	case forkCallAddr, withdrawalCallAddr:
+		forkDetected, withdrawalAvailable, err := m.rollupTreeStatus()
+		if err != nil {
+			return nil, err
+		}
+		value := withdrawalAvailable
+		if *msg.To == forkCallAddr {
+			value = forkDetected
+		}
+		var buf bytes.Buffer
+		if err = gob.NewEncoder(&buf).Encode(value); err != nil {
+			return nil, fmt.Errorf("could not encode bool. Cause: %w", err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (m *Node) hostAddresses() ([]byte, error) {
+	var hostAddresses []string
+	attested := map[gethcommon.Address]bool{}
+	for _, b := range m.BlocksBetween(MockGenesisBlock, m.Head()) {
+		for _, tx := range b.Transactions() {
+			if tx.To() == nil || (*tx.To() != initializeSecretTxAddr && *tx.To() != storeSecretTxAddr) {
+				continue
+			}
+			t, err := decodeTx(tx)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode transaction. Cause: %w", err)
+			}
+			switch l1Tx := t.(type) {
+			case *ethadapter.L1InitializeSecretTx:
+				if len(attested) > 0 || l1Tx.AggregatorID == nil {
+					continue
+				}
+				attested[*l1Tx.AggregatorID] = true
+				hostAddresses = append(hostAddresses, l1Tx.HostAddress)
+			case *ethadapter.L1RespondSecretTx:
+				// As in the management contract, responses are only accepted from attested nodes, with their signature.
+				if !attested[l1Tx.AttesterID] || l1Tx.VerifySignature() != nil {
+					continue
+				}
+				attested[l1Tx.RequesterID] = true
+				hostAddresses = append(hostAddresses, l1Tx.HostAddress)
+			}
+		}
+	}
+
+	encoded, err := encodeHostAddresses(hostAddresses)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode host addresses. Cause: %w", err)
+	}
+	return encoded, nil
+}
+
+// rollupTreeStatus replays the rollups published on the canonical chain into a tree, as the management contract does,
+// and returns whether the tree contains a second cousin fork, and whether withdrawals are still available
+func (m *Node) rollupTreeStatus() (bool, bool, error) {
+	parents := map[common.L2RootHash]common.L2RootHash{}
+	children := map[common.L2RootHash][]common.L2RootHash{}
+	var head common.L2RootHash
+	initialized := false
+	withdrawalAvailable := false
+
+	for _, b := range m.BlocksBetween(MockGenesisBlock, m.Head()) {
+		for _, tx := range b.Transactions() {
+			if tx.To() == nil || *tx.To() != rollupTxAddr {
+				continue
+			}
+			t, err := decodeTx(tx)
+			if err != nil {
+				return false, false, fmt.Errorf("could not decode transaction. Cause: %w", err)
+			}
+			rollupTx, ok := t.(*ethadapter.L1RollupTx)
+			if !ok {
+				continue
+			}
+			rollup, err := common.DecodeRollup(rollupTx.Rollup)
+			if err != nil {
+				return false, false, fmt.Errorf("could not decode rollup. Cause: %w", err)
+			}
+			hash := rollup.Header.Hash()
+
+			// the first rollup initializes the tree
+			if !initialized {
+				initialized = true
+				withdrawalAvailable = true
+				head = hash
+				children[hash] = nil
+				continue
+			}
+			// rollups with an unknown parent are rejected
+			if _, found := children[rollup.Header.ParentHash]; !found {
+				continue
+			}
+			parents[hash] = rollup.Header.ParentHash
+			children[rollup.Header.ParentHash] = append(children[rollup.Header.ParentHash], hash)
+			children[hash] = nil
+			if rollup.Header.ParentHash == head {
+				head = hash
+			}
+			if withdrawalAvailable && hasSecondCousinFork(head, parents, children) {
+				withdrawalAvailable = false
+			}
+		}
+	}
+
+	return hasSecondCousinFork(head, parents, children), withdrawalAvailable, nil
+}
+
+// hasSecondCousinFork mirrors the management contract's check: whether a sibling of the head's parent has children
+func hasSecondCousinFork(head common.L2RootHash, parents map[common.L2RootHash]common.L2RootHash, children map[common.L2RootHash][]common.L2RootHash) bool {
+	parent, found := parents[head]
+	if !found {
+		return false
+	}
+	grandparent, found := parents[parent]
+	if !found {
+		return false
+	}
+	for _, child := range children[grandparent] {
+		if child != parent && len(children[child]) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 func (m *Node) EthClient() *ethclient_ethereum.Client {
@@ -442,6 +682,7 @@ func NewMiner(
 		exitCh:           make(chan bool),
 		exitMiningCh:     make(chan bool),
 		interrupt:        new(int32),
+		miningPaused:     new(int32),
 		p2pCh:            make(chan *types.Block),
 		miningCh:         make(chan *types.Block),
 		canonicalCh:      make(chan *types.Block),
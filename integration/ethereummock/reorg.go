@@ -0,0 +1,122 @@
+package ethereummock
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// forkCounter gives every scripted branch a distinct identity (see NewForkBlock)
+var forkCounter uint64
+
+// ForkSpec describes a scripted L1 reorg
+type ForkSpec struct {
+	// Height of the last block shared by the old and the new branch, ignored if ReorgDepth is set
+	Height uint64
+	// ReorgDepth, if set, forks the chain this many blocks below the head at the time of the fork (instead of at Height),
+	// i.e. it is the number of canonical blocks that are orphaned
+	ReorgDepth int
+	// Depth is the number of blocks in the new branch, it defaults to the minimum required to overtake the old branch
+	Depth int
+	// Txs[i] are included in the i-th block of the new branch
+	Txs [][]*types.Transaction
+}
+
+// ForkResult describes the chain after a scripted reorg
+type ForkResult struct {
+	Ancestor  *types.Block   // the last block shared by the old and the new branch
+	Orphaned  []*types.Block // the blocks of the old branch that are no longer canonical, in ascending order
+	NewBranch []*types.Block // the blocks of the new branch, in ascending order
+}
+
+// Fork deterministically reorganises the whole mock L1 network:
+//   - mining is paused on every node and the blocks in flight are given time to be delivered
+//   - a new branch is built on top of the block at the fork height on the chain of the highest head
+//   - the branch is delivered in order to every node, they all switch to it as it is longer than the old branch
+//   - mining resumes on top of the new branch (txs from the orphaned blocks are still in the mempools, so are re-mined)
+func (n *MockEthNetwork) Fork(spec ForkSpec) (*ForkResult, error) {
+	for _, m := range n.AllNodes {
+		m.PauseMining()
+	}
+	defer func() {
+		for _, m := range n.AllNodes {
+			m.ResumeMining()
+		}
+	}()
+
+	headNode, head := n.awaitSettledHead()
+
+	height := spec.Height
+	if spec.ReorgDepth > 0 {
+		if uint64(spec.ReorgDepth) > head.NumberU64() {
+			return nil, fmt.Errorf("cannot orphan %d blocks, the L1 head is at height %d", spec.ReorgDepth, head.NumberU64())
+		}
+		height = head.NumberU64() - uint64(spec.ReorgDepth)
+	}
+	if height > head.NumberU64() {
+		return nil, fmt.Errorf("cannot fork at height %d, the L1 head is at height %d", height, head.NumberU64())
+	}
+	depth := spec.Depth
+	if depth == 0 {
+		depth = int(head.NumberU64()-height) + 1
+	}
+	if height+uint64(depth) <= head.NumberU64() {
+		return nil, fmt.Errorf("a branch of %d blocks from height %d would not overtake the L1 head at height %d", depth, height, head.NumberU64())
+	}
+	if len(spec.Txs) > depth {
+		return nil, fmt.Errorf("txs were provided for %d blocks but the new branch only has %d blocks", len(spec.Txs), depth)
+	}
+
+	oldBranch := headNode.BlocksBetween(MockGenesisBlock, head)
+	ancestor := oldBranch[height]
+	result := &ForkResult{
+		Ancestor:  ancestor,
+		Orphaned:  oldBranch[height+1:],
+		NewBranch: make([]*types.Block, 0, depth),
+	}
+
+	forkID := atomic.AddUint64(&forkCounter, 1)
+	parent := ancestor
+	for i := 0; i < depth; i++ {
+		var txs []*types.Transaction
+		if i < len(spec.Txs) {
+			txs = spec.Txs[i]
+		}
+		parent = NewForkBlock(parent, n.CurrentNode.l2ID, txs, forkID)
+		result.NewBranch = append(result.NewBranch, parent)
+	}
+
+	for _, m := range n.AllNodes {
+		m.receiveBlocks(result.NewBranch)
+	}
+	for _, b := range result.NewBranch {
+		n.Stats.NewBlock(b)
+	}
+
+	for _, m := range n.AllNodes {
+		if nodeHead := m.Head(); nodeHead.Hash() != parent.Hash() {
+			return nil, fmt.Errorf("node %s did not switch to the new branch, head=%s expected=%s", m.l2ID, nodeHead.Hash(), parent.Hash())
+		}
+	}
+	n.CurrentNode.logger.Info(fmt.Sprintf("Scripted L1 fork at height %d, orphaned=%d, new branch=%d", height, len(result.Orphaned), depth))
+	return result, nil
+}
+
+// awaitSettledHead waits for the blocks in flight to be delivered and returns the highest head of the network along
+// with the node that holds it, it must be called once mining is paused. The nodes might not agree on the head (two
+// blocks can be mined at the same height) but a branch that overtakes the highest head becomes canonical for all of them
+func (n *MockEthNetwork) awaitSettledHead() (*Node, *types.Block) {
+	// blocks mined before the pause can take up to twice the average latency to be delivered
+	time.Sleep(2 * n.avgLatency)
+
+	var headNode *Node
+	var head *types.Block
+	for _, m := range n.AllNodes {
+		if nodeHead := m.Head(); head == nil || nodeHead.NumberU64() > head.NumberU64() {
+			headNode, head = m, nodeHead
+		}
+	}
+	return headNode, head
+}
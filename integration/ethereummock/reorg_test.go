@@ -0,0 +1,105 @@
+package ethereummock
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	testcommon "github.com/obscuronet/go-obscuro/integration/common"
+)
+
+const (
+	testAvgBlockDuration = 40 * time.Millisecond
+	testAvgLatency       = 5 * time.Millisecond
+)
+
+func TestForkOrphansHeadBlocksOnAllNodes(t *testing.T) {
+	nodes := startTestNodes(3)
+	defer stopTestNodes(nodes)
+	awaitHeight(t, nodes[0], 5)
+
+	network := nodes[0].Network.(*MockEthNetwork)
+	forkTx := types.NewTx(&types.LegacyTx{Nonce: 1, To: &depositTxAddr})
+	result, err := network.Fork(ForkSpec{ReorgDepth: 2, Txs: [][]*types.Transaction{{forkTx}}})
+	if err != nil {
+		t.Fatalf("fork failed: %s", err)
+	}
+
+	if len(result.Orphaned) != 2 {
+		t.Errorf("expected 2 orphaned blocks, got %d", len(result.Orphaned))
+	}
+	if len(result.NewBranch) != 3 {
+		t.Errorf("expected a new branch of 3 blocks, got %d", len(result.NewBranch))
+	}
+	if result.NewBranch[0].Transactions()[0].Hash() != forkTx.Hash() {
+		t.Errorf("expected the first block of the new branch to contain the scripted tx")
+	}
+
+	tip := result.NewBranch[len(result.NewBranch)-1]
+	for _, m := range nodes {
+		for _, orphan := range result.Orphaned {
+			if m.Resolver.IsAncestor(m.Head(), orphan) {
+				t.Errorf("orphaned block b_%d is still canonical", orphan.NumberU64())
+			}
+		}
+	}
+
+	// mining resumes on top of the new branch
+	awaitHeight(t, nodes[0], tip.NumberU64()+2)
+	if !nodes[0].Resolver.IsAncestor(nodes[0].Head(), tip) {
+		t.Errorf("mining did not resume on top of the new branch")
+	}
+}
+
+func TestForkRejectsBranchThatDoesNotOvertakeHead(t *testing.T) {
+	nodes := startTestNodes(2)
+	defer stopTestNodes(nodes)
+	awaitHeight(t, nodes[0], 3)
+
+	network := nodes[0].Network.(*MockEthNetwork)
+	if _, err := network.Fork(ForkSpec{ReorgDepth: 2, Depth: 2}); err == nil {
+		t.Errorf("expected fork with a branch that does not overtake the head to fail")
+	}
+}
+
+func startTestNodes(numNodes int) []*Node {
+	s := stats.NewStats(numNodes)
+	nodes := make([]*Node, numNodes)
+	for i := 0; i < numNodes; i++ {
+		network := NewMockEthNetwork(testAvgBlockDuration, testAvgLatency, s)
+		cfg := MiningConfig{
+			PowTime: func() time.Duration {
+				return testcommon.RndBtwTime(testAvgBlockDuration/2, testAvgBlockDuration*2)
+			},
+			LogFile: log.SysOut,
+		}
+		nodes[i] = NewMiner(gethcommon.BigToAddress(big.NewInt(int64(i))), cfg, network, s)
+		network.CurrentNode = nodes[i]
+	}
+	for _, m := range nodes {
+		m.Network.(*MockEthNetwork).AllNodes = nodes
+		go m.Start()
+	}
+	return nodes
+}
+
+func stopTestNodes(nodes []*Node) {
+	for _, m := range nodes {
+		go m.Stop()
+	}
+}
+
+func awaitHeight(t *testing.T, m *Node, height uint64) {
+	deadline := time.Now().Add(10 * time.Second)
+	for m.Head().NumberU64() < height {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for L1 height %d", height)
+		}
+		time.Sleep(testAvgBlockDuration)
+	}
+}
@@ -96,7 +96,7 @@ func TestL2IssueTxWaitReceipt(t *testing.T) {
 
 	vk, err := rpc.GenerateAndSignViewingKey(w)
 	assert.Nil(t, err)
-	client, err := rpc.NewEncNetworkClient(fmt.Sprintf("ws://%s:%d", host, port), vk, gethlog.New())
+	client, err := rpc.NewEncNetworkClient(fmt.Sprintf("ws://%s:%d", host, port), vk, gethlog.New(), rpc.WithInsecureSkipAttestation(true))
 	assert.Nil(t, err)
 	authClient := obsclient.NewAuthObsClient(client)
 
@@ -0,0 +1,134 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/obsclient"
+	"github.com/obscuronet/go-obscuro/integration/networktest"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// KeyL1Forks key to the []*networktest.L1ForkResult of the L1 forks forced by the test so far
+var KeyL1Forks = ActionKey("l1Forks")
+
+// maxBatchesChecked bounds how far back VerifyL2RolledBack walks a node's L2 chain
+const maxBatchesChecked = 1000
+
+// ForkL1 forces an L1 reorg that orphans the latest `reorgDepth` canonical L1 blocks, they are replaced by a longer branch
+// of empty blocks (requires a network with a scriptable L1, see networktest.ScriptableL1)
+func ForkL1(reorgDepth int) networktest.Action {
+	return &forkL1Action{fork: &networktest.L1Fork{ReorgDepth: reorgDepth}}
+}
+
+// ForkL1AtHeight forces an L1 reorg where a branch of `depth` blocks is built on the canonical block at `height`,
+// txs[i] are included in the i-th block of the new branch
+func ForkL1AtHeight(height uint64, depth int, txs [][]*types.Transaction) networktest.Action {
+	return &forkL1Action{fork: &networktest.L1Fork{Height: height, Depth: depth, Txs: txs}}
+}
+
+type forkL1Action struct {
+	fork *networktest.L1Fork
+}
+
+func (f *forkL1Action) Run(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+	l1, err := network.GetScriptableL1()
+	if err != nil {
+		return nil, err
+	}
+	result, err := l1.Fork(f.fork)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fork L1 - %w", err)
+	}
+	fmt.Printf("L1 forked at height %d: %d blocks orphaned, new branch of %d blocks\n", result.AncestorHeight, len(result.Orphaned), len(result.NewBranch))
+
+	forks, _ := ctx.Value(KeyL1Forks).([]*networktest.L1ForkResult)
+	return context.WithValue(ctx, KeyL1Forks, append(forks, result)), nil
+}
+
+func (f *forkL1Action) Verify(_ context.Context, _ networktest.NetworkConnector) error {
+	return nil
+}
+
+// VerifyL2RolledBack checks that no node's canonical L2 chain still contains batches built on an L1 block orphaned by
+// the ForkL1 actions of the test, i.e. that the nodes rolled back their L2 heads and rebuilt on the new L1 branch
+func VerifyL2RolledBack() networktest.Action {
+	return VerifyOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) error {
+		forks, ok := ctx.Value(KeyL1Forks).([]*networktest.L1ForkResult)
+		if !ok || len(forks) == 0 {
+			return errors.New("no L1 fork found in context, VerifyL2RolledBack must follow a ForkL1 action")
+		}
+		orphaned := make(map[gethcommon.Hash]bool)
+		lowestAncestor := forks[0].AncestorHeight
+		for _, fork := range forks {
+			for _, h := range fork.Orphaned {
+				orphaned[h] = true
+			}
+			if fork.AncestorHeight < lowestAncestor {
+				lowestAncestor = fork.AncestorHeight
+			}
+		}
+
+		l1Client, err := network.GetL1Client()
+		if err != nil {
+			return err
+		}
+		rpcAddresses := []string{network.SequencerRPCAddress()}
+		for i := 0; i < network.NumValidators(); i++ {
+			rpcAddresses = append(rpcAddresses, network.ValidatorRPCAddress(i))
+		}
+		for _, rpcAddress := range rpcAddresses {
+			err := verifyNoBatchOnOrphanedBlocks(rpcAddress, l1Client, orphaned, lowestAncestor)
+			if err != nil {
+				return fmt.Errorf("node at %s did not roll back - %w", rpcAddress, err)
+			}
+		}
+		return nil
+	})
+}
+
+// verifyNoBatchOnOrphanedBlocks walks the node's L2 chain back from its head until it reaches the batches built on
+// blocks below the fork, failing if any batch was built on an orphaned block or if it does not get there within
+// maxBatchesChecked batches
+func verifyNoBatchOnOrphanedBlocks(rpcAddress string, l1Client ethadapter.EthClient, orphaned map[gethcommon.Hash]bool, forkHeight uint64) error {
+	client, err := obsclient.Dial(rpcAddress)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	headNum, err := client.RollupNumber()
+	if err != nil {
+		return fmt.Errorf("unable to fetch L2 head - %w", err)
+	}
+	batch, err := client.RollupHeaderByNumber(big.NewInt(0).SetUint64(headNum))
+	if err != nil {
+		return fmt.Errorf("unable to fetch batch %d - %w", headNum, err)
+	}
+	for i := 0; batch.Number.Uint64() > 0; i++ {
+		if i == maxBatchesChecked {
+			return fmt.Errorf("gave up after %d batches before reaching fork height %d", maxBatchesChecked, forkHeight)
+		}
+		if orphaned[batch.L1Proof] {
+			return fmt.Errorf("batch %d is built on orphaned L1 block %s", batch.Number, batch.L1Proof)
+		}
+		l1Block, err := l1Client.BlockByHash(batch.L1Proof)
+		if err != nil {
+			return fmt.Errorf("unable to fetch L1 proof of batch %d - %w", batch.Number, err)
+		}
+		if l1Block.NumberU64() <= forkHeight {
+			return nil
+		}
+		parent, err := client.RollupHeaderByHash(batch.ParentHash)
+		if err != nil {
+			return fmt.Errorf("unable to fetch parent of batch %d - %w", batch.Number, err)
+		}
+		batch = parent
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package actions
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -39,6 +41,9 @@ func (s *SendNativeFunds) Run(ctx context.Context, _ networktest.NetworkConnecto
 	}
 	s.user = user
 	s.txHash = txHash
+	if ledger, ok := ctx.Value(KeyTransferLedger).(*TransferLedger); ok {
+		ledger.record(s.FromUser, s.ToUser, s.Amount, *txHash)
+	}
 	return ctx, nil
 }
 
@@ -114,3 +119,89 @@ func (v *VerifyBalanceDiffAfterTest) Verify(ctx context.Context, network network
 	}
 	return nil
 }
+
+// KeyTransferLedger key to the *TransferLedger recording the native transfers between test users
+var KeyTransferLedger = ActionKey("transferLedger")
+
+// TransferLedger records every SendNativeFunds action of a test, so the user balances can be reconciled at the end
+// (it is shared by pointer as parallel actions cannot modify the context)
+type TransferLedger struct {
+	transfers []transfer
+	mu        sync.Mutex
+}
+
+type transfer struct {
+	from   int
+	to     int
+	amount *big.Int
+	txHash common.Hash
+}
+
+func NewTransferLedger() *TransferLedger {
+	return &TransferLedger{}
+}
+
+func (l *TransferLedger) record(from int, to int, amount *big.Int, txHash common.Hash) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.transfers = append(l.transfers, transfer{from: from, to: to, amount: amount, txHash: txHash})
+}
+
+// VerifyUserBalancesMatchTransfers checks that every user's balance equals their balance at the SnapAfterAllocation
+// snapshot, plus the transfers they received, minus the transfers they sent and the gas they paid - so no transfer was
+// lost (or applied twice), whatever happened to the nodes during the test
+func VerifyUserBalancesMatchTransfers() networktest.Action {
+	return VerifyOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) error {
+		ledger, ok := ctx.Value(KeyTransferLedger).(*TransferLedger)
+		if !ok {
+			return errors.New("no transfer ledger found in context, test users must be created with CreateAndFundTestUsers")
+		}
+		numUsers, err := FetchNumberOfTestUsers(ctx)
+		if err != nil {
+			return err
+		}
+		expected := make([]*big.Int, numUsers)
+		for i := 0; i < numUsers; i++ {
+			expected[i], err = FetchBalanceAtSnapshot(ctx, i, SnapAfterAllocation)
+			if err != nil {
+				return err
+			}
+			// copy the snapshot value as it is updated in place below
+			expected[i] = big.NewInt(0).Set(expected[i])
+		}
+
+		ledger.mu.Lock()
+		defer ledger.mu.Unlock()
+		for _, t := range ledger.transfers {
+			sender, err := FetchTestUser(ctx, t.from)
+			if err != nil {
+				return err
+			}
+			receipt, err := sender.AwaitReceipt(ctx, &t.txHash)
+			if err != nil {
+				return fmt.Errorf("transfer %s from user %d was lost - %w", t.txHash, t.from, err)
+			}
+			gasCost := big.NewInt(0).Mul(big.NewInt(0).SetUint64(receipt.GasUsed), userwallet.DefaultGasPrice)
+			expected[t.from].Sub(expected[t.from], gasCost)
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				expected[t.from].Sub(expected[t.from], t.amount)
+				expected[t.to].Add(expected[t.to], t.amount)
+			}
+		}
+
+		for i := 0; i < numUsers; i++ {
+			user, err := FetchTestUser(ctx, i)
+			if err != nil {
+				return err
+			}
+			bal, err := user.NativeBalance(ctx)
+			if err != nil {
+				return err
+			}
+			if bal.Cmp(expected[i]) != 0 {
+				return fmt.Errorf("user %d balance does not match their transfers, expected=%d actual=%d", i, expected[i], bal)
+			}
+		}
+		return nil
+	})
+}
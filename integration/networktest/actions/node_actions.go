@@ -7,6 +7,7 @@ import (
 
 	"github.com/obscuronet/go-obscuro/go/common/retry"
 	"github.com/obscuronet/go-obscuro/integration/networktest"
+	"golang.org/x/sync/errgroup"
 )
 
 func StartValidatorEnclave(validatorIdx int) networktest.Action {
@@ -77,30 +78,144 @@ func StartValidatorHost(validatorIdx int) networktest.Action {
 	})
 }
 
+func StopSequencerHost() networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Println("Sequencer: stopping host")
+		err := network.GetSequencerNode().StopHost()
+		if err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	})
+}
+
+func StartSequencerHost() networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Println("Sequencer: starting host")
+		err := network.GetSequencerNode().StartHost()
+		if err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	})
+}
+
+func StopSequencerEnclave() networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Println("Sequencer: stopping enclave")
+		err := network.GetSequencerNode().StopEnclave()
+		if err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	})
+}
+
+func StartSequencerEnclave() networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Println("Sequencer: starting enclave")
+		err := network.GetSequencerNode().StartEnclave()
+		if err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	})
+}
+
+// WipeValidatorDatabases deletes the host and enclave databases of a stopped validator, so that it has to resync from
+// scratch when it is restarted
+func WipeValidatorDatabases(validatorIdx int) networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Printf("Validator %d: wiping databases\n", validatorIdx)
+		validator := network.GetValidatorNode(validatorIdx)
+		err := validator.WipeDatabases()
+		if err != nil {
+			return nil, err
+		}
+		return ctx, nil
+	})
+}
+
+// StopAllNodes stops the sequencer and all the validators at the same time
+func StopAllNodes() networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Println("Stopping all nodes")
+		return ctx, onAllNodes(network, networktest.NodeOperator.Stop)
+	})
+}
+
+// StartAllNodes starts the sequencer and all the validators at the same time
+func StartAllNodes() networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		fmt.Println("Starting all nodes")
+		return ctx, onAllNodes(network, networktest.NodeOperator.Start)
+	})
+}
+
+func onAllNodes(network networktest.NetworkConnector, op func(networktest.NodeOperator) error) error {
+	nodes := []networktest.NodeOperator{network.GetSequencerNode()}
+	for i := 0; i < network.NumValidators(); i++ {
+		nodes = append(nodes, network.GetValidatorNode(i))
+	}
+	var grp errgroup.Group
+	for _, n := range nodes {
+		node := n
+		grp.Go(func() error {
+			return op(node)
+		})
+	}
+	return grp.Wait()
+}
+
 func WaitForValidatorHealthCheck(validatorIdx int, maxWait time.Duration) networktest.Action {
-	return &waitForValidatorHealthCheckAction{
-		validatorIdx: validatorIdx,
-		maxWait:      maxWait,
+	return &waitForNodeHealthCheckAction{
+		description: fmt.Sprintf("Validator %d", validatorIdx),
+		node: func(network networktest.NetworkConnector) networktest.NodeOperator {
+			return network.GetValidatorNode(validatorIdx)
+		},
+		maxWait: maxWait,
 	}
 }
 
-type waitForValidatorHealthCheckAction struct {
-	validatorIdx int
-	maxWait      time.Duration
+func WaitForSequencerHealthCheck(maxWait time.Duration) networktest.Action {
+	return &waitForNodeHealthCheckAction{
+		description: "Sequencer",
+		node: func(network networktest.NetworkConnector) networktest.NodeOperator {
+			return network.GetSequencerNode()
+		},
+		maxWait: maxWait,
+	}
 }
 
-func (w *waitForValidatorHealthCheckAction) Run(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
-	validator := network.GetValidatorNode(w.validatorIdx)
+// WaitForAllNodesHealthCheck waits for the sequencer and all the validators to be healthy
+func WaitForAllNodesHealthCheck(maxWait time.Duration) networktest.Action {
+	return RunOnlyAction(func(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+		waits := []networktest.Action{WaitForSequencerHealthCheck(maxWait)}
+		for i := 0; i < network.NumValidators(); i++ {
+			waits = append(waits, WaitForValidatorHealthCheck(i, maxWait))
+		}
+		return Parallel(waits...).Run(ctx, network)
+	})
+}
+
+type waitForNodeHealthCheckAction struct {
+	description string
+	node        func(network networktest.NetworkConnector) networktest.NodeOperator
+	maxWait     time.Duration
+}
+
+func (w *waitForNodeHealthCheckAction) Run(ctx context.Context, network networktest.NetworkConnector) (context.Context, error) {
+	node := w.node(network)
 	// poll the health check until success or timeout
 	err := retry.Do(func() error {
-		return networktest.NodeHealthCheck(validator.HostRPCAddress())
-	}, retry.NewTimeoutStrategy(30*time.Second, 1*time.Second))
+		return networktest.NodeHealthCheck(node.HostRPCAddress())
+	}, retry.NewTimeoutStrategy(w.maxWait, 1*time.Second))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%s did not become healthy - %w", w.description, err)
 	}
 	return ctx, nil
 }
 
-func (w *waitForValidatorHealthCheckAction) Verify(_ context.Context, _ networktest.NetworkConnector) error {
+func (w *waitForNodeHealthCheckAction) Verify(_ context.Context, _ networktest.NetworkConnector) error {
 	return nil
 }
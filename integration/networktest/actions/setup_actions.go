@@ -3,6 +3,9 @@ package actions
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common/retry"
 
 	"github.com/obscuronet/go-obscuro/integration"
 	"github.com/obscuronet/go-obscuro/integration/common/testlog"
@@ -31,6 +34,8 @@ func (c *CreateTestUser) Verify(_ context.Context, _ networktest.NetworkConnecto
 	return nil
 }
 
+const _maxFaucetFundsWait = 30 * time.Second
+
 type AllocateFaucetFunds struct {
 	UserID int
 }
@@ -44,7 +49,27 @@ func (a *AllocateFaucetFunds) Run(ctx context.Context, network networktest.Netwo
 	if err != nil {
 		return ctx, err
 	}
-	return ctx, network.AllocateFaucetFunds(ctx, user.Address())
+	balBefore, err := user.NativeBalance(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	err = network.AllocateFaucetFunds(ctx, user.Address())
+	if err != nil {
+		return ctx, err
+	}
+	// the faucet tx is confirmed by the faucet's node, wait for the user's own node to see the funds too so later
+	// balance snapshots are accurate
+	err = retry.Do(func() error {
+		bal, err := user.NativeBalance(ctx)
+		if err != nil {
+			return err
+		}
+		if bal.Cmp(balBefore) == 0 {
+			return fmt.Errorf("faucet funds not yet visible to user %d", a.UserID)
+		}
+		return nil
+	}, retry.NewTimeoutStrategy(_maxFaucetFundsWait, time.Second))
+	return ctx, err
 }
 
 func (a *AllocateFaucetFunds) Verify(_ context.Context, _ networktest.NetworkConnector) error {
@@ -62,6 +87,7 @@ func CreateAndFundTestUsers(numUsers int) *MultiAction {
 	}
 	// set number of users on the context so downstream know how many test users to access if they want all of them
 	newUserActions = append(newUserActions, SetContextValue(KeyNumberOfTestUsers, numUsers))
+	newUserActions = append(newUserActions, SetContextValue(KeyTransferLedger, NewTransferLedger()))
 	newUserActions = append(newUserActions, SnapshotUserBalances(SnapAfterAllocation))
 	return Series(newUserActions...)
 }
@@ -7,10 +7,12 @@ import (
 	"github.com/obscuronet/go-obscuro/integration/simulation/devnetwork"
 )
 
-type devNetworkEnv struct{}
+type devNetworkEnv struct {
+	createNetwork func() *devnetwork.InMemDevNetwork
+}
 
 func (d *devNetworkEnv) Prepare() (networktest.NetworkConnector, func(), error) {
-	devNet := devnetwork.DefaultDevNetwork()
+	devNet := d.createNetwork()
 	devNet.Start()
 
 	err := awaitNodesAvailable(devNet)
@@ -30,5 +32,10 @@ func awaitNodesAvailable(_ networktest.NetworkConnector) error { //nolint:unpara
 }
 
 func LocalDevNetwork() networktest.Environment {
-	return &devNetworkEnv{}
+	return &devNetworkEnv{createNetwork: devnetwork.DefaultDevNetwork}
+}
+
+// LocalDevNetworkWithMockL1 runs the dev network on an in-memory L1 that tests can script (see networktest.ScriptableL1)
+func LocalDevNetworkWithMockL1() networktest.Environment {
+	return &devNetworkEnv{createNetwork: devnetwork.DevNetworkWithMockL1}
 }
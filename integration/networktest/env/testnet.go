@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -78,6 +79,10 @@ func (t *testnetConnector) GetL1Client() (ethadapter.EthClient, error) {
 	return client, nil
 }
 
+func (t *testnetConnector) GetScriptableL1() (networktest.ScriptableL1, error) {
+	return nil, errors.New("the L1 of a testnet cannot be scripted")
+}
+
 func (t *testnetConnector) GetSequencerNode() networktest.NodeOperator {
 	panic("node operators cannot be accessed for testnets")
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // NetworkConnector represents the network being tested against, e.g. testnet, dev-testnet, dev-sim
@@ -21,6 +22,8 @@ type NetworkConnector interface {
 	GetSequencerNode() NodeOperator
 	GetValidatorNode(idx int) NodeOperator
 	GetL1Client() (ethadapter.EthClient, error)
+	// GetScriptableL1 returns an error if the network's L1 cannot be scripted (e.g. a testnet or a geth L1)
+	GetScriptableL1() (ScriptableL1, error)
 }
 
 // Action is any step in a test, they will typically be either minimally small steps in the test or they will be containers
@@ -57,6 +60,29 @@ type NodeOperator interface {
 	StopEnclave() error
 	StartHost() error
 	StopHost() error
+	// WipeDatabases deletes the host and enclave databases (the node must be stopped), it resyncs from the L1 when restarted
+	WipeDatabases() error
 
 	HostRPCAddress() string
 }
+
+// ScriptableL1 gives tests deterministic control over the L1 network, so scenarios like L1 reorgs can be reproduced exactly
+type ScriptableL1 interface {
+	// Fork reorganises the L1 so that the branch described by the fork becomes canonical on every L1 node
+	Fork(fork *L1Fork) (*L1ForkResult, error)
+}
+
+// L1Fork describes a scripted L1 reorg
+type L1Fork struct {
+	Height     uint64                 // height of the last block shared by the old and the new branch, ignored if ReorgDepth is set
+	ReorgDepth int                    // if set, the fork is made this many blocks below the L1 head at the time of the fork
+	Depth      int                    // number of blocks in the new branch, defaults to the minimum required to overtake the old branch
+	Txs        [][]*types.Transaction // Txs[i] are included in the i-th block of the new branch
+}
+
+// L1ForkResult describes the L1 after a scripted reorg
+type L1ForkResult struct {
+	AncestorHeight uint64        // height of the last block shared by the old and the new branch
+	Orphaned       []common.Hash // blocks that are no longer canonical
+	NewBranch      []common.Hash // blocks of the new branch, in ascending order
+}
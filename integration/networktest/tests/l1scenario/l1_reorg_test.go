@@ -0,0 +1,35 @@
+package l1scenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest/actions"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest"
+	"github.com/obscuronet/go-obscuro/integration/networktest/env"
+)
+
+func TestL1Reorg(t *testing.T) {
+	networktest.TestOnlyRunsInIDE(t)
+	networktest.Run(
+		"l1-reorg",
+		t,
+		env.LocalDevNetworkWithMockL1(),
+		actions.Series(
+			actions.CreateAndFundTestUsers(3),
+
+			// build up some L2 state on top of the blocks that will be orphaned
+			actions.GenerateUsersRandomisedTransferActionsInParallel(2, 10*time.Second),
+
+			// orphan the latest L1 blocks on every L1 node
+			actions.ForkL1(3),
+			actions.SleepAction(10*time.Second), // allow time for the nodes to process the new L1 branch
+
+			// the network should keep processing transactions on top of the new branch
+			actions.GenerateUsersRandomisedTransferActionsInParallel(2, 10*time.Second),
+
+			actions.VerifyL2RolledBack(),
+		),
+	)
+}
@@ -0,0 +1,41 @@
+package nodescenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest/actions"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest"
+	"github.com/obscuronet/go-obscuro/integration/networktest/env"
+)
+
+// restart the sequencer and all the validators at the same time
+func TestRestartAllNodes(t *testing.T) {
+	networktest.TestOnlyRunsInIDE(t)
+	networktest.Run(
+		"restart-all-nodes",
+		t,
+		env.LocalDevNetworkWithMockL1(),
+		actions.Series(
+			actions.CreateAndFundTestUsers(4),
+
+			// short load test, build up some state
+			actions.GenerateUsersRandomisedTransferActionsInParallel(4, 10*time.Second),
+			actions.SleepAction(5*time.Second), // allow time for in-flight transactions
+
+			actions.StopAllNodes(),
+			actions.SleepAction(5*time.Second), // allow time for shutdown
+			actions.StartAllNodes(),
+			actions.WaitForAllNodesHealthCheck(60*time.Second),
+			actions.SleepAction(5*time.Second), // allow time for re-sync
+
+			// the restarted enclaves lost the users' viewing keys
+			actions.AuthenticateAllUsers(),
+
+			actions.GenerateUsersRandomisedTransferActionsInParallel(4, 10*time.Second),
+
+			actions.VerifyUserBalancesMatchTransfers(),
+		),
+	)
+}
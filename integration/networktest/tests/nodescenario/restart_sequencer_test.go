@@ -0,0 +1,64 @@
+package nodescenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest/actions"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest"
+	"github.com/obscuronet/go-obscuro/integration/networktest/env"
+)
+
+// restart the sequencer host while the validators keep running
+func TestRestartSequencerHost(t *testing.T) {
+	networktest.TestOnlyRunsInIDE(t)
+	networktest.Run(
+		"restart-sequencer-host",
+		t,
+		env.LocalDevNetworkWithMockL1(),
+		actions.Series(
+			actions.CreateAndFundTestUsers(4),
+
+			// short load test, build up some state
+			actions.GenerateUsersRandomisedTransferActionsInParallel(4, 10*time.Second),
+			actions.SleepAction(5*time.Second), // allow time for in-flight transactions
+
+			actions.StopSequencerHost(),
+			actions.SleepAction(5*time.Second), // allow time for shutdown
+			actions.StartSequencerHost(),
+			actions.WaitForSequencerHealthCheck(30*time.Second),
+
+			// the users are connected to the validators, their txs must reach the restarted sequencer
+			actions.GenerateUsersRandomisedTransferActionsInParallel(4, 10*time.Second),
+
+			actions.VerifyUserBalancesMatchTransfers(),
+		),
+	)
+}
+
+// restart the sequencer enclave while users are submitting transactions, so it goes down part way through producing batches
+func TestRestartSequencerEnclaveMidBatch(t *testing.T) {
+	networktest.TestOnlyRunsInIDE(t)
+	networktest.Run(
+		"restart-sequencer-enclave",
+		t,
+		env.LocalDevNetworkWithMockL1(),
+		actions.Series(
+			actions.CreateAndFundTestUsers(4),
+
+			actions.Parallel(
+				actions.GenerateUsersRandomisedTransferActionsInParallel(4, 20*time.Second),
+				actions.Series(
+					actions.SleepAction(5*time.Second),
+					actions.StopSequencerEnclave(),
+					actions.SleepAction(3*time.Second),
+					actions.StartSequencerEnclave(),
+					actions.WaitForSequencerHealthCheck(30*time.Second),
+				),
+			),
+
+			actions.VerifyUserBalancesMatchTransfers(),
+		),
+	)
+}
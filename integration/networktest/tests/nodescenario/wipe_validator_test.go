@@ -0,0 +1,45 @@
+package nodescenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest/actions"
+
+	"github.com/obscuronet/go-obscuro/integration/networktest"
+	"github.com/obscuronet/go-obscuro/integration/networktest/env"
+)
+
+// wipe the databases of a validator, it has to resync the whole chain from the L1 and the sequencer
+func TestWipeValidatorDatabases(t *testing.T) {
+	networktest.TestOnlyRunsInIDE(t)
+	networktest.Run(
+		"wipe-validator",
+		t,
+		env.LocalDevNetworkWithMockL1(),
+		actions.Series(
+			actions.CreateAndFundTestUsers(4),
+
+			// short load test, build up some state
+			actions.GenerateUsersRandomisedTransferActionsInParallel(4, 10*time.Second),
+			actions.SleepAction(5*time.Second), // allow time for in-flight transactions
+
+			actions.StopValidatorEnclave(1),
+			actions.StopValidatorHost(1),
+			actions.SleepAction(5*time.Second), // allow time for shutdown
+			actions.WipeValidatorDatabases(1),
+			actions.StartValidatorEnclave(1),
+			actions.StartValidatorHost(1),
+			actions.WaitForValidatorHealthCheck(1, 60*time.Second),
+			actions.SleepAction(10*time.Second), // allow time for re-sync
+
+			// the wiped enclave lost the users' viewing keys
+			actions.AuthenticateAllUsers(),
+
+			// at least one of the users is connected to the wiped validator
+			actions.GenerateUsersRandomisedTransferActionsInParallel(4, 10*time.Second),
+
+			actions.VerifyUserBalancesMatchTransfers(),
+		),
+	)
+}
@@ -27,6 +27,12 @@ const (
 	_receiptPollInterval = 1 * time.Second
 )
 
+// DefaultGasPrice is the gas price of the transactions sent by SendFunds
+var DefaultGasPrice = gethcommon.Big1
+
+// The test networks' enclaves do not run in SGX, so cannot produce a verifiable attestation.
+var insecureSkipAttestation = rpc.WithInsecureSkipAttestation(true)
+
 // UserWallet implements wallet.Wallet so it can be used with the original Wallet code.
 // But it aims to provide a wider range of functionality, akin to the software and hardware wallets that users interact with.
 // Note: UserWallet is **not** thread-safe for a single wallet (creates nonce conflicts etc.)
@@ -51,7 +57,7 @@ type Option func(wallet *UserWallet)
 // Note: will use testlog.Logger() as the logger
 func GenerateRandomWallet(network networktest.NetworkConnector) *UserWallet {
 	wallet := datagenerator.RandomWallet(network.ChainID())
-	_, err := obsclient.DialWithAuth(network.SequencerRPCAddress(), wallet, testlog.Logger())
+	_, err := obsclient.DialWithAuth(network.SequencerRPCAddress(), wallet, testlog.Logger(), insecureSkipAttestation)
 	if err != nil {
 		panic(err)
 	}
@@ -91,7 +97,7 @@ func (s *UserWallet) SendFunds(ctx context.Context, addr gethcommon.Address, val
 		Nonce:    s.nonce,
 		Value:    value,
 		Gas:      uint64(1_000_000),
-		GasPrice: gethcommon.Big1,
+		GasPrice: DefaultGasPrice,
 		To:       &addr,
 	}
 
@@ -165,7 +171,7 @@ func (s *UserWallet) EnsureClientSetup(ctx context.Context) error {
 		// client already setup
 		return nil
 	}
-	authClient, err := obsclient.DialWithAuth(s.rpcEndpoint, s, s.logger)
+	authClient, err := obsclient.DialWithAuth(s.rpcEndpoint, s, s.logger, insecureSkipAttestation)
 	if err != nil {
 		return err
 	}
@@ -188,7 +194,7 @@ func (s *UserWallet) ResetClient(ctx context.Context) error {
 		// client already setup, close it before re-authenticating
 		s.client.Close()
 	}
-	authClient, err := obsclient.DialWithAuth(s.rpcEndpoint, s, s.logger)
+	authClient, err := obsclient.DialWithAuth(s.rpcEndpoint, s, s.logger, insecureSkipAttestation)
 	if err != nil {
 		return err
 	}
@@ -61,6 +61,7 @@ func TestCanStartStandaloneObscuroHostAndEnclave(t *testing.T) {
 	enclaveConfig.ProfilerEnabled = true
 	enclaveConfig.LogPath = testlog.LogFile()
 	enclaveConfig.WillAttest = false
+	enclaveConfig.InsecureSkipAttestation = true
 
 	binariesPath, err := eth2network.EnsureBinariesExist()
 	if err != nil {
@@ -32,8 +32,22 @@ func DefaultDevNetwork() *InMemDevNetwork {
 		NumNodes:         4,
 		AvgBlockDuration: 1 * time.Second,
 	}
-	l1Network := NewGethNetwork(networkWallets, l1Config)
+	return newDevNetwork(networkWallets, NewGethNetwork(networkWallets, l1Config))
+}
+
+// DevNetworkWithMockL1 provides a dev network whose L1 is made of in-memory mock nodes, so it can be scripted by tests
+// (e.g. to force L1 reorgs) and does not need docker
+func DevNetworkWithMockL1() *InMemDevNetwork {
+	numNodes := 4
+	networkWallets := params.NewSimWallets(0, numNodes, integration.EthereumChainID, integration.ObscuroChainID)
+	l1Config := &L1Config{
+		NumNodes:         numNodes,
+		AvgBlockDuration: 1 * time.Second,
+	}
+	return newDevNetwork(networkWallets, NewMockL1Network(l1Config))
+}
 
+func newDevNetwork(networkWallets *params.SimWallets, l1Network L1Network) *InMemDevNetwork {
 	return &InMemDevNetwork{
 		logger:         testlog.Logger(),
 		networkWallets: networkWallets,
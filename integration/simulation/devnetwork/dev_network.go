@@ -2,6 +2,7 @@ package devnetwork
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -92,6 +93,15 @@ func (s *InMemDevNetwork) GetL1Client() (ethadapter.EthClient, error) {
 	return s.l1Network.GetClient(0), nil
 }
 
+// GetScriptableL1 is only supported when the dev network runs on the in-memory mock L1 (see DevNetworkWithMockL1)
+func (s *InMemDevNetwork) GetScriptableL1() (networktest.ScriptableL1, error) {
+	scriptable, ok := s.l1Network.(networktest.ScriptableL1)
+	if !ok {
+		return nil, errors.New("the L1 of this dev network cannot be scripted, use the mock L1")
+	}
+	return scriptable, nil
+}
+
 func (s *InMemDevNetwork) GetSequencerNode() networktest.NodeOperator {
 	return s.obscuroSequencer
 }
@@ -116,10 +126,12 @@ func (s *InMemDevNetwork) DeployL1StandardContracts() {
 func (s *InMemDevNetwork) startNodes() {
 	if s.obscuroSequencer == nil {
 		// initialise node operators
-		s.obscuroSequencer = NewInMemNodeOperator(0, s.obscuroConfig, common.Sequencer, s.l1Network.ObscuroSetupData(), s.l1Network.GetClient(0), s.networkWallets.NodeWallets[0], s.logger)
+		mgmtContractLib := s.l1Network.MgmtContractLib()
+		sequencerID := s.networkWallets.NodeWallets[0].Address()
+		s.obscuroSequencer = NewInMemNodeOperator(0, s.obscuroConfig, common.Sequencer, sequencerID, s.l1Network.ObscuroSetupData(), s.l1Network.GetClient(0), mgmtContractLib, s.networkWallets.NodeWallets[0], s.logger)
 		for i := 1; i <= s.obscuroConfig.InitNumValidators; i++ {
 			l1Client := s.l1Network.GetClient(i % s.l1Network.NumNodes())
-			s.obscuroValidators = append(s.obscuroValidators, NewInMemNodeOperator(i, s.obscuroConfig, common.Validator, s.l1Network.ObscuroSetupData(), l1Client, s.networkWallets.NodeWallets[i], s.logger))
+			s.obscuroValidators = append(s.obscuroValidators, NewInMemNodeOperator(i, s.obscuroConfig, common.Validator, sequencerID, s.l1Network.ObscuroSetupData(), l1Client, mgmtContractLib, s.networkWallets.NodeWallets[i], s.logger))
 		}
 	}
 
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
+	"github.com/obscuronet/go-obscuro/integration/common/testlog"
 	"github.com/obscuronet/go-obscuro/integration/eth2network"
 	"github.com/obscuronet/go-obscuro/integration/simulation/network"
 	"github.com/obscuronet/go-obscuro/integration/simulation/params"
@@ -49,3 +51,7 @@ func (g *gethDockerNetwork) GetClient(idx int) ethadapter.EthClient {
 func (g *gethDockerNetwork) ObscuroSetupData() *params.L1SetupData {
 	return g.l1SetupData
 }
+
+func (g *gethDockerNetwork) MgmtContractLib() mgmtcontractlib.MgmtContractLib {
+	return mgmtcontractlib.NewMgmtContractLib(&g.l1SetupData.MgmtContractAddress, testlog.Logger())
+}
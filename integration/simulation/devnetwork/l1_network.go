@@ -2,6 +2,7 @@ package devnetwork
 
 import (
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
 	"github.com/obscuronet/go-obscuro/integration/simulation/params"
 )
 
@@ -14,4 +15,6 @@ type L1Network interface {
 	NumNodes() int
 	GetClient(i int) ethadapter.EthClient
 	ObscuroSetupData() *params.L1SetupData
+	// MgmtContractLib is the lib the Obscuro nodes must use to interact with the management contract on this L1
+	MgmtContractLib() mgmtcontractlib.MgmtContractLib
 }
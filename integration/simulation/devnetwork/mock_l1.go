@@ -0,0 +1,88 @@
+package devnetwork
+
+import (
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/obscuronet/go-obscuro/integration/networktest"
+	"github.com/obscuronet/go-obscuro/integration/simulation/network"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
+	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// mockL1Network is an L1 made of in-memory ethereummock nodes, unlike a geth network it can be scripted deterministically
+// (it implements networktest.ScriptableL1)
+type mockL1Network struct {
+	l1Config        *L1Config
+	nodes           []*ethereummock.Node
+	mgmtContractLib mgmtcontractlib.MgmtContractLib
+}
+
+func NewMockL1Network(l1Config *L1Config) L1Network {
+	return &mockL1Network{
+		l1Config:        l1Config,
+		mgmtContractLib: ethereummock.NewMgmtContractLibMock(),
+	}
+}
+
+func (m *mockL1Network) Start() {
+	l1Stats := stats.NewStats(m.l1Config.NumNodes)
+	avgLatency := m.l1Config.AvgBlockDuration / 15
+	m.nodes = make([]*ethereummock.Node, m.l1Config.NumNodes)
+	for i := 0; i < m.l1Config.NumNodes; i++ {
+		m.nodes[i] = network.CreateMockEthNode(int64(i), m.l1Config.NumNodes, m.l1Config.AvgBlockDuration, avgLatency, l1Stats)
+	}
+	for _, node := range m.nodes {
+		node.Network.(*ethereummock.MockEthNetwork).AllNodes = m.nodes
+		go node.Start()
+	}
+}
+
+func (m *mockL1Network) Stop() {
+	for _, node := range m.nodes {
+		go node.Stop()
+	}
+}
+
+func (m *mockL1Network) NumNodes() int {
+	return len(m.nodes)
+}
+
+func (m *mockL1Network) GetClient(idx int) ethadapter.EthClient {
+	return m.nodes[idx]
+}
+
+func (m *mockL1Network) ObscuroSetupData() *params.L1SetupData {
+	disabledBus := gethcommon.BigToAddress(gethcommon.Big0)
+	return &params.L1SetupData{
+		MgmtContractAddress: *m.mgmtContractLib.GetContractAddr(),
+		MessageBusAddr:      &disabledBus,
+	}
+}
+
+func (m *mockL1Network) MgmtContractLib() mgmtcontractlib.MgmtContractLib {
+	return m.mgmtContractLib
+}
+
+func (m *mockL1Network) Fork(fork *networktest.L1Fork) (*networktest.L1ForkResult, error) {
+	result, err := m.nodes[0].Network.(*ethereummock.MockEthNetwork).Fork(ethereummock.ForkSpec{
+		Height:     fork.Height,
+		ReorgDepth: fork.ReorgDepth,
+		Depth:      fork.Depth,
+		Txs:        fork.Txs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	forkResult := &networktest.L1ForkResult{AncestorHeight: result.Ancestor.NumberU64()}
+	for _, b := range result.Orphaned {
+		forkResult.Orphaned = append(forkResult.Orphaned, b.Hash())
+	}
+	for _, b := range result.NewBranch {
+		forkResult.NewBranch = append(forkResult.NewBranch, b.Hash())
+	}
+	return forkResult, nil
+}
@@ -36,18 +36,23 @@ import (
 //
 // Note: InMemNodeOperator will panic when things go wrong, we want to fail fast in sims and avoid verbose error handling in usage
 type InMemNodeOperator struct {
-	operatorIdx int
-	config      ObscuroConfig
-	nodeType    common.NodeType
-	l1Data      *params.L1SetupData
-	l1Client    ethadapter.EthClient
-	logger      gethlog.Logger
+	operatorIdx     int
+	config          ObscuroConfig
+	nodeType        common.NodeType
+	l1Data          *params.L1SetupData
+	l1Client        ethadapter.EthClient
+	mgmtContractLib mgmtcontractlib.MgmtContractLib
+	logger          gethlog.Logger
 
 	host              *hostcontainer.HostContainer
 	enclave           *enclavecontainer.EnclaveContainer
 	l1Wallet          wallet.Wallet
+	sequencerID       gethcommon.Address // The ID of the network's sequencer, which is its L1 wallet address
 	enclaveDBFilepath string
 	hostDBFilepath    string
+
+	// the host stops its enclave when it shuts down, the enclave must then be restarted along with the host
+	enclaveStoppedByHost bool
 }
 
 func (n *InMemNodeOperator) StopHost() error {
@@ -55,6 +60,7 @@ func (n *InMemNodeOperator) StopHost() error {
 	if err != nil {
 		return fmt.Errorf("unable to stop host - %w", err)
 	}
+	n.enclaveStoppedByHost = true
 	return nil
 }
 
@@ -74,6 +80,12 @@ func (n *InMemNodeOperator) Start() error {
 func (n *InMemNodeOperator) StartHost() error {
 	// even if host was running previously we recreate the container to ensure state is like a new process
 	// todo: check if host is still running, stop or error?
+	if n.enclaveStoppedByHost {
+		err := n.StartEnclave()
+		if err != nil {
+			return fmt.Errorf("failed to restart enclave stopped with the host - %w", err)
+		}
+	}
 	n.host = n.createHostContainer()
 	go func() {
 		err := n.host.Start()
@@ -90,6 +102,7 @@ func (n *InMemNodeOperator) StartEnclave() error {
 	// even if enclave was running previously we recreate the container to ensure state is like a new process
 	// todo: check if enclave is still running?
 	n.enclave = n.createEnclaveContainer()
+	n.enclaveStoppedByHost = false
 	return n.enclave.Start()
 }
 
@@ -101,7 +114,7 @@ func (n *InMemNodeOperator) createHostContainer() *hostcontainer.HostContainer {
 	p2pAddr := fmt.Sprintf("%s:%d", network.Localhost, p2pPort)
 
 	hostConfig := &config.HostConfig{
-		ID:                        getHostID(n.operatorIdx),
+		ID:                        n.l1Wallet.Address(),
 		IsGenesis:                 n.nodeType == common.Sequencer,
 		NodeType:                  n.nodeType,
 		HasClientRPCHTTP:          true,
@@ -131,8 +144,7 @@ func (n *InMemNodeOperator) createHostContainer() *hostcontainer.HostContainer {
 
 	enclaveClient := enclaverpc.NewClient(hostConfig, testlog.Logger().New(log.NodeIDKey, n.operatorIdx))
 	rpcServer := clientrpc.NewServer(hostConfig, n.logger)
-	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&hostConfig.ManagementContractAddress, n.logger)
-	return hostcontainer.NewHostContainer(hostConfig, nodeP2p, n.l1Client, enclaveClient, mgmtContractLib, n.l1Wallet, rpcServer, hostLogger, metrics.New(false, 0, n.logger))
+	return hostcontainer.NewHostContainer(hostConfig, nodeP2p, n.l1Client, enclaveClient, n.mgmtContractLib, n.l1Wallet, rpcServer, hostLogger, metrics.New(false, 0, n.logger))
 }
 
 func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveContainer {
@@ -144,8 +156,8 @@ func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveCo
 	hostAddr := fmt.Sprintf("%s:%d", network.Localhost, hostPort)
 
 	enclaveConfig := config.EnclaveConfig{
-		HostID:                    getHostID(n.operatorIdx),
-		SequencerID:               getHostID(0),
+		HostID:                    n.l1Wallet.Address(),
+		SequencerID:               n.sequencerID,
 		HostAddress:               hostAddr,
 		Address:                   enclaveAddr,
 		NodeType:                  n.nodeType,
@@ -153,6 +165,7 @@ func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveCo
 		ObscuroChainID:            integration.ObscuroChainID,
 		ValidateL1Blocks:          false,
 		WillAttest:                false,
+		InsecureSkipAttestation:   true,
 		GenesisJSON:               nil,
 		UseInMemoryDB:             false,
 		ManagementContractAddress: n.l1Data.MgmtContractAddress,
@@ -161,7 +174,7 @@ func (n *InMemNodeOperator) createEnclaveContainer() *enclavecontainer.EnclaveCo
 		SqliteDBPath:              n.enclaveDBFilepath,
 		Cadence:                   10,
 	}
-	return enclavecontainer.NewEnclaveContainerWithLogger(enclaveConfig, enclaveLogger)
+	return enclavecontainer.NewEnclaveContainerWithMgmtContractLib(enclaveConfig, n.mgmtContractLib, enclaveLogger)
 }
 
 func (n *InMemNodeOperator) Stop() error {
@@ -195,24 +208,38 @@ func (n *InMemNodeOperator) StopEnclave() error {
 	return nil
 }
 
-func NewInMemNodeOperator(operatorIdx int, config ObscuroConfig, nodeType common.NodeType, l1Data *params.L1SetupData,
-	l1Client ethadapter.EthClient, l1Wallet wallet.Wallet, logger gethlog.Logger,
-) *InMemNodeOperator {
-	// todo: put sqlite and levelDB storage in the same temp dir
-	sqliteDBPath, err := sql.CreateTempDBFile()
+// WipeDatabases replaces the host and enclave databases with empty ones, the node must be stopped
+func (n *InMemNodeOperator) WipeDatabases() error {
+	if err := os.Remove(n.enclaveDBFilepath); err != nil {
+		return fmt.Errorf("failed to delete enclave db - %w", err)
+	}
+	if err := os.RemoveAll(n.hostDBFilepath); err != nil {
+		return fmt.Errorf("failed to delete host db - %w", err)
+	}
+	enclaveDBFilepath, hostDBFilepath, err := createDBPaths()
 	if err != nil {
-		panic("failed to create temp sqlite db path")
+		return err
 	}
-	levelDBPath, err := os.MkdirTemp("", "levelDB_*")
+	n.enclaveDBFilepath = enclaveDBFilepath
+	n.hostDBFilepath = hostDBFilepath
+	return nil
+}
+
+func NewInMemNodeOperator(operatorIdx int, config ObscuroConfig, nodeType common.NodeType, sequencerID gethcommon.Address, l1Data *params.L1SetupData,
+	l1Client ethadapter.EthClient, mgmtContractLib mgmtcontractlib.MgmtContractLib, l1Wallet wallet.Wallet, logger gethlog.Logger,
+) *InMemNodeOperator {
+	sqliteDBPath, levelDBPath, err := createDBPaths()
 	if err != nil {
-		panic("failed to create temp levelDBPath")
+		panic(err)
 	}
 	return &InMemNodeOperator{
 		operatorIdx:       operatorIdx,
 		config:            config,
 		nodeType:          nodeType,
+		sequencerID:       sequencerID,
 		l1Data:            l1Data,
 		l1Client:          l1Client,
+		mgmtContractLib:   mgmtContractLib,
 		l1Wallet:          l1Wallet,
 		logger:            logger,
 		enclaveDBFilepath: sqliteDBPath,
@@ -220,6 +247,15 @@ func NewInMemNodeOperator(operatorIdx int, config ObscuroConfig, nodeType common
 	}
 }
 
-func getHostID(nodeIdx int) gethcommon.Address {
-	return gethcommon.BigToAddress(big.NewInt(int64(nodeIdx)))
+func createDBPaths() (string, string, error) {
+	// todo: put sqlite and levelDB storage in the same temp dir
+	sqliteDBPath, err := sql.CreateTempDBFile()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create temp sqlite db path - %w", err)
+	}
+	levelDBPath, err := os.MkdirTemp("", "levelDB_*")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create temp levelDBPath - %w", err)
+	}
+	return sqliteDBPath, levelDBPath, nil
 }
@@ -0,0 +1,98 @@
+package simulation
+
+import (
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/ethadapter"
+	"github.com/obscuronet/go-obscuro/go/wallet"
+	"github.com/obscuronet/go-obscuro/integration"
+	"github.com/obscuronet/go-obscuro/integration/datagenerator"
+	"github.com/obscuronet/go-obscuro/integration/simulation/network"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
+)
+
+const benchmarkL1Transfers = 200
+
+// BenchmarkL1ReceiptsFetching compares fetching the receipts of L1 blocks with a request per transaction, as the host
+// used to, with fetching them with a single request per block, against the geth network used by the geth simulation.
+func BenchmarkL1ReceiptsFetching(b *testing.B) {
+	if os.Getenv(gethTestEnv) == "" {
+		b.Skipf("set the variable to run this benchmark: `%s=true`", gethTestEnv)
+	}
+
+	wallets := params.NewSimWallets(1, 1, integration.EthereumChainID, integration.ObscuroChainID)
+	_, ethClients, eth2Network := network.SetUpGethNetwork(wallets, integration.StartPortL1ReceiptsBenchmark, 1, 1)
+	defer network.StopEth2Network(ethClients, eth2Network)
+	ethClient := ethClients[0]
+
+	blocks := blocksWithTransfers(b, ethClient, wallets.SimEthWallets[0])
+
+	b.Run("PerTransaction", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, block := range blocks {
+				for _, tx := range block.Transactions() {
+					if _, err := ethClient.TransactionReceipt(tx.Hash()); err != nil {
+						b.Fatalf("could not fetch receipt. Cause: %s", err)
+					}
+				}
+			}
+		}
+	})
+
+	b.Run("PerBlock", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, block := range blocks {
+				if _, err := ethClient.BlockReceipts(block); err != nil {
+					b.Fatalf("could not fetch receipts. Cause: %s", err)
+				}
+			}
+		}
+	})
+}
+
+// Sends transfers from the wallet, and returns the blocks they were included in.
+func blocksWithTransfers(b *testing.B, ethClient ethadapter.EthClient, w wallet.Wallet) []*types.Block {
+	var lastTx *types.Transaction
+	for i := 0; i < benchmarkL1Transfers; i++ {
+		to := datagenerator.RandomAddress()
+		txData, err := ethClient.EstimateGasAndGasPrice(&types.LegacyTx{
+			Nonce: w.GetNonceAndIncrement(),
+			To:    &to,
+			Value: big.NewInt(1),
+		}, w.Address())
+		if err != nil {
+			b.Fatalf("could not estimate transfer. Cause: %s", err)
+		}
+		lastTx, err = w.SignTransaction(txData)
+		if err != nil {
+			b.Fatalf("could not sign transfer. Cause: %s", err)
+		}
+		if err = ethClient.SendTransaction(lastTx); err != nil {
+			b.Fatalf("could not send transfer. Cause: %s", err)
+		}
+	}
+
+	var receipt *types.Receipt
+	for start := time.Now(); receipt == nil; time.Sleep(time.Second) {
+		if time.Since(start) > time.Minute {
+			b.Fatal("transfers were not included in time")
+		}
+		receipt, _ = ethClient.TransactionReceipt(lastTx.Hash())
+	}
+
+	var blocks []*types.Block
+	for number := uint64(1); number <= receipt.BlockNumber.Uint64(); number++ {
+		block, err := ethClient.BlockByNumber(new(big.Int).SetUint64(number))
+		if err != nil {
+			b.Fatalf("could not fetch block. Cause: %s", err)
+		}
+		if len(block.Transactions()) > 0 {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
@@ -46,13 +46,14 @@ func (n *basicNetworkOfInMemoryNodes) Create(params *params.SimParams, stats *st
 		isGenesis := i == 0
 
 		// create the in memory l1 and l2 node
-		miner := createMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, params.AvgNetworkLatency, stats)
+		miner := CreateMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, params.AvgNetworkLatency, stats)
 		p2pLayers[i] = p2p.NewMockP2P(params.AvgBlockDuration, params.AvgNetworkLatency)
 
 		agg := createInMemObscuroNode(
 			int64(i),
 			isGenesis,
 			GetNodeType(i),
+			params.Wallets.NodeWallets[0].Address(),
 			params.MgmtContractLib,
 			false,
 			nil,
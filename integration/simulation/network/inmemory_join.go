@@ -0,0 +1,110 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/host"
+	"github.com/obscuronet/go-obscuro/go/host/container"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+	"github.com/obscuronet/go-obscuro/integration/simulation/p2p"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
+	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// NodesOfInMemoryNetwork is a network of in memory L1 and L2 nodes whose Obscuro nodes are started and stopped
+// individually, to test how nodes join the network.
+type NodesOfInMemoryNetwork struct {
+	ethNodes     []*ethereummock.Node
+	obscuroNodes []*container.HostContainer
+	running      []bool
+}
+
+// NewNodesOfInMemoryNetwork creates the nodes and starts the L1 nodes. The Obscuro nodes are not started.
+func NewNodesOfInMemoryNetwork(params *params.SimParams, stats *stats.Stats) *NodesOfInMemoryNetwork {
+	n := &NodesOfInMemoryNetwork{
+		ethNodes:     make([]*ethereummock.Node, params.NumberOfNodes),
+		obscuroNodes: make([]*container.HostContainer, params.NumberOfNodes),
+		running:      make([]bool, params.NumberOfNodes),
+	}
+	p2pLayers := make([]*p2p.MockP2P, params.NumberOfNodes)
+	obscuroHosts := make([]host.Host, params.NumberOfNodes)
+	disabledBus := gethcommon.BigToAddress(gethcommon.Big0)
+
+	for i := 0; i < params.NumberOfNodes; i++ {
+		n.ethNodes[i] = CreateMockEthNode(int64(i), params.NumberOfNodes, params.AvgBlockDuration, params.AvgNetworkLatency, stats)
+		p2pLayers[i] = p2p.NewMockP2P(params.AvgBlockDuration, params.AvgNetworkLatency)
+		n.obscuroNodes[i] = createInMemObscuroNode(
+			int64(i),
+			i == 0,
+			GetNodeType(i),
+			params.Wallets.NodeWallets[0].Address(),
+			params.MgmtContractLib,
+			false,
+			nil,
+			params.Wallets.NodeWallets[i],
+			n.ethNodes[i],
+			p2pLayers[i],
+			&disabledBus,
+			gethcommon.Hash{},
+		)
+		obscuroHosts[i] = n.obscuroNodes[i].Host()
+	}
+
+	for i := 0; i < params.NumberOfNodes; i++ {
+		n.ethNodes[i].Network.(*ethereummock.MockEthNetwork).AllNodes = n.ethNodes
+		p2pLayers[i].Nodes = obscuroHosts
+	}
+	for _, ethNode := range n.ethNodes {
+		go ethNode.Start()
+		time.Sleep(params.AvgBlockDuration)
+	}
+	return n
+}
+
+// StartNode starts the Obscuro node with the given index.
+func (n *NodesOfInMemoryNetwork) StartNode(idx int) error {
+	if err := n.obscuroNodes[idx].Start(); err != nil {
+		return fmt.Errorf("could not start node %d. Cause: %w", idx, err)
+	}
+	n.running[idx] = true
+	return nil
+}
+
+// StopNode stops the Obscuro node with the given index.
+func (n *NodesOfInMemoryNetwork) StopNode(idx int) error {
+	if err := n.obscuroNodes[idx].Stop(); err != nil {
+		return fmt.Errorf("could not stop node %d. Cause: %w", idx, err)
+	}
+	n.running[idx] = false
+	return nil
+}
+
+// AwaitEnclaveRunning waits until the enclave of the Obscuro node with the given index is running, which for a node
+// joining the network means that it has received the network secret.
+func (n *NodesOfInMemoryNetwork) AwaitEnclaveRunning(idx int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		health, err := n.obscuroNodes[idx].Host().HealthCheck()
+		if err == nil && health.HealthCheckEnclave.Status == common.Running.String() {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("enclave of node %d was not running after %s", idx, timeout)
+}
+
+// TearDown stops the running Obscuro nodes and the L1 nodes.
+func (n *NodesOfInMemoryNetwork) TearDown() {
+	for idx, running := range n.running {
+		if running {
+			_ = n.StopNode(idx)
+		}
+	}
+	for _, ethNode := range n.ethNodes {
+		go ethNode.Stop()
+	}
+}
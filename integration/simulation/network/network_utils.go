@@ -35,7 +35,8 @@ const (
 	DefaultL1RPCTimeout     = 15 * time.Second
 )
 
-func createMockEthNode(id int64, nrNodes int, avgBlockDuration time.Duration, avgNetworkLatency time.Duration, stats *stats.Stats) *ethereummock.Node {
+// CreateMockEthNode creates an in-memory mock L1 node, it still needs to be wired up to its peers before being started
+func CreateMockEthNode(id int64, nrNodes int, avgBlockDuration time.Duration, avgNetworkLatency time.Duration, stats *stats.Stats) *ethereummock.Node {
 	mockEthNetwork := ethereummock.NewMockEthNetwork(avgBlockDuration, avgNetworkLatency, stats)
 	ethereumMockCfg := defaultMockEthNodeCfg(nrNodes, avgBlockDuration)
 	// create an in memory mock ethereum node responsible with notifying the layer 2 node about blocks
@@ -48,6 +49,7 @@ func createInMemObscuroNode(
 	id int64,
 	isGenesis bool,
 	nodeType common.NodeType,
+	sequencerID gethcommon.Address,
 	mgmtContractLib mgmtcontractlib.MgmtContractLib,
 	validateBlocks bool,
 	genesisJSON []byte,
@@ -60,7 +62,7 @@ func createInMemObscuroNode(
 	mgtContractAddress := mgmtContractLib.GetContractAddr()
 
 	hostConfig := &config.HostConfig{
-		ID:                        gethcommon.BigToAddress(big.NewInt(id)),
+		ID:                        ethWallet.Address(),
 		IsGenesis:                 isGenesis,
 		NodeType:                  nodeType,
 		HasClientRPCHTTP:          false,
@@ -71,10 +73,12 @@ func createInMemObscuroNode(
 
 	enclaveConfig := config.EnclaveConfig{
 		HostID:                    hostConfig.ID,
+		SequencerID:               sequencerID,
 		NodeType:                  nodeType,
 		L1ChainID:                 integration.EthereumChainID,
 		ObscuroChainID:            integration.ObscuroChainID,
 		WillAttest:                false,
+		InsecureSkipAttestation:   true,
 		ValidateL1Blocks:          validateBlocks,
 		GenesisJSON:               genesisJSON,
 		UseInMemoryDB:             true,
@@ -112,7 +116,7 @@ func createSocketObscuroHostContainer(
 	l1StartBlk gethcommon.Hash,
 ) *container.HostContainer {
 	hostConfig := &config.HostConfig{
-		ID:                        gethcommon.BigToAddress(big.NewInt(id)),
+		ID:                        ethWallet.Address(),
 		IsGenesis:                 isGenesis,
 		NodeType:                  nodeType,
 		HasClientRPCHTTP:          true,
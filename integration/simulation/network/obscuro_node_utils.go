@@ -24,7 +24,6 @@ import (
 	"github.com/obscuronet/go-obscuro/integration/simulation/p2p"
 	"github.com/obscuronet/go-obscuro/integration/simulation/params"
 
-	gethcommon "github.com/ethereum/go-ethereum/common"
 	enclavecontainer "github.com/obscuronet/go-obscuro/go/enclave/container"
 	hostcontainer "github.com/obscuronet/go-obscuro/go/host/container"
 )
@@ -47,6 +46,7 @@ func startInMemoryObscuroNodes(params *params.SimParams, genesisJSON []byte, l1C
 			int64(i),
 			isGenesis,
 			GetNodeType(i),
+			params.Wallets.NodeWallets[0].Address(),
 			params.MgmtContractLib,
 			true,
 			genesisJSON,
@@ -170,7 +170,8 @@ func createAuthClients(clients []rpc.Client, wal wallet.Wallet) []*obsclient.Aut
 			panic(err)
 		}
 		// todo - use a child logger
-		encClient, err := rpc.NewEncRPCClient(client, vk, testlog.Logger())
+		// The simulated enclaves do not run in SGX, so cannot produce a verifiable attestation.
+		encClient, err := rpc.NewEncRPCClient(client, vk, testlog.Logger(), rpc.WithInsecureSkipAttestation(true))
 		if err != nil {
 			panic(err)
 		}
@@ -187,19 +188,21 @@ func startRemoteEnclaveServers(params *params.SimParams) {
 
 		// TODO - Change/derive from the default enclave config
 		enclaveConfig := config.EnclaveConfig{
-			HostID:            gethcommon.BigToAddress(big.NewInt(int64(i))),
-			HostAddress:       hostAddr,
-			Address:           enclaveAddr,
-			NodeType:          GetNodeType(i),
-			L1ChainID:         integration.EthereumChainID,
-			ObscuroChainID:    integration.ObscuroChainID,
-			ValidateL1Blocks:  false,
-			WillAttest:        false,
-			GenesisJSON:       nil,
-			UseInMemoryDB:     false,
-			MinGasPrice:       big.NewInt(1),
-			MessageBusAddress: *params.L1SetupData.MessageBusAddr,
-			Cadence:           10,
+			HostID:                  params.Wallets.NodeWallets[i].Address(),
+			SequencerID:             params.Wallets.NodeWallets[0].Address(),
+			HostAddress:             hostAddr,
+			Address:                 enclaveAddr,
+			NodeType:                GetNodeType(i),
+			L1ChainID:               integration.EthereumChainID,
+			ObscuroChainID:          integration.ObscuroChainID,
+			ValidateL1Blocks:        false,
+			WillAttest:              false,
+			InsecureSkipAttestation: true,
+			GenesisJSON:             nil,
+			UseInMemoryDB:           false,
+			MinGasPrice:             big.NewInt(1),
+			MessageBusAddress:       *params.L1SetupData.MessageBusAddr,
+			Cadence:                 10,
 		}
 		enclaveLogger := testlog.Logger().New(log.NodeIDKey, i, log.CmpKey, log.EnclaveCmp)
 		encl := enclave.NewEnclave(enclaveConfig, &genesis.TestnetGenesis, params.MgmtContractLib, enclaveLogger)
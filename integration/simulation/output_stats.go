@@ -79,7 +79,10 @@ func (o *OutputStats) countBlockChain() {
 
 func (o *OutputStats) incrementStats(block *types.Block, l1Node ethadapter.EthClient) {
 	for _, tx := range block.Transactions() {
-		t := o.simulation.Params.MgmtContractLib.DecodeTx(tx)
+		t, err := o.simulation.Params.MgmtContractLib.DecodeTx(tx)
+		if err != nil {
+			testlog.Logger().Crit("could not decode transaction.", log.ErrKey, err)
+		}
 		if t == nil {
 			t = o.simulation.Params.ERC20ContractLib.DecodeTx(tx)
 		}
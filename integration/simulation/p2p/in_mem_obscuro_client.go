@@ -70,9 +70,15 @@ func (c *inMemObscuroClient) Call(result interface{}, method string, args ...int
 	case rpc.GetTransactionCount:
 		return c.getTransactionCount(result, args)
 
+	case rpc.EstimateGas:
+		return c.estimateGas(result, args)
+
 	case rpc.GetTransactionReceipt:
 		return c.getTransactionReceipt(result, args)
 
+	case rpc.GetTransactionStatus:
+		return c.getTransactionStatus(result, args)
+
 	case rpc.RollupNumber:
 		*result.(*hexutil.Uint64) = c.ethAPI.BlockNumber()
 		return nil
@@ -93,9 +99,24 @@ func (c *inMemObscuroClient) Call(result interface{}, method string, args ...int
 	case rpc.GetRollupByHash:
 		return c.getRollupByHash(result, args)
 
+	case rpc.GetCode:
+		return c.getCode(result, args)
+
+	case rpc.GasPrice:
+		return c.gasPrice(result)
+
+	case rpc.MaxPriorityFeePerGas:
+		return c.maxPriorityFeePerGas(result)
+
 	case rpc.Health:
 		return c.health(result)
 
+	case rpc.SyncStatus:
+		return c.syncStatus(result)
+
+	case rpc.Syncing:
+		return c.syncing(result)
+
 	case rpc.GetTotalTxs:
 		return c.getTotalTransactions(result)
 
@@ -162,6 +183,19 @@ func (c *inMemObscuroClient) rpcCall(result interface{}, args []interface{}) err
 	return nil
 }
 
+func (c *inMemObscuroClient) estimateGas(result interface{}, args []interface{}) error {
+	enc, err := getEncryptedBytes(args, rpc.EstimateGas)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := c.ethAPI.EstimateGas(context.Background(), enc)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.EstimateGas, err)
+	}
+	*result.(*interface{}) = *encryptedResponse
+	return nil
+}
+
 func (c *inMemObscuroClient) getTransactionReceipt(result interface{}, args []interface{}) error {
 	enc, err := getEncryptedBytes(args, rpc.GetTransactionReceipt)
 	if err != nil {
@@ -179,6 +213,23 @@ func (c *inMemObscuroClient) getTransactionReceipt(result interface{}, args []in
 	return nil
 }
 
+func (c *inMemObscuroClient) getTransactionStatus(result interface{}, args []interface{}) error {
+	enc, err := getEncryptedBytes(args, rpc.GetTransactionStatus)
+	if err != nil {
+		return err
+	}
+	encryptedResponse, err := c.obscuroAPI.GetTransactionStatus(context.Background(), enc)
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetTransactionStatus, err)
+	}
+
+	// GetTransactionStatus returns string pointer, we want string
+	if encryptedResponse != nil {
+		*result.(*interface{}) = *encryptedResponse
+	}
+	return nil
+}
+
 func (c *inMemObscuroClient) getTransactionCount(result interface{}, args []interface{}) error {
 	enc, err := getEncryptedBytes(args, rpc.GetTransactionCount)
 	if err != nil {
@@ -207,17 +258,12 @@ func (c *inMemObscuroClient) getLogs(result interface{}, args []interface{}) err
 }
 
 func (c *inMemObscuroClient) getRollupByNumber(result interface{}, args []interface{}) error {
-	blockNumberHex, ok := args[0].(string)
-	if !ok {
-		return fmt.Errorf("arg to %s is of type %T, expected int64", rpc.GetRollupByNumber, args[0])
-	}
-
-	blockNumber, err := hexutil.DecodeUint64(blockNumberHex)
+	blockNumber, err := toBlockNumber(args[0], rpc.GetRollupByNumber)
 	if err != nil {
-		return fmt.Errorf("arg to %s could not be decoded from hex. Cause: %w", rpc.GetRollupByNumber, err)
+		return err
 	}
 
-	headerMap, err := c.ethAPI.GetBlockByNumber(nil, gethrpc.BlockNumber(blockNumber), false) //nolint:staticcheck
+	headerMap, err := c.ethAPI.GetBlockByNumber(nil, common.BlockNumber(blockNumber), false) //nolint:staticcheck
 	if err != nil {
 		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetRollupByNumber, err)
 	}
@@ -261,6 +307,42 @@ func (c *inMemObscuroClient) getRollupByHash(result interface{}, args []interfac
 	return nil
 }
 
+func (c *inMemObscuroClient) getCode(result interface{}, args []interface{}) error {
+	address, ok := args[0].(gethcommon.Address)
+	if !ok {
+		return fmt.Errorf("arg to %s is of type %T, expected common.Address", rpc.GetCode, args[0])
+	}
+	blockNumber, err := toBlockNumber(args[1], rpc.GetCode)
+	if err != nil {
+		return err
+	}
+
+	code, err := c.ethAPI.GetCode(context.Background(), address, gethrpc.BlockNumberOrHashWithNumber(blockNumber))
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GetCode, err)
+	}
+	*result.(*hexutil.Bytes) = code
+	return nil
+}
+
+func (c *inMemObscuroClient) gasPrice(result interface{}) error {
+	price, err := c.ethAPI.GasPrice(context.Background())
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.GasPrice, err)
+	}
+	*result.(*hexutil.Big) = *price
+	return nil
+}
+
+func (c *inMemObscuroClient) maxPriorityFeePerGas(result interface{}) error {
+	tipCap, err := c.ethAPI.MaxPriorityFeePerGas(context.Background())
+	if err != nil {
+		return fmt.Errorf("`%s` call failed. Cause: %w", rpc.MaxPriorityFeePerGas, err)
+	}
+	*result.(*hexutil.Big) = *tipCap
+	return nil
+}
+
 func (c *inMemObscuroClient) Stop() {
 	// There is no RPC connection to close.
 }
@@ -295,6 +377,29 @@ func (c *inMemObscuroClient) health(result interface{}) error {
 	return nil
 }
 
+func (c *inMemObscuroClient) syncStatus(result interface{}) error {
+	status, err := c.obscuroAPI.SyncStatus()
+	if err != nil {
+		return err
+	}
+	*result.(**hostcommon.SyncStatus) = status
+	return nil
+}
+
+func (c *inMemObscuroClient) syncing(result interface{}) error {
+	syncing, err := c.ethAPI.Syncing()
+	if err != nil {
+		return err
+	}
+	// the result is decoded by the caller, as the response can either be a bool or an object
+	encodedSyncing, err := json.Marshal(syncing)
+	if err != nil {
+		return fmt.Errorf("could not encode %s response. Cause: %w", rpc.Syncing, err)
+	}
+	*result.(*json.RawMessage) = encodedSyncing
+	return nil
+}
+
 func (c *inMemObscuroClient) getTotalTransactions(result interface{}) error {
 	totalTxs, err := c.obscuroScanAPI.GetTotalTransactions()
 	if err != nil {
@@ -370,3 +475,16 @@ func getEncryptedBytes(args []interface{}, methodName string) ([]byte, error) {
 	}
 	return encBytes, nil
 }
+
+// Converts a block number argument (e.g. "latest" or a hex-encoded number) to a block number.
+func toBlockNumber(arg interface{}, method string) (gethrpc.BlockNumber, error) {
+	blockNumberStr, ok := arg.(string)
+	if !ok {
+		return 0, fmt.Errorf("arg to %s is of type %T, expected string", method, arg)
+	}
+	var blockNumber common.BlockNumber
+	if err := blockNumber.UnmarshalJSON([]byte(blockNumberStr)); err != nil {
+		return 0, fmt.Errorf("arg to %s could not be decoded as a block number. Cause: %w", method, err)
+	}
+	return gethrpc.BlockNumber(blockNumber), nil
+}
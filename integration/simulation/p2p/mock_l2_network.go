@@ -111,7 +111,7 @@ func (netw *MockP2P) SendBatches(batchMsg *host.BatchMsg, requesterAddress strin
 }
 
 func (netw *MockP2P) Status() *host.P2PStatus {
-	return &host.P2PStatus{}
+	return &host.P2PStatus{Healthy: true}
 }
 
 func (netw *MockP2P) HealthCheck() bool {
@@ -9,13 +9,16 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/obscuronet/go-obscuro/contracts/generated/ERC20"
 	"github.com/obscuronet/go-obscuro/go/common"
 	"github.com/obscuronet/go-obscuro/go/common/errutil"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/ethadapter"
 	"github.com/obscuronet/go-obscuro/go/wallet"
+	"github.com/obscuronet/go-obscuro/integration"
 	"github.com/obscuronet/go-obscuro/integration/common/testlog"
 	"github.com/obscuronet/go-obscuro/integration/erc20contract"
 	"github.com/obscuronet/go-obscuro/integration/ethereummock"
@@ -59,6 +62,7 @@ func (s *Simulation) Start() {
 	s.trackLogs()              // Create log subscriptions, to validate that they're working correctly later.
 	s.prefundObscuroAccounts() // Prefund every L2 wallet
 	s.deployObscuroERC20s()    // Deploy the Obscuro HOC and POC ERC20 contracts
+	s.checkContractBindings()  // Checks that the generated contract bindings can be used with the Obscuro client
 	s.prefundL1Accounts()      // Prefund every L1 wallet
 	s.checkHealthStatus()      // Checks the nodes health status
 
@@ -101,7 +105,10 @@ func (s *Simulation) waitForObscuroGenesisOnL1() {
 		if err == nil {
 			for _, b := range client.BlocksBetween(ethereummock.MockGenesisBlock, head) {
 				for _, tx := range b.Transactions() {
-					t := s.Params.MgmtContractLib.DecodeTx(tx)
+					t, err := s.Params.MgmtContractLib.DecodeTx(tx)
+					if err != nil {
+						panic(fmt.Errorf("could not decode transaction. Cause: %w", err))
+					}
 					if t == nil {
 						continue
 					}
@@ -135,7 +142,7 @@ func (s *Simulation) trackLogs() {
 			hocFilter := filters.FilterCriteria{
 				Addresses: []gethcommon.Address{gethcommon.HexToAddress("0x" + testcommon.HOCAddr)},
 			}
-			sub, err := client.SubscribeFilterLogs(context.Background(), hocFilter, channel)
+			sub, err := client.SubscribeFilterLogsWithID(context.Background(), hocFilter, channel)
 			if err != nil {
 				panic(fmt.Errorf("subscription failed. Cause: %w", err))
 			}
@@ -192,6 +199,56 @@ func (s *Simulation) deployObscuroERC20s() {
 	wg.Wait()
 }
 
+// Deploys a contract and transacts with it using the generated contract bindings, with the Obscuro client as their
+// backend.
+func (s *Simulation) checkContractBindings() {
+	owner := s.Params.Wallets.Tokens[testcommon.HOC].L2Owner
+	client := s.RPCHandles.ObscuroWalletRndClient(owner)
+	ctx, cancel := context.WithTimeout(s.ctx, s.Params.ReceiptTimeout)
+	defer cancel()
+
+	opts, err := bind.NewKeyedTransactorWithChainID(owner.PrivateKey(), big.NewInt(integration.ObscuroChainID))
+	if err != nil {
+		panic(err)
+	}
+	opts.Context = ctx
+	// The wallet tracks its own nonce, so we use it rather than letting the bindings retrieve it.
+	opts.Nonce = big.NewInt(int64(NextNonce(s.ctx, s.RPCHandles, owner)))
+
+	_, deployTx, token, err := ERC20.DeployERC20(opts, client, "Bindings", "BND")
+	if err != nil {
+		panic(fmt.Errorf("could not deploy contract using bindings. Cause: %w", err))
+	}
+	if _, err = bind.WaitDeployed(ctx, client, deployTx); err != nil {
+		panic(fmt.Errorf("contract deployed using bindings was not deployed. Cause: %w", err))
+	}
+
+	spender := s.Params.Wallets.SimObsWallets[0].Address()
+	opts.Nonce = big.NewInt(int64(NextNonce(s.ctx, s.RPCHandles, owner)))
+	approveTx, err := token.Approve(opts, spender, gethcommon.Big1)
+	if err != nil {
+		panic(fmt.Errorf("could not transact with contract using bindings. Cause: %w", err))
+	}
+	receipt, err := bind.WaitMined(ctx, client, approveTx)
+	if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+		panic(fmt.Errorf("transaction sent using bindings was not successful. Cause: %w", err))
+	}
+
+	allowance, err := token.Allowance(&bind.CallOpts{Context: ctx}, owner.Address(), spender)
+	if err != nil || allowance.Cmp(gethcommon.Big1) != 0 {
+		panic(fmt.Errorf("could not call contract using bindings. Allowance: %d. Cause: %w", allowance, err))
+	}
+
+	approvals, err := token.FilterApproval(&bind.FilterOpts{Context: ctx}, []gethcommon.Address{owner.Address()}, []gethcommon.Address{spender})
+	if err != nil {
+		panic(fmt.Errorf("could not filter contract events using bindings. Cause: %w", err))
+	}
+	defer approvals.Close()
+	if !approvals.Next() || approvals.Event.Value.Cmp(gethcommon.Big1) != 0 {
+		panic(fmt.Errorf("did not find contract event using bindings. Cause: %w", approvals.Error()))
+	}
+}
+
 // Sends an amount from the faucet to each L1 account, to pay for transactions.
 func (s *Simulation) prefundL1Accounts() {
 	for _, w := range s.Params.Wallets.SimEthWallets {
@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obscuronet/go-obscuro/integration"
+	"github.com/obscuronet/go-obscuro/integration/simulation/network"
+	"github.com/obscuronet/go-obscuro/integration/simulation/params"
+	"github.com/obscuronet/go-obscuro/integration/simulation/stats"
+
+	ethereum_mock "github.com/obscuronet/go-obscuro/integration/ethereummock"
+)
+
+const secretTimeout = 30 * time.Second
+
+// This test checks that a validator can still obtain the network secret when the genesis node is down, by having it
+// answered by another attested node once the genesis node's turn to respond has passed.
+func TestValidatorJoinsWhileGenesisNodeIsDown(t *testing.T) {
+	setupSimTestLog("secret-join")
+
+	numberOfNodes := 3
+	simParams := &params.SimParams{
+		NumberOfNodes:    numberOfNodes,
+		AvgBlockDuration: 100 * time.Millisecond,
+		MgmtContractLib:  ethereum_mock.NewMgmtContractLibMock(),
+		Wallets:          params.NewSimWallets(0, numberOfNodes, integration.EthereumChainID, integration.ObscuroChainID),
+	}
+	simParams.AvgNetworkLatency = simParams.AvgBlockDuration / 15
+
+	nodes := network.NewNodesOfInMemoryNetwork(simParams, stats.NewStats(numberOfNodes))
+	defer nodes.TearDown()
+
+	// The genesis node initialises the network secret, and answers the first validator's request.
+	for _, idx := range []int{0, 1} {
+		if err := nodes.StartNode(idx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := nodes.AwaitEnclaveRunning(1, secretTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	// With the genesis node down, only the first validator can answer the second validator's request.
+	if err := nodes.StopNode(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := nodes.StartNode(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := nodes.AwaitEnclaveRunning(2, secretTimeout); err != nil {
+		t.Fatal(err)
+	}
+}
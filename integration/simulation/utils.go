@@ -6,7 +6,8 @@ import (
 	"math/big"
 	"time"
 
-	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/obscuronet/go-obscuro/contracts/generated/ERC20"
 	"github.com/obscuronet/go-obscuro/go/obsclient"
 
 	"github.com/obscuronet/go-obscuro/integration/common/testlog"
@@ -16,7 +17,6 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/obscuronet/go-obscuro/go/common"
-	"github.com/obscuronet/go-obscuro/go/ethadapter/erc20contractlib"
 )
 
 const (
@@ -61,21 +61,14 @@ func getHeadRollupHeader(client *obsclient.ObsClient, nodeIdx int) *common.Batch
 
 // Uses the client to retrieve the balance of the wallet with the given address.
 func balance(ctx context.Context, client *obsclient.AuthObsClient, address gethcommon.Address, l2ContractAddress *gethcommon.Address) *big.Int {
-	balanceData := erc20contractlib.CreateBalanceOfData(address)
-
-	callMsg := ethereum.CallMsg{
-		From: address,
-		To:   l2ContractAddress,
-		Data: balanceData,
+	token, err := ERC20.NewERC20Caller(*l2ContractAddress, client)
+	if err != nil {
+		panic(fmt.Errorf("simulation failed due to failed ERC20 binding. Cause: %w", err))
 	}
-
-	response, err := client.CallContract(ctx, callMsg, nil)
+	b, err := token.BalanceOf(&bind.CallOpts{From: address, Context: ctx}, address)
 	if err != nil {
 		panic(fmt.Errorf("simulation failed due to failed RPC call. Cause: %w", err))
 	}
-	b := new(big.Int)
-	// remove the "0x" prefix (we already confirmed it is present), convert the remaining hex value (base 16) to a balance number
-	b.SetString(string(response)[2:], 16)
 	return b
 }
 
@@ -280,7 +280,10 @@ func ExtractDataFromEthereumChain(
 		for _, tx := range block.Transactions() {
 			t := s.Params.ERC20ContractLib.DecodeTx(tx)
 			if t == nil {
-				t = s.Params.MgmtContractLib.DecodeTx(tx)
+				var err error
+				if t, err = s.Params.MgmtContractLib.DecodeTx(tx); err != nil {
+					panic(fmt.Errorf("could not decode transaction. Cause: %w", err))
+				}
 			}
 
 			if t == nil {
@@ -351,6 +354,19 @@ func checkBlockchainOfObscuroNode(t *testing.T, rpcHandles *network.RPCHandles,
 		t.Errorf("Node %d: Node's head rollup had a height %d, but %s height was %d", nodeIdx, l2Height, rpc.RollupNumber, l2HeightFromRollupNumber)
 	}
 
+	// check that the sync status is consistent with the node's chain (which may have advanced in the meantime)
+	syncStatus, err := obscuroClient.SyncStatus()
+	if err != nil {
+		t.Errorf("Node %d: Could not retrieve sync status. Cause: %s", nodeIdx, err)
+	} else {
+		if syncStatus.HeadBatchHeight < l2Height.Uint64() {
+			t.Errorf("Node %d: Node's head rollup had a height %d, but %s head batch height was %d", nodeIdx, l2Height, rpc.SyncStatus, syncStatus.HeadBatchHeight)
+		}
+		if syncStatus.EnclaveL1HeadHeight == 0 || syncStatus.EnclaveL1HeadHeight > syncStatus.L1HeadHeight {
+			t.Errorf("Node %d: %s reported an invalid enclave L1 head height %d (L1 head height %d)", nodeIdx, rpc.SyncStatus, syncStatus.EnclaveL1HeadHeight, syncStatus.L1HeadHeight)
+		}
+	}
+
 	totalL2Blocks := s.Stats.NoL2Blocks[nodeIdx]
 	// in case the blockchain has advanced above what was collected, there is no longer a point to this check
 	if l2Height.Uint64() <= totalL2Blocks {
@@ -516,12 +532,21 @@ func checkTransactionReceipts(ctx context.Context, t *testing.T, nodeIdx int, rp
 		sender := getSender(tx)
 
 		// We check that there is a receipt available for each transaction
-		receipt, err := rpcHandles.ObscuroWalletClient(sender, nodeIdx).TransactionReceipt(ctx, tx.Hash())
+		client := rpcHandles.ObscuroWalletClient(sender, nodeIdx)
+		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
 		if err != nil {
 			t.Errorf("node %d: could not retrieve receipt for transaction %s. Cause: %s", nodeIdx, tx.Hash().Hex(), err)
 			continue
 		}
 
+		// We check that the transaction's status reflects its inclusion in a batch.
+		txStatus, err := client.TransactionStatus(ctx, tx.Hash())
+		if err != nil {
+			t.Errorf("node %d: could not retrieve status of transaction %s. Cause: %s", nodeIdx, tx.Hash().Hex(), err)
+		} else if !txStatus.Status.Reached(common.TxStatusBatched) {
+			t.Errorf("node %d: transaction %s has a receipt but has status %s", nodeIdx, tx.Hash().Hex(), txStatus.Status)
+		}
+
 		// We check that the receipt's logs are relevant to the sender.
 		for _, retrievedLog := range receipt.Logs {
 			assertRelevantLogsOnly(t, sender.Hex(), *retrievedLog)
@@ -44,6 +44,7 @@ func (t *Testnet) Start() error {
 		node.WithNodeType("sequencer"),
 		node.WithGenesis(true),
 		node.WithSGXEnabled(false),
+		node.WithInsecureSkipAttestation(true), // the local testnet's enclaves do not run in SGX
 		node.WithEnclaveImage(t.cfg.sequencerEnclaveDockerImage),
 		node.WithEnclaveDebug(t.cfg.sequencerEnclaveDebug),
 		node.WithHostImage("testnetobscuronet.azurecr.io/obscuronet/host:latest"),
@@ -83,6 +84,7 @@ func (t *Testnet) Start() error {
 		node.WithNodeType("validator"),
 		node.WithGenesis(false),
 		node.WithSGXEnabled(false),
+		node.WithInsecureSkipAttestation(true), // the local testnet's enclaves do not run in SGX
 		node.WithEnclaveImage(t.cfg.validatorEnclaveDockerImage),
 		node.WithEnclaveDebug(t.cfg.validatorEnclaveDebug),
 		node.WithHostImage("testnetobscuronet.azurecr.io/obscuronet/host:latest"),
@@ -129,6 +131,7 @@ func (t *Testnet) Start() error {
 			l2cd.WithHocPKString("6e384a07a01263518a09a5424c7b6bbfc3604ba7d93f47e3a455cbdd7f9f0682"),
 			l2cd.WithPocPKString("4bfe14725e685901c062ccd4e220c61cf9c189897b6c78bd18d7f51291b2b8f8"),
 			l2cd.WithDockerImage("testnetobscuronet.azurecr.io/obscuronet/hardhatdeployer:latest"),
+			l2cd.WithInsecureSkipAttestation(true), // the local testnet's enclaves do not run in SGX
 		),
 	)
 	if err != nil {
@@ -6,16 +6,17 @@ import (
 
 // L2ContractDeployerConfigCLI represents the configurations passed into the deployer over CLI
 type L2ContractDeployerConfigCLI struct {
-	l1Host                 string
-	l1HTTPPort             int
-	privateKey             string
-	dockerImage            string
-	l2Host                 string
-	l2WSPort               int
-	messageBusContractAddr string
-	l2PrivateKey           string
-	l2HOCPrivateKey        string
-	l2POCPrivateKey        string
+	l1Host                  string
+	l1HTTPPort              int
+	privateKey              string
+	dockerImage             string
+	l2Host                  string
+	l2WSPort                int
+	messageBusContractAddr  string
+	l2PrivateKey            string
+	l2HOCPrivateKey         string
+	l2POCPrivateKey         string
+	insecureSkipAttestation bool
 }
 
 // ParseConfigCLI returns a NodeConfigCLI based the cli params and defaults.
@@ -33,6 +34,7 @@ func ParseConfigCLI() *L2ContractDeployerConfigCLI {
 	l2PrivateKey := flag.String(l2privateKeyFlag, "", flagUsageMap[l2privateKeyFlag])
 	l2HOCPrivateKey := flag.String(l2HOCPrivateKeyFlag, "", flagUsageMap[l2HOCPrivateKeyFlag])
 	l2POCPrivateKey := flag.String(l2POCPrivateKeyFlag, "", flagUsageMap[l2POCPrivateKeyFlag])
+	insecureSkipAttestation := flag.Bool(insecureSkipAttestationFlag, false, flagUsageMap[insecureSkipAttestationFlag])
 
 	flag.Parse()
 
@@ -46,6 +48,7 @@ func ParseConfigCLI() *L2ContractDeployerConfigCLI {
 	cfg.l2PrivateKey = *l2PrivateKey
 	cfg.l2HOCPrivateKey = *l2POCPrivateKey
 	cfg.l2POCPrivateKey = *l2HOCPrivateKey
+	cfg.insecureSkipAttestation = *insecureSkipAttestation
 
 	return cfg
 }
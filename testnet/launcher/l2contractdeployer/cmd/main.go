@@ -22,6 +22,7 @@ func main() {
 			l2cd.WithHocPKString(cliConfig.l2HOCPrivateKey),                      // "6e384a07a01263518a09a5424c7b6bbfc3604ba7d93f47e3a455cbdd7f9f0682"),
 			l2cd.WithPocPKString(cliConfig.l2POCPrivateKey),                      // "4bfe14725e685901c062ccd4e220c61cf9c189897b6c78bd18d7f51291b2b8f8"),
 			l2cd.WithDockerImage(cliConfig.dockerImage),
+			l2cd.WithInsecureSkipAttestation(cliConfig.insecureSkipAttestation),
 		),
 	)
 	if err != nil {
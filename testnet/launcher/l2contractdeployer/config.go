@@ -15,6 +15,8 @@ type Config struct {
 	pocPKString       string
 	messageBusAddress string
 	dockerImage       string
+
+	insecureSkipAttestation bool
 }
 
 func NewContractDeployerConfig(opts ...Option) *Config {
@@ -86,3 +88,9 @@ func WithPocPKString(s string) Option {
 		c.pocPKString = s
 	}
 }
+
+func WithInsecureSkipAttestation(b bool) Option {
+	return func(c *Config) {
+		c.insecureSkipAttestation = b
+	}
+}
@@ -44,6 +44,7 @@ func (n *ContractDeployer) Start() error {
         },
         "layer2" : {
             "obscuroEncRpcUrl" : "ws://%s:%d",
+            "obscuroInsecureSkipAttestation" : %t,
             "url": "http://127.0.0.1:3000",
             "live" : false,
             "saveDeployments" : true,
@@ -62,7 +63,7 @@ func (n *ContractDeployer) Start() error {
             ]
         }
     }
-`, n.cfg.l1Host, n.cfg.l1Port, n.cfg.l1privateKey, n.cfg.l2Host, n.cfg.l2Port, n.cfg.l2PrivateKey, n.cfg.hocPKString, n.cfg.pocPKString),
+`, n.cfg.l1Host, n.cfg.l1Port, n.cfg.l1privateKey, n.cfg.l2Host, n.cfg.l2Port, n.cfg.insecureSkipAttestation, n.cfg.l2PrivateKey, n.cfg.hocPKString, n.cfg.pocPKString),
 	}
 
 	containerID, err := docker.StartNewContainer("hh-l2-deployer", n.cfg.dockerImage, cmds, nil, envs, nil)
@@ -38,5 +38,8 @@ net_status=$(curl -s --request POST 'http://127.0.0.1:13000' \
 
 echo "Health Status: ${net_status}"
 echo ""
+echo "Liveness (/healthz): $(curl -s -o /dev/null -w '%{http_code}' 'http://127.0.0.1:13000/healthz')"
+echo "Readiness (/readyz): $(curl -s -o /dev/null -w '%{http_code}' 'http://127.0.0.1:13000/readyz')"
+echo ""
 echo "Container Status:"
 docker inspect --format "Container: {{.Name}} - Status: {{.State.Status}} {{println }}Created at: {{.Created}}{{println }}Arguments: {{println }}{{range .Args}}{{println .}}{{end}} " $(docker-compose ps -a -q)
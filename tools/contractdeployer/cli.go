@@ -20,13 +20,15 @@ var (
 // DefaultConfig stores the contract client default config
 func DefaultConfig() *Config {
 	return &Config{
-		NodeHost:          "",
-		NodePort:          0,
-		IsL1Deployment:    false,
-		PrivateKey:        "",
-		ChainID:           defaultL2ChainID,
-		ContractName:      "",
-		ConstructorParams: []string{},
+		NodeHost:                "",
+		NodePort:                0,
+		IsL1Deployment:          false,
+		PrivateKey:              "",
+		ChainID:                 defaultL2ChainID,
+		ContractName:            "",
+		ConstructorParams:       []string{},
+		AttestationPolicyPath:   "",
+		InsecureSkipAttestation: false,
 	}
 }
 
@@ -39,6 +41,10 @@ type Config struct {
 	ChainID           *big.Int // chain ID we're deploying too
 	ContractName      string   // the name of the contract to deploy (e.g. ERC20 or MGMT)
 	ConstructorParams []string // parameters sent to the constructor
+	// path to the policy the Obscuro node's attestation is checked against (required unless attestation is skipped)
+	AttestationPolicyPath string
+	// whether to skip verifying the Obscuro node's attestation, for local networks whose enclaves do not run in SGX
+	InsecureSkipAttestation bool
 }
 
 // ParseConfig returns a Config after parsing all available flags
@@ -53,6 +59,8 @@ func ParseConfig() *Config {
 	// if this flag has a non-zero value it will be used instead of the default chain IDs
 	overrideChainID := flag.Int64(chainIDName, chainIDPlaceholder, chainIDUsage)
 	constructorParams := flag.String(constructorParamsName, constructorParamsPlaceholder, constructorParamsUsage)
+	attestationPolicy := flag.String(attestationPolicyName, defaultConfig.AttestationPolicyPath, attestationPolicyUsage)
+	insecureSkipAttestation := flag.Bool(insecureSkipAttestationName, defaultConfig.InsecureSkipAttestation, insecureSkipAttestationUsage)
 
 	flag.Parse()
 
@@ -61,6 +69,8 @@ func ParseConfig() *Config {
 	defaultConfig.IsL1Deployment = *isL1Deployment
 	defaultConfig.PrivateKey = *privateKeyStr
 	defaultConfig.ContractName = *contractName
+	defaultConfig.AttestationPolicyPath = *attestationPolicy
+	defaultConfig.InsecureSkipAttestation = *insecureSkipAttestation
 
 	if defaultConfig.IsL1Deployment {
 		// for L1 deployment we default the chain ID to the L1 chain (it will still be overridden if arg was set by caller)
@@ -22,4 +22,10 @@ const (
 
 	constructorParamsName  = "constructorParams"
 	constructorParamsUsage = "A comma separated list of strings that will be passed to the deployer. Defaults to empty."
+
+	attestationPolicyName  = "attestationPolicy"
+	attestationPolicyUsage = "The path to a JSON file containing the policy the Obscuro node's attestation is checked against. Required unless insecureSkipAttestation is set"
+
+	insecureSkipAttestationName  = "insecureSkipAttestation"
+	insecureSkipAttestationUsage = "Whether to skip verifying the attestation of the Obscuro node. Only safe for local networks whose enclaves do not run in SGX"
 )
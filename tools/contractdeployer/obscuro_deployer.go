@@ -8,9 +8,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
 	"github.com/obscuronet/go-obscuro/go/enclave/genesis"
 	"github.com/obscuronet/go-obscuro/go/obsclient"
 	"github.com/obscuronet/go-obscuro/go/obsclient/clientutil"
+	"github.com/obscuronet/go-obscuro/go/rpc"
 	"github.com/obscuronet/go-obscuro/go/wallet"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -18,13 +20,18 @@ import (
 )
 
 func prepareObscuroDeployer(cfg *Config, wal wallet.Wallet, logger gethlog.Logger) (contractDeployerClient, error) {
-	client, err := connectClient(getURL(cfg), wal, logger)
+	clientOpts, err := attestationOpts(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := connectClient(getURL(cfg), wal, clientOpts, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup obscuro client - %w", err)
 	}
 
 	// todo: this step doesn't belong in the contract_deployer tool, script should fail for underfunded deployer account
-	err = fundDeployerWithFaucet(cfg, client, logger)
+	err = fundDeployerWithFaucet(cfg, client, clientOpts, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fund deployer acc from faucet - %w", err)
 	}
@@ -32,7 +39,7 @@ func prepareObscuroDeployer(cfg *Config, wal wallet.Wallet, logger gethlog.Logge
 	return &obscuroDeployer{client: client}, nil
 }
 
-func fundDeployerWithFaucet(cfg *Config, client *obsclient.AuthObsClient, logger gethlog.Logger) error {
+func fundDeployerWithFaucet(cfg *Config, client *obsclient.AuthObsClient, clientOpts []rpc.EncRPCClientOption, logger gethlog.Logger) error {
 	// Create the L2 faucet wallet and client.
 	faucetPrivKey, err := crypto.HexToECDSA(genesis.TestnetPrefundedPK)
 	if err != nil {
@@ -40,7 +47,7 @@ func fundDeployerWithFaucet(cfg *Config, client *obsclient.AuthObsClient, logger
 	}
 	faucetWallet := wallet.NewInMemoryWalletFromPK(cfg.ChainID, faucetPrivKey, logger)
 
-	faucetClient, err := connectClient(getURL(cfg), faucetWallet, logger)
+	faucetClient, err := connectClient(getURL(cfg), faucetWallet, clientOpts, logger)
 	if err != nil {
 		return err
 	}
@@ -88,14 +95,29 @@ func fundDeployerWithFaucet(cfg *Config, client *obsclient.AuthObsClient, logger
 	return nil
 }
 
-func connectClient(url string, wal wallet.Wallet, logger gethlog.Logger) (*obsclient.AuthObsClient, error) {
+// Returns the options for checking the Obscuro node's attestation before a viewing key is registered with it.
+func attestationOpts(cfg *Config) ([]rpc.EncRPCClientOption, error) {
+	if cfg.InsecureSkipAttestation {
+		return []rpc.EncRPCClientOption{rpc.WithInsecureSkipAttestation(true)}, nil
+	}
+	if cfg.AttestationPolicyPath == "" {
+		return nil, fmt.Errorf("an attestation policy is required to check the Obscuro node's attestation, unless attestation is skipped")
+	}
+	policy, err := attestation.LoadPolicy(cfg.AttestationPolicyPath)
+	if err != nil {
+		return nil, err
+	}
+	return []rpc.EncRPCClientOption{rpc.WithAttestationPolicy(policy)}, nil
+}
+
+func connectClient(url string, wal wallet.Wallet, clientOpts []rpc.EncRPCClientOption, logger gethlog.Logger) (*obsclient.AuthObsClient, error) {
 	var client *obsclient.AuthObsClient
 	var err error
 
 	startConnectingTime := time.Now()
 	// since the nodes we are connecting to may have only just started, we retry connection until it is successful
 	for client == nil && time.Since(startConnectingTime) < timeoutWait {
-		client, err = obsclient.DialWithAuth(url, wal, logger)
+		client, err = obsclient.DialWithAuth(url, wal, logger, clientOpts...)
 		if err == nil {
 			break // success
 		}
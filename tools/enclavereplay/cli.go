@@ -0,0 +1,77 @@
+package enclavereplay
+
+import (
+	"flag"
+	"os"
+
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Flag names and usages.
+	recordingName  = "recording"
+	recordingUsage = "The path to the recording made by the host (see the host's enclaveRecordingPath flag)"
+
+	sequencerIDName  = "sequencerID"
+	sequencerIDUsage = "The address of the sequencer whose batches are replayed. Defaults to the recording host if it is the sequencer"
+
+	messageBusAddressName  = "messageBusAddress"
+	messageBusAddressUsage = "The address of the L1 message bus contract, as configured on the recording node's enclave"
+
+	obscuroGenesisName  = "obscuroGenesis"
+	obscuroGenesisUsage = "The json string with the obscuro genesis, as configured on the recording node's enclave"
+
+	logLevelName  = "logLevel"
+	logLevelUsage = "The verbosity level of the replaying enclave's logs. (Defaults to Info)"
+
+	logPathName  = "logPath"
+	logPathUsage = "The path to use for the replaying enclave's log file (Defaults to stdout)"
+)
+
+// Config is the configuration of a replay
+type Config struct {
+	RecordingPath     string
+	SequencerID       gethcommon.Address
+	MessageBusAddress gethcommon.Address
+	ObscuroGenesis    string
+	LogLevel          int
+	LogPath           string
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		LogLevel: int(gethlog.LvlInfo),
+		LogPath:  log.SysOut,
+	}
+}
+
+// ParseConfig returns a Config based on the command line flags
+func ParseConfig() *Config {
+	cfg := defaultConfig()
+
+	recording := flag.String(recordingName, cfg.RecordingPath, recordingUsage)
+	sequencerID := flag.String(sequencerIDName, "", sequencerIDUsage)
+	messageBusAddress := flag.String(messageBusAddressName, cfg.MessageBusAddress.Hex(), messageBusAddressUsage)
+	obscuroGenesis := flag.String(obscuroGenesisName, cfg.ObscuroGenesis, obscuroGenesisUsage)
+	logLevel := flag.Int(logLevelName, cfg.LogLevel, logLevelUsage)
+	logPath := flag.String(logPathName, cfg.LogPath, logPathUsage)
+	flag.Parse()
+
+	if *recording == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg.RecordingPath = *recording
+	if *sequencerID != "" {
+		cfg.SequencerID = gethcommon.HexToAddress(*sequencerID)
+	}
+	cfg.MessageBusAddress = gethcommon.HexToAddress(*messageBusAddress)
+	cfg.ObscuroGenesis = *obscuroGenesis
+	cfg.LogLevel = *logLevel
+	cfg.LogPath = *logPath
+	return cfg
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/tools/enclavereplay"
+)
+
+func main() {
+	cfg := enclavereplay.ParseConfig()
+	logger := log.New(log.EnclaveReplayCmp, cfg.LogLevel, cfg.LogPath)
+
+	result, err := enclavereplay.Replay(cfg, logger)
+	if err != nil {
+		fmt.Printf("Could not replay %s. Cause: %s\n", cfg.RecordingPath, err)
+		os.Exit(1)
+	}
+
+	if result.Divergence != nil {
+		fmt.Printf("Replayed %d inputs from %d sessions, then %s\n", result.Replayed, result.Sessions, result.Divergence)
+		os.Exit(1)
+	}
+	fmt.Printf("Replayed %d inputs from %d sessions, the enclave behaved as recorded.\n", result.Replayed, result.Sessions)
+}
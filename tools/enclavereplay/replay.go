@@ -0,0 +1,191 @@
+package enclavereplay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/enclaverecording"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/config"
+	"github.com/obscuronet/go-obscuro/go/enclave"
+	"github.com/obscuronet/go-obscuro/go/enclave/genesis"
+	"github.com/obscuronet/go-obscuro/go/ethadapter/mgmtcontractlib"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+// Divergence describes the first recorded input for which the replaying enclave did not behave as recorded
+type Divergence struct {
+	Index    int                        // position of the input in the recording (session starts are not counted)
+	Kind     enclaverecording.EntryKind // the kind of input
+	Input    string                     // a description of the input (e.g. the L1 block or batch number and hash)
+	Recorded string                     // the outcome observed when the input was recorded
+	Replayed string                     // the outcome observed when the input was replayed
+}
+
+func (d *Divergence) String() string {
+	return fmt.Sprintf("input %d (%s %s) diverged:\n  recorded: %s\n  replayed: %s", d.Index, d.Kind, d.Input, d.Recorded, d.Replayed)
+}
+
+// Result summarises a replay
+type Result struct {
+	Sessions   int         // number of recording sessions (host starts) replayed
+	Replayed   int         // number of inputs replayed
+	Divergence *Divergence // the first divergence, nil if the enclave behaved as recorded throughout
+}
+
+// Replay boots a validator enclave with an in-memory DB and submits the recorded inputs to it in order. It stops at the
+// first input whose outcome (acceptance, rejection or error) differs from the recorded one. Batches are validated by
+// re-executing them, so a batch whose state root differs from the replayed state is reported as a divergence.
+func Replay(cfg *Config, logger gethlog.Logger) (*Result, error) {
+	return replay(cfg, nil, logger)
+}
+
+// replay allows the management contract lib to be swapped out, if nil the lib for the recorded management contract is used
+func replay(cfg *Config, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger) (*Result, error) {
+	reader, err := enclaverecording.OpenRecording(cfg.RecordingPath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	first, err := reader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("could not read recording session. Cause: %w", err)
+	}
+	if first.Kind != enclaverecording.SessionStart {
+		return nil, fmt.Errorf("recording does not start with a session but with a %s", first.Kind)
+	}
+
+	enclaveConfig, err := replayEnclaveConfig(cfg, first.Session)
+	if err != nil {
+		return nil, err
+	}
+	obscuroGenesis, err := genesis.New(enclaveConfig.ObscuroGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse obscuro genesis. Cause: %w", err)
+	}
+	if mgmtContractLib == nil {
+		mgmtContractLib = mgmtcontractlib.NewMgmtContractLib(&enclaveConfig.ManagementContractAddress, logger)
+	}
+
+	enclaveLogger := logger.New(log.CmpKey, log.EnclaveCmp)
+	replayEnclave := enclave.NewEnclave(enclaveConfig, obscuroGenesis, mgmtContractLib, enclaveLogger)
+	defer func() {
+		if err := replayEnclave.Stop(); err != nil {
+			logger.Warn("Could not stop replay enclave.", log.ErrKey, err)
+		}
+	}()
+	// the recorded enclave's secret cannot be recovered, so EVM randomness derived from it will not match the recording
+	if _, err = replayEnclave.GenerateSecret(); err != nil {
+		return nil, fmt.Errorf("could not generate enclave secret. Cause: %w", err)
+	}
+
+	result := &Result{Sessions: 1}
+	for {
+		entry, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if entry.Kind == enclaverecording.SessionStart {
+			result.Sessions++
+			logger.Info(fmt.Sprintf("Replaying session %d, recorded by host %s", result.Sessions, entry.Session.HostID))
+			continue
+		}
+
+		divergence, err := replayEntry(replayEnclave, entry)
+		if err != nil {
+			return nil, fmt.Errorf("could not replay input %d. Cause: %w", result.Replayed, err)
+		}
+		if divergence != nil {
+			divergence.Index = result.Replayed
+			result.Divergence = divergence
+			return result, nil
+		}
+		result.Replayed++
+	}
+}
+
+// replayEnclaveConfig returns the config of the replaying enclave, it is always a validator so that the batches produced
+// by a sequencer are checked rather than re-produced (the sequencer's mempool is not recorded)
+func replayEnclaveConfig(cfg *Config, session *enclaverecording.Session) (config.EnclaveConfig, error) {
+	enclaveConfig := config.DefaultEnclaveConfig()
+	enclaveConfig.HostID = session.HostID
+	enclaveConfig.NodeType = common.Validator
+	enclaveConfig.L1ChainID = int64(session.L1ChainID)
+	enclaveConfig.ObscuroChainID = int64(session.ObscuroChainID)
+	enclaveConfig.ManagementContractAddress = session.ManagementContractAddress
+	enclaveConfig.UseInMemoryDB = true
+	enclaveConfig.MessageBusAddress = cfg.MessageBusAddress
+	enclaveConfig.ObscuroGenesis = cfg.ObscuroGenesis
+	enclaveConfig.LogLevel = cfg.LogLevel
+	enclaveConfig.LogPath = cfg.LogPath
+
+	enclaveConfig.SequencerID = cfg.SequencerID
+	if enclaveConfig.SequencerID == (gethcommon.Address{}) {
+		if session.NodeType != common.Sequencer.String() {
+			return enclaveConfig, fmt.Errorf("the recording was made by a %s, the sequencer ID must be provided", session.NodeType)
+		}
+		enclaveConfig.SequencerID = session.HostID
+	}
+	return enclaveConfig, nil
+}
+
+// replayEntry submits a recorded input to the enclave and returns a divergence if the outcome differs from the recorded one
+func replayEntry(replayEnclave common.Enclave, entry *enclaverecording.Entry) (*Divergence, error) {
+	var input string
+	var replayErr error
+	switch entry.Kind {
+	case enclaverecording.L1Block:
+		block, err := entry.DecodeBlock()
+		if err != nil {
+			return nil, err
+		}
+		receipts, err := entry.DecodeReceipts()
+		if err != nil {
+			return nil, err
+		}
+		input = fmt.Sprintf("b_%d, height=%d, hash=%s", common.ShortHash(block.Hash()), block.NumberU64(), block.Hash())
+		_, replayErr = replayEnclave.SubmitL1Block(*block, receipts, entry.IsLatest)
+
+	case enclaverecording.Batch, enclaverecording.ProducedBatch:
+		batch, err := entry.DecodeBatch()
+		if err != nil {
+			return nil, err
+		}
+		input = fmt.Sprintf("batch %d, hash=%s, root=%s", batch.Header.Number, batch.Hash(), batch.Header.Root)
+		replayErr = replayEnclave.SubmitBatch(batch)
+
+	default:
+		return nil, fmt.Errorf("unknown recording entry kind %s", entry.Kind)
+	}
+
+	replayed := enclaverecording.NewOutcome(replayErr)
+	if !outcomesDiverge(entry.Outcome, replayed) {
+		return nil, nil //nolint:nilnil
+	}
+	return &Divergence{Kind: entry.Kind, Input: input, Recorded: entry.Outcome.String(), Replayed: replayed.String()}, nil
+}
+
+// outcomesDiverge returns whether the replayed outcome of an input differs from the recorded one. The recorded error
+// went through the enclave's RPC layer, which wraps it, so the errors match if the recorded one contains the replayed one.
+func outcomesDiverge(recorded enclaverecording.Outcome, replayed enclaverecording.Outcome) bool {
+	switch {
+	case recorded.Accepted() || replayed.Accepted():
+		return recorded.Accepted() != replayed.Accepted()
+	case recorded.Rejected != replayed.Rejected:
+		return true
+	case recorded.Rejected && recorded.RejectL1Head != replayed.RejectL1Head:
+		return true
+	default:
+		return !strings.Contains(recorded.Err, replayed.Err)
+	}
+}
@@ -0,0 +1,130 @@
+package enclavereplay
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/enclaverecording"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/config"
+	"github.com/obscuronet/go-obscuro/go/enclave"
+	"github.com/obscuronet/go-obscuro/go/enclave/genesis"
+	"github.com/obscuronet/go-obscuro/integration/ethereummock"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	testHostID      = gethcommon.HexToAddress("0x1")
+	testSequencerID = gethcommon.HexToAddress("0x2")
+)
+
+func TestReplayMatchesRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording")
+	recordingEnclave := newRecordingEnclave(t, path)
+
+	b1 := ethereummock.NewBlock(ethereummock.MockGenesisBlock, testHostID, nil)
+	b2 := ethereummock.NewBlock(b1, testHostID, nil)
+	for _, block := range []*types.Block{ethereummock.MockGenesisBlock, b1, b2, b2} {
+		// b2 is submitted twice, the second submission is expected to be rejected
+		_, _ = recordingEnclave.SubmitL1Block(*block, types.Receipts{}, true)
+	}
+	if err := recordingEnclave.StopClient(); err != nil {
+		t.Fatalf("could not stop recording. Cause: %s", err)
+	}
+
+	result, err := replay(&Config{RecordingPath: path, SequencerID: testSequencerID}, ethereummock.NewMgmtContractLibMock(), testLogger())
+	if err != nil {
+		t.Fatalf("could not replay recording. Cause: %s", err)
+	}
+	if result.Divergence != nil {
+		t.Fatalf("expected replay to match the recording, got %s", result.Divergence)
+	}
+	if result.Replayed != 4 {
+		t.Errorf("expected 4 inputs to be replayed, got %d", result.Replayed)
+	}
+}
+
+func TestReplayReportsFirstDivergence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording")
+	recorder, err := enclaverecording.NewRecorder(path, enclaverecording.Session{HostID: testHostID, NodeType: common.Validator.String()})
+	if err != nil {
+		t.Fatalf("could not create recorder. Cause: %s", err)
+	}
+
+	b1 := ethereummock.NewBlock(ethereummock.MockGenesisBlock, testHostID, nil)
+	// b1 is recorded as accepted twice, but the replaying enclave will reject it the second time as already processed
+	for _, block := range []*types.Block{ethereummock.MockGenesisBlock, b1, b1} {
+		entry, err := enclaverecording.NewL1BlockEntry(*block, types.Receipts{}, true, nil)
+		if err != nil {
+			t.Fatalf("could not create entry. Cause: %s", err)
+		}
+		if err = recorder.Record(entry); err != nil {
+			t.Fatalf("could not record entry. Cause: %s", err)
+		}
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("could not close recorder. Cause: %s", err)
+	}
+
+	result, err := replay(&Config{RecordingPath: path, SequencerID: testSequencerID}, ethereummock.NewMgmtContractLibMock(), testLogger())
+	if err != nil {
+		t.Fatalf("could not replay recording. Cause: %s", err)
+	}
+	if result.Divergence == nil {
+		t.Fatalf("expected the replay to diverge from the recording")
+	}
+	if result.Divergence.Index != 2 || result.Divergence.Kind != enclaverecording.L1Block {
+		t.Errorf("expected the duplicate L1 block (input 2) to diverge, got %s", result.Divergence)
+	}
+}
+
+func TestReplayRequiresSequencerIDForValidatorRecordings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording")
+	recorder, err := enclaverecording.NewRecorder(path, enclaverecording.Session{HostID: testHostID, NodeType: common.Validator.String()})
+	if err != nil {
+		t.Fatalf("could not create recorder. Cause: %s", err)
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("could not close recorder. Cause: %s", err)
+	}
+
+	if _, err = replay(&Config{RecordingPath: path}, ethereummock.NewMgmtContractLibMock(), testLogger()); err == nil {
+		t.Errorf("expected replay of a validator recording without a sequencer ID to fail")
+	}
+}
+
+func newRecordingEnclave(t *testing.T, path string) common.Enclave {
+	enclaveConfig := config.DefaultEnclaveConfig()
+	enclaveConfig.HostID = testHostID
+	enclaveConfig.NodeType = common.Validator
+	enclaveConfig.SequencerID = testSequencerID
+	enclaveConfig.LogLevel = int(gethlog.LvlError)
+
+	obscuroGenesis, err := genesis.New("")
+	if err != nil {
+		t.Fatalf("could not create genesis. Cause: %s", err)
+	}
+	recordedEnclave := enclave.NewEnclave(enclaveConfig, obscuroGenesis, ethereummock.NewMgmtContractLibMock(), testLogger())
+	if _, err = recordedEnclave.GenerateSecret(); err != nil {
+		t.Fatalf("could not generate secret. Cause: %s", err)
+	}
+
+	recorder, err := enclaverecording.NewRecorder(path, enclaverecording.Session{
+		HostID:         testHostID,
+		NodeType:       common.Validator.String(),
+		L1ChainID:      uint64(enclaveConfig.L1ChainID),
+		ObscuroChainID: uint64(enclaveConfig.ObscuroChainID),
+	})
+	if err != nil {
+		t.Fatalf("could not create recorder. Cause: %s", err)
+	}
+	return enclaverecording.NewRecordingEnclave(recordedEnclave, recorder, testLogger())
+}
+
+func testLogger() gethlog.Logger {
+	return log.New(log.EnclaveReplayCmp, int(gethlog.LvlError), log.SysOut)
+}
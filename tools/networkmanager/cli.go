@@ -54,6 +54,12 @@ const (
 
 	erc20TokenName  = "erc20Token" //nolint:gosec
 	erc20TokenUsage = "The name of the ERC20 token. Default: TST"
+
+	attestationPolicyName  = "attestationPolicy"
+	attestationPolicyUsage = "The path to a JSON file containing the policy the Obscuro node's attestation is checked against. Required unless insecureSkipAttestation is set"
+
+	insecureSkipAttestationName  = "insecureSkipAttestation"
+	insecureSkipAttestationUsage = "Whether to skip verifying the attestation of the Obscuro node. Only safe for local networks whose enclaves do not run in SGX"
 )
 
 type Config struct {
@@ -68,6 +74,10 @@ type Config struct {
 	erc20ContractAddress common.Address
 	obscuroClientAddress string
 	erc20Token           string
+	// path to the policy the Obscuro node's attestation is checked against (required unless attestation is skipped)
+	attestationPolicyPath string
+	// whether to skip verifying the Obscuro node's attestation, for local networks whose enclaves do not run in SGX
+	insecureSkipAttestation bool
 }
 
 func defaultNetworkManagerConfig() Config {
@@ -76,13 +86,15 @@ func defaultNetworkManagerConfig() Config {
 		l1NodeWebsocketPort: 9000,
 		l1RPCTimeout:        time.Duration(defaultL1RPCTimeoutSecs) * time.Second,
 		// Default chosen to not conflict with default private key used by host.
-		privateKeys:          []string{"0000000000000000000000000000000000000000000000000000000000000002"},
-		l1ChainID:            integration.EthereumChainID,
-		obscuroChainID:       integration.ObscuroChainID,
-		mgmtContractAddress:  common.BytesToAddress([]byte("")),
-		erc20ContractAddress: common.BytesToAddress([]byte("")),
-		obscuroClientAddress: "127.0.0.1:13001",
-		erc20Token:           "TST",
+		privateKeys:             []string{"0000000000000000000000000000000000000000000000000000000000000002"},
+		l1ChainID:               integration.EthereumChainID,
+		obscuroChainID:          integration.ObscuroChainID,
+		mgmtContractAddress:     common.BytesToAddress([]byte("")),
+		erc20ContractAddress:    common.BytesToAddress([]byte("")),
+		obscuroClientAddress:    "127.0.0.1:13001",
+		erc20Token:              "TST",
+		attestationPolicyPath:   "",
+		insecureSkipAttestation: false,
 	}
 }
 
@@ -100,6 +112,8 @@ func ParseCLIArgs() (Config, []string) {
 	erc20ContractAddress := flag.String(erc20ContractAddressName, defaultConfig.erc20ContractAddress.Hex(), erc20ContractAddressUsage)
 	obscuroClientAddress := flag.String(obscuroClientAddressName, defaultConfig.obscuroClientAddress, obscuroClientAddressUsage)
 	erc20Token := flag.String(erc20TokenName, defaultConfig.obscuroClientAddress, erc20TokenUsage)
+	attestationPolicy := flag.String(attestationPolicyName, defaultConfig.attestationPolicyPath, attestationPolicyUsage)
+	insecureSkipAttestation := flag.Bool(insecureSkipAttestationName, defaultConfig.insecureSkipAttestation, insecureSkipAttestationUsage)
 
 	flag.Parse()
 
@@ -113,6 +127,8 @@ func ParseCLIArgs() (Config, []string) {
 	defaultConfig.erc20ContractAddress = common.HexToAddress(*erc20ContractAddress)
 	defaultConfig.obscuroClientAddress = *obscuroClientAddress
 	defaultConfig.erc20Token = *erc20Token
+	defaultConfig.attestationPolicyPath = *attestationPolicy
+	defaultConfig.insecureSkipAttestation = *insecureSkipAttestation
 
 	command := flag.Arg(0)
 	var args []string
@@ -10,6 +10,7 @@ import (
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
 	"github.com/obscuronet/go-obscuro/go/obsclient"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -56,7 +57,7 @@ func InjectTransactions(cfg Config, args []string, logger gethlog.Logger) {
 	avgBlockDuration := time.Second
 
 	wallets := createWallets(cfg, l1Client, l2Client, logger)
-	walletClients := createWalletRPCClients(wallets, cfg.obscuroClientAddress, logger)
+	walletClients := createWalletRPCClients(wallets, cfg.obscuroClientAddress, attestationOpts(cfg), logger)
 
 	rpcHandles := &network.RPCHandles{
 		EthClients:     []ethadapter.EthClient{l1Client},
@@ -90,8 +91,23 @@ func InjectTransactions(cfg Config, args []string, logger gethlog.Logger) {
 	os.Exit(0)
 }
 
+// attestationOpts returns the options for checking the Obscuro node's attestation before viewing keys are registered with it
+func attestationOpts(cfg Config) []rpc.EncRPCClientOption {
+	if cfg.insecureSkipAttestation {
+		return []rpc.EncRPCClientOption{rpc.WithInsecureSkipAttestation(true)}
+	}
+	if cfg.attestationPolicyPath == "" {
+		panic("an attestation policy is required to check the Obscuro node's attestation, unless attestation is skipped")
+	}
+	policy, err := attestation.LoadPolicy(cfg.attestationPolicyPath)
+	if err != nil {
+		panic(err)
+	}
+	return []rpc.EncRPCClientOption{rpc.WithAttestationPolicy(policy)}
+}
+
 // createWalletRPCClients creates map of wallet address to list of wallet clients (of length 1 because we have 1 node)
-func createWalletRPCClients(wallets *params.SimWallets, obscuroNodeAddr string, logger gethlog.Logger) map[string][]*obsclient.AuthObsClient {
+func createWalletRPCClients(wallets *params.SimWallets, obscuroNodeAddr string, clientOpts []rpc.EncRPCClientOption, logger gethlog.Logger) map[string][]*obsclient.AuthObsClient {
 	clients := make(map[string][]*obsclient.AuthObsClient)
 
 	for _, w := range wallets.SimObsWallets {
@@ -99,7 +115,7 @@ func createWalletRPCClients(wallets *params.SimWallets, obscuroNodeAddr string,
 		if err != nil {
 			panic(err)
 		}
-		client, err := rpc.NewEncNetworkClient(obscuroNodeAddr, vk, logger)
+		client, err := rpc.NewEncNetworkClient(obscuroNodeAddr, vk, logger, clientOpts...)
 		if err != nil {
 			panic(err)
 		}
@@ -113,7 +129,7 @@ func createWalletRPCClients(wallets *params.SimWallets, obscuroNodeAddr string,
 		if err != nil {
 			panic(err)
 		}
-		client, err := rpc.NewEncNetworkClient(obscuroNodeAddr, vk, logger)
+		client, err := rpc.NewEncNetworkClient(obscuroNodeAddr, vk, logger, clientOpts...)
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,228 @@
+package obscuroscan
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/obsclient"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	defaultIndexerPollInterval = time.Second
+	maxBlockWalkBack           = 64 // The maximum number of unindexed ancestors of an L1 block indexed along with it
+)
+
+// The source of the data indexed by the Indexer.
+type nodeSource interface {
+	HeadBatchNumber() (uint64, error)
+	BatchByNumber(number uint64) (*common.ExtBatch, error)
+	BlockHeader(hash gethcommon.Hash) (*types.Header, error)
+	RollupsForBlock(hash gethcommon.Hash) ([]*common.PublicRollup, error)
+}
+
+// A nodeSource that retrieves data from a host using the `obscuroscan_*` RPC methods.
+type rpcNodeSource struct {
+	client    rpc.Client
+	obsClient *obsclient.ObsClient
+}
+
+func (r *rpcNodeSource) HeadBatchNumber() (uint64, error) {
+	return r.obsClient.RollupNumber()
+}
+
+func (r *rpcNodeSource) BatchByNumber(number uint64) (*common.ExtBatch, error) {
+	header, err := r.obsClient.RollupHeaderByNumber(big.NewInt(int64(number)))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve header of batch %d. Cause: %w", number, err)
+	}
+
+	var batch *common.ExtBatch
+	if err = r.client.Call(&batch, rpc.GetBatch, header.Hash()); err != nil {
+		return nil, fmt.Errorf("could not retrieve batch %d. Cause: %w", number, err)
+	}
+	if batch == nil || batch.Header == nil {
+		return nil, fmt.Errorf("retrieved batch %d had a nil header", number)
+	}
+	return batch, nil
+}
+
+func (r *rpcNodeSource) BlockHeader(hash gethcommon.Hash) (*types.Header, error) {
+	var header *types.Header
+	if err := r.client.Call(&header, rpc.GetBlockHeaderByHash, hash); err != nil {
+		return nil, fmt.Errorf("could not retrieve block %s. Cause: %w", hash, err)
+	}
+	if header == nil {
+		return nil, fmt.Errorf("retrieved block %s was nil", hash)
+	}
+	return header, nil
+}
+
+func (r *rpcNodeSource) RollupsForBlock(hash gethcommon.Hash) ([]*common.PublicRollup, error) {
+	var rollups []*common.PublicRollup
+	if err := r.client.Call(&rollups, rpc.GetRollupsForBlock, hash); err != nil {
+		return nil, fmt.Errorf("could not retrieve rollups for block %s. Cause: %w", hash, err)
+	}
+	return rollups, nil
+}
+
+// Indexer follows a node's batches, and stores them along with their transactions, the L1 blocks they were produced
+// against and the rollups published in those blocks.
+type Indexer struct {
+	source       nodeSource
+	db           *indexerDB
+	pollInterval time.Duration
+	logger       gethlog.Logger
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newIndexer(source nodeSource, db *indexerDB, pollInterval time.Duration, logger gethlog.Logger) *Indexer {
+	if pollInterval == 0 {
+		pollInterval = defaultIndexerPollInterval
+	}
+	return &Indexer{
+		source:       source,
+		db:           db,
+		pollInterval: pollInterval,
+		logger:       logger,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start begins indexing in the background.
+func (i *Indexer) Start() {
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		ticker := time.NewTicker(i.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := i.indexNewBatches(); err != nil {
+				i.logger.Warn("Could not index new batches.", log.ErrKey, err)
+			}
+
+			select {
+			case <-i.stopCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops indexing, and waits for the current indexing round to complete.
+func (i *Indexer) Stop() {
+	close(i.stopCh)
+	i.wg.Wait()
+}
+
+// Indexes the batches from the last indexed batch up to the node's head batch.
+func (i *Indexer) indexNewBatches() error {
+	headNumber, err := i.source.HeadBatchNumber()
+	if err != nil {
+		return fmt.Errorf("could not retrieve head batch number. Cause: %w", err)
+	}
+	nextNumber, err := i.db.nextBatchNumber()
+	if err != nil {
+		return err
+	}
+
+	for nextNumber <= headNumber {
+		select {
+		case <-i.stopCh:
+			return nil
+		default:
+		}
+
+		batch, err := i.source.BatchByNumber(nextNumber)
+		if err != nil {
+			return err
+		}
+
+		// If the batch does not follow on from the last indexed batch, the L2 chain has been reorganised, and we
+		// re-index from the last indexed batch.
+		if nextNumber > 0 {
+			parent, err := i.db.getBatchByNumber(nextNumber - 1)
+			if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+				return err
+			}
+			if parent != nil && parent.Hash != batch.Header.ParentHash {
+				i.logger.Info(fmt.Sprintf("Batch %d was reorganised out. Re-indexing from it.", nextNumber-1))
+				if err = i.db.deleteBatchesFrom(nextNumber - 1); err != nil {
+					return err
+				}
+				nextNumber--
+				continue
+			}
+		}
+
+		if err = i.indexBlock(batch.Header.L1Proof); err != nil {
+			return err
+		}
+		if err = i.db.addBatch(batch); err != nil {
+			return err
+		}
+		nextNumber++
+	}
+	return nil
+}
+
+// Indexes the L1 block with the given hash and the rollups published in it, along with any of its unindexed ancestors
+// (up to a maximum depth), since rollups may have been published in blocks no batch was produced against.
+func (i *Indexer) indexBlock(hash gethcommon.Hash) error {
+	if hash == (gethcommon.Hash{}) {
+		return nil
+	}
+	found, err := i.db.hasBlock(hash)
+	if err != nil || found {
+		return err
+	}
+
+	header, err := i.source.BlockHeader(hash)
+	if err != nil {
+		return err
+	}
+	unindexed := []*types.Header{header}
+	for depth := 0; depth < maxBlockWalkBack; depth++ {
+		parentHash := unindexed[len(unindexed)-1].ParentHash
+		found, err = i.db.hasBlock(parentHash)
+		if err != nil {
+			return err
+		}
+		if found || parentHash == (gethcommon.Hash{}) {
+			break
+		}
+		// The node may not hold the blocks from before it started following the L1.
+		parent, err := i.source.BlockHeader(parentHash)
+		if err != nil {
+			break
+		}
+		unindexed = append(unindexed, parent)
+	}
+
+	// We index the blocks oldest first, so that an interrupted walk is resumed from the right place.
+	for idx := len(unindexed) - 1; idx >= 0; idx-- {
+		block := unindexed[idx]
+		rollups, err := i.source.RollupsForBlock(block.Hash())
+		if err != nil {
+			return err
+		}
+		if err = i.db.addBlock(block, rollups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package obscuroscan
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	_ "github.com/mattn/go-sqlite3" // this imports the sqlite driver to make the sql.Open() connection work
+)
+
+const (
+	inMemoryDB = ":memory:"
+
+	// Hashes and addresses are stored as hex strings.
+	createTablesQry = `
+create table if not exists batches (
+    number      integer primary key,
+    hash        text unique not null,
+    parent_hash text not null,
+    l1_block    text not null,
+    sequencer   text not null,
+    timestamp   integer not null,
+    tx_count    integer not null
+);
+create table if not exists transactions (
+    hash         text primary key,
+    batch_number integer not null references batches (number) on delete cascade
+);
+create index if not exists transactions_batch_number on transactions (batch_number);
+create table if not exists l1_blocks (
+    hash        text primary key,
+    number      integer not null,
+    parent_hash text not null,
+    timestamp   integer not null
+);
+create index if not exists l1_blocks_number on l1_blocks (number);
+create table if not exists rollups (
+    hash            text primary key,
+    number          integer not null,
+    l1_block        text not null references l1_blocks (hash),
+    sequencer       text not null,
+    timestamp       integer not null,
+    head_batch_hash text not null,
+    batch_count     integer not null
+);
+create index if not exists rollups_l1_block on rollups (l1_block);
+create table if not exists rollup_batches (
+    rollup_hash text not null references rollups (hash),
+    batch_hash  text not null,
+    position    integer not null,
+    primary key (rollup_hash, position)
+);`
+
+	batchColumns  = "b.number, b.hash, b.parent_hash, b.l1_block, b.sequencer, b.timestamp, b.tx_count"
+	rollupColumns = "r.hash, r.number, r.l1_block, l.number, r.sequencer, r.timestamp, r.head_batch_hash, r.batch_count"
+	blockColumns  = "l.hash, l.number, l.parent_hash, l.timestamp, (select count(*) from rollups r where r.l1_block = l.hash)"
+)
+
+// BatchSummary is the indexed information about a batch.
+type BatchSummary struct {
+	Number     uint64             `json:"number"`
+	Hash       gethcommon.Hash    `json:"hash"`
+	ParentHash gethcommon.Hash    `json:"parentHash"`
+	L1Block    gethcommon.Hash    `json:"l1Block"`
+	Sequencer  gethcommon.Address `json:"sequencer"`
+	Timestamp  uint64             `json:"timestamp"`
+	TxCount    uint64             `json:"txCount"`
+}
+
+// RollupSummary is the indexed information about a rollup published to the L1.
+type RollupSummary struct {
+	Hash          gethcommon.Hash    `json:"hash"`
+	Number        uint64             `json:"number"`
+	L1Block       gethcommon.Hash    `json:"l1Block"`
+	L1BlockNumber uint64             `json:"l1BlockNumber"`
+	Sequencer     gethcommon.Address `json:"sequencer"`
+	Timestamp     uint64             `json:"timestamp"`
+	HeadBatchHash gethcommon.Hash    `json:"headBatchHash"`
+	BatchCount    uint64             `json:"batchCount"`
+}
+
+// BlockSummary is the indexed information about an L1 block.
+type BlockSummary struct {
+	Hash        gethcommon.Hash `json:"hash"`
+	Number      uint64          `json:"number"`
+	ParentHash  gethcommon.Hash `json:"parentHash"`
+	Timestamp   uint64          `json:"timestamp"`
+	RollupCount uint64          `json:"rollupCount"`
+}
+
+// TransactionSummary is the indexed information about a transaction.
+type TransactionSummary struct {
+	Hash        gethcommon.Hash `json:"hash"`
+	BatchNumber uint64          `json:"batchNumber"`
+	BatchHash   gethcommon.Hash `json:"batchHash"`
+	Timestamp   uint64          `json:"timestamp"`
+}
+
+// SequencerStats are the statistics about the batches produced by a sequencer.
+type SequencerStats struct {
+	Address          gethcommon.Address `json:"address"`
+	Batches          uint64             `json:"batches"`
+	Transactions     uint64             `json:"transactions"`
+	Rollups          uint64             `json:"rollups"`
+	LastBatchNumber  uint64             `json:"lastBatchNumber"`
+	LastBatchTime    uint64             `json:"lastBatchTime"`
+	AvgBatchInterval float64            `json:"avgBatchInterval"` // The average number of seconds between batches
+}
+
+// Stats are the statistics about the indexed data.
+type Stats struct {
+	Batches      uint64            `json:"batches"`
+	Transactions uint64            `json:"transactions"`
+	Rollups      uint64            `json:"rollups"`
+	L1Blocks     uint64            `json:"l1Blocks"`
+	Sequencers   []*SequencerStats `json:"sequencers"`
+}
+
+// Page is a page of a list of items, ordered from the most recent item.
+type Page struct {
+	Total  uint64      `json:"total"`  // The total number of items in the list
+	Offset uint64      `json:"offset"` // The position in the list of the first item in the page
+	Size   uint64      `json:"size"`   // The maximum number of items in the page
+	Items  interface{} `json:"items"`
+}
+
+// The embedded database holding the data indexed by the Indexer.
+type indexerDB struct {
+	db *sql.DB
+}
+
+// Opens the indexer database at the given path, creating it if needed. If the path is empty, an in-memory database is
+// used.
+func newIndexerDB(dbPath string) (*indexerDB, error) {
+	if dbPath == "" {
+		dbPath = inMemoryDB
+	}
+	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=on")
+	if err != nil {
+		return nil, fmt.Errorf("could not open indexer database. Cause: %w", err)
+	}
+	// SQLite does not support concurrent writes, and each connection to an in-memory database has its own database.
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec(createTablesQry); err != nil {
+		return nil, fmt.Errorf("could not create indexer database tables. Cause: %w", err)
+	}
+	return &indexerDB{db: db}, nil
+}
+
+func (i *indexerDB) close() error {
+	return i.db.Close()
+}
+
+// Returns the number of the next batch to index.
+func (i *indexerDB) nextBatchNumber() (uint64, error) {
+	var maxNumber sql.NullInt64
+	if err := i.db.QueryRow("select max(number) from batches").Scan(&maxNumber); err != nil {
+		return 0, fmt.Errorf("could not retrieve latest indexed batch. Cause: %w", err)
+	}
+	if !maxNumber.Valid {
+		return 0, nil
+	}
+	return uint64(maxNumber.Int64) + 1, nil
+}
+
+// Stores the batch and its transactions.
+func (i *indexerDB) addBatch(batch *common.ExtBatch) error {
+	tx, err := i.db.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin database transaction. Cause: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	header := batch.Header
+	_, err = tx.Exec("insert into batches values (?, ?, ?, ?, ?, ?, ?)", header.Number.Int64(), batch.Hash().Hex(),
+		header.ParentHash.Hex(), header.L1Proof.Hex(), header.Agg.Hex(), header.Time, len(batch.TxHashes))
+	if err != nil {
+		return fmt.Errorf("could not store batch %d. Cause: %w", header.Number, err)
+	}
+	for _, txHash := range batch.TxHashes {
+		if _, err = tx.Exec("insert into transactions values (?, ?)", txHash.Hex(), header.Number.Int64()); err != nil {
+			return fmt.Errorf("could not store transaction %s. Cause: %w", txHash, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Deletes the batches from the given number onwards, along with their transactions.
+func (i *indexerDB) deleteBatchesFrom(number uint64) error {
+	if _, err := i.db.Exec("delete from batches where number >= ?", number); err != nil {
+		return fmt.Errorf("could not delete batches from number %d. Cause: %w", number, err)
+	}
+	return nil
+}
+
+// Indicates whether the L1 block with the given hash has been indexed.
+func (i *indexerDB) hasBlock(hash gethcommon.Hash) (bool, error) {
+	var count int
+	if err := i.db.QueryRow("select count(*) from l1_blocks where hash = ?", hash.Hex()).Scan(&count); err != nil {
+		return false, fmt.Errorf("could not check for block %s. Cause: %w", hash, err)
+	}
+	return count > 0, nil
+}
+
+// Stores the L1 block and the rollups published in it.
+func (i *indexerDB) addBlock(header *types.Header, rollups []*common.PublicRollup) error {
+	tx, err := i.db.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin database transaction. Cause: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	_, err = tx.Exec("insert into l1_blocks values (?, ?, ?, ?)", header.Hash().Hex(), header.Number.Int64(),
+		header.ParentHash.Hex(), header.Time)
+	if err != nil {
+		return fmt.Errorf("could not store block %s. Cause: %w", header.Hash(), err)
+	}
+
+	for _, rollup := range rollups {
+		rollupHash := rollup.Header.Hash().Hex()
+		var number int64
+		if rollup.Header.Number != nil {
+			number = rollup.Header.Number.Int64()
+		}
+		_, err = tx.Exec("insert or ignore into rollups values (?, ?, ?, ?, ?, ?, ?)", rollupHash, number,
+			header.Hash().Hex(), rollup.Header.Agg.Hex(), rollup.Header.Time, rollup.Header.HeadBatchHash.Hex(),
+			len(rollup.BatchHashes))
+		if err != nil {
+			return fmt.Errorf("could not store rollup %s. Cause: %w", rollupHash, err)
+		}
+		for position, batchHash := range rollup.BatchHashes {
+			_, err = tx.Exec("insert or ignore into rollup_batches values (?, ?, ?)", rollupHash, batchHash.Hex(), position)
+			if err != nil {
+				return fmt.Errorf("could not store batches of rollup %s. Cause: %w", rollupHash, err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Returns a page of the indexed batches, from the most recent.
+func (i *indexerDB) listBatches(offset uint64, size uint64) (*Page, error) {
+	return i.listPage("select count(*) from batches",
+		"select "+batchColumns+" from batches b order by b.number desc limit ? offset ?",
+		nil, offset, size, scanBatch)
+}
+
+// Returns the batch with the given number, or errutil.ErrNotFound if it has not been indexed.
+func (i *indexerDB) getBatchByNumber(number uint64) (*BatchSummary, error) {
+	batch, err := scanBatch(i.db.QueryRow("select "+batchColumns+" from batches b where b.number = ?", number))
+	if err != nil {
+		return nil, scanErr(err)
+	}
+	return batch.(*BatchSummary), nil
+}
+
+// Returns the batch with the given hash, or errutil.ErrNotFound if it has not been indexed.
+func (i *indexerDB) getBatchByHash(hash gethcommon.Hash) (*BatchSummary, error) {
+	batch, err := scanBatch(i.db.QueryRow("select "+batchColumns+" from batches b where b.hash = ?", hash.Hex()))
+	if err != nil {
+		return nil, scanErr(err)
+	}
+	return batch.(*BatchSummary), nil
+}
+
+// Returns a page of the indexed rollups, from the most recent.
+func (i *indexerDB) listRollups(offset uint64, size uint64) (*Page, error) {
+	return i.listPage("select count(*) from rollups",
+		"select "+rollupColumns+" from rollups r join l1_blocks l on r.l1_block = l.hash "+
+			"order by l.number desc, r.number desc limit ? offset ?",
+		nil, offset, size, scanRollup)
+}
+
+// Returns the rollup with the given hash, or errutil.ErrNotFound if it has not been indexed.
+func (i *indexerDB) getRollup(hash gethcommon.Hash) (*RollupSummary, error) {
+	rollup, err := scanRollup(i.db.QueryRow("select "+rollupColumns+" from rollups r join l1_blocks l on r.l1_block = l.hash "+
+		"where r.hash = ?", hash.Hex()))
+	if err != nil {
+		return nil, scanErr(err)
+	}
+	return rollup.(*RollupSummary), nil
+}
+
+// Returns a page of the indexed batches included in the rollup with the given hash, from the most recent.
+func (i *indexerDB) listRollupBatches(rollupHash gethcommon.Hash, offset uint64, size uint64) (*Page, error) {
+	return i.listPage("select count(*) from rollup_batches rb join batches b on rb.batch_hash = b.hash where rb.rollup_hash = ?",
+		"select "+batchColumns+" from rollup_batches rb join batches b on rb.batch_hash = b.hash where rb.rollup_hash = ? "+
+			"order by rb.position desc limit ? offset ?",
+		[]interface{}{rollupHash.Hex()}, offset, size, scanBatch)
+}
+
+// Returns a page of the indexed L1 blocks, from the most recent.
+func (i *indexerDB) listBlocks(offset uint64, size uint64) (*Page, error) {
+	return i.listPage("select count(*) from l1_blocks",
+		"select "+blockColumns+" from l1_blocks l order by l.number desc limit ? offset ?",
+		nil, offset, size, scanBlock)
+}
+
+// Returns the L1 block with the given hash, or errutil.ErrNotFound if it has not been indexed.
+func (i *indexerDB) getBlock(hash gethcommon.Hash) (*BlockSummary, error) {
+	block, err := scanBlock(i.db.QueryRow("select "+blockColumns+" from l1_blocks l where l.hash = ?", hash.Hex()))
+	if err != nil {
+		return nil, scanErr(err)
+	}
+	return block.(*BlockSummary), nil
+}
+
+// Returns the transaction with the given hash, or errutil.ErrNotFound if it has not been indexed.
+func (i *indexerDB) getTransaction(hash gethcommon.Hash) (*TransactionSummary, error) {
+	var txHash, batchHash string
+	summary := &TransactionSummary{}
+	err := i.db.QueryRow("select t.hash, b.number, b.hash, b.timestamp from transactions t "+
+		"join batches b on t.batch_number = b.number where t.hash = ?", hash.Hex()).
+		Scan(&txHash, &summary.BatchNumber, &batchHash, &summary.Timestamp)
+	if err != nil {
+		return nil, scanErr(err)
+	}
+	summary.Hash = gethcommon.HexToHash(txHash)
+	summary.BatchHash = gethcommon.HexToHash(batchHash)
+	return summary, nil
+}
+
+// Returns the statistics about the indexed data, including per-sequencer statistics.
+func (i *indexerDB) getStats() (*Stats, error) {
+	stats := &Stats{Sequencers: []*SequencerStats{}}
+	err := i.db.QueryRow("select (select count(*) from batches), (select count(*) from transactions), "+
+		"(select count(*) from rollups), (select count(*) from l1_blocks)").
+		Scan(&stats.Batches, &stats.Transactions, &stats.Rollups, &stats.L1Blocks)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve statistics. Cause: %w", err)
+	}
+
+	rows, err := i.db.Query("select b.sequencer, count(*), sum(b.tx_count), max(b.number), max(b.timestamp), min(b.timestamp), " +
+		"(select count(*) from rollups r where r.sequencer = b.sequencer) from batches b group by b.sequencer order by b.sequencer")
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve sequencer statistics. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var address string
+		var firstBatchTime uint64
+		sequencerStats := &SequencerStats{}
+		err = rows.Scan(&address, &sequencerStats.Batches, &sequencerStats.Transactions, &sequencerStats.LastBatchNumber,
+			&sequencerStats.LastBatchTime, &firstBatchTime, &sequencerStats.Rollups)
+		if err != nil {
+			return nil, fmt.Errorf("could not read sequencer statistics. Cause: %w", err)
+		}
+		sequencerStats.Address = gethcommon.HexToAddress(address)
+		if sequencerStats.Batches > 1 {
+			sequencerStats.AvgBatchInterval = float64(sequencerStats.LastBatchTime-firstBatchTime) / float64(sequencerStats.Batches-1)
+		}
+		stats.Sequencers = append(stats.Sequencers, sequencerStats)
+	}
+	return stats, rows.Err()
+}
+
+// Either a single row or one of multiple rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Returns the page of items selected by the list query, which must take the `limit` and `offset` as its final
+// arguments. The count query returns the total number of items, and takes the list query's other arguments.
+func (i *indexerDB) listPage(countQry string, listQry string, args []interface{}, offset uint64, size uint64, scan func(rowScanner) (interface{}, error)) (*Page, error) {
+	page := &Page{Offset: offset, Size: size}
+	if err := i.db.QueryRow(countQry, args...).Scan(&page.Total); err != nil {
+		return nil, fmt.Errorf("could not count items. Cause: %w", err)
+	}
+
+	rows, err := i.db.Query(listQry, append(args, size, offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list items. Cause: %w", err)
+	}
+	defer rows.Close()
+
+	items := []interface{}{}
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("could not read item. Cause: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list items. Cause: %w", err)
+	}
+	page.Items = items
+	return page, nil
+}
+
+// Converts the error from scanning a single row to errutil.ErrNotFound if there was no such row.
+func scanErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return errutil.ErrNotFound
+	}
+	return fmt.Errorf("could not read item. Cause: %w", err)
+}
+
+func scanBatch(scanner rowScanner) (interface{}, error) {
+	var hash, parentHash, l1Block, sequencer string
+	summary := &BatchSummary{}
+	err := scanner.Scan(&summary.Number, &hash, &parentHash, &l1Block, &sequencer, &summary.Timestamp, &summary.TxCount)
+	if err != nil {
+		return nil, err
+	}
+	summary.Hash = gethcommon.HexToHash(hash)
+	summary.ParentHash = gethcommon.HexToHash(parentHash)
+	summary.L1Block = gethcommon.HexToHash(l1Block)
+	summary.Sequencer = gethcommon.HexToAddress(sequencer)
+	return summary, nil
+}
+
+func scanRollup(scanner rowScanner) (interface{}, error) {
+	var hash, l1Block, sequencer, headBatchHash string
+	summary := &RollupSummary{}
+	err := scanner.Scan(&hash, &summary.Number, &l1Block, &summary.L1BlockNumber, &sequencer, &summary.Timestamp,
+		&headBatchHash, &summary.BatchCount)
+	if err != nil {
+		return nil, err
+	}
+	summary.Hash = gethcommon.HexToHash(hash)
+	summary.L1Block = gethcommon.HexToHash(l1Block)
+	summary.Sequencer = gethcommon.HexToAddress(sequencer)
+	summary.HeadBatchHash = gethcommon.HexToHash(headBatchHash)
+	return summary, nil
+}
+
+func scanBlock(scanner rowScanner) (interface{}, error) {
+	var hash, parentHash string
+	summary := &BlockSummary{}
+	if err := scanner.Scan(&hash, &summary.Number, &parentHash, &summary.Timestamp, &summary.RollupCount); err != nil {
+		return nil, err
+	}
+	summary.Hash = gethcommon.HexToHash(hash)
+	summary.ParentHash = gethcommon.HexToHash(parentHash)
+	return summary, nil
+}
@@ -0,0 +1,261 @@
+package obscuroscan
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	numTestBlocks  = 3
+	numTestBatches = 5
+)
+
+var (
+	testSequencer      = gethcommon.HexToAddress("0x1")
+	testOtherSequencer = gethcommon.HexToAddress("0x2")
+)
+
+func TestIndexerIndexesBatchesBlocksAndRollups(t *testing.T) {
+	source := newFakeNodeSource()
+	indexer := createTestIndexer(t, source)
+	if err := indexer.indexNewBatches(); err != nil {
+		t.Fatalf("could not index batches. Cause: %s", err)
+	}
+
+	stats, err := indexer.db.getStats()
+	if err != nil {
+		t.Fatalf("could not get stats. Cause: %s", err)
+	}
+	if stats.Batches != numTestBatches || stats.Transactions != numTestBatches || stats.L1Blocks != numTestBlocks || stats.Rollups != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if len(stats.Sequencers) != 2 || stats.Sequencers[0].Address != testSequencer || stats.Sequencers[0].Rollups != 1 {
+		t.Fatalf("unexpected sequencer stats %+v", stats.Sequencers)
+	}
+
+	// The rollup was published in the last block, and contains the first three batches.
+	rollupHash := source.rollups[source.blockHashes[numTestBlocks-1]][0].Header.Hash()
+	page, err := indexer.db.listRollupBatches(rollupHash, 0, maxPageSize)
+	if err != nil {
+		t.Fatalf("could not list rollup batches. Cause: %s", err)
+	}
+	assertBatchNumbers(t, page, 2, 1, 0)
+
+	tx, err := indexer.db.getTransaction(source.batches[3].TxHashes[0])
+	if err != nil {
+		t.Fatalf("could not get transaction. Cause: %s", err)
+	}
+	if tx.BatchHash != source.batches[3].Hash() {
+		t.Fatalf("expected transaction to be in batch %s, got %s", source.batches[3].Hash(), tx.BatchHash)
+	}
+}
+
+func TestIndexerReindexesReorganisedBatches(t *testing.T) {
+	source := newFakeNodeSource()
+	indexer := createTestIndexer(t, source)
+	if err := indexer.indexNewBatches(); err != nil {
+		t.Fatalf("could not index batches. Cause: %s", err)
+	}
+
+	// We replace the last two batches with a longer fork.
+	source.batches = source.batches[:3]
+	for i := 0; i < 3; i++ {
+		source.addBatch(testOtherSequencer, 1_000)
+	}
+	if err := indexer.indexNewBatches(); err != nil {
+		t.Fatalf("could not index batches. Cause: %s", err)
+	}
+
+	page, err := indexer.db.listBatches(0, maxPageSize)
+	if err != nil {
+		t.Fatalf("could not list batches. Cause: %s", err)
+	}
+	assertBatchNumbers(t, page, 5, 4, 3, 2, 1, 0)
+	for _, batch := range page.Items.([]interface{}) {
+		summary := batch.(*BatchSummary)
+		if summary.Hash != source.batches[summary.Number].Hash() {
+			t.Fatalf("expected batch %d to be from the fork", summary.Number)
+		}
+	}
+}
+
+func TestRESTAPIServesPaginatedBatches(t *testing.T) {
+	source := newFakeNodeSource()
+	obscuroscan := createTestObscuroscan(t, source)
+
+	var page struct {
+		Total uint64
+		Items []*BatchSummary
+	}
+	resp := makeRESTRequest(obscuroscan, "/api/batches/?offset=1&size=2")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.Code)
+	}
+	if err := json.Unmarshal(resp.Body.Bytes(), &page); err != nil {
+		t.Fatalf("could not unmarshal page. Cause: %s", err)
+	}
+	if page.Total != numTestBatches || len(page.Items) != 2 || page.Items[0].Number != 3 || page.Items[1].Number != 2 {
+		t.Fatalf("unexpected page %+v", page)
+	}
+
+	var batch BatchSummary
+	resp = makeRESTRequest(obscuroscan, fmt.Sprintf("/api/batches/%s", source.batches[1].Hash().Hex()))
+	if err := json.Unmarshal(resp.Body.Bytes(), &batch); err != nil {
+		t.Fatalf("could not unmarshal batch. Cause: %s", err)
+	}
+	if batch.Number != 1 {
+		t.Fatalf("expected batch 1, got batch %d", batch.Number)
+	}
+}
+
+func TestRESTAPIReturnsErrors(t *testing.T) {
+	obscuroscan := createTestObscuroscan(t, newFakeNodeSource())
+
+	expectedCodes := map[string]int{
+		"/api/batches/?size=101":                  http.StatusBadRequest,
+		"/api/batches/abc":                        http.StatusBadRequest,
+		"/api/batches/100":                        http.StatusNotFound,
+		"/api/rollups/0x1234":                     http.StatusBadRequest,
+		"/api/blocks/" + gethcommon.Hash{}.Hex():  http.StatusNotFound,
+		"/api/rollups/" + gethcommon.Hash{}.Hex(): http.StatusNotFound,
+	}
+	for path, expectedCode := range expectedCodes {
+		resp := makeRESTRequest(obscuroscan, path)
+		if resp.Code != expectedCode {
+			t.Fatalf("expected status %d for %s, got %d", expectedCode, path, resp.Code)
+		}
+		var restErr restError
+		if err := json.Unmarshal(resp.Body.Bytes(), &restErr); err != nil || restErr.Error == "" {
+			t.Fatalf("expected JSON error for %s, got %s", path, resp.Body.String())
+		}
+	}
+}
+
+// A nodeSource with a chain of L1 blocks, and a chain of batches produced against them.
+type fakeNodeSource struct {
+	blocks      map[gethcommon.Hash]*types.Header
+	blockHashes []gethcommon.Hash
+	rollups     map[gethcommon.Hash][]*common.PublicRollup
+	batches     []*common.ExtBatch
+}
+
+// Creates a source where the batches are produced in turn against each block by one of two sequencers, and a rollup
+// of the first three batches is published in the last block.
+func newFakeNodeSource() *fakeNodeSource {
+	source := &fakeNodeSource{
+		blocks:  map[gethcommon.Hash]*types.Header{},
+		rollups: map[gethcommon.Hash][]*common.PublicRollup{},
+	}
+
+	parentHash := gethcommon.Hash{}
+	for i := 0; i < numTestBlocks; i++ {
+		block := &types.Header{ParentHash: parentHash, Number: big.NewInt(int64(i)), Time: uint64(i)}
+		source.blocks[block.Hash()] = block
+		source.blockHashes = append(source.blockHashes, block.Hash())
+		parentHash = block.Hash()
+	}
+
+	sequencers := []gethcommon.Address{testSequencer, testOtherSequencer}
+	for i := 0; i < numTestBatches; i++ {
+		source.addBatch(sequencers[i%len(sequencers)], 0)
+	}
+
+	lastBlock := source.blockHashes[numTestBlocks-1]
+	rollup := &common.ExtRollup{
+		Header:  &common.RollupHeader{Agg: testSequencer, Number: big.NewInt(0), HeadBatchHash: source.batches[2].Hash()},
+		Batches: source.batches[:3],
+	}
+	source.rollups[lastBlock] = []*common.PublicRollup{rollup.ToPublicRollup(lastBlock)}
+
+	return source
+}
+
+// Adds a batch with a single transaction to the chain. The time offset allows forks to be created.
+func (f *fakeNodeSource) addBatch(sequencer gethcommon.Address, timeOffset uint64) {
+	number := len(f.batches)
+	parentHash := gethcommon.Hash{}
+	if number > 0 {
+		parentHash = f.batches[number-1].Hash()
+	}
+	f.batches = append(f.batches, &common.ExtBatch{
+		Header: &common.BatchHeader{
+			ParentHash: parentHash,
+			Number:     big.NewInt(int64(number)),
+			Time:       uint64(number) + timeOffset,
+			Agg:        sequencer,
+			L1Proof:    f.blockHashes[number%numTestBlocks],
+		},
+		TxHashes: []gethcommon.Hash{gethcommon.BigToHash(big.NewInt(int64(number) + int64(timeOffset)))},
+	})
+}
+
+func (f *fakeNodeSource) HeadBatchNumber() (uint64, error) {
+	return uint64(len(f.batches) - 1), nil
+}
+
+func (f *fakeNodeSource) BatchByNumber(number uint64) (*common.ExtBatch, error) {
+	if number >= uint64(len(f.batches)) {
+		return nil, fmt.Errorf("batch %d not found", number)
+	}
+	return f.batches[number], nil
+}
+
+func (f *fakeNodeSource) BlockHeader(hash gethcommon.Hash) (*types.Header, error) {
+	block, found := f.blocks[hash]
+	if !found {
+		return nil, fmt.Errorf("block %s not found", hash)
+	}
+	return block, nil
+}
+
+func (f *fakeNodeSource) RollupsForBlock(hash gethcommon.Hash) ([]*common.PublicRollup, error) {
+	return f.rollups[hash], nil
+}
+
+func createTestIndexer(t *testing.T, source nodeSource) *Indexer {
+	db, err := newIndexerDB("")
+	if err != nil {
+		t.Fatalf("could not create indexer database. Cause: %s", err)
+	}
+	t.Cleanup(func() { _ = db.close() })
+	return newIndexer(source, db, 0, gethlog.New())
+}
+
+// Creates an Obscuroscan whose indexer has indexed the source's batches.
+func createTestObscuroscan(t *testing.T, source nodeSource) *Obscuroscan {
+	indexer := createTestIndexer(t, source)
+	if err := indexer.indexNewBatches(); err != nil {
+		t.Fatalf("could not index batches. Cause: %s", err)
+	}
+	return &Obscuroscan{indexer: indexer, indexerDB: indexer.db, logger: gethlog.New()}
+}
+
+func makeRESTRequest(obscuroscan *Obscuroscan, path string) *httptest.ResponseRecorder {
+	serveMux := http.NewServeMux()
+	obscuroscan.registerRESTHandlers(serveMux)
+	resp := httptest.NewRecorder()
+	serveMux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, path, nil))
+	return resp
+}
+
+func assertBatchNumbers(t *testing.T, page *Page, expectedNumbers ...uint64) {
+	items := page.Items.([]interface{})
+	if len(items) != len(expectedNumbers) {
+		t.Fatalf("expected %d batches, got %d", len(expectedNumbers), len(items))
+	}
+	for i, item := range items {
+		if number := item.(*BatchSummary).Number; number != expectedNumbers[i] {
+			t.Fatalf("expected batch %d at position %d, got batch %d", expectedNumbers[i], i, number)
+		}
+	}
+}
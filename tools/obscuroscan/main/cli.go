@@ -2,6 +2,8 @@ package main
 
 import (
 	"flag"
+	"strings"
+	"time"
 )
 
 const (
@@ -17,21 +19,41 @@ const (
 
 	logPathName  = "logPath"
 	logPathUsage = "The path to use for Obscuroscan's log file"
+
+	dbPathName  = "dbPath"
+	dbPathUsage = "The path to use for the database of indexed batches, rollups and L1 blocks. If empty, the database is kept in memory"
+
+	indexerPollIntervalName  = "indexerPollInterval"
+	indexerPollIntervalUsage = "How often the indexer checks the node for new batches"
+
+	attestationPolicyPathName  = "attestationPolicyPath"
+	attestationPolicyPathUsage = "The path to a JSON file containing the policy hosts' attestations are checked against. If empty, only genuine, non-debug enclaves on up-to-date platforms pass"
+
+	attestedHostsName  = "attestedHosts"
+	attestedHostsUsage = "A comma-separated list of the RPC addresses of hosts whose attestations should be checked, in addition to the monitored node"
 )
 
 type obscuroscanConfig struct {
-	nodeID        string
-	rpcServerAddr string
-	address       string
-	logPath       string
+	nodeID                string
+	rpcServerAddr         string
+	address               string
+	logPath               string
+	dbPath                string
+	indexerPollInterval   time.Duration
+	attestationPolicyPath string
+	attestedHosts         []string
 }
 
 func defaultObscuroClientConfig() obscuroscanConfig {
 	return obscuroscanConfig{
-		nodeID:        "",
-		rpcServerAddr: "http://testnet.obscu.ro:13000",
-		address:       "127.0.0.1:3000",
-		logPath:       "obscuroscan_logs.txt",
+		nodeID:                "",
+		rpcServerAddr:         "http://testnet.obscu.ro:13000",
+		address:               "127.0.0.1:3000",
+		logPath:               "obscuroscan_logs.txt",
+		dbPath:                "obscuroscan.db",
+		indexerPollInterval:   time.Second,
+		attestationPolicyPath: "",
+		attestedHosts:         []string{},
 	}
 }
 
@@ -42,13 +64,28 @@ func parseCLIArgs() obscuroscanConfig {
 	rpcServerAddr := flag.String(rpcServerAddrName, defaultConfig.rpcServerAddr, rpcServerAddrUsage)
 	address := flag.String(addressName, defaultConfig.address, addressUsage)
 	logPath := flag.String(logPathName, defaultConfig.logPath, logPathUsage)
+	dbPath := flag.String(dbPathName, defaultConfig.dbPath, dbPathUsage)
+	indexerPollInterval := flag.Duration(indexerPollIntervalName, defaultConfig.indexerPollInterval, indexerPollIntervalUsage)
+	attestationPolicyPath := flag.String(attestationPolicyPathName, defaultConfig.attestationPolicyPath, attestationPolicyPathUsage)
+	attestedHosts := flag.String(attestedHostsName, strings.Join(defaultConfig.attestedHosts, ","), attestedHostsUsage)
 
 	flag.Parse()
 
+	parsedAttestedHosts := []string{}
+	for _, host := range strings.Split(*attestedHosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			parsedAttestedHosts = append(parsedAttestedHosts, host)
+		}
+	}
+
 	return obscuroscanConfig{
-		nodeID:        *nodeID,
-		rpcServerAddr: *rpcServerAddr,
-		address:       *address,
-		logPath:       *logPath,
+		nodeID:                *nodeID,
+		rpcServerAddr:         *rpcServerAddr,
+		address:               *address,
+		logPath:               *logPath,
+		dbPath:                *dbPath,
+		indexerPollInterval:   *indexerPollInterval,
+		attestationPolicyPath: *attestationPolicyPath,
+		attestedHosts:         parsedAttestedHosts,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 
 	"github.com/obscuronet/go-obscuro/tools/obscuroscan"
@@ -12,8 +13,23 @@ import (
 func main() {
 	config := parseCLIArgs()
 
+	var attestationPolicy *attestation.Policy
+	if config.attestationPolicyPath != "" {
+		var err error
+		attestationPolicy, err = attestation.LoadPolicy(config.attestationPolicyPath)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	server := obscuroscan.NewObscuroscan(
-		config.rpcServerAddr,
+		obscuroscan.Config{
+			NodeRPCAddress:      config.rpcServerAddr,
+			DBPath:              config.dbPath,
+			IndexerPollInterval: config.indexerPollInterval,
+			AttestationPolicy:   attestationPolicy,
+			AttestedHosts:       config.attestedHosts,
+		},
 		log.New(log.ObscuroscanCmp, int(gethlog.LvlInfo), config.logPath),
 	)
 	go server.Serve(config.address)
@@ -13,20 +13,17 @@ import (
 	"io/fs"
 	"math/big"
 	"net/http"
-	"os"
-	"os/signal"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
-	"github.com/edgelesssys/ego/enclave"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
 	"github.com/obscuronet/go-obscuro/go/common/httputil"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/enclave/crypto"
@@ -47,6 +44,7 @@ const (
 	pathDecryptTxBlob     = "/decrypttxblob/"
 	pathAttestation       = "/attestation/"
 	pathAttestationReport = "/attestationreport/"
+	pathAttestations      = "/attestations/"
 	pathRoot              = "/"
 
 	staticDir   = "static"
@@ -60,11 +58,31 @@ var staticFiles embed.FS
 
 // Obscuroscan is a server that allows the monitoring of a running Obscuro network.
 type Obscuroscan struct {
-	server      *http.Server
-	client      rpc.Client
-	obsClient   *obsclient.ObsClient
-	contractABI abi.ABI
-	logger      gethlog.Logger
+	server        *http.Server
+	client        rpc.Client
+	obsClient     *obsclient.ObsClient
+	contractABI   abi.ABI
+	indexer       *Indexer
+	indexerDB     *indexerDB
+	attestedHosts []*attestedHost
+	policy        *attestation.Policy
+	verifier      *attestation.Verifier
+	logger        gethlog.Logger
+}
+
+// Config contains the configuration of an Obscuroscan.
+type Config struct {
+	NodeRPCAddress      string              // The RPC address of the node to monitor.
+	DBPath              string              // The path of the indexer's database. If empty, the database is kept in memory.
+	IndexerPollInterval time.Duration       // How often the indexer checks for new batches. If zero, this is every second.
+	AttestationPolicy   *attestation.Policy // The policy hosts' attestations are checked against. If nil, only genuine, non-debug enclaves on up-to-date platforms pass.
+	AttestedHosts       []string            // The RPC addresses of the hosts whose attestations are checked, in addition to the monitored node.
+}
+
+// A host whose attestation is checked against the policy.
+type attestedHost struct {
+	address string
+	client  rpc.Client
 }
 
 // Identical to attestation.Report, but with the status mapped to a user-friendly string.
@@ -78,8 +96,24 @@ type attestationReportExternal struct {
 	TCBStatus       string
 }
 
-func NewObscuroscan(address string, logger gethlog.Logger) *Obscuroscan {
-	client, err := rpc.NewNetworkClient(address)
+// The outcome of checking each host's attestation against the policy.
+type attestationChecks struct {
+	Policy *attestation.Policy
+	Hosts  []*attestationCheck
+}
+
+// The outcome of checking a host's attestation against the policy.
+type attestationCheck struct {
+	Host       string
+	Owner      *gethcommon.Address
+	Report     *attestation.Report
+	Passed     bool
+	Violations []string
+	Error      string // Set if the attestation could not be retrieved or verified.
+}
+
+func NewObscuroscan(config Config, logger gethlog.Logger) *Obscuroscan {
+	client, err := rpc.NewNetworkClient(config.NodeRPCAddress)
 	if err != nil {
 		panic(err)
 	}
@@ -90,11 +124,36 @@ func NewObscuroscan(address string, logger gethlog.Logger) *Obscuroscan {
 		panic("could not parse management contract ABI to decrypt rollups")
 	}
 
+	db, err := newIndexerDB(config.DBPath)
+	if err != nil {
+		panic(err)
+	}
+	source := &rpcNodeSource{client: client, obsClient: obsClient}
+
+	attestedHosts := []*attestedHost{{address: config.NodeRPCAddress, client: client}}
+	for _, hostAddress := range config.AttestedHosts {
+		hostClient, err := rpc.NewNetworkClient(hostAddress)
+		if err != nil {
+			panic(err)
+		}
+		attestedHosts = append(attestedHosts, &attestedHost{address: hostAddress, client: hostClient})
+	}
+
+	policy := config.AttestationPolicy
+	if policy == nil {
+		policy = &attestation.Policy{}
+	}
+
 	return &Obscuroscan{
-		client:      client,
-		obsClient:   obsClient,
-		contractABI: contractABI,
-		logger:      logger,
+		client:        client,
+		obsClient:     obsClient,
+		contractABI:   contractABI,
+		indexer:       newIndexer(source, db, config.IndexerPollInterval, logger),
+		indexerDB:     db,
+		attestedHosts: attestedHosts,
+		policy:        policy,
+		verifier:      attestation.NewVerifier(policy),
+		logger:        logger,
 	}
 }
 
@@ -112,6 +171,8 @@ func (o *Obscuroscan) Serve(hostAndPort string) {
 	serveMux.HandleFunc(pathAPI+pathDecryptTxBlob, o.decryptTxBlob)         // Decrypt a transaction blob.
 	serveMux.HandleFunc(pathAPI+pathAttestation, o.attestation)             // Retrieve the node's attestation.
 	serveMux.HandleFunc(pathAPI+pathAttestationReport, o.attestationReport) // Retrieve the node's attestation report.
+	serveMux.HandleFunc(pathAPI+pathAttestations, o.attestations)           // Check the hosts' attestations against the policy.
+	o.registerRESTHandlers(serveMux)
 
 	// Serves the web assets for the user interface.
 	staticFileFS, err := fs.Sub(staticFiles, staticDir)
@@ -127,6 +188,7 @@ func (o *Obscuroscan) Serve(hostAndPort string) {
 		staticFileFilesystem.ServeHTTP(w, r)
 	}))
 
+	o.indexer.Start()
 	o.server = &http.Server{Addr: hostAndPort, Handler: serveMux, ReadHeaderTimeout: 10 * time.Second}
 	err = o.server.ListenAndServe()
 	if !errors.Is(err, http.ErrServerClosed) {
@@ -140,6 +202,10 @@ func (o *Obscuroscan) Shutdown() {
 		if err != nil {
 			o.logger.Error("could not shut down Obscuroscan.", log.ErrKey, err)
 		}
+		o.indexer.Stop()
+	}
+	if err := o.indexerDB.close(); err != nil {
+		o.logger.Error("could not close Obscuroscan's indexer database.", log.ErrKey, err)
 	}
 }
 
@@ -404,15 +470,15 @@ func (o *Obscuroscan) decryptTxBlob(resp http.ResponseWriter, req *http.Request)
 
 // Retrieves the node's attestation.
 func (o *Obscuroscan) attestation(resp http.ResponseWriter, _ *http.Request) {
-	var attestation *common.AttestationReport
-	err := o.client.Call(&attestation, rpc.Attestation)
+	var att *common.AttestationReport
+	err := o.client.Call(&att, rpc.Attestation)
 	if err != nil {
 		o.logger.Error("could not retrieve node's attestation.", log.ErrKey, err)
 		logAndSendErr(resp, "Could not retrieve node's attestation.")
 		return
 	}
 
-	jsonAttestation, err := json.Marshal(attestation)
+	jsonAttestation, err := json.Marshal(att)
 	if err != nil {
 		o.logger.Error("could not convert node's attestation to JSON.", log.ErrKey, err)
 		logAndSendErr(resp, "Could not retrieve node's attestation.")
@@ -428,21 +494,15 @@ func (o *Obscuroscan) attestation(resp http.ResponseWriter, _ *http.Request) {
 
 // Retrieves the node's attestation report.
 func (o *Obscuroscan) attestationReport(resp http.ResponseWriter, _ *http.Request) {
-	var attestation *common.AttestationReport
-	err := o.client.Call(&attestation, rpc.Attestation)
+	var att *common.AttestationReport
+	err := o.client.Call(&att, rpc.Attestation)
 	if err != nil {
 		o.logger.Error("could not retrieve node's attestation.", log.ErrKey, err)
 		logAndSendErr(resp, "Could not verify node's attestation.")
 		return
 	}
 
-	// If DCAP isn't set up, verifying the report will send a SIGSYS signal. We catch this, so that it doesn't crash the program.
-	sigChannel := make(chan os.Signal, 1)
-	defer signal.Stop(sigChannel)
-	signal.Notify(sigChannel, syscall.SIGSYS)
-	attestationReport, err := enclave.VerifyRemoteReport(attestation.Report)
-	signal.Stop(sigChannel)
-
+	attestationReport, err := attestation.VerifyRemoteReport(att.Report)
 	if err != nil {
 		o.logger.Error("could not verify node's attestation.", log.ErrKey, err)
 		logAndSendErr(resp, "Could not verify node's attestation.")
@@ -473,6 +533,43 @@ func (o *Obscuroscan) attestationReport(resp http.ResponseWriter, _ *http.Reques
 	}
 }
 
+// Checks the attestation of each host against the attestation policy.
+func (o *Obscuroscan) attestations(resp http.ResponseWriter, req *http.Request) {
+	if httputil.EnableCORS(resp, req) {
+		return
+	}
+
+	checks := make([]*attestationCheck, len(o.attestedHosts))
+	for i, host := range o.attestedHosts {
+		checks[i] = o.checkAttestation(host)
+	}
+	o.sendJSON(resp, http.StatusOK, attestationChecks{Policy: o.policy, Hosts: checks})
+}
+
+// Retrieves the host's attestation, and checks it against the attestation policy.
+func (o *Obscuroscan) checkAttestation(host *attestedHost) *attestationCheck {
+	check := &attestationCheck{Host: host.address}
+
+	var att *common.AttestationReport
+	if err := host.client.Call(&att, rpc.Attestation); err != nil {
+		o.logger.Warn("Could not retrieve host's attestation.", "host", host.address, log.ErrKey, err)
+		check.Error = fmt.Sprintf("could not retrieve attestation: %s", err)
+		return check
+	}
+
+	result, err := o.verifier.Verify(att)
+	if err != nil {
+		check.Owner = &att.Owner
+		check.Error = err.Error()
+		return check
+	}
+	check.Owner = &result.Owner
+	check.Report = result.Report
+	check.Violations = result.Violations
+	check.Passed = result.Passed()
+	return check
+}
+
 // Returns the rollup with the given number.
 func (o *Obscuroscan) getRollupByNumber(rollupNumber int64) (*common.ExtRollup, error) {
 	// TODO - If required, consolidate the two calls below into a single RPCGetRollupByNumber call to minimise round trips.
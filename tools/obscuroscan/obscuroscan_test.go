@@ -50,7 +50,7 @@ func generateEncryptedTxBlob(txs []*common.L2Tx) []byte {
 
 func TestObscuroscan_getRollupByNumOrTxHash(t *testing.T) {
 	logger := gethlog.Logger.New(gethlog.Root())
-	ob := NewObscuroscan("http://testnet.obscuroscan.io", logger)
+	ob := NewObscuroscan(Config{NodeRPCAddress: "http://testnet.obscuroscan.io"}, logger)
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
 	req.Method = http.MethodOptions
 	resp := httptest.NewRecorder()
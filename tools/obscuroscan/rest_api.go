@@ -0,0 +1,229 @@
+package obscuroscan
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/obscuronet/go-obscuro/go/common/errutil"
+	"github.com/obscuronet/go-obscuro/go/common/httputil"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	pathBatches      = "/batches/"
+	pathRollups      = "/rollups/"
+	pathBlocks       = "/blocks/"
+	pathTransactions = "/transactions/"
+	pathStats        = "/stats/"
+	pathSubBatches   = "/batches"
+
+	queryOffset     = "offset"
+	querySize       = "size"
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	httpCodeBadRequest = 400
+	httpCodeNotFound   = 404
+)
+
+// The body of an error response from the REST API.
+type restError struct {
+	Error string `json:"error"`
+}
+
+// Registers the handlers for the REST API, which serves the data indexed by the Indexer.
+func (o *Obscuroscan) registerRESTHandlers(serveMux *http.ServeMux) {
+	serveMux.HandleFunc(pathAPI+pathBatches, o.batches)           // List the batches, or get a batch by number or hash.
+	serveMux.HandleFunc(pathAPI+pathRollups, o.rollups)           // List the rollups, get a rollup by hash, or list its batches.
+	serveMux.HandleFunc(pathAPI+pathBlocks, o.blocks)             // List the L1 blocks, or get an L1 block by hash.
+	serveMux.HandleFunc(pathAPI+pathTransactions, o.transactions) // Get a transaction by hash.
+	serveMux.HandleFunc(pathAPI+pathStats, o.stats)               // Get the network and per-sequencer statistics.
+}
+
+// Handles `/api/batches/` and `/api/batches/{number or hash}`.
+func (o *Obscuroscan) batches(resp http.ResponseWriter, req *http.Request) {
+	if httputil.EnableCORS(resp, req) {
+		return
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, pathAPI+pathBatches), "/")
+	if id == "" {
+		o.sendPage(resp, req, o.indexerDB.listBatches)
+		return
+	}
+
+	if strings.HasPrefix(id, "0x") {
+		hash, ok := parseHash(id)
+		if !ok {
+			o.sendRESTErr(resp, httpCodeBadRequest, fmt.Sprintf("invalid batch hash %s", id))
+			return
+		}
+		batch, err := o.indexerDB.getBatchByHash(hash)
+		o.sendItem(resp, batch, err, "batch")
+		return
+	}
+
+	number, err := strconv.ParseUint(id, 10, 63)
+	if err != nil {
+		o.sendRESTErr(resp, httpCodeBadRequest, fmt.Sprintf("invalid batch number %s", id))
+		return
+	}
+	batch, err := o.indexerDB.getBatchByNumber(number)
+	o.sendItem(resp, batch, err, "batch")
+}
+
+// Handles `/api/rollups/`, `/api/rollups/{hash}` and `/api/rollups/{hash}/batches/`.
+func (o *Obscuroscan) rollups(resp http.ResponseWriter, req *http.Request) {
+	if httputil.EnableCORS(resp, req) {
+		return
+	}
+	id := strings.TrimPrefix(req.URL.Path, pathAPI+pathRollups)
+	if id == "" {
+		o.sendPage(resp, req, o.indexerDB.listRollups)
+		return
+	}
+
+	id = strings.TrimSuffix(id, "/")
+	listBatches := strings.HasSuffix(id, pathSubBatches)
+	id = strings.TrimSuffix(id, pathSubBatches)
+	hash, ok := parseHash(id)
+	if !ok {
+		o.sendRESTErr(resp, httpCodeBadRequest, fmt.Sprintf("invalid rollup hash %s", id))
+		return
+	}
+
+	rollup, err := o.indexerDB.getRollup(hash)
+	if !listBatches || err != nil {
+		o.sendItem(resp, rollup, err, "rollup")
+		return
+	}
+	o.sendPage(resp, req, func(offset uint64, size uint64) (*Page, error) {
+		return o.indexerDB.listRollupBatches(hash, offset, size)
+	})
+}
+
+// Handles `/api/blocks/` and `/api/blocks/{hash}`.
+func (o *Obscuroscan) blocks(resp http.ResponseWriter, req *http.Request) {
+	if httputil.EnableCORS(resp, req) {
+		return
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, pathAPI+pathBlocks), "/")
+	if id == "" {
+		o.sendPage(resp, req, o.indexerDB.listBlocks)
+		return
+	}
+
+	hash, ok := parseHash(id)
+	if !ok {
+		o.sendRESTErr(resp, httpCodeBadRequest, fmt.Sprintf("invalid block hash %s", id))
+		return
+	}
+	block, err := o.indexerDB.getBlock(hash)
+	o.sendItem(resp, block, err, "block")
+}
+
+// Handles `/api/transactions/{hash}`.
+func (o *Obscuroscan) transactions(resp http.ResponseWriter, req *http.Request) {
+	if httputil.EnableCORS(resp, req) {
+		return
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, pathAPI+pathTransactions), "/")
+	hash, ok := parseHash(id)
+	if !ok {
+		o.sendRESTErr(resp, httpCodeBadRequest, fmt.Sprintf("invalid transaction hash %s", id))
+		return
+	}
+	tx, err := o.indexerDB.getTransaction(hash)
+	o.sendItem(resp, tx, err, "transaction")
+}
+
+// Handles `/api/stats/`.
+func (o *Obscuroscan) stats(resp http.ResponseWriter, req *http.Request) {
+	if httputil.EnableCORS(resp, req) {
+		return
+	}
+	stats, err := o.indexerDB.getStats()
+	o.sendItem(resp, stats, err, "statistics")
+}
+
+// Sends the page of items returned by the list function for the request's `offset` and `size` query parameters.
+func (o *Obscuroscan) sendPage(resp http.ResponseWriter, req *http.Request, list func(offset uint64, size uint64) (*Page, error)) {
+	offset, size, err := parsePagination(req)
+	if err != nil {
+		o.sendRESTErr(resp, httpCodeBadRequest, err.Error())
+		return
+	}
+	page, err := list(offset, size)
+	o.sendItem(resp, page, err, "items")
+}
+
+// Sends the item as JSON, or the appropriate error response if it could not be retrieved.
+func (o *Obscuroscan) sendItem(resp http.ResponseWriter, item interface{}, err error, itemName string) {
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			o.sendRESTErr(resp, httpCodeNotFound, fmt.Sprintf("%s not found", itemName))
+			return
+		}
+		o.logger.Error(fmt.Sprintf("Could not retrieve %s.", itemName), log.ErrKey, err)
+		o.sendRESTErr(resp, httpCodeErr, fmt.Sprintf("could not retrieve %s", itemName))
+		return
+	}
+	o.sendJSON(resp, http.StatusOK, item)
+}
+
+func (o *Obscuroscan) sendRESTErr(resp http.ResponseWriter, statusCode int, msg string) {
+	o.sendJSON(resp, statusCode, restError{Error: msg})
+}
+
+func (o *Obscuroscan) sendJSON(resp http.ResponseWriter, statusCode int, body interface{}) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		o.logger.Error("could not convert response to JSON.", log.ErrKey, err)
+		http.Error(resp, "could not convert response to JSON", httpCodeErr)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(statusCode)
+	if _, err = resp.Write(jsonBody); err != nil {
+		o.logger.Error("could not return response to client.", log.ErrKey, err)
+	}
+}
+
+// Returns the `offset` and `size` query parameters, applying the defaults if they are not set. Numbers are limited to 63
+// bits, the range of the database's integers.
+func parsePagination(req *http.Request) (uint64, uint64, error) {
+	query := req.URL.Query()
+
+	var offset uint64
+	if offsetStr := query.Get(queryOffset); offsetStr != "" {
+		var err error
+		if offset, err = strconv.ParseUint(offsetStr, 10, 63); err != nil {
+			return 0, 0, fmt.Errorf("invalid offset %s", offsetStr)
+		}
+	}
+
+	size := uint64(defaultPageSize)
+	if sizeStr := query.Get(querySize); sizeStr != "" {
+		var err error
+		if size, err = strconv.ParseUint(sizeStr, 10, 64); err != nil || size == 0 || size > maxPageSize {
+			return 0, 0, fmt.Errorf("invalid size %s; the size must be between 1 and %d", sizeStr, maxPageSize)
+		}
+	}
+
+	return offset, size, nil
+}
+
+// Parses a hex-encoded 32-byte hash.
+func parseHash(hashHex string) (gethcommon.Hash, bool) {
+	hashBytes, err := hexutil.Decode(hashHex)
+	if err != nil || len(hashBytes) != gethcommon.HashLength {
+		return gethcommon.Hash{}, false
+	}
+	return gethcommon.BytesToHash(hashBytes), true
+}
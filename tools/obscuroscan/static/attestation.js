@@ -4,9 +4,12 @@ const eventDomLoaded = "DOMContentLoaded";
 
 const idAttestation = "attestation";
 const idAttestationReport = "attestationReport";
+const idAttestationPolicy = "attestationPolicy";
+const idAttestationChecks = "attestationChecks";
 
 const pathAttestation = "/api/attestation/";
 const pathAttestationReport = "/api/attestationreport/"
+const pathAttestations = "/api/attestations/";
 
 // Updates the displayed stats.
 async function displayAttestation() {
@@ -31,8 +34,42 @@ async function displayAttestation() {
     }
 }
 
+// Displays the outcome of checking each host's attestation against the attestation policy.
+async function displayAttestationChecks() {
+    const fieldAttestationPolicy = document.getElementById(idAttestationPolicy);
+    const fieldAttestationChecks = document.getElementById(idAttestationChecks);
+
+    const respAttestations = await fetch(pathAttestations);
+    if (!respAttestations.ok) {
+        fieldAttestationChecks.innerText = "Failed to check attestations.";
+        return;
+    }
+    const attestationsJSON = JSON.parse(await respAttestations.text());
+    fieldAttestationPolicy.innerText = JSON.stringify(attestationsJSON.Policy, null, "\t");
+
+    fieldAttestationChecks.innerText = "";
+    for (const check of attestationsJSON.Hosts) {
+        const heading = document.createElement("h6");
+        const details = document.createElement("pre");
+        details.style.whiteSpace = "pre-wrap";
+
+        if (check.Error !== "") {
+            heading.innerText = `❌ ${check.Host}: could not be verified`;
+            details.innerText = check.Error;
+        } else if (check.Passed) {
+            heading.innerText = `✅ ${check.Host}: passed`;
+            details.innerText = JSON.stringify(check.Report, null, "\t");
+        } else {
+            heading.innerText = `❌ ${check.Host}: failed`;
+            details.innerText = check.Violations.join("\n") + "\n\n" + JSON.stringify(check.Report, null, "\t");
+        }
+        fieldAttestationChecks.append(heading, details);
+    }
+}
+
 const initialize = async () => {
     await displayAttestation();
+    await displayAttestationChecks();
 }
 
 window.addEventListener(eventDomLoaded, initialize);
\ No newline at end of file
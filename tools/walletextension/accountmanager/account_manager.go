@@ -1,13 +1,16 @@
 package accountmanager
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/obscuronet/go-obscuro/go/common/gethencoding"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
@@ -23,6 +26,7 @@ import (
 	"github.com/obscuronet/go-obscuro/tools/walletextension/userconn"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
 const (
@@ -40,6 +44,7 @@ type AccountManager struct {
 	unauthedClient rpc.Client
 	// TODO - Create two types of clients - WS clients, and HTTP clients - to not create WS clients unnecessarily.
 	accountClients map[gethcommon.Address]*rpc.EncRPCClient // An encrypted RPC client per registered account
+	txSenders      *txSenders                               // The senders of the transactions recently submitted via the wallet extension
 	logger         gethlog.Logger
 }
 
@@ -47,6 +52,7 @@ func NewAccountManager(unauthedClient rpc.Client, logger gethlog.Logger) Account
 	return AccountManager{
 		unauthedClient: unauthedClient,
 		accountClients: make(map[gethcommon.Address]*rpc.EncRPCClient),
+		txSenders:      newTxSenders(maxTrackedTxs),
 		logger:         logger,
 	}
 }
@@ -56,31 +62,34 @@ func (m *AccountManager) AddClient(address gethcommon.Address, client *rpc.EncRP
 	m.accountClients[address] = client
 }
 
-// ProxyRequest tries to identify the correct EncRPCClient to proxy the request to the Obscuro node, or it will attempt
-// the request with all clients until it succeeds
+// ProxyRequest identifies the correct EncRPCClient to proxy the request to the Obscuro node. If none can be identified,
+// the request is fanned out to all the clients
 func (m *AccountManager) ProxyRequest(rpcReq *RPCRequest, rpcResp *interface{}, userConn userconn.UserConn) error {
+	// subscriptions to public data do not require a viewing key, so they are always made with the unauthenticated client
+	if isPublicSubscription(rpcReq) {
+		return m.executePublicSubscribe(rpcReq, rpcResp, userConn)
+	}
+
 	// for obscuro RPC requests it is important we know the sender account for the viewing key encryption/decryption
-	suggestedClient := m.suggestAccountClient(rpcReq, m.accountClients)
+	account, err := m.suggestAccount(rpcReq)
+	if err != nil {
+		return err
+	}
 
 	switch {
-	case suggestedClient != nil: // use the suggested client if there is one
-		// todo: if we have a suggested client, should we still loop through the other clients if it fails?
-		// 		The call data guessing won't often be wrong but there could be edge-cases there
-		return m.performRequest(suggestedClient, rpcReq, rpcResp, userConn)
-
-	case len(m.accountClients) > 0: // try registered clients until there's a successful execution
-		m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(m.accountClients)))
-		var err error
-		for _, client := range m.accountClients {
-			err = m.performRequest(client, rpcReq, rpcResp, userConn)
-			if err == nil || errors.Is(err, rpc.ErrNilResponse) {
-				// request didn't fail, we don't need to continue trying the other clients
-				return nil
+	case account != nil: // use the suggested account's client if there is one
+		err = m.performRequest(m.accountClients[*account], rpcReq, rpcResp, userConn)
+		if err == nil && rpcReq.Method == rpc.SendRawTransaction {
+			// We remember the transaction's sender, so that lookups of the transaction are routed to its client.
+			if txHash, ok := (*rpcResp).(string); ok {
+				m.txSenders.add(gethcommon.HexToHash(txHash), *account)
 			}
 		}
-		// every attempt errored
 		return err
 
+	case len(m.accountClients) > 0: // no single account applies, so we fan the request out to all clients
+		return m.fanOutRequest(rpcReq, rpcResp, userConn)
+
 	default: // no clients registered, use the unauthenticated one
 		if rpc.IsSensitiveMethod(rpcReq.Method) {
 			return fmt.Errorf(ErrNoViewingKey, rpcReq.Method)
@@ -89,42 +98,45 @@ func (m *AccountManager) ProxyRequest(rpcReq *RPCRequest, rpcResp *interface{},
 	}
 }
 
-// suggestAccountClient works through various methods to try and guess which available client to use for a request, returns nil if none found
-func (m *AccountManager) suggestAccountClient(req *RPCRequest, accClients map[gethcommon.Address]*rpc.EncRPCClient) *rpc.EncRPCClient {
-	if len(accClients) == 1 {
-		for _, client := range accClients {
-			// return the first (and only) client
-			return client
-		}
+// Makes the request with all the clients, in order of account address. Log requests are made with every client and the
+// results merged, since each account may only be able to see some of the logs. Other requests are attempted with each
+// client until one succeeds.
+func (m *AccountManager) fanOutRequest(req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
+	clients := make([]*rpc.EncRPCClient, 0, len(m.accountClients))
+	for _, client := range m.accountClients {
+		clients = append(clients, client)
 	}
+	sort.Slice(clients, func(i, j int) bool {
+		return bytes.Compare(clients[i].Account().Bytes(), clients[j].Account().Bytes()) < 0
+	})
 
-	paramsMap, err := parseParams(req.Params)
-	if err != nil {
-		// no further info to deduce calling client
-		return nil
+	switch {
+	case req.Method == rpc.GetLogs:
+		return executeMergedGetLogs(clients, req, resp)
+	case isLogSubscription(req):
+		return m.executeLogSubscribe(clients, req, resp, userConn)
 	}
 
-	if req.Method == rpc.Call {
-		// check if request params had a "from" address and if we had a client for that address
-		fromClient, found := checkForFromField(paramsMap, accClients)
-		if found {
-			return fromClient
-		}
-
-		// Otherwise, we search the `data` field for an address matching a registered viewing key.
-		addr, err := searchDataFieldForAccount(paramsMap, accClients)
+	m.logger.Info(fmt.Sprintf("appropriate client not found, attempting request with up to %d clients", len(clients)))
+	var err error
+	foundNilResponse := false
+	for _, client := range clients {
+		err = m.performRequest(client, req, resp, userConn)
 		if err == nil {
-			return accClients[*addr]
+			// request didn't fail, we don't need to continue trying the other clients
+			return nil
+		}
+		// A nil response may mean the account cannot see the requested item (e.g. a transaction receipt), so we
+		// continue trying the other clients.
+		if errors.Is(err, rpc.ErrNilResponse) {
+			foundNilResponse = true
 		}
 	}
-
-	// TODO - #1016 - Use the first topic to determine which account to make a log subscription or get logs request from.
-	//  Currently, the request is attempted on all clients.
-
-	// todo: add other mechanisms for determining the correct account to use. E.g. we may want to start caching and
-	// 	 	recent transaction hashes for accounts so that receipt lookups know which acc to use
-
-	return nil
+	if foundNilResponse {
+		return rpc.ErrNilResponse
+	}
+	// every attempt errored
+	return err
 }
 
 // Many eth RPC requests provide params as first argument in a json map with similar fields (e.g. a `from` field)
@@ -150,7 +162,7 @@ func parseParams(args []interface{}) (map[string]interface{}, error) {
 	return params, nil
 }
 
-func checkForFromField(paramsMap map[string]interface{}, accClients map[gethcommon.Address]*rpc.EncRPCClient) (*rpc.EncRPCClient, bool) {
+func checkForFromField(paramsMap map[string]interface{}, accClients map[gethcommon.Address]*rpc.EncRPCClient) (*gethcommon.Address, bool) {
 	fromVal, found := paramsMap[wecommon.JSONKeyFrom]
 	if !found {
 		return nil, false
@@ -162,8 +174,8 @@ func checkForFromField(paramsMap map[string]interface{}, accClients map[gethcomm
 	}
 
 	fromAddr := gethcommon.HexToAddress(fromStr)
-	client, found := accClients[fromAddr]
-	return client, found
+	_, found = accClients[fromAddr]
+	return &fromAddr, found
 }
 
 // Extracts the arguments from the request's `data` field. If any of them, after removing padding, match the viewing
@@ -218,62 +230,148 @@ func (m *AccountManager) performRequest(client *rpc.EncRPCClient, req *RPCReques
 	return executeCall(client, req, resp)
 }
 
+// Makes the get logs request with each client, and returns the logs that are visible to any of the accounts, ordered by
+// batch and without duplicates.
+func executeMergedGetLogs(clients []*rpc.EncRPCClient, req *RPCRequest, resp *interface{}) error {
+	var mergedLogs []*types.Log
+	seen := map[logKey]bool{}
+	var err error
+	succeeded := false
+	for _, client := range clients {
+		var logs []*types.Log
+		if err = executeCall(client, req, &logs); err != nil && !errors.Is(err, rpc.ErrNilResponse) {
+			continue
+		}
+		succeeded = true
+		for _, logItem := range logs {
+			if key := keyForLog(logItem); !seen[key] {
+				seen[key] = true
+				mergedLogs = append(mergedLogs, logItem)
+			}
+		}
+	}
+	if !succeeded {
+		return err
+	}
+
+	sort.SliceStable(mergedLogs, func(i, j int) bool {
+		if mergedLogs[i].BlockNumber != mergedLogs[j].BlockNumber {
+			return mergedLogs[i].BlockNumber < mergedLogs[j].BlockNumber
+		}
+		return mergedLogs[i].Index < mergedLogs[j].Index
+	})
+	if mergedLogs == nil {
+		mergedLogs = []*types.Log{}
+	}
+	*resp = mergedLogs
+	return nil
+}
+
 func (m *AccountManager) executeSubscribe(client *rpc.EncRPCClient, req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
 	if len(req.Params) == 0 {
 		return fmt.Errorf("could not subscribe as no subscription namespace was provided")
 	}
-	ch := make(chan common.IDAndLog)
-	subscription, err := client.Subscribe(context.Background(), resp, rpc.SubscribeNamespace, ch, req.Params...)
+
+	if req.Params[0] == rpc.SubscriptionTypePendingTransactions {
+		ch := make(chan common.IDAndTxHash)
+		subscription, err := client.Subscribe(context.Background(), resp, rpc.SubscribeNamespace, ch, req.Params...)
+		if err != nil {
+			return fmt.Errorf("could not call %s with params %v. Cause: %w", req.Method, req.Params, err)
+		}
+
+		// We listen for incoming transaction hashes on the subscription.
+		go func() {
+			for {
+				select {
+				case idAndTxHash := <-ch:
+					if !m.forwardSubscriptionEvent(userConn, idAndTxHash.SubID, idAndTxHash.TxHash) {
+						return
+					}
+				case err = <-subscription.Err():
+					// A message on this channel means the subscription has ended, so we exit the loop. The error is nil if
+					// the subscription was terminated by unsubscribing.
+					if err != nil {
+						userConn.HandleError(err.Error())
+					}
+					return
+				}
+			}
+		}()
+		go m.unsubscribeOnClose(subscription, userConn)
+		return nil
+	}
+
+	return m.executeLogSubscribe([]*rpc.EncRPCClient{client}, req, resp, userConn)
+}
+
+// Subscribes to public data (new heads or rollups). These subscriptions are not wrapped by the node, so the node
+// doesn't send the subscription ID back, and we generate our own ID to return to the client.
+func (m *AccountManager) executePublicSubscribe(req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
+	ch := make(chan json.RawMessage)
+	subscription, err := m.unauthedClient.Subscribe(context.Background(), nil, rpc.SubscribeNamespace, ch, req.Params...)
 	if err != nil {
 		return fmt.Errorf("could not call %s with params %v. Cause: %w", req.Method, req.Params, err)
 	}
+	subID := gethrpc.NewID()
+	*resp = subID
 
-	// We listen for incoming messages on the subscription.
+	// We listen for incoming headers on the subscription.
 	go func() {
 		for {
 			select {
-			case idAndLog := <-ch:
-				if userConn.IsClosed() {
-					m.logger.Info("received log but websocket was closed on subscription", log.SubIDKey, idAndLog.SubID)
+			case header := <-ch:
+				if !m.forwardSubscriptionEvent(userConn, subID, header) {
 					return
 				}
-
-				jsonResponse, err := prepareLogResponse(idAndLog)
-				if err != nil {
-					m.logger.Error("could not marshal log response to JSON on subscription.", log.SubIDKey, idAndLog.SubID, log.ErrKey, err)
-					continue
-				}
-
-				m.logger.Trace(fmt.Sprintf("Forwarding log from Obscuro node: %s", jsonResponse), log.SubIDKey, idAndLog.SubID)
-				err = userConn.WriteResponse(jsonResponse)
+			case err = <-subscription.Err():
+				// A message on this channel means the subscription has ended, so we exit the loop. The error is nil if
+				// the subscription was terminated by unsubscribing.
 				if err != nil {
-					m.logger.Error("could not write the JSON log to the websocket on subscription %", log.SubIDKey, idAndLog.SubID, log.ErrKey, err)
-					continue
+					userConn.HandleError(err.Error())
 				}
-
-			case err = <-subscription.Err():
-				// An error on this channel means the subscription has ended, so we exit the loop.
-				userConn.HandleError(err.Error())
-				return
-			}
-		}
-	}()
-
-	// We periodically check if the websocket is closed, and terminate the subscription.
-	go func() {
-		for {
-			if userConn.IsClosed() {
-				subscription.Unsubscribe()
 				return
 			}
-			time.Sleep(100 * time.Millisecond)
 		}
 	}()
+	go m.unsubscribeOnClose(subscription, userConn)
 
 	return nil
 }
 
-func executeCall(client *rpc.EncRPCClient, req *RPCRequest, resp *interface{}) error {
+// Writes an event received on a subscription to the websocket. Returns false if the websocket is closed, meaning the
+// subscription should no longer be forwarded.
+func (m *AccountManager) forwardSubscriptionEvent(userConn userconn.UserConn, subID gethrpc.ID, result interface{}) bool {
+	if userConn.IsClosed() {
+		m.logger.Info("received event but websocket was closed on subscription", log.SubIDKey, subID)
+		return false
+	}
+
+	jsonResponse, err := prepareSubscriptionResponse(subID, result)
+	if err != nil {
+		m.logger.Error("could not marshal event response to JSON on subscription.", log.SubIDKey, subID, log.ErrKey, err)
+		return true
+	}
+
+	m.logger.Trace(fmt.Sprintf("Forwarding event from Obscuro node: %s", jsonResponse), log.SubIDKey, subID)
+	err = userConn.WriteResponse(jsonResponse)
+	if err != nil {
+		m.logger.Error("could not write the JSON event to the websocket on subscription", log.SubIDKey, subID, log.ErrKey, err)
+	}
+	return true
+}
+
+// We periodically check if the websocket is closed, and terminate the subscription.
+func (m *AccountManager) unsubscribeOnClose(subscription *gethrpc.ClientSubscription, userConn userconn.UserConn) {
+	for {
+		if userConn.IsClosed() {
+			subscription.Unsubscribe()
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func executeCall(client *rpc.EncRPCClient, req *RPCRequest, resp interface{}) error {
 	if req.Method == rpc.Call || req.Method == rpc.EstimateGas {
 		// Never modify the original request, as it might be reused.
 		req = req.Clone()
@@ -330,11 +428,24 @@ func setFromFieldIfMissing(args []interface{}, account gethcommon.Address) ([]in
 	return request, nil
 }
 
-// Formats the log to be sent as an Eth JSON-RPC response.
-func prepareLogResponse(idAndLog common.IDAndLog) ([]byte, error) {
+// Indicates whether the request is a subscription to logs.
+func isLogSubscription(req *RPCRequest) bool {
+	return req.Method == rpc.Subscribe && len(req.Params) > 0 && req.Params[0] == rpc.SubscriptionTypeLogs
+}
+
+// Indicates whether the request is a subscription to public data, which does not require a viewing key.
+func isPublicSubscription(req *RPCRequest) bool {
+	if req.Method != rpc.Subscribe || len(req.Params) == 0 {
+		return false
+	}
+	return req.Params[0] == rpc.SubscriptionTypeNewHeads || req.Params[0] == rpc.SubscriptionTypeRollups
+}
+
+// Formats the subscription event (e.g. a log) to be sent as an Eth JSON-RPC response.
+func prepareSubscriptionResponse(subID gethrpc.ID, result interface{}) ([]byte, error) {
 	paramsMap := make(map[string]interface{})
-	paramsMap[wecommon.JSONKeySubscription] = idAndLog.SubID
-	paramsMap[wecommon.JSONKeyResult] = idAndLog.Log
+	paramsMap[wecommon.JSONKeySubscription] = subID
+	paramsMap[wecommon.JSONKeyResult] = result
 
 	respMap := make(map[string]interface{})
 	respMap[wecommon.JSONKeyRPCVersion] = jsonrpc.Version
@@ -343,22 +454,24 @@ func prepareLogResponse(idAndLog common.IDAndLog) ([]byte, error) {
 
 	jsonResponse, err := json.Marshal(respMap)
 	if err != nil {
-		return nil, fmt.Errorf("could not marshal log response to JSON. Cause: %w", err)
+		return nil, fmt.Errorf("could not marshal subscription response to JSON. Cause: %w", err)
 	}
 	return jsonResponse, nil
 }
 
 type RPCRequest struct {
-	ID     json.RawMessage
-	Method string
-	Params []interface{}
+	ID      json.RawMessage
+	Method  string
+	Params  []interface{}
+	Account *gethcommon.Address // The account the user chose to make the request with, if any
 }
 
 // Clone returns a new instance of the *RPCRequest
 func (r *RPCRequest) Clone() *RPCRequest {
 	return &RPCRequest{
-		ID:     r.ID,
-		Method: r.Method,
-		Params: r.Params,
+		ID:      r.ID,
+		Method:  r.Method,
+		Params:  r.Params,
+		Account: r.Account,
 	}
 }
@@ -0,0 +1,281 @@
+package accountmanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/common"
+	"github.com/obscuronet/go-obscuro/go/common/log"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+	"github.com/obscuronet/go-obscuro/tools/walletextension/userconn"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	wecommon "github.com/obscuronet/go-obscuro/tools/walletextension/common"
+)
+
+const (
+	maxResubscribeAttempts   = 10
+	resubscribeRetryInterval = time.Second
+	closedConnCheckInterval  = 100 * time.Millisecond
+	logDedupeWindow          = 1000 // The number of batches behind the latest forwarded log for which logs are deduplicated
+)
+
+// Subscribes to logs with each of the clients, and forwards the logs to the user under a single subscription ID. If
+// there are several clients (i.e. the subscription could not be routed to a single account), a log that is visible to
+// several of the accounts is only forwarded once.
+//
+// If a subscription to the node is lost while the user is still connected, it is resumed from the last batch whose logs
+// were forwarded, with the node replaying the logs missed in between.
+func (m *AccountManager) executeLogSubscribe(clients []*rpc.EncRPCClient, req *RPCRequest, resp *interface{}, userConn userconn.UserConn) error {
+	if len(clients) == 0 {
+		return fmt.Errorf("could not subscribe as no clients were provided")
+	}
+	startBatch := m.getLogSubscriptionStart(clients[0], req.Params)
+
+	var dedupe *logDeduplicator
+	if len(clients) > 1 {
+		dedupe = newLogDeduplicator()
+	}
+
+	var subID gethrpc.ID
+	var err error
+	for _, client := range clients {
+		ch := make(chan common.IDAndLog)
+		var clientResp interface{}
+		subscription, subErr := client.Subscribe(context.Background(), &clientResp, rpc.SubscribeNamespace, ch, req.Params...)
+		if subErr != nil {
+			err = fmt.Errorf("could not call %s with params %v. Cause: %w", req.Method, req.Params, subErr)
+			m.logger.Warn("Could not subscribe for logs.", "account", client.Account(), log.ErrKey, subErr)
+			continue
+		}
+		clientSubID, ok := clientResp.(string)
+		if !ok {
+			subscription.Unsubscribe()
+			err = fmt.Errorf("expected subscription ID of type string, got %T", clientResp)
+			continue
+		}
+
+		// The logs from all the clients are forwarded under the ID of the first subscription.
+		if subID == "" {
+			subID = gethrpc.ID(clientSubID)
+		}
+		cursor := &logCursor{startBatch: startBatch}
+		go m.forwardLogs(client, req.Params, subID, subscription, ch, cursor, dedupe, userConn)
+	}
+
+	if subID == "" {
+		return err
+	}
+	*resp = string(subID)
+	return nil
+}
+
+// Forwards the logs received on the subscription to the user under the given subscription ID, resubscribing if the
+// subscription to the node is lost.
+func (m *AccountManager) forwardLogs(client *rpc.EncRPCClient, params []interface{}, subID gethrpc.ID, subscription *gethrpc.ClientSubscription, ch chan common.IDAndLog, cursor *logCursor, dedupe *logDeduplicator, userConn userconn.UserConn) {
+	closedConnCheck := time.NewTicker(closedConnCheckInterval)
+	defer closedConnCheck.Stop()
+
+	for {
+		select {
+		case idAndLog := <-ch:
+			// After resubscribing, the node replays the logs of the last batch we forwarded logs for.
+			if cursor.isForwarded(idAndLog.Log) {
+				continue
+			}
+			cursor.update(idAndLog.Log)
+			if !m.forwardLog(userConn, subID, idAndLog.Log, dedupe) {
+				subscription.Unsubscribe()
+				return
+			}
+
+		case err := <-subscription.Err():
+			if userConn.IsClosed() {
+				return
+			}
+			m.logger.Warn("Log subscription to the node was lost. Resubscribing.", log.SubIDKey, subID, log.ErrKey, err)
+			subscription = m.resubscribeLogs(client, params, subID, ch, cursor, userConn)
+			if subscription == nil {
+				return
+			}
+			cursor.resumed = true
+
+		case <-closedConnCheck.C:
+			if userConn.IsClosed() {
+				subscription.Unsubscribe()
+				return
+			}
+		}
+	}
+}
+
+// Forwards the log to the user, unless the deduplicator (if any) shows the log has already been forwarded. Returns false
+// if the websocket is closed.
+func (m *AccountManager) forwardLog(userConn userconn.UserConn, subID gethrpc.ID, logItem *types.Log, dedupe *logDeduplicator) bool {
+	if dedupe == nil {
+		return m.forwardSubscriptionEvent(userConn, subID, logItem)
+	}
+
+	// We hold the lock while forwarding, so that the logs from the different clients are written one at a time.
+	dedupe.lock.Lock()
+	defer dedupe.lock.Unlock()
+	if !dedupe.add(logItem) {
+		return true
+	}
+	return m.forwardSubscriptionEvent(userConn, subID, logItem)
+}
+
+// Recreates the log subscription from the cursor's resume point. Returns nil if the subscription cannot be recreated,
+// or the user disconnects in the meantime.
+func (m *AccountManager) resubscribeLogs(client *rpc.EncRPCClient, params []interface{}, subID gethrpc.ID, ch chan common.IDAndLog, cursor *logCursor, userConn userconn.UserConn) *gethrpc.ClientSubscription {
+	var err error
+	for attempt := 0; attempt < maxResubscribeAttempts; attempt++ {
+		if userConn.IsClosed() {
+			return nil
+		}
+
+		var subscription *gethrpc.ClientSubscription
+		subscription, err = client.Subscribe(context.Background(), nil, rpc.SubscribeNamespace, ch, cursor.resumeParams(params)...)
+		if err == nil {
+			return subscription
+		}
+		m.logger.Warn("Could not resubscribe for logs.", log.SubIDKey, subID, log.ErrKey, err)
+		time.Sleep(resubscribeRetryInterval)
+	}
+
+	userConn.HandleError(fmt.Sprintf("could not resume log subscription %s. Cause: %s", subID, err))
+	return nil
+}
+
+// Returns the batch from which logs would be delivered by a new log subscription with the given params. This is the
+// requested `fromBlock` if there is one, and otherwise the batch after the current head batch. Returns nil if this
+// cannot be determined, in which case a lost subscription is resumed without a replay.
+func (m *AccountManager) getLogSubscriptionStart(client *rpc.EncRPCClient, params []interface{}) *uint64 {
+	if filter := getLogFilter(params); filter != nil {
+		if fromBlock, ok := filter[wecommon.JSONKeyFromBlock].(string); ok {
+			if fromBatch, err := hexutil.DecodeUint64(fromBlock); err == nil {
+				return &fromBatch
+			}
+		}
+	}
+
+	var headBatch hexutil.Uint64
+	if err := client.Call(&headBatch, rpc.RollupNumber); err != nil {
+		m.logger.Warn("Could not retrieve head batch. Lost log subscriptions will be resumed without a replay.", log.ErrKey, err)
+		return nil
+	}
+	startBatch := uint64(headBatch) + 1
+	return &startBatch
+}
+
+// Returns the filter criteria of a log subscription's params as a map, or nil if there are none.
+func getLogFilter(params []interface{}) map[string]interface{} {
+	if len(params) < 2 {
+		return nil
+	}
+	filter, ok := params[1].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return filter
+}
+
+// Tracks the logs forwarded on a log subscription, to determine where to resume it from.
+type logCursor struct {
+	startBatch *uint64 // The batch the subscription started delivering logs from, if known
+	forwarded  bool    // Whether any logs have been forwarded
+	lastBatch  uint64  // The batch of the last log forwarded
+	lastIndex  uint    // The index within its batch of the last log forwarded
+	resumed    bool    // Whether the subscription was resumed, and the node may be replaying logs already forwarded
+}
+
+// Indicates whether the log was already forwarded. This can only be the case for the logs the node replays after the
+// subscription is resumed, which are delivered in order.
+func (c *logCursor) isForwarded(logItem *types.Log) bool {
+	if !c.resumed || !c.forwarded {
+		return false
+	}
+	return logItem.BlockNumber < c.lastBatch || (logItem.BlockNumber == c.lastBatch && logItem.Index <= c.lastIndex)
+}
+
+// Records that the log was forwarded. Once a log that was not forwarded before is seen, the replay has caught up.
+func (c *logCursor) update(logItem *types.Log) {
+	c.forwarded = true
+	c.lastBatch = logItem.BlockNumber
+	c.lastIndex = logItem.Index
+	c.resumed = false
+}
+
+// Returns the params to resubscribe with. The original filter is kept, with its `fromBlock` set to the last batch logs
+// were forwarded for (since the subscription may have been lost partway through the batch's logs), or to the
+// subscription's starting batch if no logs have been forwarded.
+func (c *logCursor) resumeParams(params []interface{}) []interface{} {
+	var fromBatch *uint64
+	switch {
+	case c.forwarded:
+		fromBatch = &c.lastBatch
+	case c.startBatch != nil:
+		fromBatch = c.startBatch
+	default:
+		return params
+	}
+
+	filter := map[string]interface{}{}
+	for key, value := range getLogFilter(params) {
+		filter[key] = value
+	}
+	filter[wecommon.JSONKeyFromBlock] = hexutil.EncodeUint64(*fromBatch)
+	return []interface{}{rpc.SubscriptionTypeLogs, filter}
+}
+
+// Uniquely identifies a log.
+type logKey struct {
+	batchHash gethcommon.Hash
+	txHash    gethcommon.Hash
+	index     uint
+}
+
+func keyForLog(logItem *types.Log) logKey {
+	return logKey{batchHash: logItem.BlockHash, txHash: logItem.TxHash, index: logItem.Index}
+}
+
+// Tracks the logs forwarded on a subscription made with several clients, so that a log received from more than one
+// client is only forwarded once. Only the logs of the most recent batches are tracked.
+type logDeduplicator struct {
+	lock        sync.Mutex
+	seen        map[uint64]map[logKey]bool // The logs forwarded, by batch number
+	latestBatch uint64
+}
+
+func newLogDeduplicator() *logDeduplicator {
+	return &logDeduplicator{seen: map[uint64]map[logKey]bool{}}
+}
+
+// Records the log as forwarded. Returns false if it had already been forwarded. Must be called with the lock held.
+func (d *logDeduplicator) add(logItem *types.Log) bool {
+	key := keyForLog(logItem)
+	batchLogs, found := d.seen[logItem.BlockNumber]
+	if !found {
+		batchLogs = map[logKey]bool{}
+		d.seen[logItem.BlockNumber] = batchLogs
+	}
+	if batchLogs[key] {
+		return false
+	}
+	batchLogs[key] = true
+
+	if logItem.BlockNumber > d.latestBatch {
+		d.latestBatch = logItem.BlockNumber
+		for batch := range d.seen {
+			if batch+logDedupeWindow < d.latestBatch {
+				delete(d.seen, batch)
+			}
+		}
+	}
+	return true
+}
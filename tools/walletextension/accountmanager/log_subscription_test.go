@@ -0,0 +1,62 @@
+package accountmanager
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+)
+
+func TestLogSubscriptionResumesFromLastForwardedBatch(t *testing.T) {
+	startBatch := uint64(3)
+	cursor := &logCursor{startBatch: &startBatch}
+	params := []interface{}{rpc.SubscriptionTypeLogs, map[string]interface{}{"address": "0x1"}}
+
+	// Before any logs are forwarded, the subscription is resumed from its starting batch.
+	expectedParams := []interface{}{rpc.SubscriptionTypeLogs, map[string]interface{}{"address": "0x1", "fromBlock": "0x3"}}
+	if resumeParams := cursor.resumeParams(params); !reflect.DeepEqual(resumeParams, expectedParams) {
+		t.Fatalf("expected resume params %v, got %v", expectedParams, resumeParams)
+	}
+
+	cursor.update(&types.Log{BlockNumber: 5, Index: 1})
+	expectedParams = []interface{}{rpc.SubscriptionTypeLogs, map[string]interface{}{"address": "0x1", "fromBlock": "0x5"}}
+	if resumeParams := cursor.resumeParams(params); !reflect.DeepEqual(resumeParams, expectedParams) {
+		t.Fatalf("expected resume params %v, got %v", expectedParams, resumeParams)
+	}
+
+	// The original params are not modified.
+	if _, found := params[1].(map[string]interface{})["fromBlock"]; found {
+		t.Fatal("expected original params not to be modified")
+	}
+}
+
+func TestLogSubscriptionSkipsReplayedLogs(t *testing.T) {
+	cursor := &logCursor{}
+	if cursor.isForwarded(&types.Log{BlockNumber: 0, Index: 0}) {
+		t.Fatal("expected no logs to be forwarded yet")
+	}
+
+	cursor.update(&types.Log{BlockNumber: 5, Index: 1})
+	if cursor.isForwarded(&types.Log{BlockNumber: 5, Index: 1}) {
+		t.Fatal("expected logs not to be deduplicated before the subscription is resumed")
+	}
+
+	cursor.resumed = true
+	for _, replayedLog := range []*types.Log{{BlockNumber: 4, Index: 3}, {BlockNumber: 5, Index: 0}, {BlockNumber: 5, Index: 1}} {
+		if !cursor.isForwarded(replayedLog) {
+			t.Fatalf("expected log %d/%d to have been forwarded", replayedLog.BlockNumber, replayedLog.Index)
+		}
+	}
+	for _, newLog := range []*types.Log{{BlockNumber: 5, Index: 2}, {BlockNumber: 6, Index: 0}} {
+		if cursor.isForwarded(newLog) {
+			t.Fatalf("expected log %d/%d not to have been forwarded", newLog.BlockNumber, newLog.Index)
+		}
+	}
+
+	// Once the replay has caught up, logs are no longer deduplicated.
+	cursor.update(&types.Log{BlockNumber: 6, Index: 0})
+	if cursor.isForwarded(&types.Log{BlockNumber: 6, Index: 0}) {
+		t.Fatal("expected logs not to be deduplicated after the replay has caught up")
+	}
+}
@@ -0,0 +1,234 @@
+package accountmanager
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	wecommon "github.com/obscuronet/go-obscuro/tools/walletextension/common"
+)
+
+const (
+	maxTrackedTxs     = 10_000 // The number of recent transactions whose senders are remembered
+	topicAddrPadding  = 12     // The number of zero bytes that pad an address to a topic's length
+	firstAddressTopic = 1      // The first topic that can hold an address; the topic at index 0 is the event signature
+)
+
+// Identifies the account whose client should be used to make the request. In order of precedence, this is:
+//   - The account the user chose for the request
+//   - The only registered account, if there is just one
+//   - The account deduced from the request's params (see `suggestAccountFromParams`)
+//
+// Returns nil if no single account applies, in which case the request should be made with all the clients.
+func (m *AccountManager) suggestAccount(req *RPCRequest) (*gethcommon.Address, error) {
+	if req.Account != nil {
+		if _, found := m.accountClients[*req.Account]; !found {
+			return nil, fmt.Errorf("no viewing key has been registered for requested account %s", req.Account.Hex())
+		}
+		return req.Account, nil
+	}
+
+	if len(m.accountClients) == 1 {
+		for account := range m.accountClients {
+			onlyAccount := account
+			return &onlyAccount, nil
+		}
+	}
+
+	return m.suggestAccountFromParams(req), nil
+}
+
+// Deduces the account to use from the request's params. This is:
+//   - For calls and gas estimates, the `from` field, or otherwise an account found in the `data` field
+//   - For raw transactions, the transaction's signer
+//   - For transaction and receipt lookups, the sender of the transaction, if it was submitted via the wallet extension
+//   - For balance and nonce lookups, the account being queried
+//   - For log requests and subscriptions, an account found in the filter's topics
+//
+// Returns nil if no registered account is found.
+func (m *AccountManager) suggestAccountFromParams(req *RPCRequest) *gethcommon.Address {
+	switch req.Method {
+	case rpc.Call, rpc.EstimateGas:
+		paramsMap, err := parseParams(req.Params)
+		if err != nil {
+			// no further info to deduce calling client
+			return nil
+		}
+
+		// check if request params had a "from" address and if we had a client for that address
+		fromAddr, found := checkForFromField(paramsMap, m.accountClients)
+		if found {
+			return fromAddr
+		}
+
+		// Otherwise, we search the `data` field for an address matching a registered viewing key.
+		addr, err := searchDataFieldForAccount(paramsMap, m.accountClients)
+		if err == nil {
+			return addr
+		}
+
+	case rpc.SendRawTransaction:
+		sender, err := getTxSender(req.Params)
+		if err != nil {
+			m.logger.Debug(fmt.Sprintf("Could not determine sender of raw transaction. Cause: %s", err))
+			return nil
+		}
+		return m.registeredAccount(sender)
+
+	case rpc.GetTransactionByHash, rpc.GetTransactionReceipt, rpc.GetTransactionStatus:
+		txHashHex, ok := firstParamString(req.Params)
+		if !ok {
+			return nil
+		}
+		if sender, found := m.txSenders.get(gethcommon.HexToHash(txHashHex)); found {
+			return m.registeredAccount(sender)
+		}
+
+	case rpc.GetBalance, rpc.GetTransactionCount:
+		addrHex, ok := firstParamString(req.Params)
+		if !ok || !gethcommon.IsHexAddress(addrHex) {
+			return nil
+		}
+		return m.registeredAccount(gethcommon.HexToAddress(addrHex))
+
+	case rpc.GetLogs:
+		filter, err := parseParams(req.Params)
+		if err != nil {
+			return nil
+		}
+		return m.searchTopicsForAccount(filter)
+
+	case rpc.Subscribe:
+		if isLogSubscription(req) {
+			return m.searchTopicsForAccount(getLogFilter(req.Params))
+		}
+	}
+
+	return nil
+}
+
+// Returns the address if a viewing key has been registered for it, and nil otherwise.
+func (m *AccountManager) registeredAccount(address gethcommon.Address) *gethcommon.Address {
+	if _, found := m.accountClients[address]; !found {
+		return nil
+	}
+	return &address
+}
+
+// Searches the filter's topics for registered accounts. A log with an account in its topics is visible to that account,
+// so if exactly one registered account is found, it is returned. If several are found, the filter may match logs that
+// are only visible to some of them, so nil is returned.
+func (m *AccountManager) searchTopicsForAccount(filter map[string]interface{}) *gethcommon.Address {
+	topics, ok := filter[wecommon.JSONKeyTopics].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var found *gethcommon.Address
+	for i := firstAddressTopic; i < len(topics); i++ {
+		// Each topic is either a single value, or a list of alternative values.
+		alternatives, ok := topics[i].([]interface{})
+		if !ok {
+			alternatives = []interface{}{topics[i]}
+		}
+
+		for _, topic := range alternatives {
+			topicHex, ok := topic.(string)
+			if !ok {
+				continue
+			}
+			account := m.registeredAccount(topicToAddress(topicHex))
+			if account == nil {
+				continue
+			}
+			if found != nil && *found != *account {
+				return nil
+			}
+			found = account
+		}
+	}
+
+	return found
+}
+
+// Converts a topic to the address it holds. If the topic does not hold an address, the zero address is returned.
+func topicToAddress(topicHex string) gethcommon.Address {
+	topic, err := hexutil.Decode(topicHex)
+	if err != nil || len(topic) != gethcommon.HashLength {
+		return gethcommon.Address{}
+	}
+	for _, b := range topic[:topicAddrPadding] {
+		if b != 0 {
+			return gethcommon.Address{}
+		}
+	}
+	return gethcommon.BytesToAddress(topic[topicAddrPadding:])
+}
+
+// Recovers the signer of the raw transaction in the request's params.
+func getTxSender(params []interface{}) (gethcommon.Address, error) {
+	rawTxHex, ok := firstParamString(params)
+	if !ok {
+		return gethcommon.Address{}, fmt.Errorf("first param was not a raw transaction string")
+	}
+	rawTx, err := hexutil.Decode(rawTxHex)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("could not decode raw transaction from hex. Cause: %w", err)
+	}
+
+	tx := &types.Transaction{}
+	if err = tx.UnmarshalBinary(rawTx); err != nil {
+		return gethcommon.Address{}, fmt.Errorf("could not decode raw transaction. Cause: %w", err)
+	}
+	return types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+}
+
+func firstParamString(params []interface{}) (string, bool) {
+	if len(params) == 0 {
+		return "", false
+	}
+	param, ok := params[0].(string)
+	return param, ok
+}
+
+// Remembers the senders of the most recent transactions, evicting the oldest transactions first.
+type txSenders struct {
+	lock    sync.RWMutex
+	senders map[gethcommon.Hash]gethcommon.Address
+	order   []gethcommon.Hash // The tracked transactions, oldest first
+	maxTxs  int
+}
+
+func newTxSenders(maxTxs int) *txSenders {
+	return &txSenders{
+		senders: map[gethcommon.Hash]gethcommon.Address{},
+		maxTxs:  maxTxs,
+	}
+}
+
+func (t *txSenders) add(txHash gethcommon.Hash, sender gethcommon.Address) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, found := t.senders[txHash]; !found {
+		t.order = append(t.order, txHash)
+	}
+	t.senders[txHash] = sender
+
+	for len(t.order) > t.maxTxs {
+		delete(t.senders, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+func (t *txSenders) get(txHash gethcommon.Hash) (gethcommon.Address, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	sender, found := t.senders[txHash]
+	return sender, found
+}
@@ -0,0 +1,129 @@
+package accountmanager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/obscuronet/go-obscuro/go/rpc"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+)
+
+var unregisteredAddress = gethcommon.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976C")
+
+func TestRequestIsRoutedToChosenAccount(t *testing.T) {
+	manager := createRoutingTestManager()
+
+	req := &RPCRequest{Method: rpc.GetBalance, Params: []interface{}{viewingKeyAddressOne.Hex()}, Account: &viewingKeyAddressTwo}
+	assertSuggestedAccount(t, manager, req, &viewingKeyAddressTwo)
+
+	req.Account = &unregisteredAddress
+	if _, err := manager.suggestAccount(req); err == nil {
+		t.Fatal("expected an error when choosing an account without a viewing key")
+	}
+}
+
+func TestRequestIsRoutedToQueriedAccount(t *testing.T) {
+	manager := createRoutingTestManager()
+
+	assertSuggestedAccount(t, manager, &RPCRequest{Method: rpc.GetTransactionCount, Params: []interface{}{viewingKeyAddressTwo.Hex(), "latest"}}, &viewingKeyAddressTwo)
+	assertSuggestedAccount(t, manager, &RPCRequest{Method: rpc.GetBalance, Params: []interface{}{unregisteredAddress.Hex(), "latest"}}, nil)
+}
+
+func TestTransactionLookupIsRoutedToSender(t *testing.T) {
+	manager := createRoutingTestManager()
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key. Cause: %s", err)
+	}
+	sender := crypto.PubkeyToAddress(privateKey.PublicKey)
+	manager.accountClients[sender] = nil
+
+	signer := types.LatestSignerForChainID(big.NewInt(777))
+	tx, err := types.SignNewTx(privateKey, signer, &types.LegacyTx{To: &viewingKeyAddressOne, GasPrice: big.NewInt(1)})
+	if err != nil {
+		t.Fatalf("could not sign transaction. Cause: %s", err)
+	}
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("could not encode transaction. Cause: %s", err)
+	}
+	assertSuggestedAccount(t, manager, &RPCRequest{Method: rpc.SendRawTransaction, Params: []interface{}{hexutil.Encode(rawTx)}}, &sender)
+
+	receiptReq := &RPCRequest{Method: rpc.GetTransactionReceipt, Params: []interface{}{tx.Hash().Hex()}}
+	assertSuggestedAccount(t, manager, receiptReq, nil)
+	manager.txSenders.add(tx.Hash(), sender)
+	assertSuggestedAccount(t, manager, receiptReq, &sender)
+}
+
+func TestLogRequestIsRoutedUsingTopics(t *testing.T) {
+	manager := createRoutingTestManager()
+	eventTopic := gethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef").Hex()
+	topicOne := gethcommon.BytesToHash(viewingKeyAddressOne.Bytes()).Hex()
+	topicTwo := gethcommon.BytesToHash(viewingKeyAddressTwo.Bytes()).Hex()
+
+	filter := map[string]interface{}{"topics": []interface{}{eventTopic, nil, topicOne}}
+	assertSuggestedAccount(t, manager, &RPCRequest{Method: rpc.GetLogs, Params: []interface{}{filter}}, &viewingKeyAddressOne)
+	assertSuggestedAccount(t, manager, &RPCRequest{Method: rpc.Subscribe, Params: []interface{}{rpc.SubscriptionTypeLogs, filter}}, &viewingKeyAddressOne)
+
+	// If the logs may only be visible to some of the accounts, the request cannot be routed to a single account.
+	filter = map[string]interface{}{"topics": []interface{}{eventTopic, []interface{}{topicOne, topicTwo}}}
+	assertSuggestedAccount(t, manager, &RPCRequest{Method: rpc.GetLogs, Params: []interface{}{filter}}, nil)
+}
+
+func TestTxSendersEvictsOldestTransactions(t *testing.T) {
+	senders := newTxSenders(2)
+	for i := int64(1); i <= 3; i++ {
+		senders.add(gethcommon.BigToHash(big.NewInt(i)), viewingKeyAddressOne)
+	}
+
+	if _, found := senders.get(gethcommon.BigToHash(big.NewInt(1))); found {
+		t.Fatal("expected oldest transaction to be evicted")
+	}
+	if _, found := senders.get(gethcommon.BigToHash(big.NewInt(3))); !found {
+		t.Fatal("expected newest transaction to be tracked")
+	}
+}
+
+func TestLogDeduplicatorForwardsEachLogOnce(t *testing.T) {
+	dedupe := newLogDeduplicator()
+	logItem := &types.Log{BlockNumber: 1, BlockHash: gethcommon.HexToHash("0x1"), TxHash: gethcommon.HexToHash("0x2")}
+
+	if !dedupe.add(logItem) {
+		t.Fatal("expected log not to have been forwarded")
+	}
+	if dedupe.add(logItem) {
+		t.Fatal("expected log to have been forwarded")
+	}
+	if !dedupe.add(&types.Log{BlockNumber: 1, BlockHash: logItem.BlockHash, TxHash: logItem.TxHash, Index: 1}) {
+		t.Fatal("expected log with another index not to have been forwarded")
+	}
+
+	// The logs of old batches are no longer tracked.
+	dedupe.add(&types.Log{BlockNumber: logDedupeWindow + 2})
+	if _, found := dedupe.seen[1]; found {
+		t.Fatal("expected logs of old batches to no longer be tracked")
+	}
+}
+
+func createRoutingTestManager() *AccountManager {
+	manager := NewAccountManager(nil, gethlog.New())
+	manager.accountClients[viewingKeyAddressOne] = nil
+	manager.accountClients[viewingKeyAddressTwo] = nil
+	return &manager
+}
+
+func assertSuggestedAccount(t *testing.T, manager *AccountManager, req *RPCRequest, expected *gethcommon.Address) {
+	account, err := manager.suggestAccount(req)
+	if err != nil {
+		t.Fatalf("could not suggest account for %s request. Cause: %s", req.Method, err)
+	}
+	if (account == nil) != (expected == nil) || (account != nil && *account != *expected) {
+		t.Fatalf("expected %s request to be routed to %v, got %v", req.Method, expected, account)
+	}
+}
@@ -7,6 +7,7 @@ const (
 	JSONKeyData         = "data"
 	JSONKeyErr          = "error"
 	JSONKeyFrom         = "from"
+	JSONKeyFromBlock    = "fromBlock"
 	JSONKeyID           = "id"
 	JSONKeyMethod       = "method"
 	JSONKeyParams       = "params"
@@ -17,4 +18,9 @@ const (
 	JSONKeySubscription = "subscription"
 	JSONKeyCode         = "code"
 	JSONKeyMessage      = "message"
+	JSONKeyAccount      = "obscuroAccount"
+	JSONKeyTopics       = "topics"
+
+	// HeaderAccount is the HTTP header that can be set to choose the account whose viewing key is used for requests
+	HeaderAccount = "X-Obscuro-Account"
 )
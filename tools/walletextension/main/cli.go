@@ -43,6 +43,14 @@ const (
 	verboseFlagName    = "verbose"
 	verboseFlagDefault = false
 	verboseFlagUsage   = "Flag to enable verbose logging of wallet extension traffic"
+
+	attestationPolicyName    = "attestationPolicy"
+	attestationPolicyDefault = ""
+	attestationPolicyUsage   = "The path to a JSON file containing the policy the node's attestation is checked against. The policy must specify the accepted unique IDs or signer ID. Required unless insecureSkipAttestation is set."
+
+	insecureSkipAttestationName    = "insecureSkipAttestation"
+	insecureSkipAttestationDefault = false
+	insecureSkipAttestationUsage   = "Flag to disable the checking of the node's attestation. Only use this with local development networks, whose enclaves do not run in SGX."
 )
 
 func parseCLIArgs() walletextension.Config {
@@ -55,6 +63,8 @@ func parseCLIArgs() walletextension.Config {
 	logPath := flag.String(logPathName, logPathDefault, logPathUsage)
 	persistencePath := flag.String(persistencePathName, persistencePathDefault, persistencePathUsage)
 	verboseFlag := flag.Bool(verboseFlagName, verboseFlagDefault, verboseFlagUsage)
+	attestationPolicy := flag.String(attestationPolicyName, attestationPolicyDefault, attestationPolicyUsage)
+	insecureSkipAttestation := flag.Bool(insecureSkipAttestationName, insecureSkipAttestationDefault, insecureSkipAttestationUsage)
 	flag.Parse()
 
 	return walletextension.Config{
@@ -66,5 +76,7 @@ func parseCLIArgs() walletextension.Config {
 		LogPath:                 *logPath,
 		PersistencePathOverride: *persistencePath,
 		VerboseFlag:             *verboseFlag,
+		AttestationPolicyPath:   *attestationPolicy,
+		InsecureSkipAttestation: *insecureSkipAttestation,
 	}
 }
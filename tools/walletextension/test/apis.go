@@ -89,6 +89,11 @@ func (api *DummyAPI) GetTransactionReceipt(_ context.Context, encryptedParams co
 	return &reEncryptParams, err
 }
 
+func (api *DummyAPI) GetTransactionStatus(_ context.Context, encryptedParams common.EncryptedParamsGetTxStatus) (*string, error) {
+	reEncryptParams, err := api.reEncryptParams(encryptedParams)
+	return &reEncryptParams, err
+}
+
 func (api *DummyAPI) SendRawTransaction(_ context.Context, encryptedParams common.EncryptedParamsSendRawTx) (string, error) {
 	return api.reEncryptParams(encryptedParams)
 }
@@ -158,6 +163,64 @@ func (api *DummyAPI) Logs(ctx context.Context, encryptedParams common.EncryptedP
 	return subscription, nil
 }
 
+// NewHeads emits a batch header with an incrementing number every ten milliseconds.
+func (api *DummyAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+
+	go func() {
+		for number := int64(0); ; number++ {
+			notifier.Notify(subscription.ID, common.BatchHeader{Number: big.NewInt(number)}) //nolint:errcheck
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	return subscription, nil
+}
+
+// NewPendingTransactions emits an encrypted, unique transaction hash every ten milliseconds.
+func (api *DummyAPI) NewPendingTransactions(ctx context.Context, encryptedParams common.EncryptedParamsPendingTxSubscription) (*rpc.Subscription, error) {
+	// We check the params can be decrypted and decoded.
+	encodedParams, err := api.enclavePrivateKey.Decrypt(encryptedParams, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt params with enclave private key. Cause: %w", err)
+	}
+	var params common.PendingTxSubscription
+	if err = rlp.DecodeBytes(encodedParams, &params); err != nil {
+		return nil, fmt.Errorf("could not decocde pending transaction subscription request from RLP. Cause: %w", err)
+	}
+
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("creation of subscriptions is not supported")
+	}
+	subscription := notifier.CreateSubscription()
+	err = notifier.Notify(subscription.ID, common.IDAndEncTxHash{
+		SubID: subscription.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not send subscription ID to client on subscription %s", subscription.ID)
+	}
+
+	go func() {
+		for idx := int64(0); ; idx++ {
+			txHash := []byte(gethcommon.BigToHash(big.NewInt(idx)).Hex())
+			encryptedBytes, err := ecies.Encrypt(rand.Reader, api.viewingKey, txHash, nil, nil)
+			if err != nil {
+				panic("could not encrypt transaction hash with viewing key")
+			}
+			notifier.Notify(subscription.ID, common.IDAndEncTxHash{ //nolint:errcheck
+				SubID:     subscription.ID,
+				EncTxHash: encryptedBytes,
+			})
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	return subscription, nil
+}
+
 func (api *DummyAPI) GetLogs(_ context.Context, encryptedParams common.EncryptedParamsGetLogs) (*string, error) {
 	reEncryptParams, err := api.reEncryptParams(encryptedParams)
 	return &reEncryptParams, err
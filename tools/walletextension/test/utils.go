@@ -21,6 +21,7 @@ import (
 	"github.com/obscuronet/go-obscuro/tools/walletextension"
 	"github.com/obscuronet/go-obscuro/tools/walletextension/common"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethnode "github.com/ethereum/go-ethereum/node"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
@@ -40,6 +41,8 @@ func createWalExtCfg(connectPort, wallHTTPPort, wallWSPort int) *walletextension
 		PersistencePathOverride: testPersistencePath.Name(),
 		WalletExtensionPort:     wallHTTPPort,
 		WalletExtensionPortWS:   wallWSPort,
+		// The dummy host cannot produce a verifiable attestation.
+		InsecureSkipAttestation: true,
 	}
 }
 
@@ -115,6 +118,29 @@ func makeHTTPEthJSONReq(port int, method string, params interface{}) []byte {
 	return makeRequestHTTP(fmt.Sprintf("http://%s:%d", common.Localhost, port), reqBody)
 }
 
+// Makes an Ethereum JSON RPC request over HTTP using the given account, and returns the response body.
+func makeHTTPEthJSONReqAsAccount(port int, method string, params interface{}, account gethcommon.Address) []byte {
+	reqBody := prepareRequestBody(method, params)
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s:%d", common.Localhost, port), bytes.NewBuffer(reqBody)) //nolint:noctx
+	if err != nil {
+		panic(err)
+	}
+	req.Header.Set(common.HeaderAccount, account.Hex())
+
+	resp, err := http.DefaultClient.Do(req)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		panic(err)
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+	return respBody
+}
+
 // Makes an Ethereum JSON RPC request over websockets and returns the response body.
 func makeWSEthJSONReq(port int, method string, params interface{}) ([]byte, *websocket.Conn) {
 	reqBody := prepareRequestBody(method, params)
@@ -156,6 +182,12 @@ func prepareRequestBody(method string, params interface{}) []byte {
 
 // Generates a new account and registers it with the node.
 func registerPrivateKey(t *testing.T, walletHTTPPort, walletWSPort int, useWS bool) []byte {
+	_, viewingKeyBytes := registerAccount(t, walletHTTPPort, walletWSPort, useWS)
+	return viewingKeyBytes
+}
+
+// Generates a new account and registers it with the node. Returns the account's address and viewing key.
+func registerAccount(t *testing.T, walletHTTPPort, walletWSPort int, useWS bool) (gethcommon.Address, []byte) {
 	accountPrivateKey, err := crypto.GenerateKey()
 	if err != nil {
 		t.Fatalf(err.Error())
@@ -166,7 +198,7 @@ func registerPrivateKey(t *testing.T, walletHTTPPort, walletWSPort int, useWS bo
 	signature := signViewingKey(accountPrivateKey, viewingKeyBytes)
 	submitViewingKey(accountAddr.String(), walletHTTPPort, walletWSPort, signature, useWS)
 
-	return viewingKeyBytes
+	return accountAddr, viewingKeyBytes
 }
 
 // Generates a viewing key.
@@ -44,6 +44,7 @@ func TestWalletExtension(t *testing.T) {
 		"canInvokeSensitiveMethodsAfterSubmittingMultipleViewingKeys": canInvokeSensitiveMethodsAfterSubmittingMultipleViewingKeys,
 		"cannotSubscribeOverHTTP":                                     cannotSubscribeOverHTTP,
 		"canRegisterViewingKeyAndMakeRequestsOverWebsockets":          canRegisterViewingKeyAndMakeRequestsOverWebsockets,
+		"canChooseAccountForRequest":                                  canChooseAccountForRequest,
 	} {
 		t.Run(name, func(t *testing.T) {
 			hostPort := _hostWSPort + i*_testOffset
@@ -143,6 +144,24 @@ func canInvokeSensitiveMethodsAfterSubmittingMultipleViewingKeys(t *testing.T, t
 	}
 }
 
+func canChooseAccountForRequest(t *testing.T, testHelper *testHelper) {
+	chosenAccount, chosenViewingKey := registerAccount(t, testHelper.walletHTTPPort, testHelper.walletWSPort, false)
+	otherAccount, _ := registerAccount(t, testHelper.walletHTTPPort, testHelper.walletWSPort, false)
+	testHelper.hostAPI.setViewingKey(chosenViewingKey)
+
+	respBody := makeHTTPEthJSONReqAsAccount(testHelper.walletHTTPPort, rpc.GetBalance, []interface{}{map[string]interface{}{"params": dummyParams}}, chosenAccount)
+	validateJSONResponse(t, respBody)
+	if !strings.Contains(string(respBody), dummyParams) {
+		t.Fatalf("expected response containing '%s', got '%s'", dummyParams, string(respBody))
+	}
+
+	// The request is only made with the chosen account, rather than being attempted with every account.
+	respBody = makeHTTPEthJSONReqAsAccount(testHelper.walletHTTPPort, rpc.GetBalance, []interface{}{map[string]interface{}{"params": dummyParams}}, otherAccount)
+	if !strings.Contains(string(respBody), errFailedDecrypt) {
+		t.Fatalf("expected response containing '%s', got '%s'", errFailedDecrypt, string(respBody))
+	}
+}
+
 func cannotSubscribeOverHTTP(t *testing.T, testHelper *testHelper) {
 	respBody := makeHTTPEthJSONReq(testHelper.walletHTTPPort, rpc.Subscribe, []interface{}{rpc.SubscriptionTypeLogs})
 	fmt.Println(respBody)
@@ -274,3 +293,79 @@ func TestCanSubscribeForLogsOverWebsockets(t *testing.T) {
 		}
 	}
 }
+
+func TestCanSubscribeForNewHeadsOverWebsocketsWithoutViewingKey(t *testing.T) {
+	hostPort := _hostWSPort + _testOffset*10
+	walletHTTPPort := hostPort + 1
+	walletWSPort := hostPort + 2
+
+	_, shutdownHost := createDummyHost(t, hostPort)
+	defer shutdownHost() //nolint: errcheck
+	shutdownWallet := createWalExt(t, createWalExtCfg(hostPort, walletHTTPPort, walletWSPort))
+	defer shutdownWallet()
+
+	resp, conn := makeWSEthJSONReq(walletWSPort, rpc.Subscribe, []interface{}{rpc.SubscriptionTypeNewHeads})
+	validateSubscriptionResponse(t, resp)
+
+	headersJSON := readMessagesForDuration(t, conn, time.Second)
+	if len(headersJSON) < 50 {
+		t.Errorf("expected to receive at least 50 headers, only received %d", len(headersJSON))
+	}
+
+	var subResp map[string]interface{}
+	if err := json.Unmarshal(resp, &subResp); err != nil {
+		t.Fatalf("could not unmarshal subscription response from JSON")
+	}
+	for _, headerJSON := range headersJSON {
+		var headerResp map[string]interface{}
+		if err := json.Unmarshal(headerJSON, &headerResp); err != nil {
+			t.Fatalf("could not unmarshal received header from JSON")
+		}
+		params := headerResp[wecommon.JSONKeyParams].(map[string]interface{})
+		if params[wecommon.JSONKeySubscription] != subResp[wecommon.JSONKeyResult] {
+			t.Errorf("expected header for subscription %s, got %s", subResp[wecommon.JSONKeyResult], params[wecommon.JSONKeySubscription])
+		}
+		if _, found := params[wecommon.JSONKeyResult].(map[string]interface{})["Number"]; !found {
+			t.Errorf("expected a batch header, got %s", string(headerJSON))
+		}
+	}
+}
+
+func TestCanSubscribeForPendingTransactionsOverWebsockets(t *testing.T) {
+	hostPort := _hostWSPort + _testOffset*11
+	walletHTTPPort := hostPort + 1
+	walletWSPort := hostPort + 2
+
+	dummyAPI, shutdownHost := createDummyHost(t, hostPort)
+	defer shutdownHost() //nolint: errcheck
+	shutdownWallet := createWalExt(t, createWalExtCfg(hostPort, walletHTTPPort, walletWSPort))
+	defer shutdownWallet()
+
+	viewingKeyBytes := registerPrivateKey(t, walletHTTPPort, walletWSPort, false)
+	dummyAPI.setViewingKey(viewingKeyBytes)
+
+	resp, conn := makeWSEthJSONReq(walletWSPort, rpc.Subscribe, []interface{}{rpc.SubscriptionTypePendingTransactions})
+	validateSubscriptionResponse(t, resp)
+
+	txHashesJSON := readMessagesForDuration(t, conn, time.Second)
+	if len(txHashesJSON) < 50 {
+		t.Errorf("expected to receive at least 50 transaction hashes, only received %d", len(txHashesJSON))
+	}
+
+	// We check the hashes were decrypted, and that none were sent twice.
+	seen := map[string]bool{}
+	for _, txHashJSON := range txHashesJSON {
+		var txHashResp map[string]interface{}
+		if err := json.Unmarshal(txHashJSON, &txHashResp); err != nil {
+			t.Fatalf("could not unmarshal received transaction hash from JSON")
+		}
+		txHash := txHashResp[wecommon.JSONKeyParams].(map[string]interface{})[wecommon.JSONKeyResult].(string)
+		if !strings.HasPrefix(txHash, "0x000000") {
+			t.Errorf("expected a decrypted transaction hash, got %s", txHash)
+		}
+		if seen[txHash] {
+			t.Errorf("received transaction hash %s twice", txHash)
+		}
+		seen[txHash] = true
+	}
+}
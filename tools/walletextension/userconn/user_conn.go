@@ -29,6 +29,7 @@ type UserConn interface {
 	HandleError(msg string)
 	SupportsSubscriptions() bool
 	IsClosed() bool
+	Header(name string) string // The value of the named header of the HTTP request that opened the connection
 }
 
 // Represents a user's connection over HTTP.
@@ -41,6 +42,7 @@ type userConnHTTP struct {
 // Represents a user's connection websockets.
 type userConnWS struct {
 	conn     *websocket.Conn
+	header   http.Header
 	isClosed bool
 	logger   gethlog.Logger
 }
@@ -61,6 +63,7 @@ func NewUserConnWS(resp http.ResponseWriter, req *http.Request, logger gethlog.L
 
 	return &userConnWS{
 		conn:   conn,
+		header: req.Header,
 		logger: logger,
 	}, nil
 }
@@ -98,6 +101,10 @@ func (h *userConnHTTP) IsClosed() bool {
 	return false
 }
 
+func (h *userConnHTTP) Header(name string) string {
+	return h.req.Header.Get(name)
+}
+
 func (w *userConnWS) ReadRequest() ([]byte, error) {
 	_, msg, err := w.conn.ReadMessage()
 	if err != nil {
@@ -154,6 +161,10 @@ func (w *userConnWS) IsClosed() bool {
 	return w.isClosed
 }
 
+func (w *userConnWS) Header(name string) string {
+	return w.header.Get(name)
+}
+
 // Logs the error, prints it to the console, and returns the error over HTTP.
 func httpLogAndSendErr(resp http.ResponseWriter, msg string) {
 	http.Error(resp, msg, httpCodeErr)
@@ -18,6 +18,7 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/go-kit/kit/transport/http/jsonrpc"
+	"github.com/obscuronet/go-obscuro/go/common/attestation"
 	"github.com/obscuronet/go-obscuro/go/common/httputil"
 	"github.com/obscuronet/go-obscuro/go/common/log"
 	"github.com/obscuronet/go-obscuro/go/rpc"
@@ -54,6 +55,7 @@ type WalletExtension struct {
 	persistence        *persistence.Persistence
 	logger             gethlog.Logger
 	isShutDown         atomicBool
+	clientOpts         []rpc.EncRPCClientOption // The options used to create the encrypted RPC clients, e.g. to verify the node's attestation.
 }
 
 type atomicBool int32
@@ -73,13 +75,14 @@ func NewWalletExtension(config Config, logger gethlog.Logger) *WalletExtension {
 		accountManager: accountmanager.NewAccountManager(unauthedClient, logger),
 		persistence:    persistence.NewPersistence(config.NodeRPCWebsocketAddress, config.PersistencePathOverride, logger),
 		logger:         logger,
+		clientOpts:     attestationOpts(config, logger),
 	}
 
 	// We reload the existing viewing keys from persistence.
 	for accountAddr, viewingKey := range walletExtension.persistence.LoadViewingKeys() {
 		// create an encrypted RPC client with the signed VK and register it with the enclave
 		// TODO - Create the clients lazily, to reduce connections to the host.
-		client, err := rpc.NewEncNetworkClient(walletExtension.hostAddr, viewingKey, logger)
+		client, err := rpc.NewEncNetworkClient(walletExtension.hostAddr, viewingKey, logger, walletExtension.clientOpts...)
 		if err != nil {
 			logger.Error(fmt.Sprintf("failed to create encrypted RPC client for persisted account %s", accountAddr), log.ErrKey, err)
 			continue
@@ -90,6 +93,24 @@ func NewWalletExtension(config Config, logger gethlog.Logger) *WalletExtension {
 	return walletExtension
 }
 
+// Returns the options for checking the node's attestation before any viewing key is registered with it.
+func attestationOpts(config Config, logger gethlog.Logger) []rpc.EncRPCClientOption {
+	if config.InsecureSkipAttestation {
+		return []rpc.EncRPCClientOption{rpc.WithInsecureSkipAttestation(true)}
+	}
+	if config.AttestationPolicyPath == "" {
+		logger.Crit("an attestation policy is required to check the node's attestation, unless attestation is skipped for a local development network")
+	}
+	policy, err := attestation.LoadPolicy(config.AttestationPolicyPath)
+	if err != nil {
+		logger.Crit("could not load attestation policy", log.ErrKey, err)
+	}
+	if !policy.PinsEnclaveIdentity() {
+		logger.Crit("the attestation policy must specify the accepted unique IDs or signer ID, otherwise an enclave running any code is accepted")
+	}
+	return []rpc.EncRPCClientOption{rpc.WithAttestationPolicy(policy)}
+}
+
 // Serve listens for and serves Ethereum JSON-RPC requests and viewing-key generation requests.
 func (we *WalletExtension) Serve(host string, httpPort int, wsPort int) {
 	httpServer := we.createHTTPServer(host, httpPort)
@@ -225,7 +246,7 @@ func (we *WalletExtension) handleEthJSON(userConn userconn.UserConn) {
 		return
 	}
 
-	rpcReq, err := we.parseRequest(body)
+	rpcReq, err := we.parseRequest(body, userConn.Header(common.HeaderAccount))
 	if err != nil {
 		userConn.HandleError(err.Error())
 		return
@@ -301,7 +322,9 @@ func adjustStateRoot(rpcResp interface{}, respMap map[string]interface{}) {
 	}
 }
 
-func (we *WalletExtension) parseRequest(body []byte) (*accountmanager.RPCRequest, error) {
+// Parses the JSON-RPC request. The account to make the request with can be chosen using the request's `obscuroAccount`
+// member, or otherwise the account header.
+func (we *WalletExtension) parseRequest(body []byte, accountHeader string) (*accountmanager.RPCRequest, error) {
 	// We unmarshal the JSON request
 	var reqJSONMap map[string]json.RawMessage
 	err := json.Unmarshal(body, &reqJSONMap)
@@ -325,10 +348,27 @@ func (we *WalletExtension) parseRequest(body []byte) (*accountmanager.RPCRequest
 		return nil, fmt.Errorf("could not unmarshal params list from JSON-RPC request body: %w", err)
 	}
 
+	accountHex := accountHeader
+	if accountJSON, found := reqJSONMap[common.JSONKeyAccount]; found {
+		err = json.Unmarshal(accountJSON, &accountHex)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal %s string from JSON-RPC request body: %w", common.JSONKeyAccount, err)
+		}
+	}
+	var account *gethcommon.Address
+	if accountHex != "" {
+		if !gethcommon.IsHexAddress(accountHex) {
+			return nil, fmt.Errorf("requested account %s is not a valid address", accountHex)
+		}
+		address := gethcommon.HexToAddress(accountHex)
+		account = &address
+	}
+
 	return &accountmanager.RPCRequest{
-		ID:     reqID,
-		Method: method,
-		Params: params,
+		ID:      reqID,
+		Method:  method,
+		Params:  params,
+		Account: account,
 	}, nil
 }
 
@@ -404,7 +444,7 @@ func (we *WalletExtension) handleSubmitViewingKey(userConn userconn.UserConn) {
 	vk.SignedKey = signature
 	// create an encrypted RPC client with the signed VK and register it with the enclave
 	// TODO - Create the clients lazily, to reduce connections to the host.
-	client, err := rpc.NewEncNetworkClient(we.hostAddr, vk, we.logger)
+	client, err := rpc.NewEncNetworkClient(we.hostAddr, vk, we.logger, we.clientOpts...)
 	if err != nil {
 		userConn.HandleError(fmt.Sprintf("failed to create encrypted RPC client for account %s. Cause: %s", accAddress, err))
 		return
@@ -431,4 +471,6 @@ type Config struct {
 	LogPath                 string
 	PersistencePathOverride string // Overrides the persistence file location. Used in tests.
 	VerboseFlag             bool
+	AttestationPolicyPath   string // The path to the policy the node's attestation is checked against. Required unless attestation is skipped.
+	InsecureSkipAttestation bool   // Disables the checking of the node's attestation. Only safe for local development networks.
 }